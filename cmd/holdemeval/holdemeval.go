@@ -1,15 +1,30 @@
-// Binary holdemeval computes exact holdem hand equities for a given set
-// of hands.
+// Binary holdemeval computes holdem hand equities for a given set
+// of hands or ranges, either exactly or by sampling runouts.
 // For example:
 //   holdemeval -hands "AcKh KdTh QhQd" -board 7d8c8sTs
 // The board can be empty (in which case they are preflop equities),
 // or any number of cards up to 5.
+//
+// Cards that can't appear in the runouts, such as cards folded by other
+// players, can be given with -dead.
+//
+// With -samples or -stderr, equities are estimated by sampling random
+// runouts rather than enumerating all of them, and a 95% confidence
+// interval is shown. For example:
+//   holdemeval -hands "AcKh KdTh QhQd 7s6s" -samples 100000 -seed 42
+//
+// Exact range equities enumerate every runout for every combination of
+// combos, which can take hours preflop. So unless the board is complete,
+// range equities are sampled until the standard error is at most 0.1
+// percentage points, unless -exact is given.
 package main
 
 import (
 	"flag"
 	"fmt"
+	"math/rand"
 	"os"
+	"regexp"
 	"strings"
 
 	"github.com/paulhankin/poker/v2/poker"
@@ -18,49 +33,36 @@ import (
 var (
 	handsFlag = flag.String("hands", "", "hands to compare")
 	boardFlag = flag.String("board", "", "board cards to start with")
+	deadFlag  = flag.String("dead", "", "cards that are out of the deck")
+
+	samplesFlag = flag.Int("samples", 0, "if non-zero, estimate equities by sampling this many runouts")
+	stderrFlag  = flag.Float64("stderr", 0, "if non-zero, sample runouts until the standard error of each equity is at most this many percentage points")
+	seedFlag    = flag.Int64("seed", 1, "random seed used when sampling runouts")
+	combosFlag  = flag.Bool("combos", false, "show the equity of each combo in the ranges")
+	exactFlag   = flag.Bool("exact", false, "compute exact range equities, even when the board isn't complete")
 )
 
-func parseCard(s string) (poker.Card, error) {
-	if len(s) != 2 {
-		return 0, fmt.Errorf("card should be of length 2, like Ac, but got %q", s)
-	}
-	c, ok := poker.NameToCard[strings.ToUpper(s)]
-	if ok {
-		return c, nil
-	}
-	// try with suit and rank the other way round
-	c, ok = poker.NameToCard[strings.ToUpper(s[1:]+s[:1])]
-	if ok {
-		return c, nil
-	}
-	return 0, fmt.Errorf("failed to parse card %q", s)
-}
+// defaultRangeStdErr is the target standard error used when sampling
+// range equities without -samples or -stderr.
+const defaultRangeStdErr = 0.001
+
+var rangeCommaRE = regexp.MustCompile(`\s*,\s*`)
 
 func parseHand(s string) ([2]poker.Card, error) {
-	var hz [2]poker.Card
-	if len(s) != 4 {
-		return hz, fmt.Errorf("expect hand in format like AcKh, got %q", s)
-	}
-	c0, err0 := parseCard(s[:2])
-	c1, err1 := parseCard(s[2:])
-	if err0 == nil {
-		err0 = err1
+	h, err := poker.ParseHand(s)
+	if err != nil {
+		return [2]poker.Card{}, err
 	}
-	if err0 != nil {
-		return hz, err0
+	if len(h) != 2 {
+		return [2]poker.Card{}, fmt.Errorf("expect hand of 2 cards like AcKh, got %q", s)
 	}
-	return [2]poker.Card{c0, c1}, nil
-}
-
-func fmtHand(h [2]poker.Card) string {
-	s0 := h[0].Rank().String() + strings.ToLower(h[0].Suit().String())
-	s1 := h[1].Rank().String() + strings.ToLower(h[1].Suit().String())
-	return s0 + s1
+	return [2]poker.Card{h[0], h[1]}, nil
 }
 
 func main() {
 	flag.Parse()
 	var hands [][2]poker.Card
+	var ranges []poker.Range
 
 	fail := func(err error) {
 		fmt.Fprintf(os.Stderr, "error: %s", err)
@@ -71,34 +73,93 @@ func main() {
 		fail(fmt.Errorf("must specify one or more hands via the -hands flag"))
 	}
 
-	for _, p := range strings.Fields(*handsFlag) {
+	// Hands are separated by spaces, and the parts of a range by commas.
+	fields := strings.Fields(rangeCommaRE.ReplaceAllString(*handsFlag, ","))
+	for _, p := range fields {
 		h, err := parseHand(p)
 		if err != nil {
-			fail(err)
+			ranges = nil
+			break
 		}
 		hands = append(hands, h)
+		ranges = append(ranges, poker.Range{{Hand: h, Weight: 1}})
+	}
+	if len(hands) != len(fields) {
+		// Not all the hands are exact hole cards, so treat them as ranges.
+		hands = nil
+		for _, p := range fields {
+			r, err := poker.ParseRange(p)
+			if err != nil {
+				fail(fmt.Errorf("bad hand or range %q: %v", p, err))
+			}
+			ranges = append(ranges, r)
+		}
 	}
 
-	brd := strings.ReplaceAll(*boardFlag, " ", "")
-	if len(brd)%2 != 0 {
-		fail(fmt.Errorf("bad -board flag %q. Missing a suit or rank?", *boardFlag))
+	board, err := poker.ParseBoard(*boardFlag)
+	if err != nil {
+		fail(fmt.Errorf("bad -board flag: %v", err))
 	}
-	var board []poker.Card
-	for i := 0; i < len(brd); i += 2 {
-		c, err := parseCard(brd[i : i+2])
-		if err != nil {
-			fail(fmt.Errorf("bad -board flag %q: %v", *boardFlag, err))
-		}
-		board = append(board, c)
+	dead, err := poker.ParseHand(*deadFlag)
+	if err != nil {
+		fail(fmt.Errorf("bad -dead flag: %v", err))
 	}
 
-	eqs, err := poker.HoldemEquities(hands, board)
+	sampled := *samplesFlag != 0 || *stderrFlag != 0
+	sampleOpts := poker.SampleOptions{
+		Samples:      *samplesFlag,
+		TargetStdErr: *stderrFlag / 100,
+		Rand:         rand.New(rand.NewSource(*seedFlag)),
+	}
+	if sampled && *exactFlag {
+		fail(fmt.Errorf("-exact can't be used with -samples or -stderr"))
+	}
+	if hands == nil && !sampled && !*exactFlag && len(board) < 5 {
+		sampled = true
+		sampleOpts.TargetStdErr = defaultRangeStdErr
+	}
+	var eqs []poker.Equity
+	var reqs []poker.RangeEquity
+	if hands == nil || *combosFlag {
+		opts := poker.RangeOptions{PerCombo: *combosFlag, Dead: dead}
+		if sampled {
+			opts.Sample = &sampleOpts
+		}
+		reqs, err = poker.RangeEquities(ranges, board, opts)
+		for _, req := range reqs {
+			eqs = append(eqs, req.Equity)
+		}
+	} else if sampled {
+		sampleOpts.Dead = dead
+		eqs, err = poker.HoldemEquitiesSampled(hands, board, sampleOpts)
+	} else {
+		eqs, err = poker.HoldemEquitiesWithOptions(hands, board, poker.EquityOptions{Dead: dead})
+	}
 	if err != nil {
 		fail(fmt.Errorf("failed to compute equities: %v", err))
 	}
-	fmt.Printf("%d runouts evaluated\n", eqs[0].Boards)
-	for i := 0; i < len(hands); i++ {
-		fmt.Printf("%s: equity:%.02f%%\twin:%.02f%%\ttie:%.02f%%\n", fmtHand(hands[i]), eqs[i].Equity*100, eqs[i].Win*100, eqs[i].Tie*100)
+	if sampled {
+		fmt.Printf("%d runouts sampled\n", eqs[0].Boards)
+	} else {
+		fmt.Printf("%d runouts evaluated\n", eqs[0].Boards)
+	}
+	printEquity := func(name string, eq poker.Equity) {
+		fmt.Printf("%s: equity:%.02f%%\twin:%.02f%%\ttie:%.02f%%", name, eq.Equity*100, eq.Win*100, eq.Tie*100)
+		if sampled {
+			lo, hi := eq.ConfidenceInterval(1.96)
+			fmt.Printf("\t95%% CI:%.02f%%-%.02f%%", lo*100, hi*100)
+		}
+		fmt.Println()
+	}
+	for i := range eqs {
+		printEquity(fields[i], eqs[i])
+		if reqs == nil {
+			continue
+		}
+		for _, ce := range reqs[i].Combos {
+			if ce.Weight > 0 {
+				printEquity("  "+poker.Hand(ce.Hand[:]).Notation(), ce.Equity)
+			}
+		}
 	}
-
 }
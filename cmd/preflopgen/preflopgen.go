@@ -0,0 +1,90 @@
+// Binary preflopgen computes the table of all-in preflop equities of
+// each of the 169 classes of holdem hole cards against each other.
+// For example:
+//   preflopgen -o preflop.dat
+// The table can be loaded with poker.ReadPreflopTableFile.
+//
+// With -go, a Go source file is written instead, which contains the
+// table as a base64-encoded byte slice (in base64.RawStdEncoding, like
+// the tables in poker/tables_static.go) that can be compiled into a
+// program and read with
+//   poker.ReadPreflopTable(base64.NewDecoder(base64.RawStdEncoding, bytes.NewReader(data)))
+// For example:
+//   preflopgen -go preflop_data.go -pkg mypkg -var preflopData
+//
+// Computing the table takes a couple of hours of CPU time.
+package main
+
+import (
+	"bufio"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/paulhankin/poker/v2/poker"
+)
+
+var (
+	outFlag     = flag.String("o", "preflop.dat", "file to write the table to")
+	goFlag      = flag.String("go", "", "if set, write the table as Go source to this file instead")
+	pkgFlag     = flag.String("pkg", "main", "the package of the Go source written with -go")
+	varFlag     = flag.String("var", "preflopTableData", "the variable holding the table in the Go source written with -go")
+	workersFlag = flag.Int("workers", 0, "the number of goroutines to use, or 0 to use all CPUs")
+	quietFlag   = flag.Bool("quiet", false, "don't show progress")
+)
+
+func writeGoSource(name string, pt *poker.PreflopTable) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	fmt.Fprintf(w, "// Code generated by preflopgen. DO NOT EDIT.\n\npackage %s\n\n", *pkgFlag)
+	fmt.Fprintf(w, "// %s is a base64-encoded (base64.RawStdEncoding) table of\n", *varFlag)
+	fmt.Fprintf(w, "// preflop equities, which can be read with poker.ReadPreflopTable.\n")
+	fmt.Fprintf(w, "var %s = []uint8(\"", *varFlag)
+	e64 := base64.NewEncoder(base64.RawStdEncoding, w)
+	if err := pt.Write(e64); err != nil {
+		f.Close()
+		return err
+	}
+	if err := e64.Close(); err != nil {
+		f.Close()
+		return err
+	}
+	fmt.Fprintf(w, "\")\n")
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func main() {
+	flag.Parse()
+
+	opts := poker.PreflopTableOptions{Workers: *workersFlag}
+	if !*quietFlag {
+		opts.Progress = func(done, total int) {
+			if done%1000 == 0 || done == total {
+				fmt.Fprintf(os.Stderr, "%d/%d matchups\n", done, total)
+			}
+		}
+	}
+	pt, err := poker.ComputePreflopTable(opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to compute table: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *goFlag != "" {
+		err = writeGoSource(*goFlag, pt)
+	} else {
+		err = pt.WriteFile(*outFlag)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write table: %v\n", err)
+		os.Exit(1)
+	}
+}
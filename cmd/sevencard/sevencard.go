@@ -16,24 +16,15 @@ var (
 )
 
 func parseHand(s string) ([7]poker.Card, error) {
-	if len(s) != 14 {
-		return [7]poker.Card{}, fmt.Errorf("hand must be exactly 7 cards (14 characters), got %q", s)
-	}
-
 	var hand [7]poker.Card
-	for i := 0; i < 7; i++ {
-		cardStr := s[i*2 : i*2+2]
-		// Try first with suit then rank
-		c, ok := poker.NameToCard[strings.ToUpper(cardStr)]
-		if !ok {
-			// Try with rank and suit reversed
-			c, ok = poker.NameToCard[strings.ToUpper(string(cardStr[1])+string(cardStr[0]))]
-		}
-		if !ok {
-			return hand, fmt.Errorf("invalid card at position %d: %q", i, cardStr)
-		}
-		hand[i] = c
+	h, err := poker.ParseHand(s)
+	if err != nil {
+		return hand, err
+	}
+	if len(h) != 7 {
+		return hand, fmt.Errorf("hand must be exactly 7 cards, got %d in %q", len(h), s)
 	}
+	copy(hand[:], h)
 	return hand, nil
 }
 
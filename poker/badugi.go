@@ -0,0 +1,166 @@
+package poker
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ScoreBadugiMax is the largest possible score returned by the
+// badugi evaluators. It is the score of the best hand, 4-3-2-A
+// in four different suits.
+const ScoreBadugiMax = 1091
+
+type badugiInfos struct {
+	keyToScore map[int]int16
+	desc       [ScoreBadugiMax + 1]string
+}
+
+var (
+	badugiInfo     *badugiInfos
+	badugiInfoInit sync.Once
+)
+
+func getBadugiInfo() *badugiInfos {
+	badugiInfoInit.Do(func() {
+		badugiInfo = makeBadugiInfo()
+	})
+	return badugiInfo
+}
+
+// badugiKey returns a number that orders badugi hands given their ranks
+// (from 1 for ace to 13 for king) in decreasing order. The ranks
+// must be distinct, and the cards of distinct suits. Lower keys are
+// better hands: more cards is better, and then lower cards are better.
+func badugiKey(ranks []int) int {
+	k := 4 - len(ranks)
+	for i := 0; i < 4; i++ {
+		k *= 16
+		if i < len(ranks) {
+			k += ranks[i]
+		}
+	}
+	return k
+}
+
+func badugiDesc(ranks []int) string {
+	var parts []string
+	for _, r := range ranks {
+		parts = append(parts, Rank(r).String())
+	}
+	return fmt.Sprintf("%d-card %s badugi", len(ranks), strings.Join(parts, "-"))
+}
+
+func makeBadugiInfo() *badugiInfos {
+	// Enumerate every set of distinct ranks of size 1 to 4.
+	keys := map[int]string{}
+	for m := 1; m < 1<<13; m++ {
+		var ranks []int
+		for r := 13; r >= 1; r-- {
+			if m&(1<<(r-1)) != 0 {
+				ranks = append(ranks, r)
+			}
+		}
+		if len(ranks) > 4 {
+			continue
+		}
+		keys[badugiKey(ranks)] = badugiDesc(ranks)
+	}
+	var sorted []int
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Ints(sorted)
+	if ScoreBadugiMax != len(sorted)-1 {
+		log.Fatalf("Expected max badugi score of %d, but found %d", ScoreBadugiMax, len(sorted)-1)
+	}
+	bi := &badugiInfos{keyToScore: map[int]int16{}}
+	for i, k := range sorted {
+		// The best hands have the lowest keys, but the highest scores.
+		score := int16(ScoreBadugiMax - i)
+		bi.keyToScore[k] = score
+		bi.desc[score] = keys[k]
+	}
+	return bi
+}
+
+// EvalBadugi evaluates a 4-card badugi hand, returning a score
+// from 0 to ScoreBadugiMax (inclusive), where higher scores are better.
+// The hand plays the largest subset of its cards which are all of
+// different ranks and different suits, and more cards beat fewer.
+// Hands with the same number of cards are compared from the highest card
+// down, and lower cards are better. Aces are low.
+func EvalBadugi(hand *[4]Card) int16 {
+	needTableBadugi()
+	v := rootNodeBadugiTable[hand[0]]
+	tx := suitTransformByte(v)
+	idx := int(v >> 8)
+
+	v = rootNodeBadugiTable[idx+int(tx.Apply(hand[1]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	v = rootNodeBadugiTable[idx+int(tx.Apply(hand[2]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	return int16(rootNodeBadugiTable[idx+int(tx.Apply(hand[3]))])
+}
+
+// EvalSlowBadugi takes a 4-card badugi hand and returns its score,
+// in the same range as EvalBadugi.
+// This function should not generally be used, and EvalBadugi used
+// instead. It uses a straightforward algorithm for hand-ranking.
+// It returns -1 if the hand doesn't have 4 cards.
+func EvalSlowBadugi(c []Card) int16 {
+	if len(c) != 4 {
+		return -1
+	}
+	bi := getBadugiInfo()
+	best := int16(-1)
+	for m := 1; m < 1<<4; m++ {
+		var suits, ranks uint16
+		var rs []int
+		ok := true
+		for i, ci := range c {
+			if m&(1<<i) == 0 {
+				continue
+			}
+			sb, rb := uint16(1)<<ci.Suit(), uint16(1)<<ci.Rank()
+			if suits&sb != 0 || ranks&rb != 0 {
+				ok = false
+				break
+			}
+			suits |= sb
+			ranks |= rb
+			rs = append(rs, int(ci.Rank()))
+		}
+		if !ok {
+			continue
+		}
+		sort.Sort(sort.Reverse(sort.IntSlice(rs)))
+		if s := bi.keyToScore[badugiKey(rs)]; s > best {
+			best = s
+		}
+	}
+	return best
+}
+
+// DescribeBadugi describes a score returned by one of the badugi
+// evaluators, for example "4-card 7-4-2-A badugi" or "3-card 7-4-2 badugi".
+func DescribeBadugi(score int16) (string, error) {
+	if score < 0 || score > ScoreBadugiMax {
+		return "", fmt.Errorf("invalid badugi score %d", score)
+	}
+	return getBadugiInfo().desc[score], nil
+}
+
+// InternalTablesBadugi returns the table of data used in the
+// optimized badugi evaluator.
+// The contents of this table is subject to change.
+func InternalTablesBadugi() []uint32 {
+	needTableBadugi()
+	return rootNodeBadugiTable[:]
+}
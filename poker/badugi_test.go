@@ -0,0 +1,93 @@
+package poker
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestEvalBadugi(t *testing.T) {
+	testCases := []struct {
+		hand string
+		want string
+	}{
+		{"CA D2 H3 S4", "4-card 4-3-2-A badugi"},
+		{"C7 D4 H2 SA", "4-card 7-4-2-A badugi"},
+		{"CK DQ HJ ST", "4-card K-Q-J-T badugi"},
+		{"C7 D4 H2 S2", "3-card 7-4-2 badugi"},
+		{"C7 D4 H2 H3", "3-card 7-4-2 badugi"},
+		{"C7 C4 H2 SA", "3-card 4-2-A badugi"},
+		{"CK CQ DA DJ", "2-card Q-A badugi"},
+		{"C2 D2 H2 S2", "1-card 2 badugi"},
+		{"CA C2 C3 C4", "1-card A badugi"},
+		{"C5 C6 D5 D6", "2-card 6-5 badugi"},
+	}
+	for _, tc := range testCases {
+		var h [4]Card
+		copy(h[:], mustParseHand(t, tc.hand))
+		score := EvalBadugi(&h)
+		if slow := EvalSlowBadugi(h[:]); slow != score {
+			t.Errorf("EvalBadugi(%s) = %d, but EvalSlowBadugi = %d", tc.hand, score, slow)
+		}
+		got, err := DescribeBadugi(score)
+		if err != nil {
+			t.Fatalf("DescribeBadugi(EvalBadugi(%s)) failed: %v", tc.hand, err)
+		}
+		if got != tc.want {
+			t.Errorf("DescribeBadugi(EvalBadugi(%s)) = %q, want %q", tc.hand, got, tc.want)
+		}
+	}
+}
+
+func TestEvalSlowBadugiOrdering(t *testing.T) {
+	// Each hand is better than the one before it.
+	hands := []string{
+		"CK DK HK SK",
+		"CA DA HA SA",
+		"CK CQ DK DQ",
+		"CK CQ DA DJ",
+		"C3 C4 D2 DA",
+		"CK DQ HJ SJ",
+		"C3 D2 HA SA",
+		"CK DQ HJ ST",
+		"C5 D4 H3 SA",
+		"CA D2 H3 S4",
+	}
+	prev := int16(-1)
+	for _, hs := range hands {
+		got := EvalSlowBadugi(mustParseHand(t, hs))
+		if got <= prev {
+			t.Errorf("%s (score %d) isn't better than the previous hand (score %d)", hs, got, prev)
+		}
+		prev = got
+	}
+	if prev != ScoreBadugiMax {
+		t.Errorf("the best hand has score %d, want %d", prev, ScoreBadugiMax)
+	}
+}
+
+func TestEvalBadugiAll(t *testing.T) {
+	// Check every 4-card hand, in a random order.
+	rnd := rand.New(rand.NewSource(1))
+	for a := Card(0); a < Card(52); a++ {
+		for b := a + 1; b < Card(52); b++ {
+			for c := b + 1; c < Card(52); c++ {
+				for d := c + 1; d < Card(52); d++ {
+					h := [4]Card{a, b, c, d}
+					want := EvalSlowBadugi(h[:])
+					rnd.Shuffle(4, func(i, j int) { h[i], h[j] = h[j], h[i] })
+					if got := EvalBadugi(&h); got != want {
+						t.Fatalf("EvalBadugi(%s) = %d, want %d", Hand(h[:]), got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDescribeBadugiInvalid(t *testing.T) {
+	for _, score := range []int16{-1, ScoreBadugiMax + 1} {
+		if d, err := DescribeBadugi(score); err == nil {
+			t.Errorf("DescribeBadugi(%d) = %q, want error", score, d)
+		}
+	}
+}
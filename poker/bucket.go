@@ -0,0 +1,484 @@
+package poker
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"os"
+	"runtime"
+	"sync"
+)
+
+// DefaultHistogramBins is the number of histogram bins used by
+// ComputeBuckets when BucketOptions.Bins is zero.
+const DefaultHistogramBins = 50
+
+// DefaultBucketIterations is the maximum number of k-means iterations
+// used by ComputeBuckets when BucketOptions.Iterations is zero.
+const DefaultBucketIterations = 100
+
+// maxBuckets is the largest number of buckets, so that buckets
+// can be stored in a uint16.
+const maxBuckets = 1 << 16
+
+// BucketOptions configures how ComputeBuckets clusters hands.
+type BucketOptions struct {
+	// Buckets is the number of buckets, from 1 to 65536.
+	Buckets int
+
+	// Bins is the number of bins in the equity histogram of each hand.
+	// If it's zero, DefaultHistogramBins is used.
+	Bins int
+
+	// Samples, if positive, is the number of runouts sampled to estimate
+	// the histogram of each hand. Otherwise, every runout is enumerated,
+	// which is slow for hands before the turn.
+	Samples int
+
+	// Iterations is the maximum number of k-means iterations.
+	// If it's zero, DefaultBucketIterations is used.
+	Iterations int
+
+	// Seed seeds the random numbers used to sample runouts and to choose
+	// the initial clusters. The buckets depend only on the seed and the
+	// other options, and not on the number of workers.
+	Seed int64
+
+	// Workers is the maximum number of goroutines used to compute the
+	// histograms. If it's zero, runtime.NumCPU() is used.
+	Workers int
+
+	// Context, if not nil, can be used to cancel the computation,
+	// in which case the context's error is returned.
+	Context context.Context
+}
+
+// A BucketMap assigns each isomorphic class of holdem hands in a round
+// (as indexed by a HandIndexer) to a bucket, for use as a card
+// abstraction.
+type BucketMap struct {
+	indexer *HandIndexer
+	round   int
+	buckets int
+	bucket  []uint16 // the bucket of each hand index
+}
+
+// ComputeBuckets clusters the isomorphic classes of holdem hands dealt
+// up to and including the given round of the indexer into buckets.
+// The first round of the indexer must deal the 2 hole cards, and the
+// later rounds up to the given one must deal a board of 0, 3, 4 or 5
+// cards.
+//
+// Each hand is described by its equity distribution: the histogram of
+// its hand strength on the river against a random hand, over all the
+// runouts (see HandStrength.Histogram). The hands are clustered with
+// k-means, using the earth mover's distance between histograms,
+// with each class weighted by the number of hands in it.
+func ComputeBuckets(hi *HandIndexer, round int, opts BucketOptions) (*BucketMap, error) {
+	if round < 0 || round >= len(hi.rounds) {
+		return nil, fmt.Errorf("round %d out of range: want from 0 to %d", round, len(hi.rounds)-1)
+	}
+	if hi.rounds[0] != 2 {
+		return nil, fmt.Errorf("the first round deals %d cards: want 2 holdem hole cards", hi.rounds[0])
+	}
+	boardCards := 0
+	for _, n := range hi.rounds[1 : round+1] {
+		boardCards += n
+	}
+	if boardCards != 0 && (boardCards < 3 || boardCards > 5) {
+		return nil, fmt.Errorf("the board has %d cards by round %d: want 0, 3, 4 or 5", boardCards, round)
+	}
+	if opts.Buckets < 1 || opts.Buckets > maxBuckets {
+		return nil, fmt.Errorf("bad number of buckets %d: want from 1 to %d", opts.Buckets, maxBuckets)
+	}
+	if opts.Bins < 0 || opts.Samples < 0 || opts.Iterations < 0 {
+		return nil, fmt.Errorf("bad bucket options: %d bins, %d samples, %d iterations", opts.Bins, opts.Samples, opts.Iterations)
+	}
+	bins := opts.Bins
+	if bins == 0 {
+		bins = DefaultHistogramBins
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	hists, weights, err := bucketHistograms(ctx, hi, round, bins, opts)
+	if err != nil {
+		return nil, err
+	}
+	iters := opts.Iterations
+	if iters == 0 {
+		iters = DefaultBucketIterations
+	}
+	bm := &BucketMap{indexer: hi, round: round, buckets: opts.Buckets}
+	bm.bucket = kmeansEMD(ctx, hists, weights, bins, opts.Buckets, iters, rand.New(rand.NewSource(opts.Seed)))
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return bm, nil
+}
+
+// bucketHistograms returns the cumulative equity histogram of every
+// hand index in the round, stored consecutively, and the number of
+// hands in each class.
+func bucketHistograms(ctx context.Context, hi *HandIndexer, round, bins int, opts BucketOptions) ([]float32, []float64, error) {
+	size := hi.Size(round)
+	if size > math.MaxInt32 {
+		return nil, nil, fmt.Errorf("round %d has %d hand classes, which is too many to bucket", round, size)
+	}
+	n := int(size)
+	hists := make([]float32, n*bins)
+	weights := make([]float64, n)
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	work := make(chan int, workers)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for idx := range work {
+				if errs[w] != nil || ctx.Err() != nil {
+					continue
+				}
+				weights[idx], errs[w] = bucketHistogram(hi, round, idx, hists[idx*bins:(idx+1)*bins], opts)
+			}
+		}(w)
+	}
+	for idx := 0; idx < n && ctx.Err() == nil; idx++ {
+		work <- idx
+	}
+	close(work)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	for _, err := range errs {
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return hists, weights, nil
+}
+
+// bucketHistogram stores the cumulative equity histogram of the
+// hand with the given index in cdf, and returns the number of hands
+// isomorphic to it.
+func bucketHistogram(hi *HandIndexer, round, idx int, cdf []float32, opts BucketOptions) (float64, error) {
+	rs, err := hi.Unindex(round, uint64(idx))
+	if err != nil {
+		return 0, err
+	}
+	hole := [2]Card{rs[0][0], rs[0][1]}
+	var board []Card
+	for _, r := range rs[1:] {
+		board = append(board, r...)
+	}
+	hso := HandStrengthOptions{Workers: 1, Bins: len(cdf)}
+	if opts.Samples > 0 {
+		hso.Sample = &SampleOptions{
+			Samples: opts.Samples,
+			Rand:    rand.New(rand.NewSource(opts.Seed + int64(idx))),
+		}
+	}
+	hs, err := HoldemHandStrength(hole, board, hso)
+	if err != nil {
+		return 0, err
+	}
+	var sum float64
+	for i, p := range hs.Histogram {
+		sum += p
+		cdf[i] = float32(sum)
+	}
+	return float64(isomorphicHands(rs)), nil
+}
+
+// isomorphicHands returns the number of distinct hands which can be made
+// from the given hand by permuting suits.
+func isomorphicHands(rs [][]Card) int {
+	seen := map[[maxIndexerRounds]CardSet]bool{}
+	for _, st := range suitPermutations {
+		var key [maxIndexerRounds]CardSet
+		for r, cs := range rs {
+			key[r] = CardSet(st.applyMask(uint64(NewCardSet(cs...))))
+		}
+		seen[key] = true
+	}
+	return len(seen)
+}
+
+// emd returns the earth mover's distance between two histograms,
+// given as cumulative histograms. For one-dimensional histograms,
+// it's the L1 distance between the cumulative histograms.
+func emd(a, b []float32) float64 {
+	var d float64
+	for i := range a {
+		d += math.Abs(float64(a[i] - b[i]))
+	}
+	return d
+}
+
+// kmeansEMD clusters the cumulative histograms (each of the given number
+// of bins, stored consecutively) into k clusters, using k-means with the
+// earth mover's distance, and returns the cluster of each histogram.
+// The initial centers are chosen with k-means++. The center of a cluster
+// is the weighted mean of its cumulative histograms.
+func kmeansEMD(ctx context.Context, hists []float32, weights []float64, bins, k, iters int, rnd *rand.Rand) []uint16 {
+	n := len(weights)
+	hist := func(i int) []float32 { return hists[i*bins : (i+1)*bins] }
+	if k > n {
+		k = n
+	}
+	centers := make([]float32, k*bins)
+	center := func(c int) []float32 { return centers[c*bins : (c+1)*bins] }
+
+	// k-means++: choose each center with probability proportional to
+	// its weight times its squared distance from the nearest center.
+	dist := make([]float64, n)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	next := rnd.Intn(n)
+	for c := 0; c < k; c++ {
+		copy(center(c), hist(next))
+		var total float64
+		for i := range dist {
+			if d := emd(hist(i), center(c)); d < dist[i] {
+				dist[i] = d
+			}
+			total += weights[i] * dist[i] * dist[i]
+		}
+		if total == 0 {
+			// Every histogram is already a center.
+			next = rnd.Intn(n)
+			continue
+		}
+		x := rnd.Float64() * total
+		next = n - 1
+		for i := range dist {
+			x -= weights[i] * dist[i] * dist[i]
+			if x < 0 {
+				next = i
+				break
+			}
+		}
+	}
+
+	assign := make([]uint16, n)
+	sums := make([]float64, k*bins)
+	counts := make([]float64, k)
+	for it := 0; it < iters && ctx.Err() == nil; it++ {
+		changed := false
+		for i := 0; i < n; i++ {
+			best, bestD := 0, math.Inf(1)
+			for c := 0; c < k; c++ {
+				if d := emd(hist(i), center(c)); d < bestD {
+					best, bestD = c, d
+				}
+			}
+			if it == 0 || assign[i] != uint16(best) {
+				changed = true
+				assign[i] = uint16(best)
+			}
+			dist[i] = bestD
+		}
+		if !changed {
+			break
+		}
+		for i := range sums {
+			sums[i] = 0
+		}
+		for i := range counts {
+			counts[i] = 0
+		}
+		for i := 0; i < n; i++ {
+			c := int(assign[i])
+			counts[c] += weights[i]
+			for j, p := range hist(i) {
+				sums[c*bins+j] += weights[i] * float64(p)
+			}
+		}
+		for c := 0; c < k; c++ {
+			if counts[c] == 0 {
+				// Move an empty cluster to the histogram furthest
+				// from its center.
+				far := 0
+				for i := range dist {
+					if dist[i] > dist[far] {
+						far = i
+					}
+				}
+				copy(center(c), hist(far))
+				dist[far] = 0
+				continue
+			}
+			for j := range center(c) {
+				center(c)[j] = float32(sums[c*bins+j] / counts[c])
+			}
+		}
+	}
+	return assign
+}
+
+// Indexer returns the hand indexer used to index the hands.
+func (bm *BucketMap) Indexer() *HandIndexer {
+	return bm.indexer
+}
+
+// Round returns the round of the indexer whose hands are bucketed.
+func (bm *BucketMap) Round() int {
+	return bm.round
+}
+
+// Buckets returns the number of buckets.
+func (bm *BucketMap) Buckets() int {
+	return bm.buckets
+}
+
+// BucketOfIndex returns the bucket of the hand with the given index,
+// or -1 if the index is out of range.
+func (bm *BucketMap) BucketOfIndex(idx uint64) int {
+	if idx >= uint64(len(bm.bucket)) {
+		return -1
+	}
+	return int(bm.bucket[idx])
+}
+
+// Bucket returns the bucket of a hand, given the cards dealt in each
+// round up to and including the map's round, as for HandIndexer.IndexOf.
+func (bm *BucketMap) Bucket(rounds ...[]Card) (int, error) {
+	if len(rounds) != bm.round+1 {
+		return 0, fmt.Errorf("got %d rounds of cards: want %d", len(rounds), bm.round+1)
+	}
+	idx, err := bm.indexer.IndexOf(rounds...)
+	if err != nil {
+		return 0, err
+	}
+	return bm.BucketOfIndex(idx), nil
+}
+
+// bucketMapHeader is the start of a serialized bucket map, and is
+// followed by the cards in each round, and then the bucket of each
+// hand index.
+type bucketMapHeader struct {
+	Magic   [4]byte
+	Rounds  uint32
+	Round   uint32
+	Buckets uint32
+	Size    uint64
+}
+
+var bucketMapMagic = [4]byte{'P', 'K', 'B', 'M'}
+
+// Write writes the bucket map to w, as gzipped binary data.
+func (bm *BucketMap) Write(w io.Writer) error {
+	zf := gzip.NewWriter(w)
+	hdr := bucketMapHeader{
+		Magic:   bucketMapMagic,
+		Rounds:  uint32(len(bm.indexer.rounds)),
+		Round:   uint32(bm.round),
+		Buckets: uint32(bm.buckets),
+		Size:    uint64(len(bm.bucket)),
+	}
+	if err := binary.Write(zf, binary.LittleEndian, &hdr); err != nil {
+		return err
+	}
+	rounds := make([]uint32, len(bm.indexer.rounds))
+	for i, n := range bm.indexer.rounds {
+		rounds[i] = uint32(n)
+	}
+	if err := binary.Write(zf, binary.LittleEndian, rounds); err != nil {
+		return err
+	}
+	if err := binary.Write(zf, binary.LittleEndian, bm.bucket); err != nil {
+		return err
+	}
+	return zf.Close()
+}
+
+// WriteFile writes the bucket map to the named file, as Write does.
+func (bm *BucketMap) WriteFile(name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := bm.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadBucketMap reads a bucket map written by BucketMap.Write.
+func ReadBucketMap(r io.Reader) (*BucketMap, error) {
+	zf, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	var hdr bucketMapHeader
+	if err := binary.Read(zf, binary.LittleEndian, &hdr); err != nil {
+		return nil, err
+	}
+	if hdr.Magic != bucketMapMagic {
+		return nil, fmt.Errorf("not a bucket map")
+	}
+	if hdr.Rounds == 0 || hdr.Rounds > maxIndexerRounds || hdr.Round >= hdr.Rounds || hdr.Buckets == 0 || hdr.Buckets > maxBuckets {
+		return nil, fmt.Errorf("bad bucket map header: %d rounds, round %d, %d buckets", hdr.Rounds, hdr.Round, hdr.Buckets)
+	}
+	rounds := make([]uint32, hdr.Rounds)
+	if err := binary.Read(zf, binary.LittleEndian, rounds); err != nil {
+		return nil, err
+	}
+	cardsPerRound := make([]int, len(rounds))
+	for i, n := range rounds {
+		cardsPerRound[i] = int(n)
+	}
+	hi, err := NewHandIndexer(cardsPerRound...)
+	if err != nil {
+		return nil, err
+	}
+	if hi.Size(int(hdr.Round)) != hdr.Size {
+		return nil, fmt.Errorf("bucket map has %d hands, but round %d of rounds %v has %d", hdr.Size, hdr.Round, cardsPerRound, hi.Size(int(hdr.Round)))
+	}
+	bm := &BucketMap{indexer: hi, round: int(hdr.Round), buckets: int(hdr.Buckets)}
+	bm.bucket = make([]uint16, hdr.Size)
+	if err := binary.Read(zf, binary.LittleEndian, bm.bucket); err != nil {
+		return nil, err
+	}
+	for i, b := range bm.bucket {
+		if int(b) >= bm.buckets {
+			return nil, fmt.Errorf("hand %d is in bucket %d, but there are only %d buckets", i, b, bm.buckets)
+		}
+	}
+	// Read to the end of the stream, which checks the gzip trailer.
+	if n, err := io.Copy(ioutil.Discard, zf); err != nil || n > 0 {
+		if err == nil {
+			err = errors.New("bucket map is too long")
+		}
+		return nil, err
+	}
+	if err := zf.Close(); err != nil {
+		return nil, err
+	}
+	return bm, nil
+}
+
+// ReadBucketMapFile reads a bucket map from the named file.
+func ReadBucketMapFile(name string) (*BucketMap, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadBucketMap(f)
+}
@@ -0,0 +1,143 @@
+package poker
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestIsomorphicHands(t *testing.T) {
+	hi := mustHandIndexer(t, 2, 3)
+	total := 0
+	for idx := uint64(0); idx < hi.Size(0); idx++ {
+		rs, err := hi.Unindex(0, idx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		total += isomorphicHands(rs)
+	}
+	if total != 52*51/2 {
+		t.Errorf("preflop classes contain %d hands, want %d", total, 52*51/2)
+	}
+	for _, tc := range []struct {
+		rounds []string
+		want   int
+	}{
+		{[]string{"AcAd"}, 6},
+		{[]string{"AcKc"}, 4},
+		{[]string{"AcKd"}, 12},
+		{[]string{"AcKc", "2c3c4c"}, 4},
+		{[]string{"AcKd", "2h3h4s"}, 24},
+	} {
+		var rs [][]Card
+		for _, s := range tc.rounds {
+			rs = append(rs, mustParseHand(t, s))
+		}
+		if got := isomorphicHands(rs); got != tc.want {
+			t.Errorf("isomorphicHands(%v) = %d, want %d", rs, got, tc.want)
+		}
+	}
+}
+
+func TestKmeansEMD(t *testing.T) {
+	// Two clear clusters of cumulative histograms.
+	hists := []float32{
+		0.9, 1, 1,
+		1, 1, 1,
+		0.8, 1, 1,
+		0, 0.1, 1,
+		0, 0, 1,
+	}
+	weights := []float64{1, 1, 1, 1, 1}
+	for seed := int64(0); seed < 10; seed++ {
+		got := kmeansEMD(context.Background(), hists, weights, 3, 2, 100, rand.New(rand.NewSource(seed)))
+		if got[0] != got[1] || got[0] != got[2] || got[3] != got[4] || got[0] == got[3] {
+			t.Errorf("seed %d: kmeansEMD gave clusters %v", seed, got)
+		}
+	}
+}
+
+func TestComputeBucketsPreflop(t *testing.T) {
+	hi := mustHandIndexer(t, 2, 3, 1, 1)
+	opts := BucketOptions{Buckets: 5, Bins: 10, Samples: 40, Seed: 1}
+	bm, err := ComputeBuckets(hi, 0, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bucket := func(s string) int {
+		b, err := bm.Bucket(mustParseHand(t, s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	if a, b := bucket("AcAd"), bucket("AhAs"); a != b {
+		t.Errorf("AcAd is in bucket %d, but AhAs is in bucket %d", a, b)
+	}
+	if a, b := bucket("AcAd"), bucket("7c2d"); a == b {
+		t.Errorf("AcAd and 7c2d are both in bucket %d", a)
+	}
+	for idx := uint64(0); idx < hi.Size(0); idx++ {
+		if b := bm.BucketOfIndex(idx); b < 0 || b >= 5 {
+			t.Fatalf("hand %d is in bucket %d", idx, b)
+		}
+	}
+
+	// The buckets don't depend on the number of workers.
+	opts.Workers = 3
+	bm3, err := ComputeBuckets(hi, 0, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(bm3.bucket, bm.bucket) {
+		t.Errorf("buckets with 3 workers differ: %v, want %v", bm3.bucket, bm.bucket)
+	}
+
+	var buf bytes.Buffer
+	if err := bm.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	got, err := ReadBucketMap(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Round() != 0 || got.Buckets() != 5 || !reflect.DeepEqual(got.Indexer().Rounds(), []int{2, 3, 1, 1}) || !reflect.DeepEqual(got.bucket, bm.bucket) {
+		t.Errorf("ReadBucketMap(Write()) = %+v, want %+v", got, bm)
+	}
+
+	// The gzip trailer (a checksum and the size) is checked.
+	if _, err := ReadBucketMap(bytes.NewReader(data[:len(data)-8])); err == nil {
+		t.Errorf("ReadBucketMap succeeded without the gzip trailer, want error")
+	}
+	bad := append([]byte(nil), data...)
+	bad[len(bad)-8]++
+	if _, err := ReadBucketMap(bytes.NewReader(bad)); err == nil {
+		t.Errorf("ReadBucketMap succeeded with a bad gzip checksum, want error")
+	}
+}
+
+func TestComputeBucketsErrors(t *testing.T) {
+	holdem := mustHandIndexer(t, 2, 3, 1, 1)
+	for _, tc := range []struct {
+		hi    *HandIndexer
+		round int
+		opts  BucketOptions
+	}{
+		{holdem, 4, BucketOptions{Buckets: 2}},
+		{holdem, 0, BucketOptions{Buckets: 0}},
+		{holdem, 0, BucketOptions{Buckets: 1 << 17}},
+		{holdem, 0, BucketOptions{Buckets: 2, Bins: -1}},
+		{mustHandIndexer(t, 4, 3), 0, BucketOptions{Buckets: 2}},
+		{mustHandIndexer(t, 2, 1), 1, BucketOptions{Buckets: 2}},
+	} {
+		if _, err := ComputeBuckets(tc.hi, tc.round, tc.opts); err == nil {
+			t.Errorf("ComputeBuckets(%v, %d, %+v) succeeded, want error", tc.hi.Rounds(), tc.round, tc.opts)
+		}
+	}
+	if _, err := ReadBucketMap(bytes.NewReader([]byte("not a bucket map"))); err == nil {
+		t.Errorf("ReadBucketMap(garbage) succeeded, want error")
+	}
+}
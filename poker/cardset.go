@@ -0,0 +1,135 @@
+package poker
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// A CardSet is a set of cards, with bit c set if card c is in the set.
+// The zero value is the empty set.
+type CardSet uint64
+
+// FullDeck is the set of all 52 cards.
+const FullDeck CardSet = 1<<52 - 1
+
+// NewCardSet returns the set of the given cards. Invalid cards are ignored.
+func NewCardSet(cards ...Card) CardSet {
+	var s CardSet
+	for _, c := range cards {
+		s = s.Add(c)
+	}
+	return s
+}
+
+// CardSet returns the set of cards in the hand.
+// Invalid cards are ignored.
+func (h Hand) CardSet() CardSet {
+	return NewCardSet(h...)
+}
+
+// Add returns the set with the card added. Invalid cards are ignored.
+func (s CardSet) Add(c Card) CardSet {
+	if !c.Valid() {
+		return s
+	}
+	return s | 1<<c
+}
+
+// Remove returns the set with the card removed.
+func (s CardSet) Remove(c Card) CardSet {
+	if !c.Valid() {
+		return s
+	}
+	return s &^ (1 << c)
+}
+
+// Contains reports whether the card is in the set.
+func (s CardSet) Contains(c Card) bool {
+	return c.Valid() && s&(1<<c) != 0
+}
+
+// Count returns the number of cards in the set.
+func (s CardSet) Count() int {
+	return bits.OnesCount64(uint64(s))
+}
+
+// Union returns the cards in either set.
+func (s CardSet) Union(t CardSet) CardSet {
+	return s | t
+}
+
+// Intersect returns the cards in both sets.
+func (s CardSet) Intersect(t CardSet) CardSet {
+	return s & t
+}
+
+// Minus returns the cards in s which aren't in t.
+func (s CardSet) Minus(t CardSet) CardSet {
+	return s &^ t
+}
+
+// Cards returns the cards in the set, in increasing order of Card value
+// (that is, by rank from ace to king, and then by suit).
+func (s CardSet) Cards() Hand {
+	h := make(Hand, 0, s.Count())
+	for m := uint64(s); m != 0; m &= m - 1 {
+		h = append(h, Card(bits.TrailingZeros64(m)))
+	}
+	return h
+}
+
+// CopyTo copies the cards in the set, in the same order as Cards,
+// to dst. It returns the number of cards copied, which is the
+// smaller of len(dst) and s.Count().
+// It can be used to fill an array: s.CopyTo(hand[:]).
+func (s CardSet) CopyTo(dst []Card) int {
+	n := 0
+	for m := uint64(s); m != 0 && n < len(dst); m &= m - 1 {
+		dst[n] = Card(bits.TrailingZeros64(m))
+		n++
+	}
+	return n
+}
+
+// Array5 returns the cards in the set as a 5-card array, for example
+// for Eval5. The second return value is whether the set has exactly
+// 5 cards.
+func (s CardSet) Array5() ([5]Card, bool) {
+	var h [5]Card
+	s.CopyTo(h[:])
+	return h, s.Count() == 5
+}
+
+// Array7 returns the cards in the set as a 7-card array, for example
+// for Eval7. The second return value is whether the set has exactly
+// 7 cards.
+func (s CardSet) Array7() ([7]Card, bool) {
+	var h [7]Card
+	s.CopyTo(h[:])
+	return h, s.Count() == 7
+}
+
+// String returns the cards in the set in conventional form,
+// for example "{2c Td Ah}".
+func (s CardSet) String() string {
+	var parts []string
+	for _, c := range s.Cards() {
+		parts = append(parts, c.Notation())
+	}
+	return "{" + strings.Join(parts, " ") + "}"
+}
+
+// ParseCardSet parses a set of cards in any of the forms accepted by
+// ParseHand. Braces around the cards, as produced by String, are allowed.
+func ParseCardSet(s string) (CardSet, error) {
+	t := strings.TrimSpace(s)
+	if strings.HasPrefix(t, "{") && strings.HasSuffix(t, "}") {
+		t = t[1 : len(t)-1]
+	}
+	h, err := ParseHand(t)
+	if err != nil {
+		return 0, fmt.Errorf("can't parse card set %q: %v", s, err)
+	}
+	return h.CardSet(), nil
+}
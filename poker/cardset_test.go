@@ -0,0 +1,133 @@
+package poker
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestCardSetOps(t *testing.T) {
+	a := NewCardSet(mustParseHand(t, "AcKdQh")...)
+	b := NewCardSet(mustParseHand(t, "QhJs2c")...)
+
+	if got, want := a.Count(), 3; got != want {
+		t.Errorf("%s.Count() = %d, want %d", a, got, want)
+	}
+	for _, tc := range []struct {
+		name string
+		got  CardSet
+		want string
+	}{
+		{"union", a.Union(b), "AcKdQhJs2c"},
+		{"intersect", a.Intersect(b), "Qh"},
+		{"minus", a.Minus(b), "AcKd"},
+		{"add", a.Add(mustParseHand(t, "Td")[0]), "AcKdQhTd"},
+		{"add existing", a.Add(mustParseHand(t, "Ac")[0]), "AcKdQh"},
+		{"remove", a.Remove(mustParseHand(t, "Kd")[0]), "AcQh"},
+		{"remove missing", a.Remove(mustParseHand(t, "2c")[0]), "AcKdQh"},
+		{"add invalid", a.Add(52), "AcKdQh"},
+	} {
+		want := NewCardSet(mustParseHand(t, tc.want)...)
+		if tc.got != want {
+			t.Errorf("%s: got %s, want %s", tc.name, tc.got, want)
+		}
+	}
+	for _, c := range Cards {
+		if got, want := a.Contains(c), c == a.Cards()[0] || c == a.Cards()[1] || c == a.Cards()[2]; got != want {
+			t.Errorf("%s.Contains(%s) = %v, want %v", a, c, got, want)
+		}
+	}
+	if a.Contains(52) {
+		t.Errorf("%s.Contains(52) = true, want false", a)
+	}
+	if got := FullDeck.Count(); got != 52 {
+		t.Errorf("FullDeck.Count() = %d, want 52", got)
+	}
+	if got := Hand(Cards).CardSet(); got != FullDeck {
+		t.Errorf("NewCardSet(Cards...) = %s, want FullDeck", got)
+	}
+}
+
+func TestCardSetCards(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	for i := 0; i < 1000; i++ {
+		var s CardSet
+		want := map[Card]bool{}
+		for j := rnd.Intn(20); j > 0; j-- {
+			c := Cards[rnd.Intn(len(Cards))]
+			s = s.Add(c)
+			want[c] = true
+		}
+		cards := s.Cards()
+		if len(cards) != len(want) || s.Count() != len(want) {
+			t.Fatalf("%s has %d cards and count %d, want %d", s, len(cards), s.Count(), len(want))
+		}
+		for j, c := range cards {
+			if !want[c] {
+				t.Errorf("%s.Cards() contains %s, which wasn't added", s, c)
+			}
+			if j > 0 && cards[j-1] >= c {
+				t.Errorf("%s.Cards() = %s, not in increasing order", s, cards)
+			}
+		}
+		if got := NewCardSet(cards...); got != s {
+			t.Errorf("NewCardSet(%s.Cards()) = %s", s, got)
+		}
+	}
+}
+
+func TestCardSetArrays(t *testing.T) {
+	s := NewCardSet(mustParseHand(t, "AcKdQhJsTc9d8h")...)
+	h7, ok := s.Array7()
+	if !ok {
+		t.Fatalf("%s.Array7() returned not ok", s)
+	}
+	if got := NewCardSet(h7[:]...); got != s {
+		t.Errorf("%s.Array7() = %s", s, Hand(h7[:]))
+	}
+	if _, ok := s.Array5(); ok {
+		t.Errorf("%s.Array5() returned ok, but the set has 7 cards", s)
+	}
+	s5 := s.Minus(NewCardSet(mustParseHand(t, "9d8h")...))
+	h5, ok := s5.Array5()
+	if !ok {
+		t.Fatalf("%s.Array5() returned not ok", s5)
+	}
+	if got, want := Eval5(&h5), EvalSlow(mustParseHand(t, "AcKdQhJsTc")); got != want {
+		t.Errorf("Eval5(%s) = %d, want %d", Hand(h5[:]), got, want)
+	}
+	var dst [3]Card
+	if n := s.CopyTo(dst[:]); n != 3 {
+		t.Errorf("%s.CopyTo(3 cards) = %d, want 3", s, n)
+	}
+}
+
+func TestCardSetParse(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want string
+	}{
+		{"AcKh", "{Ac Kh}"},
+		{"Kh Ac", "{Ac Kh}"},
+		{"{2c Td Ah}", "{Ah 2c Td}"},
+		{"", "{}"},
+		{"{}", "{}"},
+	} {
+		s, err := ParseCardSet(tc.s)
+		if err != nil {
+			t.Errorf("ParseCardSet(%q) failed: %v", tc.s, err)
+			continue
+		}
+		if got := s.String(); got != tc.want {
+			t.Errorf("ParseCardSet(%q) = %s, want %s", tc.s, got, tc.want)
+		}
+		s2, err := ParseCardSet(s.String())
+		if err != nil || s2 != s {
+			t.Errorf("ParseCardSet(%q) = %s, %v, want %s", s.String(), s2, err, s)
+		}
+	}
+	for _, bad := range []string{"AcAc", "Xx", "{Ac"} {
+		if s, err := ParseCardSet(bad); err == nil {
+			t.Errorf("ParseCardSet(%q) = %s, want error", bad, s)
+		}
+	}
+}
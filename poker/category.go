@@ -0,0 +1,129 @@
+package poker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A HandCategory is the type of a poker hand, such as a flush or
+// two pair. Categories are ordered so that better categories are larger.
+type HandCategory int
+
+// The hand categories, from worst to best.
+const (
+	HighCard HandCategory = iota
+	OnePair
+	TwoPair
+	ThreeOfAKind
+	Straight
+	Flush
+	FullHouse
+	FourOfAKind
+	StraightFlush
+	FiveOfAKind
+)
+
+var handCategoryNames = [...]string{
+	HighCard:      "high card",
+	OnePair:       "one pair",
+	TwoPair:       "two pair",
+	ThreeOfAKind:  "three of a kind",
+	Straight:      "straight",
+	Flush:         "flush",
+	FullHouse:     "full house",
+	FourOfAKind:   "four of a kind",
+	StraightFlush: "straight flush",
+	FiveOfAKind:   "five of a kind",
+}
+
+func (hc HandCategory) String() string {
+	if hc < 0 || int(hc) >= len(handCategoryNames) {
+		return "?"
+	}
+	return handCategoryNames[hc]
+}
+
+// A HandDescription is a structured description of the strength of
+// a poker hand.
+//
+// Ranks are the ranks that define the hand within its category, from
+// most to least significant: the rank of the pair, trips or quads,
+// both pairs of two pair (higher first), the trips then the pair of a
+// full house, the top card of a straight, or the highest card of a
+// flush or high-card hand.
+// Kickers are the remaining cards that break ties, from highest to
+// lowest. A 3-card hand has fewer kickers than the equivalent 5-card hand.
+type HandDescription struct {
+	Category HandCategory
+	Ranks    []Rank
+	Kickers  []Rank
+}
+
+// DescribeScore describes a score returned by Eval3, Eval5, Eval7 or
+// EvalSlow. The score fully determines the description, so no cards
+// are needed.
+func DescribeScore(score int16) (HandDescription, error) {
+	if score < 0 || score > ScoreMax {
+		return HandDescription{}, fmt.Errorf("invalid score %d", score)
+	}
+	// The slow rank is the category followed by 5 nibbles, each either
+	// 0 (no card) or a rank from 2 to 14 (ace).
+	r := evalInfo.packedToSlowRank[score]
+	var ranks []Rank
+	for i := 4; i >= 0; i-- {
+		if n := (r >> uint(4*i)) & 15; n != 0 {
+			ranks = append(ranks, Rank((n-1)%13+1))
+		}
+	}
+	hd := HandDescription{Category: HandCategory(r >> 20)}
+	primary := 1
+	if hd.Category == TwoPair || hd.Category == FullHouse {
+		primary = 2
+	}
+	hd.Ranks = ranks[:primary:primary]
+	if len(ranks) > primary {
+		hd.Kickers = ranks[primary:]
+	}
+	return hd, nil
+}
+
+// String returns the same text as Describe does for hands with this
+// description, for example "KKK-8-7", "AA-QQ-4" or "T straight".
+func (hd HandDescription) String() string {
+	rep := func(r Rank, n int) string {
+		return strings.Repeat(r.String(), n)
+	}
+	if len(hd.Ranks) == 0 || (len(hd.Ranks) == 1 && (hd.Category == TwoPair || hd.Category == FullHouse)) {
+		return "?"
+	}
+	var parts []string
+	switch hd.Category {
+	case HighCard, Flush:
+		parts = append(parts, hd.Ranks[0].String())
+	case OnePair:
+		parts = append(parts, rep(hd.Ranks[0], 2))
+	case TwoPair:
+		parts = append(parts, rep(hd.Ranks[0], 2), rep(hd.Ranks[1], 2))
+	case ThreeOfAKind:
+		parts = append(parts, rep(hd.Ranks[0], 3))
+	case Straight:
+		return hd.Ranks[0].String() + " straight"
+	case FullHouse:
+		parts = append(parts, rep(hd.Ranks[0], 3), rep(hd.Ranks[1], 2))
+	case FourOfAKind:
+		parts = append(parts, rep(hd.Ranks[0], 4))
+	case StraightFlush:
+		return hd.Ranks[0].String() + " straight flush"
+	case FiveOfAKind:
+		return rep(hd.Ranks[0], 5)
+	default:
+		return "?"
+	}
+	for _, k := range hd.Kickers {
+		parts = append(parts, k.String())
+	}
+	if hd.Category == Flush {
+		return strings.Join(parts, "") + " flush"
+	}
+	return strings.Join(parts, "-")
+}
@@ -0,0 +1,87 @@
+package poker
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestDescribeScore(t *testing.T) {
+	testCases := []struct {
+		hand string
+		want HandDescription
+	}{
+		{"HA HK HQ HJ HT", HandDescription{StraightFlush, []Rank{1}, nil}},
+		{"SK HK DK C2 H2", HandDescription{FullHouse, []Rank{13, 2}, nil}},
+		{"HA HQ H8 H7 H5", HandDescription{Flush, []Rank{1}, []Rank{12, 8, 7, 5}}},
+		{"H5 D4 C3 D2 CA", HandDescription{Straight, []Rank{5}, nil}},
+		{"HQ DQ CQ C3 D2", HandDescription{ThreeOfAKind, []Rank{12}, []Rank{3, 2}}},
+		{"H9 D9 C7 D7 CA", HandDescription{TwoPair, []Rank{9, 7}, []Rank{1}}},
+		{"HK DK S2 D3 CQ DJ D7", HandDescription{OnePair, []Rank{13}, []Rank{12, 11, 7}}},
+		{"S7 D5 H4 S3 S2", HandDescription{HighCard, []Rank{7}, []Rank{5, 4, 3, 2}}},
+		{"DT CT HK", HandDescription{OnePair, []Rank{10}, []Rank{13}}},
+		{"HA SA DA", HandDescription{ThreeOfAKind, []Rank{1}, nil}},
+	}
+	for _, tc := range testCases {
+		h := mustParseHand(t, tc.hand)
+		got, err := DescribeScore(EvalSlow(h))
+		if err != nil {
+			t.Fatalf("DescribeScore(EvalSlow(%s)) failed: %v", tc.hand, err)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("DescribeScore(EvalSlow(%s)) = %+v, want %+v", tc.hand, got, tc.want)
+		}
+	}
+}
+
+func TestDescribeScoreMatchesDescribe(t *testing.T) {
+	var counts [FiveOfAKind + 1]int
+	for score := int16(0); score <= ScoreMax; score++ {
+		hd, err := DescribeScore(score)
+		if err != nil {
+			t.Fatalf("DescribeScore(%d) failed: %v", score, err)
+		}
+		counts[hd.Category]++
+		h, ok := EvalToHand5(score)
+		if !ok {
+			h, ok = EvalToHand3(score)
+		}
+		if !ok {
+			t.Fatalf("score %d has no example hand", score)
+		}
+		want, err := Describe(h)
+		if err != nil {
+			t.Fatalf("Describe(%s) failed: %v", Hand(h), err)
+		}
+		if got := hd.String(); got != want {
+			t.Errorf("DescribeScore(%d).String() = %q, want %q (as Describe(%s))", score, got, want, Hand(h))
+		}
+	}
+	if counts[StraightFlush] != 10 || counts[FiveOfAKind] != 13 {
+		t.Errorf("got %d straight flushes and %d five-of-a-kinds, want 10 and 13", counts[StraightFlush], counts[FiveOfAKind])
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		var h [7]Card
+		for j, k := range rnd.Perm(52)[:7] {
+			h[j] = Cards[k]
+		}
+		hd, err := DescribeScore(Eval7(&h))
+		if err != nil {
+			t.Fatalf("DescribeScore(Eval7(%s)) failed: %v", Hand(h[:]), err)
+		}
+		want, _ := Describe(h[:])
+		if got := hd.String(); got != want {
+			t.Errorf("DescribeScore(Eval7(%s)).String() = %q, want %q", Hand(h[:]), got, want)
+		}
+	}
+}
+
+func TestDescribeScoreInvalid(t *testing.T) {
+	for _, score := range []int16{-1, ScoreMax + 1} {
+		if hd, err := DescribeScore(score); err == nil {
+			t.Errorf("DescribeScore(%d) = %+v, want error", score, hd)
+		}
+	}
+}
@@ -2,22 +2,17 @@ package poker
 
 import (
 	"sort"
-	"strings"
 	"testing"
 )
 
-// This is copied from poker_test.go to avoid export issues
-func parseHandForTest(t *testing.T, s string) []Card {
-	cs := strings.Split(s, " ")
-	r := make([]Card, len(cs))
-	for i, p := range cs {
-		var ok bool
-		r[i], ok = NameToCard[p]
-		if !ok {
-			t.Fatalf("can't parse card %s", p)
-		}
+// mustParseHand parses a hand, failing the test if it can't be parsed.
+func mustParseHand(t *testing.T, s string) []Card {
+	t.Helper()
+	h, err := ParseHand(s)
+	if err != nil {
+		t.Fatal(err)
 	}
-	return r
+	return h
 }
 
 // sortCards sorts cards in descending order by rank for 2-7 evaluation
@@ -136,8 +131,8 @@ func TestEval27(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			hand1 := parseHandForTest(t, tt.hand1)
-			hand2 := parseHandForTest(t, tt.hand2)
+			hand1 := mustParseHand(t, tt.hand1)
+			hand2 := mustParseHand(t, tt.hand2)
 
 			// Sort both hands before evaluation
 			sortCards(hand1)
@@ -166,3 +161,87 @@ func TestEval27(t *testing.T) {
 		})
 	}
 }
+
+func TestEval27Table(t *testing.T) {
+	fails := 0
+	for a := Card(0); a < Card(52); a++ {
+		for b := Card(a) + 1; b < Card(52); b++ {
+			for c := Card(b) + 1; c < Card(52); c++ {
+				for d := Card(c) + 1; d < Card(52); d++ {
+					for e := Card(d) + 1; e < Card(52); e++ {
+						wantEval := EvalSlow27([]Card{a, b, c, d, e})
+						for perms := 0; perms < 120; perms += 40 {
+							h := [5]Card{a, b, c, d, e}
+							for i := 0; i < 5; i++ {
+								h[i], h[i+perms%(5-i)] = h[i+perms%(5-i)], h[i]
+							}
+							gotEval := Eval27(&h)
+							if gotEval != wantEval {
+								t.Errorf("Eval27(%v) = %d, want %d", h[:], gotEval, wantEval)
+								fails++
+								if fails > 20 {
+									t.Fatalf("too many failures")
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestEval27Extremes(t *testing.T) {
+	tcs := []struct {
+		hand string
+		want int16
+	}{
+		{"D7 H5 C4 S3 H2", 0},
+		{"D7 H5 C4 S3 D2", 0},
+		{"SA SK SQ SJ ST", Score27Max},
+	}
+	for _, tc := range tcs {
+		var h [5]Card
+		copy(h[:], mustParseHand(t, tc.hand))
+		if got := Eval27(&h); got != tc.want {
+			t.Errorf("Eval27(%s) = %d, want %d", tc.hand, got, tc.want)
+		}
+	}
+}
+
+func TestEvalToHand27(t *testing.T) {
+	for e := int16(0); e <= Score27Max; e++ {
+		h, ok := EvalToHand27(e)
+		if !ok {
+			t.Fatalf("EvalToHand27(%d) failed", e)
+		}
+		if got := EvalSlow27(h); got != e {
+			t.Errorf("EvalSlow27(EvalToHand27(%d)) = %d (hand %v)", e, got, Hand(h))
+		}
+	}
+}
+
+func BenchmarkEval27(b *testing.B) {
+	var S int64
+	for i := 0; i < b.N; i++ {
+		var T int64
+		for a := Card(0); a < Card(52); a++ {
+			for b := Card(a) + 1; b < Card(52); b++ {
+				for c := Card(b) + 1; c < Card(52); c++ {
+					for d := Card(c) + 1; d < Card(52); d++ {
+						for e := Card(d) + 1; e < Card(52); e++ {
+							h := [5]Card{a, b, c, d, e}
+							T += int64(Eval27(&h))
+							S++
+						}
+					}
+				}
+			}
+		}
+		// make sure we're not optimizing the code away.
+		if T == 0 {
+			panic("x")
+		}
+	}
+	b.Logf("1 op is %d 5-card hands\n", S/int64(b.N))
+}
@@ -1,17 +1,35 @@
 package poker
 
 import (
+	"context"
 	"fmt"
+	"runtime"
 	"sort"
 	"strings"
+	"sync"
 )
 
 // Equity contains information about poker hand equity.
+//
+// In split-pot (hi/lo) games, the pot is split between the best high
+// hand and the best qualifying low hand, and High and Low break down
+// where the equity comes from. If there's no qualifying low, the high hand
+// wins the whole pot. Win is the equity gained from outright winning either
+// half (or the whole pot), and Tie is the probability of tieing
+// for either half. For other games, High, Low and Scoop are zero.
 type Equity struct {
 	Equity float64 // total equity in the pot
 	Win    float64 // equity gained from outright winning the pot
 	Tie    float64 // probability of tieing with 1 or more hands
 	Boards int     // how many runouts were computed
+
+	// StdErr is the standard error of Equity when it's estimated
+	// by sampling runouts. It's zero for exact results.
+	StdErr float64
+
+	High  float64 // equity gained from the high half of split pots
+	Low   float64 // equity gained from the low half of split pots
+	Scoop float64 // probability of winning the whole of a split pot
 }
 
 func boardString(b []Card) string {
@@ -22,13 +40,28 @@ func boardString(b []Card) string {
 	return "[" + strings.Join(parts, " ") + "]"
 }
 
-func holdemRiverEquities(hbs [][7]Card, evs []int16, eqs []Equity) {
-	H := len(hbs)
+// holdemRiverEquities evaluates the 7-card hands, and awards
+// w pots to the best of them.
+func holdemRiverEquities(hbs [][7]Card, evs []int16, eqs []Equity, w float64) {
+	for i := range hbs {
+		evs[i] = Eval7(&hbs[i])
+	}
+	awardPotWeighted(evs, eqs, w)
+}
+
+// awardPot splits the pot between the hands with the best evaluations,
+// adding to their equities.
+func awardPot(evs []int16, eqs []Equity) {
+	awardPotWeighted(evs, eqs, 1)
+}
+
+// awardPotWeighted is like awardPot, but awards w identical pots.
+func awardPotWeighted(evs []int16, eqs []Equity, w float64) {
+	H := len(evs)
 	winCount := 0
 	var bestEV int16 = -1000
 	for i := 0; i < H; i++ {
-		ev := Eval7(&hbs[i])
-		evs[i] = ev
+		ev := evs[i]
 		if ev > bestEV {
 			winCount = 1
 			bestEV = ev
@@ -37,7 +70,7 @@ func holdemRiverEquities(hbs [][7]Card, evs []int16, eqs []Equity) {
 		}
 	}
 
-	v := 1.0 / float64(winCount)
+	v := w / float64(winCount)
 	for i := 0; i < H; i++ {
 		if evs[i] != bestEV {
 			continue
@@ -46,38 +79,125 @@ func holdemRiverEquities(hbs [][7]Card, evs []int16, eqs []Equity) {
 		if winCount == 1 {
 			eqs[i].Win += v
 		} else {
+			eqs[i].Tie += w
+		}
+	}
+}
+
+// awardPotHiLo splits the pot between the best high and the best
+// qualifying low hands, adding to their equities. If no hand
+// has a qualifying low (that is, all of los are NoLow), then the
+// high hands share the whole pot.
+func awardPotHiLo(his, los []int16, eqs []Equity) {
+	H := len(his)
+	var bestHi, bestLo int16 = -1000, NoLow
+	hiCount, loCount := 0, 0
+	for i := 0; i < H; i++ {
+		if his[i] > bestHi {
+			hiCount = 1
+			bestHi = his[i]
+		} else if his[i] == bestHi {
+			hiCount++
+		}
+		if los[i] == NoLow {
+			continue
+		}
+		if los[i] > bestLo {
+			loCount = 1
+			bestLo = los[i]
+		} else if los[i] == bestLo {
+			loCount++
+		}
+	}
+
+	hiPot := 1.0
+	if loCount > 0 {
+		hiPot = 0.5
+	}
+	for i := 0; i < H; i++ {
+		var share float64
+		tied := false
+		if his[i] == bestHi {
+			v := hiPot / float64(hiCount)
+			share += v
+			eqs[i].High += v
+			if hiCount == 1 {
+				eqs[i].Win += v
+			} else {
+				tied = true
+			}
+		}
+		if loCount > 0 && los[i] == bestLo {
+			v := 0.5 / float64(loCount)
+			share += v
+			eqs[i].Low += v
+			if loCount == 1 {
+				eqs[i].Win += v
+			} else {
+				tied = true
+			}
+		}
+		eqs[i].Equity += share
+		if tied {
 			eqs[i].Tie += 1.0
 		}
+		if share == 1.0 {
+			eqs[i].Scoop += 1.0
+		}
 	}
 }
 
 func getRemainingDeck(hands [][2]Card, board []Card) ([]Card, error) {
-	got := map[Card]int{}
-	for i, h := range hands {
-		if !h[0].Valid() {
-			return nil, fmt.Errorf("hand %d contains invalid first card %d", i, h[0])
+	return getRemainingDeckDead(hands, board, nil)
+}
+
+// getRemainingDeckDead is like getRemainingDeck, but the dead
+// cards are also removed from the deck.
+func getRemainingDeckDead(hands [][2]Card, board, dead []Card) ([]Card, error) {
+	hs := make([][]Card, len(hands))
+	for i := range hands {
+		hs[i] = hands[i][:]
+	}
+	return getRemainingDeckN(hs, board, dead)
+}
+
+// getRemainingDeckN is like getRemainingDeckDead, but the hands
+// can have any number of cards.
+func getRemainingDeckN(hands [][]Card, board, dead []Card) ([]Card, error) {
+	var got, dups CardSet
+	add := func(c Card) {
+		if got.Contains(c) {
+			dups = dups.Add(c)
 		}
-		if !h[1].Valid() {
-			return nil, fmt.Errorf("hand %d contains invalid second card %d", i, h[1])
+		got = got.Add(c)
+	}
+	for i, h := range hands {
+		for j, c := range h {
+			if !c.Valid() {
+				return nil, fmt.Errorf("hand %d contains invalid card %d at position %d", i, c, j)
+			}
+			add(c)
 		}
-		got[h[0]]++
-		got[h[1]]++
 	}
 	for i, b := range board {
 		if !b.Valid() {
 			return nil, fmt.Errorf("board[%d] card is invalid: %d", i, b)
 		}
-		got[b]++
+		add(b)
 	}
-	if len(got) != 2*len(hands)+len(board) {
-		var dups []string
-		for c, i := range got {
-			if i > 1 {
-				dups = append(dups, c.String())
-			}
+	for i, d := range dead {
+		if !d.Valid() {
+			return nil, fmt.Errorf("dead card %d is invalid: %d", i, d)
+		}
+		add(d)
+	}
+	if dups != 0 {
+		var ds []string
+		for _, c := range dups.Cards() {
+			ds = append(ds, c.String())
 		}
-		sort.Strings(dups)
-		return nil, fmt.Errorf("duplicate cards: %v found", dups)
+		sort.Strings(ds)
+		return nil, fmt.Errorf("duplicate cards: %v found", ds)
 	}
 	if len(board) > 5 {
 		return nil, fmt.Errorf("board %s has more than 5 (%d) cards", boardString(board), len(board))
@@ -86,7 +206,7 @@ func getRemainingDeck(hands [][2]Card, board []Card) ([]Card, error) {
 	// deck is all the cards that aren't already in a hand or board.
 	var deck []Card
 	for _, c := range Cards {
-		if got[c] > 0 {
+		if got.Contains(c) {
 			continue
 		}
 		deck = append(deck, c)
@@ -94,20 +214,11 @@ func getRemainingDeck(hands [][2]Card, board []Card) ([]Card, error) {
 	return deck, nil
 }
 
-// HoldemEquities returns the river equities for the given holdem hands
-// given a board of up to 5 cards.
-// The hands and board must be distinct, and the board can't have more
-// than 5 cards in it.
-func HoldemEquities(hands [][2]Card, board []Card) ([]Equity, error) {
-	deck, err := getRemainingDeck(hands, board)
-	if err != nil {
-		return nil, err
-	}
-
-	// hbs is hands and board.
-	// We store the fixed cards (given hand and board) at the
-	// end of the array for convenience when we're modifying
-	// values in the eval loop.
+// holdemHandsAndBoards returns 7-card hands made from each of the hands
+// and the board. We store the fixed cards (given hand and board) at the
+// end of the array for convenience when we're modifying values in the
+// eval loop: the first 5-len(board) cards are left for the runout.
+func holdemHandsAndBoards(hands [][2]Card, board []Card) [][7]Card {
 	hbs := make([][7]Card, len(hands))
 	for i, h := range hands {
 		hbs[i][7-len(board)-2+0] = h[0]
@@ -116,47 +227,219 @@ func HoldemEquities(hands [][2]Card, board []Card) ([]Equity, error) {
 			hbs[i][7-len(board)-2+2+j] = b
 		}
 	}
+	return hbs
+}
 
-	eqs := make([]Equity, len(hands))
-	evs := make([]int16, len(hands))
+// EquityOptions configures how equities are computed.
+type EquityOptions struct {
+	// Workers is the maximum number of goroutines used to enumerate
+	// runouts. If it's zero, runtime.NumCPU() is used.
+	// The results don't depend on the number of workers.
+	Workers int
+
+	// Context, if not nil, can be used to cancel the computation,
+	// in which case the context's error is returned.
+	Context context.Context
+
+	// Dead are cards which can't appear in the runouts, for example
+	// burned cards or cards folded by other players. They must be
+	// distinct from the hands and the board.
+	Dead []Card
+}
+
+// HoldemEquities returns the river equities for the given holdem hands
+// given a board of up to 5 cards.
+// The hands and board must be distinct, and the board can't have more
+// than 5 cards in it.
+// The runouts are enumerated in parallel using all CPUs. Use
+// HoldemEquitiesWithOptions to control this.
+func HoldemEquities(hands [][2]Card, board []Card) ([]Equity, error) {
+	return HoldemEquitiesWithOptions(hands, board, EquityOptions{})
+}
+
+// HoldemEquitiesWithOptions is like HoldemEquities, but with options
+// that control how the equities are computed.
+func HoldemEquitiesWithOptions(hands [][2]Card, board []Card, opts EquityOptions) ([]Equity, error) {
+	deck, err := getRemainingDeckDead(hands, board, opts.Dead)
+	if err != nil {
+		return nil, err
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
 
 	if len(board) == 5 {
-		holdemRiverEquities(hbs, evs, eqs)
+		hbs := holdemHandsAndBoards(hands, board)
+		eqs := make([]Equity, len(hands))
+		holdemRiverEquities(hbs, make([]int16, len(hands)), eqs, 1)
 		for i := range eqs {
 			eqs[i].Boards = 1
 		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		return eqs, nil
 	}
 
-	idxs := make([]int, 5-len(board))
+	// The runouts are split into chunks by their first card, deck[c],
+	// with the remaining cards of the runout chosen from deck[c+1:].
+	// Each chunk is summed separately and the chunks are combined
+	// in order, so the result doesn't depend on how the chunks
+	// are scheduled.
+	K := 5 - len(board)
+	if len(deck) < K {
+		return nil, fmt.Errorf("only %d cards remain in the deck, but %d are needed to complete the board", len(deck), K)
+	}
+	chunks := len(deck) - K + 1
+	if workers > chunks {
+		workers = chunks
+	}
+	partial := make([][]Equity, chunks)
+	work := make(chan int, chunks)
+	for c := 0; c < chunks; c++ {
+		work <- c
+	}
+	close(work)
+
+	sym := holdemSymmetries(hands, board, opts.Dead)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hbs := holdemHandsAndBoards(hands, board)
+			evs := make([]int16, len(hands))
+			for c := range work {
+				if ctx.Err() != nil {
+					return
+				}
+				partial[c] = holdemChunkEquities(ctx, hbs, evs, deck, c, K, sym)
+			}
+		}()
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	eqs := make([]Equity, len(hands))
+	for _, p := range partial {
+		for i := range eqs {
+			eqs[i].Equity += p[i].Equity
+			eqs[i].Win += p[i].Win
+			eqs[i].Tie += p[i].Tie
+			eqs[i].Boards += p[i].Boards
+		}
+	}
+	for i := range eqs {
+		eqs[i].scale(eqs[i].Boards)
+	}
+	return eqs, nil
+}
+
+// holdemSymmetries returns the suit permutations (other than the identity)
+// which map each of the hands, the board, and the dead cards to itself.
+// These permutations also map the remaining deck to itself, and two
+// runouts related by one of them give exactly the same results.
+func holdemSymmetries(hands [][2]Card, board, dead []Card) []suitTransform {
+	var fixed []uint64
+	var boardMask, deadMask uint64
+	for _, b := range board {
+		boardMask |= 1 << b
+	}
+	for _, d := range dead {
+		deadMask |= 1 << d
+	}
+	fixed = append(fixed, boardMask, deadMask)
+	for _, h := range hands {
+		fixed = append(fixed, 1<<h[0]|1<<h[1])
+	}
+	var sym []suitTransform
+	for _, st := range suitPermutations[1:] {
+		ok := true
+		for _, m := range fixed {
+			if st.applyMask(m) != m {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			sym = append(sym, st)
+		}
+	}
+	return sym
+}
+
+// holdemChunkEquities sums the equities over the runouts of K cards
+// whose first card is deck[c]. The totals are not divided by the
+// number of runouts, which is stored in Boards.
+// hbs holds the hands and board as returned by holdemHandsAndBoards,
+// and evs is scratch space.
+// sym are the symmetries of the hands and board, as returned by
+// holdemSymmetries. Only one runout (the one with the smallest card mask)
+// is evaluated from each set of runouts that are related by the
+// symmetries, and its result is weighted by the size of the set.
+func holdemChunkEquities(ctx context.Context, hbs [][7]Card, evs []int16, deck []Card, c, K int, sym []suitTransform) []Equity {
+	eqs := make([]Equity, len(hbs))
+	for i := range hbs {
+		hbs[i][0] = deck[c]
+	}
+	rest := deck[c+1:]
+	idxs := make([]int, K-1)
 	for i := range idxs {
 		idxs[i] = i
 	}
 
-	H := len(hands)
 	T := 0 // total number of runouts we've considered.
-
-	for {
-		T++
-		// update the boards
-		for j, ix := range idxs {
-			c := deck[ix]
-			for i := 0; i < H; i++ {
-				hbs[i][j] = c
+	for n := 1; ; n++ {
+		w := 1
+		if len(sym) > 0 {
+			m := uint64(1) << deck[c]
+			for _, ix := range idxs {
+				m |= 1 << rest[ix]
+			}
+			stab := 1
+			for _, st := range sym {
+				m2 := st.applyMask(m)
+				if m2 < m {
+					w = 0
+					break
+				}
+				if m2 == m {
+					stab++
+				}
+			}
+			if w != 0 {
+				w = (len(sym) + 1) / stab
 			}
 		}
-		holdemRiverEquities(hbs, evs, eqs)
-		if !incHEIndex(idxs, len(deck)) {
+		if w != 0 {
+			T += w
+			// update the boards
+			for j, ix := range idxs {
+				c := rest[ix]
+				for i := range hbs {
+					hbs[i][j+1] = c
+				}
+			}
+			holdemRiverEquities(hbs, evs, eqs, float64(w))
+		}
+		if !incHEIndex(idxs, len(rest)) {
+			break
+		}
+		if n%4096 == 0 && ctx.Err() != nil {
 			break
 		}
 	}
 	for i := range eqs {
-		eqs[i].Equity /= float64(T)
-		eqs[i].Win /= float64(T)
-		eqs[i].Tie /= float64(T)
-		eqs[i].Boards = int(T)
+		eqs[i].Boards = T
 	}
-	return eqs, nil
+	return eqs
 }
 
 func incHEIndex(idx []int, dl int) bool {
@@ -178,3 +461,87 @@ func incHEIndex(idx []int, dl int) bool {
 	}
 	return false
 }
+
+// OmahaEquities returns the river equities for the given Omaha hands
+// given a board of up to 5 cards. Each hand must have 4, 5 or 6 cards,
+// and is evaluated using exactly two of its cards and three from the board.
+// The hands and board must be distinct, and the board can't have more
+// than 5 cards in it.
+func OmahaEquities(hands [][]Card, board []Card) ([]Equity, error) {
+	return omahaEquities(hands, board, false)
+}
+
+// OmahaHiLoEquities returns the river equities for the given Omaha
+// hi/lo (eight-or-better) hands given a board of up to 5 cards.
+// The pot is split between the best high hand and the best qualifying
+// low, and the High, Low and Scoop fields of the results are filled in.
+// The hands and board must be as for OmahaEquities.
+func OmahaHiLoEquities(hands [][]Card, board []Card) ([]Equity, error) {
+	return omahaEquities(hands, board, true)
+}
+
+func omahaEquities(hands [][]Card, board []Card, hilo bool) ([]Equity, error) {
+	for i, h := range hands {
+		if len(h) < 4 || len(h) > 6 {
+			return nil, fmt.Errorf("hand %d has %d cards, want 4, 5 or 6", i, len(h))
+		}
+	}
+	deck, err := getRemainingDeckN(hands, board, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(deck) < 5-len(board) {
+		return nil, fmt.Errorf("only %d cards remain in the deck, but %d are needed to complete the board", len(deck), 5-len(board))
+	}
+
+	var full [5]Card
+	copy(full[:], board)
+
+	eqs := make([]Equity, len(hands))
+	evs := make([]int16, len(hands))
+	los := make([]int16, len(hands))
+	var ob OmahaBoard
+
+	idxs := make([]int, 5-len(board))
+	for i := range idxs {
+		idxs[i] = i
+	}
+
+	T := 0 // total number of runouts we've considered.
+	for {
+		T++
+		for j, ix := range idxs {
+			full[len(board)+j] = deck[ix]
+		}
+		ob.init(&full)
+		for i, h := range hands {
+			evs[i] = ob.Eval(h)
+		}
+		if hilo {
+			for i, h := range hands {
+				los[i] = ob.EvalLow8(h)
+			}
+			awardPotHiLo(evs, los, eqs)
+		} else {
+			awardPot(evs, eqs)
+		}
+		if !incHEIndex(idxs, len(deck)) {
+			break
+		}
+	}
+	for i := range eqs {
+		eqs[i].scale(T)
+	}
+	return eqs, nil
+}
+
+// scale turns the totals accumulated over T runouts into averages.
+func (eq *Equity) scale(T int) {
+	eq.Equity /= float64(T)
+	eq.Win /= float64(T)
+	eq.Tie /= float64(T)
+	eq.High /= float64(T)
+	eq.Low /= float64(T)
+	eq.Scoop /= float64(T)
+	eq.Boards = T
+}
@@ -1,8 +1,10 @@
 package poker
 
 import (
-	"fmt"
+	"context"
 	"math"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -15,31 +17,18 @@ type eqTest struct {
 	wantEqs    []Equity
 }
 
-func parseHand2(s string) ([2]Card, error) {
-	var hz [2]Card
-	if len(s) != 4 {
-		return hz, fmt.Errorf("expect hand in format like CAKH, got %q", s)
-	}
-	c0, ok0 := NameToCard[s[:2]]
-	c1, ok1 := NameToCard[s[2:]]
-	if !ok0 || !ok1 {
-		return hz, fmt.Errorf("failed to parse hand %q", s)
-	}
-	return [2]Card{c0, c1}, nil
-}
-
 func TestEquity(t *testing.T) {
 	hand := func(s string) [2]Card {
-		h, err := parseHand2(s)
-		if err != nil {
-			t.Fatal(err)
+		h := mustParseHand(t, s)
+		if len(h) != 2 {
+			t.Fatalf("expect hand in format like CAKH, got %q", s)
 		}
-		return h
+		return [2]Card{h[0], h[1]}
 	}
 	card := func(s string) Card {
-		c, ok := NameToCard[s]
-		if !ok {
-			t.Fatalf("can't parse card %s", s)
+		c, err := ParseCard(s)
+		if err != nil {
+			t.Fatal(err)
 		}
 		return c
 	}
@@ -144,3 +133,299 @@ func BenchmarkHoldemEquitiesFlop(b *testing.B) {
 		}
 	}
 }
+
+func TestOmahaEquities(t *testing.T) {
+	tcs := []struct {
+		name  string
+		hands []string
+		board string
+	}{
+		{"PLO4 flop", []string{"SA HA SK HK", "DJ DT C9 C8"}, "S2 H7 DQ"},
+		{"PLO4 turn three-way", []string{"SA HA SK HK", "DJ DT C9 C8", "S6 S5 H4 H3"}, "S2 H7 DQ C6"},
+		{"PLO5 vs PLO6 flop", []string{"SA HA SK HK D2", "DJ DT C9 C8 D8 H8"}, "S2 H7 DQ"},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var hands [][]Card
+			for _, h := range tc.hands {
+				hands = append(hands, mustParseHand(t, h))
+			}
+			board := mustParseHand(t, tc.board)
+			eqs, err := OmahaEquities(hands, board)
+			if err != nil {
+				t.Fatalf("OmahaEquities failed: %v", err)
+			}
+
+			// Compute the expected equities by brute force.
+			deck, err := getRemainingDeckN(hands, board, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := make([]Equity, len(hands))
+			evs := make([]int16, len(hands))
+			var full [5]Card
+			copy(full[:], board)
+			idxs := make([]int, 5-len(board))
+			for i := range idxs {
+				idxs[i] = i
+			}
+			T := 0
+			for {
+				T++
+				for j, ix := range idxs {
+					full[len(board)+j] = deck[ix]
+				}
+				for i, h := range hands {
+					evs[i] = evalOmahaSlow(h, &full)
+				}
+				awardPot(evs, want)
+				if !incHEIndex(idxs, len(deck)) {
+					break
+				}
+			}
+			for i := range hands {
+				if eqs[i].Boards != T {
+					t.Errorf("hand %d: got %d boards, want %d", i, eqs[i].Boards, T)
+				}
+				if math.Abs(eqs[i].Equity-want[i].Equity/float64(T)) > 1e-9 {
+					t.Errorf("hand %d: equity=%f, want %f", i, eqs[i].Equity, want[i].Equity/float64(T))
+				}
+				if math.Abs(eqs[i].Win-want[i].Win/float64(T)) > 1e-9 {
+					t.Errorf("hand %d: win=%f, want %f", i, eqs[i].Win, want[i].Win/float64(T))
+				}
+				if math.Abs(eqs[i].Tie-want[i].Tie/float64(T)) > 1e-9 {
+					t.Errorf("hand %d: tie=%f, want %f", i, eqs[i].Tie, want[i].Tie/float64(T))
+				}
+			}
+		})
+	}
+}
+
+func TestOmahaEquitiesErrors(t *testing.T) {
+	tcs := []struct {
+		name  string
+		hands []string
+		board string
+	}{
+		{"short hand", []string{"SA HA SK", "DJ DT C9 C8"}, "S2 H7 DQ"},
+		{"long hand", []string{"SA HA SK HK D2 D3 D4", "DJ DT C9 C8"}, "S2 H7 DQ"},
+		{"duplicate", []string{"SA HA SK HK", "DJ DT C9 SA"}, "S2 H7 DQ"},
+		{"board duplicate", []string{"SA HA SK HK", "DJ DT C9 C8"}, "S2 H7 SK"},
+		{"long board", []string{"SA HA SK HK", "DJ DT C9 C8"}, "S2 H7 DQ D2 D3 D4"},
+	}
+	for _, tc := range tcs {
+		var hands [][]Card
+		for _, h := range tc.hands {
+			hands = append(hands, mustParseHand(t, h))
+		}
+		if _, err := OmahaEquities(hands, mustParseHand(t, tc.board)); err == nil {
+			t.Errorf("%s: OmahaEquities succeeded, want error", tc.name)
+		}
+	}
+
+	// Eight 6-card hands leave only 4 cards for the board.
+	var hands [][]Card
+	for i := 0; i < 8; i++ {
+		var h []Card
+		for j := 0; j < 6; j++ {
+			h = append(h, Card(6*i+j))
+		}
+		hands = append(hands, h)
+	}
+	if _, err := OmahaEquities(hands, nil); err == nil || !strings.Contains(err.Error(), "cards remain") {
+		t.Errorf("OmahaEquities with too few cards left in the deck = %v, want error", err)
+	}
+	if _, err := OmahaHiLoEquities(hands, nil); err == nil || !strings.Contains(err.Error(), "cards remain") {
+		t.Errorf("OmahaHiLoEquities with too few cards left in the deck = %v, want error", err)
+	}
+}
+
+func BenchmarkOmahaEquitiesFlop(b *testing.B) {
+	hands := [][]Card{
+		{NameToCard["SA"], NameToCard["HA"], NameToCard["SK"], NameToCard["HK"]},
+		{NameToCard["DJ"], NameToCard["DT"], NameToCard["C9"], NameToCard["C8"]},
+	}
+	board := []Card{NameToCard["S2"], NameToCard["H7"], NameToCard["DQ"]}
+	for n := 0; n < b.N; n++ {
+		if _, err := OmahaEquities(hands, board); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestHoldemEquitiesWorkers(t *testing.T) {
+	hands := [][2]Card{
+		{NameToCard["CA"], NameToCard["HK"]},
+		{NameToCard["DK"], NameToCard["HT"]},
+		{NameToCard["H9"], NameToCard["D9"]},
+	}
+	for _, board := range [][]Card{
+		{NameToCard["D2"]},
+		{NameToCard["D2"], NameToCard["H2"], NameToCard["S3"]},
+		{NameToCard["D2"], NameToCard["H2"], NameToCard["S3"], NameToCard["S4"]},
+	} {
+		want, err := HoldemEquitiesWithOptions(hands, board, EquityOptions{Workers: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, workers := range []int{0, 2, 3, 100} {
+			got, err := HoldemEquitiesWithOptions(hands, board, EquityOptions{Workers: workers})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("board %v: %d workers gave %v, want %v", Hand(board), workers, got, want)
+			}
+		}
+	}
+}
+
+func TestHoldemEquitiesCancel(t *testing.T) {
+	hands := [][2]Card{
+		{NameToCard["CA"], NameToCard["HK"]},
+		{NameToCard["DK"], NameToCard["HT"]},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := HoldemEquitiesWithOptions(hands, nil, EquityOptions{Context: ctx}); err != context.Canceled {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	board := mustParseHand(t, "S2 S3 D7 C9 HJ")
+	if eqs, err := HoldemEquitiesWithOptions(hands, board, EquityOptions{Context: ctx}); eqs != nil || err != context.Canceled {
+		t.Errorf("with a complete board, got %v, %v, want nil, %v", eqs, err, context.Canceled)
+	}
+}
+
+func TestHoldemEquitiesTooManyHands(t *testing.T) {
+	var hands [][2]Card
+	for i := 0; i < 24; i++ {
+		hands = append(hands, [2]Card{Card(2 * i), Card(2*i + 1)})
+	}
+	if _, err := HoldemEquities(hands, nil); err == nil {
+		t.Errorf("expected error with 24 hands")
+	}
+}
+
+// holdemEquitiesUnreduced computes holdem equities by evaluating
+// every runout, without using suit symmetries.
+func holdemEquitiesUnreduced(t *testing.T, hands [][2]Card, board, dead []Card) []Equity {
+	deck, err := getRemainingDeckDead(hands, board, dead)
+	if err != nil {
+		t.Fatal(err)
+	}
+	K := 5 - len(board)
+	hbs := holdemHandsAndBoards(hands, board)
+	evs := make([]int16, len(hands))
+	eqs := make([]Equity, len(hands))
+	for c := 0; c+K <= len(deck); c++ {
+		p := holdemChunkEquities(context.Background(), hbs, evs, deck, c, K, nil)
+		for i := range eqs {
+			eqs[i].Equity += p[i].Equity
+			eqs[i].Win += p[i].Win
+			eqs[i].Tie += p[i].Tie
+			eqs[i].Boards += p[i].Boards
+		}
+	}
+	for i := range eqs {
+		eqs[i].scale(eqs[i].Boards)
+	}
+	return eqs
+}
+
+func TestHoldemSymmetries(t *testing.T) {
+	tcs := []struct {
+		hands []string
+		board string
+		dead  string
+		want  int
+	}{
+		{[]string{"HA HK", "SQ DQ"}, "", "", 2},
+		{[]string{"SA SK"}, "", "", 6},
+		{[]string{"SA SK", "HA HK"}, "", "", 2},
+		{[]string{"SA HA", "DK CK"}, "", "", 4},
+		{[]string{"SA HK", "DQ CJ"}, "", "", 1},
+		{[]string{"SA SK"}, "H2 D2 C2", "", 6},
+		{[]string{"SA SK"}, "H2 D2 C3", "", 2},
+		{[]string{"SA SK"}, "", "H2", 2},
+		{[]string{"SA SK"}, "", "H2 D2", 2},
+		{[]string{"SA SK"}, "", "H2 D2 C2", 6},
+	}
+	for _, tc := range tcs {
+		var hands [][2]Card
+		for _, h := range tc.hands {
+			cs := mustParseHand(t, h)
+			hands = append(hands, [2]Card{cs[0], cs[1]})
+		}
+		var board []Card
+		if tc.board != "" {
+			board = mustParseHand(t, tc.board)
+		}
+		dead := mustParseHand(t, tc.dead)
+		if got := len(holdemSymmetries(hands, board, dead)) + 1; got != tc.want {
+			t.Errorf("%v on %q with dead cards %q has %d symmetries, want %d", tc.hands, tc.board, tc.dead, got, tc.want)
+		}
+	}
+}
+
+func TestHoldemEquitiesSymmetryReduction(t *testing.T) {
+	tcs := []struct {
+		hands []string
+		board string
+		dead  string
+	}{
+		{[]string{"HA HK", "SQ DQ"}, "C2", ""},
+		{[]string{"SA SK", "HA HK"}, "D7 C7", ""},
+		{[]string{"SA SK"}, "H2 D2 C3", ""},
+		{[]string{"SA HA", "DK CK", "S9 H9"}, "S2 H3", ""},
+		{[]string{"SA HK", "DQ CJ"}, "S2 H3", ""},
+		{[]string{"SA SK", "HA HK"}, "D7 C7", "DQ CQ"},
+		{[]string{"SA SK", "HA HK"}, "D7 C7", "DQ"},
+		{[]string{"SA SK"}, "H2 D2 C3", "S4 H4 D4 C4"},
+	}
+	for _, tc := range tcs {
+		var hands [][2]Card
+		for _, h := range tc.hands {
+			cs := mustParseHand(t, h)
+			hands = append(hands, [2]Card{cs[0], cs[1]})
+		}
+		board := mustParseHand(t, tc.board)
+		dead := mustParseHand(t, tc.dead)
+		got, err := HoldemEquitiesWithOptions(hands, board, EquityOptions{Dead: dead})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := holdemEquitiesUnreduced(t, hands, board, dead)
+		for i := range want {
+			if got[i].Boards != want[i].Boards {
+				t.Errorf("%v on %s: hand %d has %d boards, want %d", tc.hands, tc.board, i, got[i].Boards, want[i].Boards)
+			}
+			if math.Abs(got[i].Equity-want[i].Equity) > 1e-12 || math.Abs(got[i].Win-want[i].Win) > 1e-12 || math.Abs(got[i].Tie-want[i].Tie) > 1e-12 {
+				t.Errorf("%v on %s: hand %d got %+v, want %+v", tc.hands, tc.board, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestHoldemEquitiesDeadErrors(t *testing.T) {
+	hands := [][2]Card{{NameToCard["HA"], NameToCard["HK"]}, {NameToCard["SQ"], NameToCard["DQ"]}}
+	board := mustParseHand(t, "C2 C3 C4")
+	for _, dead := range [][]Card{
+		mustParseHand(t, "HA"),
+		mustParseHand(t, "C2"),
+		{NameToCard["D9"], NameToCard["D9"]},
+		{Card(60)},
+	} {
+		if _, err := HoldemEquitiesWithOptions(hands, board, EquityOptions{Dead: dead}); err == nil {
+			t.Errorf("HoldemEquitiesWithOptions with dead cards %v succeeded, want error", dead)
+		}
+	}
+}
+
+func BenchmarkHoldemEquitiesPreflopSuited(b *testing.B) {
+	hands := [][2]Card{{NameToCard["HA"], NameToCard["HK"]}, {NameToCard["SQ"], NameToCard["DQ"]}}
+	for n := 0; n < b.N; n++ {
+		if _, err := HoldemEquities(hands, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
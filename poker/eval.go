@@ -141,6 +141,13 @@ func evalSlow(c []Card, replace, text bool) (eval, error) {
 	if len(c) == 7 {
 		return evalSlow7(c, replace, text)
 	}
+	return evalSlow5(c, replace, text, true)
+}
+
+// evalSlow5 evaluates a 3- or 5- card poker hand as evalSlow does.
+// If "wheel" is false, then A-2-3-4-5 is not considered a straight,
+// and the ace only plays high.
+func evalSlow5(c []Card, replace, text, wheel bool) (eval, error) {
 	flush := isFlush(c)
 	ranks := [13]int{}
 	dupes := [6]int{}  // uniqs, pairs, trips, quads, quins
@@ -157,8 +164,9 @@ func evalSlow(c []Card, replace, text bool) (eval, error) {
 		for i := 0; i < 5; i++ {
 			idx := (cr + i) % 13
 			str8s[idx] |= 1 << uint(i)
-			// Make sure to exclude wrap-around straights headed by 2, 3, 4.
-			if str8s[idx] == 31 && (idx <= 1 || idx >= 5) {
+			// Make sure to exclude wrap-around straights headed by 2, 3, 4,
+			// and the wheel (headed by 5) if it's not allowed.
+			if str8s[idx] == 31 && (idx <= 1 || idx >= 5) && (wheel || idx != 5) {
 				str8top = (idx+12)%13 + 1
 			}
 		}
@@ -300,6 +308,10 @@ type evalInfos struct {
 	rankTo5          [ScoreMax + 1][]Card
 	rankTo3          [ScoreMax + 1][]Card
 	slowRankToPacked map[int]int16
+	packedToSlowRank [ScoreMax + 1]int
+
+	rankTo27           [Score27Max + 1][]Card
+	slowRank27ToPacked map[int]int16
 }
 
 var evalInfo *evalInfos = makeEvalInfo()
@@ -376,6 +388,28 @@ func makeEvalInfo() *evalInfos {
 		}
 	}
 	hand5 := map[int][]Card{}
+	hand27 := map[int][]Card{}
+	add5 := func(h5 []Card) {
+		ev, err := evalSlow(h5, true, false)
+		if err != nil {
+			panic(err)
+		}
+		if _, ok := hand5[ev.rank]; !ok {
+			hand5[ev.rank] = h5
+		}
+		// The non-flush enumeration includes five-of-a-kind (with a
+		// duplicated card), which has no 2-7 score.
+		if h5[0].Rank() == h5[4].Rank() {
+			return
+		}
+		ev, err = evalSlow27(h5, true, false)
+		if err != nil {
+			panic(err)
+		}
+		if _, ok := hand27[ev.rank]; !ok {
+			hand27[ev.rank] = h5
+		}
+	}
 
 	// Enumerate all 5-card flush hands.
 	for a := 0; a < 13; a++ {
@@ -388,14 +422,7 @@ func makeEvalInfo() *evalInfos {
 					cardd := mustMakeCard(Club, Rank(d+1))
 					for e := d + 1; e < 13; e++ {
 						carde := mustMakeCard(Club, Rank(e+1))
-						h5 := []Card{carda, cardb, cardc, cardd, carde}
-						ev, err := evalSlow(h5, true, false)
-						if err != nil {
-							panic(err)
-						}
-						if _, ok := hand5[ev.rank]; !ok {
-							hand5[ev.rank] = h5
-						}
+						add5([]Card{carda, cardb, cardc, cardd, carde})
 					}
 				}
 			}
@@ -413,14 +440,7 @@ func makeEvalInfo() *evalInfos {
 					cardd := mustMakeCard(Spade, Rank(d+1))
 					for e := d; e < 13; e++ {
 						carde := mustMakeCard(Club, Rank(e+1))
-						h5 := []Card{carda, cardb, cardc, cardd, carde}
-						ev, err := evalSlow(h5, true, false)
-						if err != nil {
-							panic(err)
-						}
-						if _, ok := hand5[ev.rank]; !ok {
-							hand5[ev.rank] = h5
-						}
+						add5([]Card{carda, cardb, cardc, cardd, carde})
 					}
 				}
 			}
@@ -437,17 +457,34 @@ func makeEvalInfo() *evalInfos {
 	}
 	sort.Ints(allScores)
 
+	if ScoreMax != len(allScores)-1 {
+		log.Fatalf("Expected max score of %d, but found %d", ScoreMax, len(allScores)-1)
+	}
+
 	ei.slowRankToPacked = map[int]int16{}
 	for i, k := range allScores {
 		ei.slowRankToPacked[k] = int16(i)
+		ei.packedToSlowRank[i] = k
 	}
 
 	for rank, packedRank := range ei.slowRankToPacked {
 		ei.rankTo5[packedRank] = hand5[rank]
 		ei.rankTo3[packedRank] = hand3[rank]
 	}
-	if ScoreMax != len(allScores)-1 {
-		log.Fatalf("Expected max score of %d, but found %d", ScoreMax, len(allScores)-1)
+
+	// 2-7 lowball scores are packed separately.
+	all27Scores := []int{}
+	for k := range hand27 {
+		all27Scores = append(all27Scores, k)
+	}
+	sort.Ints(all27Scores)
+	if Score27Max != len(all27Scores)-1 {
+		log.Fatalf("Expected max 2-7 score of %d, but found %d", Score27Max, len(all27Scores)-1)
+	}
+	ei.slowRank27ToPacked = map[int]int16{}
+	for i, k := range all27Scores {
+		ei.slowRank27ToPacked[k] = int16(i)
+		ei.rankTo27[i] = hand27[k]
 	}
 	return ei
 }
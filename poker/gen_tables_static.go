@@ -7,6 +7,7 @@ import (
 	"compress/gzip"
 	"encoding/base64"
 	"encoding/binary"
+	"flag"
 	"fmt"
 	"log"
 	"os"
@@ -15,26 +16,14 @@ import (
 )
 
 func writeFile() {
-	rf, err := os.Create("poker.dat")
-	if err != nil {
-		log.Fatalf("failed to create data file: %v", err)
-	}
-	zf := gzip.NewWriter(rf)
-	tbl3, tbl5, tbl7 := poker.InternalTables()
-	if err := binary.Write(zf, binary.LittleEndian, tbl7[:]); err != nil {
-		log.Fatalf("failed to write data: %v", err)
-	}
-	if err := binary.Write(zf, binary.LittleEndian, tbl5[:]); err != nil {
-		log.Fatalf("failed to write data: %v", err)
-	}
-	if err := binary.Write(zf, binary.LittleEndian, tbl3[:]); err != nil {
-		log.Fatalf("failed to write data: %v", err)
+	if err := poker.WriteTablesFile(poker.TablesFile); err != nil {
+		log.Fatalf("failed to write data file: %v", err)
 	}
-	if err := zf.Close(); err != nil {
-		log.Fatalf("failed to write data: %v", err)
-	}
-	if err := rf.Close(); err != nil {
-		log.Fatalf("failed to write data: %v", err)
+}
+
+func writeMappedFile() {
+	if err := poker.WriteMappedTablesFile(poker.MappedTablesFile); err != nil {
+		log.Fatalf("failed to write mapped data file: %v", err)
 	}
 }
 
@@ -46,15 +35,34 @@ func writeSource() {
 	f := bufio.NewWriter(rf)
 	tbl3, tbl5, tbl7 := poker.InternalTables()
 	if _, err := fmt.Fprint(f, `
-// +build !gendata,!filedata
+// +build !gendata,!filedata,!mmapdata
 
 package poker
-
-var pokerTableData = []uint8("`); err != nil {
+`); err != nil {
 		log.Fatal(err)
 	}
-	e64 := base64.NewEncoder(base64.RawStdEncoding, f)
-	zs := gzip.NewWriter(e64)
+
+	// Each table is compressed separately, so that it can be
+	// decompressed the first time it's used.
+	writeTable := func(name string, tbl interface{}) {
+		if _, err := fmt.Fprintf(f, "\nvar %s = []uint8(\"", name); err != nil {
+			log.Fatal(err)
+		}
+		e64 := base64.NewEncoder(base64.RawStdEncoding, f)
+		zs := gzip.NewWriter(e64)
+		if err := binary.Write(zs, binary.LittleEndian, tbl); err != nil {
+			log.Fatal(err)
+		}
+		if err := zs.Close(); err != nil {
+			log.Fatalf("failed to close gzip: %v", err)
+		}
+		if err := e64.Close(); err != nil {
+			log.Fatalf("failed to close base64 encoder: %v", err)
+		}
+		if _, err := fmt.Fprint(f, "\")\n"); err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	// We shrink the indexes for non-terminal/non-nil nodes.
 	// We divide the index part by 52 (it's always a multiple),
@@ -72,30 +80,51 @@ var pokerTableData = []uint8("`); err != nil {
 		}
 	}
 
-	fmt.Println("writing 7 table")
-	norm(tbl7, 61153)
-
-	if err := binary.Write(zs, binary.LittleEndian, tbl7[:]); err != nil {
-		log.Fatal(err)
+	// The 7-card table has 18370 nodes with 52 entries, followed by
+	// nodes with 26 entries (see genTables7), so converting between
+	// indexes and node IDs is a little more complicated.
+	norm7 := func(tbl []uint32, n, m int) {
+		nodeID := func(i uint32) uint32 {
+			if i < uint32(n*52) {
+				return i / 52
+			}
+			return uint32(n) + (i-uint32(n*52))/26
+		}
+		for i := range tbl[:n*52+m*26] {
+			if tbl[i] == 0 {
+				continue
+			}
+			nodeIndex := nodeID(uint32(i))
+			sx := tbl[i] & 0xff
+			ix := nodeID(tbl[i] >> 8)
+			tbl[i] = sx | ((ix - nodeIndex) << 8)
+		}
 	}
+
+	fmt.Println("writing 7 table")
+	norm7(tbl7, 18370, 42783)
+	writeTable("pokerTable7Data", tbl7)
 	fmt.Println("writing 5 table")
 	norm(tbl5, 924)
-	if err := binary.Write(zs, binary.LittleEndian, tbl5[:]); err != nil {
-		log.Fatal(err)
-	}
+	writeTable("pokerTable5Data", tbl5)
 	fmt.Println("writing 3 table")
-	if err := binary.Write(zs, binary.LittleEndian, tbl3[:]); err != nil {
-		log.Fatal(err)
-	}
-	if err := zs.Close(); err != nil {
-		log.Fatalf("failed to close gzip: %v", err)
-	}
-	if err := e64.Close(); err != nil {
-		log.Fatalf("failed to close base64 encoder", err)
-	}
-	if _, err := fmt.Fprint(f, "\")\n"); err != nil {
-		log.Fatal(err)
-	}
+	writeTable("pokerTable3Data", tbl3)
+	fmt.Println("writing 2-7 table")
+	tbl27 := poker.InternalTables27()
+	norm(tbl27, 924)
+	writeTable("pokerTable27Data", tbl27)
+	fmt.Println("writing short-deck tables")
+	for _, rules := range []poker.ShortDeckRules{poker.ShortDeckFlushBeatsFullHouse, poker.ShortDeckTripsBeatStraight} {
+		tblsd := poker.InternalTablesShortDeck(rules)
+		norm(tblsd, 10645)
+		writeTable(fmt.Sprintf("pokerTableShortDeck%dData", rules), tblsd)
+	}
+	fmt.Println("writing razz table")
+	writeTable("pokerTableRazzData", poker.InternalTablesRazz())
+	fmt.Println("writing badugi table")
+	tblBadugi := poker.InternalTablesBadugi()
+	norm(tblBadugi, 183)
+	writeTable("pokerTableBadugiData", tblBadugi)
 	if err := f.Flush(); err != nil {
 		log.Fatalf("failed to flush data: %v", err)
 	}
@@ -104,9 +133,15 @@ var pokerTableData = []uint8("`); err != nil {
 	}
 }
 
+var mmap = flag.Bool("mmap", false, "also write the uncompressed table file used with -tags mmapdata")
+
 func main() {
-	// Note! writeFile must come first, because writeSource overwrites
-	// the data in the table.
+	flag.Parse()
+	// Note! writeFile and writeMappedFile must come first, because
+	// writeSource overwrites the data in the table.
 	writeFile()
+	if *mmap {
+		writeMappedFile()
+	}
 	writeSource()
 }
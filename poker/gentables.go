@@ -1,6 +1,8 @@
 package poker
 
 import (
+	"errors"
+	"fmt"
 	"runtime"
 	"sync"
 )
@@ -28,11 +30,62 @@ type genwork struct {
 	node **tblNode
 }
 
+// TableRules describes a poker variant, for generating a state-machine
+// table that evaluates its hands (see GenerateTable).
+type TableRules struct {
+	// HandSize is the number of cards in a hand, from 1 to 7.
+	HandSize int
+
+	// Deck is the cards that hands are made from. If it's nil,
+	// the full 52-card deck (Cards) is used.
+	Deck []Card
+
+	// SuitN is the number of cards of a suit needed for the suit to
+	// matter: 5 for games with flushes, or 1 if every suit matters.
+	// If it's more than HandSize, suits never matter.
+	SuitN int
+
+	// Eval ranks a complete hand of HandSize cards, where higher
+	// ranks are better. It's called once for each hand that's
+	// distinct up to suits, so it may be slow. It must give the same
+	// result for hands that are the same up to swapping suits, and
+	// for hands that differ only in the suits that can't have SuitN
+	// cards.
+	Eval func(c []Card) int16
+}
+
+// validate checks that the rules can be used to generate a table.
+func (tr *TableRules) validate() error {
+	if tr.HandSize < 1 || tr.HandSize > 7 {
+		return fmt.Errorf("table rules have hand size %d, want 1 to 7", tr.HandSize)
+	}
+	if tr.Eval == nil {
+		return errors.New("table rules have no Eval function")
+	}
+	var seen CardSet
+	for _, c := range tr.Deck {
+		if !c.Valid() {
+			return fmt.Errorf("table rules deck has invalid card %d", c)
+		}
+		if seen.Contains(c) {
+			return fmt.Errorf("table rules deck has duplicate card %s", c)
+		}
+		seen = seen.Add(c)
+	}
+	if tr.Deck != nil && len(tr.Deck) < tr.HandSize {
+		return fmt.Errorf("table rules deck has %d cards, want at least %d", len(tr.Deck), tr.HandSize)
+	}
+	return nil
+}
+
 type genner struct {
 	m     sync.Mutex
 	cache map[hand64Canonical]*tblNode
 	work  chan genwork
 	wg    sync.WaitGroup
+
+	// rules describes the hands in the tree, and how they're ranked.
+	rules TableRules
 }
 
 func (g *genner) get(key hand64Canonical) (*tblNode, bool) {
@@ -47,16 +100,13 @@ func (g *genner) get(key hand64Canonical) (*tblNode, bool) {
 	return n, false
 }
 
-func nodeeval5idx(c *[7]Card, idx [5]int) int16 {
-	h := [5]Card{c[idx[0]], c[idx[1]], c[idx[2]], c[idx[3]], c[idx[4]]}
-	return Eval5(&h)
-}
-
-func gentreeEval7(c *[7]Card) int16 {
+// gentreeEval7 returns the best evaluation of any 5 of the 7 cards.
+func gentreeEval7(c *[7]Card, eval5 func(*[5]Card) int16) int16 {
 	idx := [5]int{4, 3, 2, 1, 0}
 	var best int16
 	for {
-		if ev := nodeeval5idx(c, idx); ev > best {
+		h := [5]Card{c[idx[0]], c[idx[1]], c[idx[2]], c[idx[3]], c[idx[4]]}
+		if ev := eval5(&h); ev > best {
 			best = ev
 		}
 		if idx[0] < 6 {
@@ -85,7 +135,8 @@ func gentreeEval7(c *[7]Card) int16 {
 	}
 }
 
-func (g *genner) genworker(ncards int) {
+func (g *genner) genworker() {
+	ncards := g.rules.HandSize
 	for w := range g.work {
 		h := w.h
 		n := w.n
@@ -98,27 +149,15 @@ func (g *genner) genworker(ncards int) {
 		}
 		node.N = n
 		node.H = h
-		for c := 0; c < 52; c++ {
-			nh, ok := h.Add(n, Card(c))
+		for _, c := range g.rules.Deck {
+			nh, ok := h.Add(n, c)
 			if !ok {
 				continue
 			}
-			nhc, xf := nh.CanonicalWithTransform(n+1, ncards)
+			nhc, xf := nh.canonicalWithTransform(n+1, ncards, g.rules.SuitN)
 			if n == ncards-1 {
-				var rank int16
-				if ncards == 7 {
-					var c7 [7]Card
-					copy(c7[:], nhc.Exemplar(7).CardsN(7))
-					rank = gentreeEval7(&c7)
-				} else if ncards == 5 {
-					var c5 [5]Card
-					copy(c5[:], nhc.Exemplar(5).CardsN(5))
-					rank = EvalSlow(c5[:])
-				} else {
-					panic(ncards)
-				}
 				node.T[c] = tblTransition{
-					rank: rank,
+					rank: g.rules.Eval(nhc.Exemplar(ncards).CardsN(ncards)),
 				}
 			} else {
 				node.T[c] = tblTransition{
@@ -135,7 +174,9 @@ func (g *genner) genworker(ncards int) {
 	}
 }
 
-func indexNodes(node *tblNode) {
+// indexNodes numbers the nodes of the tree in breadth-first order,
+// and returns the number of nodes.
+func indexNodes(node *tblNode) int {
 	done := map[*tblNode]bool{}
 	nodes := []*tblNode{node}
 	for i := 0; i < len(nodes); i++ {
@@ -149,19 +190,27 @@ func indexNodes(node *tblNode) {
 			nodes = append(nodes, nn)
 		}
 	}
+	return len(nodes)
 }
 
-func gentree(ncards int) *tblNode {
+// gentree builds the state machine for the hands described by the
+// rules, which must be valid. A suit is only distinguished from other
+// suits if it can end up with rules.SuitN or more cards in the hand.
+func gentree(rules TableRules) *tblNode {
+	if rules.Deck == nil {
+		rules.Deck = Cards
+	}
 	g := &genner{
 		cache: map[hand64Canonical]*tblNode{},
 		work:  make(chan genwork, 10_000_000),
+		rules: rules,
 	}
 	g.wg.Add(1)
 	var wg sync.WaitGroup
 	for i := 0; i < runtime.NumCPU(); i++ {
 		wg.Add(1)
 		go func() {
-			g.genworker(ncards)
+			g.genworker()
 			wg.Done()
 		}()
 	}
@@ -174,28 +223,149 @@ func gentree(ncards int) *tblNode {
 	return node
 }
 
+// genTables fills in indextable from the tree of nodes: each node has 52
+// entries, which for non-terminal nodes hold the index of the next node
+// shifted left by 8, with the suit transform in the low 8 bits, and for
+// terminal nodes hold the rank of the hand. It returns the number of
+// non-terminal nodes.
+func genTables(ncards int, indextable []uint32, node *tblNode, done []bool) int {
+	table := indextable[node.Index*52 : (node.Index+1)*52]
+	if node.N == ncards-1 {
+		for i, t := range node.T {
+			table[i] = uint32(t.rank)
+		}
+		return 0
+	}
+	S := 0
+	for i, t := range node.T {
+		if t.N == nil {
+			continue
+		}
+		table[i] = (uint32(t.N.Index*52) << 8) | uint32(t.SX.Byte())
+		if !done[t.N.Index] {
+			done[t.N.Index] = true
+			S += genTables(ncards, indextable, t.N, done)
+		}
+	}
+	return 1 + S
+}
+
+// A Table is a state-machine table for evaluating the hands of a poker
+// variant, generated from TableRules. It works in the same way as the
+// tables used by Eval5 and Eval7.
+type Table struct {
+	handSize int
+	tbl      []uint32
+}
+
+// maxTableNodes is the largest number of nodes a Table can have,
+// since the index of a node is stored in 24 bits.
+const maxTableNodes = (1 << 24) / 52
+
+// GenerateTable generates the table for the rules. It takes time and
+// memory in proportion to the number of hands that are distinct up to
+// suits: for example, a few seconds for 7-card hands.
+func GenerateTable(rules TableRules) (*Table, error) {
+	if err := rules.validate(); err != nil {
+		return nil, err
+	}
+	root := gentree(rules)
+	n := indexNodes(root)
+	if n > maxTableNodes {
+		return nil, fmt.Errorf("table has %d nodes, more than the maximum %d", n, maxTableNodes)
+	}
+	tbl := make([]uint32, n*52)
+	genTables(rules.HandSize, tbl, root, make([]bool, n))
+	return &Table{handSize: rules.HandSize, tbl: tbl}, nil
+}
+
+// HandSize returns the number of cards in the hands that the
+// table evaluates.
+func (t *Table) HandSize() int {
+	return t.handSize
+}
+
+// Eval evaluates a hand, returning the rank that the Eval function
+// of the table's rules gives it. The cards must be distinct, and
+// from the deck of the rules. If the hand has the wrong number of
+// cards, the result is -1.
+func (t *Table) Eval(hand []Card) int16 {
+	if len(hand) != t.handSize {
+		return -1
+	}
+	tx := suitTransformByteIdentity
+	idx := 0
+	for _, c := range hand[:len(hand)-1] {
+		v := t.tbl[idx+int(tx.Apply(c))]
+		tx = tx.Compose(suitTransformByte(v))
+		idx = int(v >> 8)
+	}
+	return int16(t.tbl[idx+int(tx.Apply(hand[len(hand)-1]))])
+}
+
 var (
 	rootNode5card     *tblNode
 	rootNode5cardInit sync.Once
 
 	rootNode7card     *tblNode
 	rootNode7cardInit sync.Once
+
+	rootNode27card     *tblNode
+	rootNode27cardInit sync.Once
+
+	rootNodeBadugiCard     *tblNode
+	rootNodeBadugiCardInit sync.Once
+
+	rootNodeShortDeckCard     [numShortDeckRules]*tblNode
+	rootNodeShortDeckCardInit [numShortDeckRules]sync.Once
 )
 
 func rootNode7() *tblNode {
 	rootNode7cardInit.Do(func() {
-		rootNode7card = gentree(7)
+		rootNode7card = gentree(TableRules{HandSize: 7, SuitN: 5, Eval: func(c []Card) int16 {
+			var c7 [7]Card
+			copy(c7[:], c)
+			return gentreeEval7(&c7, Eval5)
+		}})
 	})
 	return rootNode7card
 }
 
 func rootNode5() *tblNode {
 	rootNode5cardInit.Do(func() {
-		rootNode5card = gentree(5)
+		rootNode5card = gentree(TableRules{HandSize: 5, SuitN: 5, Eval: EvalSlow})
 	})
 	return rootNode5card
 }
 
+func rootNode27() *tblNode {
+	rootNode27cardInit.Do(func() {
+		rootNode27card = gentree(TableRules{HandSize: 5, SuitN: 5, Eval: EvalSlow27})
+	})
+	return rootNode27card
+}
+
+func rootNodeBadugi() *tblNode {
+	rootNodeBadugiCardInit.Do(func() {
+		rootNodeBadugiCard = gentree(TableRules{HandSize: 4, SuitN: 1, Eval: EvalSlowBadugi})
+	})
+	return rootNodeBadugiCard
+}
+
+func rootNodeShortDeck(rules ShortDeckRules) *tblNode {
+	rootNodeShortDeckCardInit[rules].Do(func() {
+		scores := &getShortDeckInfo().scores[rules]
+		rootNodeShortDeckCard[rules] = gentree(TableRules{HandSize: 7, Deck: ShortDeckCards, SuitN: 5, Eval: func(c []Card) int16 {
+			var c7 [7]Card
+			copy(c7[:], c)
+			return gentreeEval7(&c7, func(h *[5]Card) int16 {
+				return scores[Eval5(h)]
+			})
+		}})
+	})
+	return rootNodeShortDeckCard[rules]
+}
+
 func nodeEval7(hand *[7]Card) int16 {
 	node := rootNode7()
 	tx := suitTransform{0, 1, 2, 3}
@@ -225,12 +395,14 @@ func nodeEval5(hand *[5]Card) int16 {
 // Eval3 evaluates a 3-card poker hand, returning a rank for the hand from
 // 0 to ScoreMax (inclusive).
 func Eval3(hand *[3]Card) int16 {
+	needTable3()
 	return rootNode3table[int(hand[0]>>2)<<8+int(hand[1]>>2)<<4+int(hand[2]>>2)]
 }
 
 // Eval5 evaluates a 5-card poker hand, returning a rank for the hand
 // from 0 to ScoreMax (inclusive).
 func Eval5(hand *[5]Card) int16 {
+	needTable5()
 	v := rootNode5table[hand[0]]
 	tx := suitTransformByte(v)
 	idx := int(v >> 8)
@@ -253,6 +425,7 @@ func Eval5(hand *[5]Card) int16 {
 // Eval7 evaluates a 7-card poker hand, returning a rank for the hand
 // from 0 to ScoreMax (inclusive).
 func Eval7(hand *[7]Card) int16 {
+	needTable7()
 	v := rootNode7table[hand[0]]
 	tx := suitTransformByte(v)
 	idx := int(v >> 8)
@@ -273,16 +446,38 @@ func Eval7(hand *[7]Card) int16 {
 	tx = tx.Compose(suitTransformByte(v))
 	idx = int(v >> 8)
 
-	v = rootNode7table[idx+int(tx.Apply(hand[5]))]
+	// The nodes for 5- and 6-card hands only have entries for
+	// suits 0 and 3, since there's at most one suit that can
+	// still make a flush. See genTables7.
+	v = rootNode7table[idx+int(tx.Apply(hand[5])>>1)]
 	tx = tx.Compose(suitTransformByte(v))
 	idx = int(v >> 8)
 
-	return int16(rootNode7table[idx+int(tx.Apply(hand[6]))])
+	return int16(rootNode7table[idx+int(tx.Apply(hand[6])>>1)])
 }
 
 // InternalTables returns the tables of data used in the
 // optimized 3- 5- and 7- card evaluators.
 // The contents of these three tables is subect to change.
 func InternalTables() (tbl3 []int16, tbl5, tbl6 []uint32) {
+	needTable3()
+	needTable5()
+	needTable7()
 	return rootNode3table[:], rootNode5table[:], rootNode7table[:]
 }
+
+// InternalTables27 returns the table of data used in the
+// optimized 2-7 lowball evaluator.
+// The contents of this table is subject to change.
+func InternalTables27() []uint32 {
+	needTable27()
+	return rootNode27table[:]
+}
+
+// InternalTablesShortDeck returns the table of data used in the
+// optimized 7-card short-deck evaluator for the given rules.
+// The contents of this table is subject to change.
+func InternalTablesShortDeck(rules ShortDeckRules) []uint32 {
+	needTableShortDeck()
+	return rootNodeShortDeckTable[rules][:]
+}
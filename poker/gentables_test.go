@@ -1,7 +1,9 @@
 package poker
 
 import (
+	"bytes"
 	"math/rand"
+	"reflect"
 	"testing"
 )
 
@@ -16,7 +18,7 @@ func TestEval5Single(t *testing.T) {
 	}
 	var cards [5]Card
 	for _, c := range cases {
-		h, err := parseHand(c)
+		h, err := ParseHand(c)
 		if err != nil {
 			t.Fatalf("parse error of %s: %v", c, err)
 		}
@@ -236,6 +238,28 @@ func BenchmarkEval7Reversed(b *testing.B) {
 	b.Logf("1 op is %d 7-card hands\n", total)
 }
 
+// BenchmarkEval7Random evaluates hands in a random order, so that
+// unlike BenchmarkEval7, lookups are rarely in cache.
+func BenchmarkEval7Random(b *testing.B) {
+	rnd := rand.New(rand.NewSource(42))
+	hands := make([][7]Card, 1<<20)
+	for i := range hands {
+		perm := rnd.Perm(52)
+		for j := range hands[i] {
+			hands[i][j] = Card(perm[j])
+		}
+	}
+	b.ResetTimer()
+	var T int64
+	for i := 0; i < b.N; i++ {
+		T += int64(Eval7(&hands[i&(len(hands)-1)]))
+	}
+	// make sure we're not optimizing the code away.
+	if T == 0 && b.N > 100 {
+		panic("x")
+	}
+}
+
 func TestTables(t *testing.T) {
 	tcs := []tableTestCase{
 		{hand: "HK DK S2 D3 CQ DJ D7"},
@@ -245,7 +269,7 @@ func TestTables(t *testing.T) {
 		{hand: "SA SK SQ CJ ST S9 S8"},
 	}
 	for _, tc := range tcs {
-		h, err := parseHand(tc.hand)
+		h, err := ParseHand(tc.hand)
 		if err != nil {
 			t.Fatalf("%s: parseHand failed: %v", tc.hand, err)
 		}
@@ -258,3 +282,178 @@ func TestTables(t *testing.T) {
 		}
 	}
 }
+
+// TestEval7Table checks every transition of the 7-card table against
+// the state machine it was built from. The table merges nodes and only
+// has 26 entries for nodes after 5 cards, so this checks that the suit
+// transforms into those nodes only produce suits 0 and 3, and that
+// every entry agrees with the unmerged node.
+func TestEval7Table(t *testing.T) {
+	if testing.Short() {
+		t.Skip("building the 7-card state machine is slow")
+	}
+	type state struct {
+		node   *tblNode
+		offset int
+	}
+	seen := map[state]bool{}
+	var walk func(node *tblNode, offset int)
+	walk = func(node *tblNode, offset int) {
+		if seen[state{node, offset}] {
+			return
+		}
+		seen[state{node, offset}] = true
+		for c, tr := range node.T {
+			i := offset + c
+			if node.N >= 5 {
+				if c&3 == 1 || c&3 == 2 {
+					continue
+				}
+				i = offset + c>>1
+			}
+			v := rootNode7table[i]
+			if node.N == 6 {
+				if int16(v) != tr.rank {
+					t.Fatalf("node %d (%s) card %s: got rank %d, want %d", node.Index, hand64(node.H).String(node.N), Card(c), int16(v), tr.rank)
+				}
+				continue
+			}
+			if tr.N == nil {
+				continue
+			}
+			if got, want := suitTransformByte(v), tr.SX.Byte(); got != want {
+				t.Fatalf("node %d (%s) card %s: got suit transform %v, want %v", node.Index, hand64(node.H).String(node.N), Card(c), got.Long(), want.Long())
+			}
+			if tr.N.N >= 5 {
+				for s, ns := range tr.SX {
+					if ns == 1 || ns == 2 {
+						t.Fatalf("node %d (%s) card %s: suit transform %v maps suit %d to %d", node.Index, hand64(node.H).String(node.N), Card(c), tr.SX, s, ns)
+					}
+				}
+			}
+			walk(tr.N, int(v>>8))
+		}
+	}
+	needTable7()
+	walk(rootNode7(), 0)
+	t.Logf("checked %d states", len(seen))
+}
+
+func TestGenerateTable(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		rules TableRules
+		want  func(h []Card) int16
+	}{
+		{
+			name:  "5-card",
+			rules: TableRules{HandSize: 5, SuitN: 5, Eval: EvalSlow},
+			want: func(h []Card) int16 {
+				var h5 [5]Card
+				copy(h5[:], h)
+				return Eval5(&h5)
+			},
+		},
+		{
+			name: "short-deck 5-card",
+			rules: TableRules{HandSize: 5, Deck: ShortDeckCards, SuitN: 5, Eval: func(c []Card) int16 {
+				return EvalSlowShortDeck(c, ShortDeckTripsBeatStraight)
+			}},
+			want: func(h []Card) int16 {
+				var h5 [5]Card
+				copy(h5[:], h)
+				return EvalShortDeck5(&h5, ShortDeckTripsBeatStraight)
+			},
+		},
+		{
+			name:  "badugi",
+			rules: TableRules{HandSize: 4, SuitN: 1, Eval: EvalSlowBadugi},
+			want: func(h []Card) int16 {
+				var h4 [4]Card
+				copy(h4[:], h)
+				return EvalBadugi(&h4)
+			},
+		},
+	} {
+		tbl, err := GenerateTable(tc.rules)
+		if err != nil {
+			t.Fatalf("%s: GenerateTable failed: %v", tc.name, err)
+		}
+		deck := tc.rules.Deck
+		if deck == nil {
+			deck = Cards
+		}
+		n := tc.rules.HandSize
+		ix := make([]int, n)
+		for i := range ix {
+			ix[i] = i
+		}
+		h := make([]Card, n)
+		rev := make([]Card, n)
+		fails, count := 0, 0
+		for {
+			count++
+			for i, j := range ix {
+				h[i] = deck[j]
+				rev[n-1-i] = deck[j]
+			}
+			want := tc.want(h)
+			if got := tbl.Eval(h); got != want {
+				t.Errorf("%s: Eval(%s) = %d, want %d", tc.name, Hand(h), got, want)
+				fails++
+			}
+			if got := tbl.Eval(rev); got != want {
+				t.Errorf("%s: Eval(%s) = %d, want %d", tc.name, Hand(rev), got, want)
+				fails++
+			}
+			if fails > 10 || !nextIdx(ix, len(deck), 0) {
+				break
+			}
+		}
+		if want := choose(uint64(len(deck)), uint64(n)); count != int(want) && fails <= 10 {
+			t.Errorf("%s: checked %d hands, want %d", tc.name, count, want)
+		}
+		if got := tbl.Eval(h[1:]); got != -1 {
+			t.Errorf("%s: Eval(%s) = %d, want -1 for the wrong number of cards", tc.name, Hand(h[1:]), got)
+		}
+
+		var buf bytes.Buffer
+		if err := tbl.Write(&buf); err != nil {
+			t.Fatalf("%s: Write failed: %v", tc.name, err)
+		}
+		got, err := ReadTable(&buf)
+		if err != nil {
+			t.Fatalf("%s: ReadTable failed: %v", tc.name, err)
+		}
+		if !reflect.DeepEqual(got, tbl) {
+			t.Errorf("%s: table differs after writing and reading", tc.name)
+		}
+	}
+}
+
+func TestGenerateTableErrors(t *testing.T) {
+	c := mustParseHand(t, "AcKdQh")
+	for _, tc := range []struct {
+		name  string
+		rules TableRules
+	}{
+		{"no cards", TableRules{HandSize: 0, Eval: EvalSlow}},
+		{"too many cards", TableRules{HandSize: 8, Eval: EvalSlow}},
+		{"no eval", TableRules{HandSize: 5}},
+		{"duplicate card", TableRules{HandSize: 2, Deck: []Card{c[0], c[1], c[0]}, Eval: EvalSlow}},
+		{"invalid card", TableRules{HandSize: 2, Deck: []Card{c[0], 60}, Eval: EvalSlow}},
+		{"small deck", TableRules{HandSize: 5, Deck: c, Eval: EvalSlow}},
+	} {
+		if _, err := GenerateTable(tc.rules); err == nil {
+			t.Errorf("%s: GenerateTable succeeded, want error", tc.name)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTables(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadTable(&buf); err == nil {
+		t.Errorf("ReadTable succeeded on the evaluator tables, want error")
+	}
+}
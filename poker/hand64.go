@@ -209,6 +209,43 @@ func (st suitTransformByte) Long() suitTransform {
 
 var suitTransformByteIdentity = suitTransform{0, 1, 2, 3}.Byte()
 
+// suitPermutations are the 24 suit transforms that are permutations
+// of the suits. The identity is first.
+var suitPermutations = func() []suitTransform {
+	var r []suitTransform
+	for a := uint8(0); a < 4; a++ {
+		for b := uint8(0); b < 4; b++ {
+			for c := uint8(0); c < 4; c++ {
+				d := 6 - a - b - c
+				if a == b || a == c || b == c || d > 3 || d == a || d == b || d == c {
+					continue
+				}
+				r = append(r, suitTransform{a, b, c, d})
+			}
+		}
+	}
+	return r
+}()
+
+// suitMasks[s] is the 64-bit mask of all cards of suit s,
+// where card c is bit c.
+var suitMasks = func() [4]uint64 {
+	var r [4]uint64
+	for c := 0; c < 52; c++ {
+		r[c&3] |= 1 << c
+	}
+	return r
+}()
+
+// applyMask applies the suit transform to each card in a
+// 64-bit mask of cards, where card c is bit c.
+func (st suitTransform) applyMask(m uint64) uint64 {
+	return (m&suitMasks[0])<<st[0] |
+		(m&suitMasks[1])>>1<<st[1] |
+		(m&suitMasks[2])>>2<<st[2] |
+		(m&suitMasks[3])>>3<<st[3]
+}
+
 func (st suitTransform) Apply(c Card) Card {
 	return Card(st[c&3]) | (c &^ 3)
 }
@@ -220,7 +257,17 @@ func (h hand64) Canonical(n, finalN int) hand64Canonical {
 	return r
 }
 
+// CanonicalWithTransform returns the canonical form of an n-card hand64,
+// and the suit transform that maps the hand's suits to the canonical
+// suits, where the hand will be extended to finalN cards and suits
+// only matter if they can form a flush.
 func (h hand64) CanonicalWithTransform(n, finalN int) (hand64Canonical, suitTransform) {
+	return h.canonicalWithTransform(n, finalN, 5)
+}
+
+// canonicalWithTransform is like CanonicalWithTransform, but a suit matters
+// if it can have suitN or more cards when the hand has finalN cards.
+func (h hand64) canonicalWithTransform(n, finalN, suitN int) (hand64Canonical, suitTransform) {
 	var csuits [4]canonSuit
 	for i := 0; i < 4; i++ {
 		csuits[i].s = Suit(i)
@@ -245,7 +292,7 @@ func (h hand64) CanonicalWithTransform(n, finalN int) (hand64Canonical, suitTran
 	var si [4]int
 	nextSuit := 0
 	for i := 0; i < 4; i++ {
-		if csuits[i].n+(finalN-n) < 5 {
+		if csuits[i].n+(finalN-n) < suitN {
 			si[i] = int(xSuit)
 		} else {
 			si[i] = nextSuit
@@ -21,15 +21,15 @@ func TestHand64Add(t *testing.T) {
 		{5, "H2 D2 C2 CQ", "S2", "S2 CQ D2 H2 C2"},
 	}
 	for _, tc := range tcs {
-		ch0, err := parseHand(tc.start)
+		ch0, err := ParseHand(tc.start)
 		if err != nil {
 			t.Fatal(err)
 		}
-		want, err := parseHand(tc.want)
+		want, err := ParseHand(tc.want)
 		if err != nil {
 			t.Fatal(err)
 		}
-		addCards, err := parseHand(tc.add)
+		addCards, err := ParseHand(tc.add)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -48,7 +48,7 @@ func TestHand64Add(t *testing.T) {
 			continue
 		}
 		got := goth.CardsN(tc.N)
-		if !reflect.DeepEqual(got, want) {
+		if !reflect.DeepEqual(got, []Card(want)) {
 			t.Errorf("%s.Add(%s) = %v, want %v", hand64(h64c).String(len(ch0)), addCard, got, want)
 		}
 	}
@@ -110,9 +110,9 @@ func TestCanonical(t *testing.T) {
 	}
 	for _, tc := range tcs {
 		head := fmt.Sprintf("%s.Canon()=%s", tc.hand, tc.want)
-		h0, err := parseHand(tc.hand)
+		h0, err := ParseHand(tc.hand)
 		if err != nil {
-			t.Fatalf("%s: parseHand(%s) gave error %s", head, tc.hand, err)
+			t.Fatalf("%s: ParseHand(%s) gave error %s", head, tc.hand, err)
 		}
 		N := len(h0)
 		var h64 hand64
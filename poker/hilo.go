@@ -0,0 +1,88 @@
+package poker
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// NoLow is the score returned by the eight-or-better low evaluators
+// for a hand that has no qualifying low.
+const NoLow = -1
+
+// ScoreLow8Max is the largest possible score returned by the
+// eight-or-better low evaluators. It is the score of the wheel, 5-4-3-2-A.
+const ScoreLow8Max = 55
+
+// lowBit returns the bit representing the card's rank in an
+// ace-to-five low, with the ace as bit 0, the deuce as bit 1, and so on,
+// or 0 if the card is higher than an eight.
+func lowBit(c Card) uint8 {
+	r := c.Rank()
+	if r == 0 || r > 8 {
+		return 0
+	}
+	return 1 << (r - 1)
+}
+
+// low8Scores maps 8-bit rank masks with exactly 5 bits set to their score.
+// A mask with a lower numeric value is a better low, since lows are
+// compared from the highest card down. Other masks map to NoLow.
+// low8Masks is the inverse map.
+var low8Scores, low8Masks = func() ([256]int16, [ScoreLow8Max + 1]uint8) {
+	var scores [256]int16
+	var masks [ScoreLow8Max + 1]uint8
+	score := ScoreLow8Max
+	for m := 0; m < 256; m++ {
+		if bits.OnesCount8(uint8(m)) != 5 {
+			scores[m] = NoLow
+			continue
+		}
+		scores[m] = int16(score)
+		masks[score] = uint8(m)
+		score--
+	}
+	return scores, masks
+}()
+
+// bestLow8 returns the score of the best low contained in the rank mask.
+func bestLow8(m uint8) int16 {
+	for bits.OnesCount8(m) > 5 {
+		m &^= 1 << (7 - bits.LeadingZeros8(m))
+	}
+	return low8Scores[m]
+}
+
+// EvalLow8 evaluates the ace-to-five low of a hand of five or more cards
+// with an eight-or-better qualifier, as used in Omaha hi/lo and stud hi/lo.
+// Aces are low, and straights and flushes don't count against the hand.
+// The best five cards of distinct rank, eight or lower, make the low.
+// The result is NoLow if there's no qualifying low, and otherwise
+// a score from 0 to ScoreLow8Max (inclusive), where higher scores are
+// better lows.
+func EvalLow8(c []Card) int16 {
+	var m uint8
+	for _, ci := range c {
+		m |= lowBit(ci)
+	}
+	return bestLow8(m)
+}
+
+// DescribeLow8 describes a score returned by one of the eight-or-better low
+// evaluators, for example "8-6-4-2-A", or "no low" for NoLow.
+func DescribeLow8(score int16) (string, error) {
+	if score == NoLow {
+		return "no low", nil
+	}
+	if score < 0 || score > ScoreLow8Max {
+		return "", fmt.Errorf("invalid low score %d", score)
+	}
+	m := low8Masks[score]
+	var parts []string
+	for r := 8; r >= 1; r-- {
+		if m&(1<<(r-1)) != 0 {
+			parts = append(parts, Rank(r).String())
+		}
+	}
+	return strings.Join(parts, "-"), nil
+}
@@ -0,0 +1,183 @@
+package poker
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEvalLow8(t *testing.T) {
+	tcs := []struct {
+		hand string
+		want string
+	}{
+		{"SA D2 H3 C4 S5", "5-4-3-2-A"},
+		{"S5 S4 S3 S2 SA", "5-4-3-2-A"},
+		{"S8 D6 H4 C2 SA", "8-6-4-2-A"},
+		{"S9 D6 H4 C2 SA", "no low"},
+		{"S8 D8 H4 C2 SA", "no low"},
+		{"SK DQ HJ CT S9", "no low"},
+		{"S8 D7 H6 C5 S4 D3 H2", "6-5-4-3-2"},
+		{"S8 D8 H7 C7 S4 D3 H2", "8-7-4-3-2"},
+		{"SA DA H2 C2 S3 D3 HK", "no low"},
+		{"SA DA H2 C2 S3 D4 HK", "no low"},
+		{"SA DA H2 C2 S3 D4 H6", "6-4-3-2-A"},
+	}
+	for _, tc := range tcs {
+		h := mustParseHand(t, tc.hand)
+		got, err := DescribeLow8(EvalLow8(h))
+		if err != nil {
+			t.Fatalf("DescribeLow8(EvalLow8(%s)) failed: %v", tc.hand, err)
+		}
+		if got != tc.want {
+			t.Errorf("EvalLow8(%s) = %s, want %s", tc.hand, got, tc.want)
+		}
+	}
+}
+
+func TestLow8Rankings(t *testing.T) {
+	// These lows are in descending order of strength.
+	hands := []string{
+		"SA D2 H3 C4 S5",
+		"SA D2 H3 C4 S6",
+		"SA D2 H3 C5 S6",
+		"D2 H3 C4 S5 S6",
+		"SA D2 H3 C4 S7",
+		"SA D2 H6 C5 S7",
+		"D6 H4 C5 S3 S7",
+		"SA D2 H3 C4 S8",
+		"H4 D5 C6 S7 S8",
+	}
+	prev := int16(ScoreLow8Max + 1)
+	for _, h := range hands {
+		ev := EvalLow8(mustParseHand(t, h))
+		if ev >= prev {
+			t.Errorf("expected %s to be worse than the previous hand, but got scores %d and %d", h, ev, prev)
+		}
+		prev = ev
+	}
+	if prev != 0 {
+		t.Errorf("worst low scored %d, want 0", prev)
+	}
+}
+
+func TestEvalOmahaLow8(t *testing.T) {
+	tcs := []struct {
+		hole, board string
+		want        string
+	}{
+		{"SA D2 HK CK", "S3 H4 D5 CQ SJ", "5-4-3-2-A"},
+		// Must use exactly two cards from the hand.
+		{"SA D2 H3 C4", "S6 H7 D8 CQ SJ", "8-7-6-2-A"},
+		// Must use exactly three cards from the board.
+		{"SA D2 HK CK", "S3 H4 D5 C6 S7", "5-4-3-2-A"},
+		{"SA DA HK CK", "S3 H4 D5 C6 S7", "no low"},
+		{"CA D2 HK CK", "SA H2 D5 C9 ST", "no low"},
+		{"CA D3 HK CK", "SA H2 D5 C6 S7", "6-5-3-2-A"},
+		{"SA D2 H3 C4", "S9 HT D8 CQ S7", "no low"},
+	}
+	for _, tc := range tcs {
+		hole := mustParseHand(t, tc.hole)
+		var board [5]Card
+		copy(board[:], mustParseHand(t, tc.board))
+		got, err := DescribeLow8(EvalOmahaLow8(hole, &board))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.want {
+			t.Errorf("EvalOmahaLow8(%s, %s) = %s, want %s", tc.hole, tc.board, got, tc.want)
+		}
+	}
+}
+
+func TestOmahaHiLoEquitiesRiver(t *testing.T) {
+	tcs := []struct {
+		name  string
+		hands []string
+		board string
+		want  []Equity
+	}{
+		{
+			name:  "high wins and quarters low",
+			hands: []string{"SA C4 SK HK", "DA H4 C9 S9", "CQ HQ DJ SJ"},
+			board: "S2 H3 D7 CK DQ",
+			want: []Equity{
+				{Equity: 0.75, Win: 0.5, Tie: 1, High: 0.5, Low: 0.25},
+				{Equity: 0.25, Win: 0, Tie: 1, Low: 0.25},
+				{},
+			},
+		},
+		{
+			name:  "no qualifying low",
+			hands: []string{"SA C4 SK HK", "DA H4 C9 S9"},
+			board: "S2 HQ D9 CK DQ",
+			want: []Equity{
+				{Equity: 1, Win: 1, High: 1, Scoop: 1},
+				{},
+			},
+		},
+		{
+			name:  "scoop",
+			hands: []string{"SA C2 S4 S5", "DK HK C9 S9"},
+			board: "S3 H7 D8 SK SQ",
+			want: []Equity{
+				{Equity: 1, Win: 1, High: 0.5, Low: 0.5, Scoop: 1},
+				{},
+			},
+		},
+		{
+			name:  "split",
+			hands: []string{"SA C2 S4 S5", "DK HK C9 S9"},
+			board: "S3 H7 D8 CK CQ",
+			want: []Equity{
+				{Equity: 0.5, Win: 0.5, Low: 0.5},
+				{Equity: 0.5, Win: 0.5, High: 0.5},
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var hands [][]Card
+			for _, h := range tc.hands {
+				hands = append(hands, mustParseHand(t, h))
+			}
+			eqs, err := OmahaHiLoEquities(hands, mustParseHand(t, tc.board))
+			if err != nil {
+				t.Fatalf("OmahaHiLoEquities failed: %v", err)
+			}
+			for i, want := range tc.want {
+				want.Boards = 1
+				if eqs[i] != want {
+					t.Errorf("hand %s: got %+v, want %+v", tc.hands[i], eqs[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestOmahaHiLoEquitiesSum(t *testing.T) {
+	hands := [][]Card{
+		mustParseHand(t, "SA C2 S4 S5"),
+		mustParseHand(t, "DK HK C9 S9"),
+		mustParseHand(t, "DA H3 C6 D7"),
+	}
+	board := mustParseHand(t, "S3 H7 D8")
+	eqs, err := OmahaHiLoEquities(hands, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var total, high, low float64
+	for _, eq := range eqs {
+		if math.Abs(eq.High+eq.Low-eq.Equity) > 1e-9 {
+			t.Errorf("high %f + low %f != equity %f", eq.High, eq.Low, eq.Equity)
+		}
+		total += eq.Equity
+		high += eq.High
+		low += eq.Low
+	}
+	if math.Abs(total-1) > 1e-9 {
+		t.Errorf("total equity = %f, want 1", total)
+	}
+	if high < 0.5 || low > 0.5 {
+		t.Errorf("high equity %f, low equity %f: high should be at least half the pot", high, low)
+	}
+}
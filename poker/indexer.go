@@ -0,0 +1,448 @@
+package poker
+
+import (
+	"bytes"
+	"fmt"
+	"math/bits"
+	"sort"
+)
+
+// maxIndexerRounds is the largest number of rounds a HandIndexer
+// supports.
+const maxIndexerRounds = 8
+
+// A HandIndexer maps hands dealt over a number of rounds to dense
+// indexes, in such a way that two hands have the same index exactly
+// when one can be made from the other by permuting the suits.
+// For example, in hold'em with rounds of 2, 3, 1 and 1 cards,
+// there are 169 preflop indexes, and AcKc has the same index as AhKh.
+// The cards in a round are unordered, but which round a card was dealt
+// in matters: AcKc with a flop of 2c3c4d has a different index from
+// 2c3c with a flop of AcKc4d.
+//
+// The indexes are constructed combinatorially (in a similar way to
+// Kevin Waugh's hand isomorphism indexer), so no tables of hands are
+// needed, even when there are billions of classes.
+type HandIndexer struct {
+	rounds  []int // the number of cards dealt in each round
+	byRound []indexerRound
+}
+
+// An indexerRound describes the indexes of hands of the first few
+// rounds.
+type indexerRound struct {
+	configs []indexerConfig
+	lookup  map[string]int // from the key of a config to its position
+	size    uint64
+}
+
+// An indexerConfig is a way of dividing the cards dealt in each round
+// amongst the suits. Suits are unlabelled, so they're sorted in
+// decreasing lexicographic order of how many cards they get in each
+// round. Hands with the same config get a contiguous range of indexes.
+type indexerConfig struct {
+	sizes  [4][]int // the number of cards of each suit in each round
+	groups []indexerGroup
+	offset uint64 // the index of the first hand with this config
+	size   uint64 // the number of hands with this config
+}
+
+// An indexerGroup is a run of suits in a config which get the same
+// number of cards in each round. Permuting them gives an isomorphic
+// hand, so the group is indexed as a multiset of the indexes of the
+// cards in each suit.
+type indexerGroup struct {
+	start, n int    // the suits are start, ..., start+n-1
+	m        uint64 // the number of ways of dealing the cards to a suit
+	count    uint64 // the number of multisets of n of the m ways
+	radix    uint64 // the multiplier of the group's index in the config's
+}
+
+// NewHandIndexer returns an indexer for hands dealt in rounds, where
+// cardsPerRound gives the number of cards dealt in each round. For
+// example, NewHandIndexer(2, 3, 1, 1) indexes hold'em hands.
+// There can be at most 8 rounds, each round must deal at least one card,
+// and the number of indexes must fit into a uint64.
+func NewHandIndexer(cardsPerRound ...int) (*HandIndexer, error) {
+	if len(cardsPerRound) == 0 || len(cardsPerRound) > maxIndexerRounds {
+		return nil, fmt.Errorf("hand indexer has %d rounds: want from 1 to %d", len(cardsPerRound), maxIndexerRounds)
+	}
+	total := 0
+	for r, n := range cardsPerRound {
+		if n < 1 {
+			return nil, fmt.Errorf("round %d deals %d cards: want at least 1", r, n)
+		}
+		total += n
+	}
+	if total > len(Cards) {
+		return nil, fmt.Errorf("hand indexer deals %d cards, more than the %d in the deck", total, len(Cards))
+	}
+	hi := &HandIndexer{
+		rounds:  append([]int(nil), cardsPerRound...),
+		byRound: make([]indexerRound, len(cardsPerRound)),
+	}
+	for K := 1; K <= len(cardsPerRound); K++ {
+		ir, err := newIndexerRound(cardsPerRound[:K])
+		if err != nil {
+			return nil, err
+		}
+		hi.byRound[K-1] = ir
+	}
+	return hi, nil
+}
+
+func newIndexerRound(rounds []int) (indexerRound, error) {
+	ir := indexerRound{lookup: map[string]int{}}
+	for _, sizes := range indexerConfigs(rounds) {
+		cfg := indexerConfig{sizes: sizes, offset: ir.size, size: 1}
+		for s := 0; s < 4; {
+			g := indexerGroup{start: s, m: 1}
+			for g.start+g.n < 4 && intsEqual(sizes[s], sizes[g.start+g.n]) {
+				g.n++
+			}
+			used := 0
+			for _, n := range sizes[s] {
+				g.m *= choose(uint64(13-used), uint64(n))
+				used += n
+			}
+			var ok bool
+			if g.count, ok = chooseChecked(g.m+uint64(g.n)-1, uint64(g.n)); !ok {
+				return ir, fmt.Errorf("hand indexer for rounds %v has too many indexes", rounds)
+			}
+			g.radix = cfg.size
+			hi, lo := bits.Mul64(cfg.size, g.count)
+			if hi != 0 {
+				return ir, fmt.Errorf("hand indexer for rounds %v has too many indexes", rounds)
+			}
+			cfg.size = lo
+			cfg.groups = append(cfg.groups, g)
+			s += g.n
+		}
+		var carry uint64
+		ir.size, carry = bits.Add64(ir.size, cfg.size, 0)
+		if carry != 0 {
+			return ir, fmt.Errorf("hand indexer for rounds %v has too many indexes", rounds)
+		}
+		ir.lookup[string(configKey(sizes))] = len(ir.configs)
+		ir.configs = append(ir.configs, cfg)
+	}
+	return ir, nil
+}
+
+// indexerConfigs returns every way of dividing the cards dealt in the
+// rounds amongst the four suits, with the suits in decreasing order.
+func indexerConfigs(rounds []int) [][4][]int {
+	var res [][4][]int
+	var cur [4][]int
+	rem := append([]int(nil), rounds...)
+	var addSuit func(s int)
+	addSuit = func(s int) {
+		if s == 4 {
+			for _, n := range rem {
+				if n != 0 {
+					return
+				}
+			}
+			var cfg [4][]int
+			for i := range cfg {
+				cfg[i] = append([]int(nil), cur[i]...)
+			}
+			res = append(res, cfg)
+			return
+		}
+		t := make([]int, len(rounds))
+		var addRound func(r, total int)
+		addRound = func(r, total int) {
+			if r == len(rounds) {
+				if s > 0 && compareInts(t, cur[s-1]) > 0 {
+					return
+				}
+				cur[s] = t
+				for i, n := range t {
+					rem[i] -= n
+				}
+				addSuit(s + 1)
+				for i, n := range t {
+					rem[i] += n
+				}
+				return
+			}
+			for n := 0; n <= rem[r] && total+n <= 13; n++ {
+				t[r] = n
+				addRound(r+1, total+n)
+			}
+		}
+		addRound(0, 0)
+	}
+	addSuit(0)
+	return res
+}
+
+func configKey(sizes [4][]int) []byte {
+	var key []byte
+	for _, ns := range sizes {
+		for _, n := range ns {
+			key = append(key, byte(n))
+		}
+	}
+	return key
+}
+
+func compareInts(a, b []int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func intsEqual(a, b []int) bool {
+	return compareInts(a, b) == 0
+}
+
+// Rounds returns the number of cards dealt in each round.
+func (hi *HandIndexer) Rounds() []int {
+	return append([]int(nil), hi.rounds...)
+}
+
+// Size returns the number of indexes of hands dealt up to and including
+// the given round (counting from 0). For hold'em, these are 169,
+// 1286792, 55190538 and 2428287420.
+func (hi *HandIndexer) Size(round int) uint64 {
+	if round < 0 || round >= len(hi.byRound) {
+		return 0
+	}
+	return hi.byRound[round].size
+}
+
+// IndexOf returns the index of a hand given the cards dealt in each
+// round, from 0 up to (but not including) Size(len(rounds)-1).
+// It's not necessary to give cards for every round: for hold'em,
+// IndexOf(hole, flop) returns the index of a hand on the flop.
+// The cards must be distinct, and each round must have the right
+// number of cards.
+func (hi *HandIndexer) IndexOf(rounds ...[]Card) (uint64, error) {
+	K := len(rounds)
+	if K == 0 || K > len(hi.rounds) {
+		return 0, fmt.Errorf("got %d rounds of cards: want from 1 to %d", K, len(hi.rounds))
+	}
+	var seen CardSet
+	var masks [4][maxIndexerRounds]uint16
+	for r, cs := range rounds {
+		if len(cs) != hi.rounds[r] {
+			return 0, fmt.Errorf("round %d has %d cards: want %d", r, len(cs), hi.rounds[r])
+		}
+		for _, c := range cs {
+			if !c.Valid() {
+				return 0, fmt.Errorf("round %d contains invalid card %d", r, c)
+			}
+			if seen.Contains(c) {
+				return 0, fmt.Errorf("duplicate card %s", c)
+			}
+			seen = seen.Add(c)
+			masks[c&3][r] |= 1 << (c >> 2)
+		}
+	}
+
+	// Find the number of cards in each round for each suit, and the
+	// index of the suit's cards.
+	var sizes [4][maxIndexerRounds]byte
+	var ps [4]uint64
+	for s := range masks {
+		var used uint16
+		radix := uint64(1)
+		for r := 0; r < K; r++ {
+			m := masks[s][r]
+			n := bits.OnesCount16(m)
+			sizes[s][r] = byte(n)
+			ps[s] += radix * colexRank(compressRanks(m, used))
+			radix *= choose(uint64(13-bits.OnesCount16(used)), uint64(n))
+			used |= m
+		}
+	}
+
+	// Sort the suits into the order of the config.
+	order := [4]int{0, 1, 2, 3}
+	for i := 1; i < 4; i++ {
+		for j := i; j > 0; j-- {
+			a, b := order[j-1], order[j]
+			c := bytes.Compare(sizes[a][:K], sizes[b][:K])
+			if c > 0 || c == 0 && ps[a] <= ps[b] {
+				break
+			}
+			order[j-1], order[j] = b, a
+		}
+	}
+	var key [4 * maxIndexerRounds]byte
+	for i, s := range order {
+		copy(key[i*K:], sizes[s][:K])
+	}
+	ir := &hi.byRound[K-1]
+	ci, ok := ir.lookup[string(key[:4*K])]
+	if !ok {
+		return 0, fmt.Errorf("internal error: no config for hand %v", rounds)
+	}
+	cfg := &ir.configs[ci]
+	idx := cfg.offset
+	for _, g := range cfg.groups {
+		var mi uint64
+		for j := 0; j < g.n; j++ {
+			mi += choose(ps[order[g.start+j]]+uint64(j), uint64(j+1))
+		}
+		idx += mi * g.radix
+	}
+	return idx, nil
+}
+
+// Unindex returns a hand with the given index, dealt up to and including
+// the given round (counting from 0). It is the inverse of IndexOf:
+// the cards dealt in each round are returned, sorted, and IndexOf
+// returns idx for them.
+func (hi *HandIndexer) Unindex(round int, idx uint64) ([][]Card, error) {
+	if round < 0 || round >= len(hi.byRound) {
+		return nil, fmt.Errorf("round %d out of range: want from 0 to %d", round, len(hi.byRound)-1)
+	}
+	ir := &hi.byRound[round]
+	if idx >= ir.size {
+		return nil, fmt.Errorf("index %d out of range for round %d: want less than %d", idx, round, ir.size)
+	}
+	ci := sort.Search(len(ir.configs), func(i int) bool {
+		return ir.configs[i].offset > idx
+	}) - 1
+	cfg := &ir.configs[ci]
+	idx -= cfg.offset
+
+	var ps [4]uint64
+	for _, g := range cfg.groups {
+		mi := (idx / g.radix) % g.count
+		for j := g.n - 1; j >= 0; j-- {
+			k := uint64(j + 1)
+			// Find the largest b with choose(b, k) <= mi.
+			b := uint64(j) + uint64(sort.Search(int(g.m), func(x int) bool {
+				return choose(uint64(x+j+1), k) > mi
+			}))
+			mi -= choose(b, k)
+			ps[g.start+j] = b - uint64(j)
+		}
+	}
+
+	var sets [maxIndexerRounds]CardSet
+	for s := range cfg.sizes {
+		var used uint16
+		p := ps[s]
+		for r, n := range cfg.sizes[s] {
+			c := choose(uint64(13-bits.OnesCount16(used)), uint64(n))
+			m := decompressRanks(colexUnrank(p%c, n), used)
+			p /= c
+			used |= m
+			for ; m != 0; m &= m - 1 {
+				sets[r] = sets[r].Add(Card(bits.TrailingZeros16(m)*4 + s))
+			}
+		}
+	}
+	res := make([][]Card, round+1)
+	for r := range res {
+		res[r] = sets[r].Cards()
+	}
+	return res, nil
+}
+
+// compressRanks returns the ranks in m, renumbered so that the ranks
+// in used are skipped.
+func compressRanks(m, used uint16) uint16 {
+	var res uint16
+	for ; m != 0; m &= m - 1 {
+		x := bits.TrailingZeros16(m)
+		res |= 1 << uint(x-bits.OnesCount16(used&(1<<uint(x)-1)))
+	}
+	return res
+}
+
+// decompressRanks is the inverse of compressRanks.
+func decompressRanks(m, used uint16) uint16 {
+	var res uint16
+	x := 0
+	for m != 0 {
+		for used&(1<<uint(x)) != 0 {
+			x++
+		}
+		if m&1 != 0 {
+			res |= 1 << uint(x)
+		}
+		m >>= 1
+		x++
+	}
+	return res
+}
+
+// colexRank returns the position of the set of ranks m amongst all
+// sets of ranks of the same size, in colexicographic order.
+func colexRank(m uint16) uint64 {
+	var r uint64
+	for i := uint64(1); m != 0; i++ {
+		r += choose(uint64(bits.TrailingZeros16(m)), i)
+		m &= m - 1
+	}
+	return r
+}
+
+// colexUnrank is the inverse of colexRank: it returns the set of n
+// ranks with the given position.
+func colexUnrank(r uint64, n int) uint16 {
+	var m uint16
+	for k := n; k > 0; k-- {
+		x := uint64(k - 1)
+		for choose(x+1, uint64(k)) <= r {
+			x++
+		}
+		r -= choose(x, uint64(k))
+		m |= 1 << x
+	}
+	return m
+}
+
+// smallChoose caches the binomial coefficients needed to index
+// the ranks of a suit.
+var smallChoose = func() (t [64][8]uint64) {
+	for n := range t {
+		for k := range t[n] {
+			t[n][k], _ = chooseChecked(uint64(n), uint64(k))
+		}
+	}
+	return t
+}()
+
+// choose returns the binomial coefficient n choose k, which must fit
+// into a uint64.
+func choose(n, k uint64) uint64 {
+	if n < 64 && k < 8 {
+		return smallChoose[n][k]
+	}
+	c, _ := chooseChecked(n, k)
+	return c
+}
+
+// chooseChecked returns the binomial coefficient n choose k, and whether
+// it fits into a uint64.
+func chooseChecked(n, k uint64) (uint64, bool) {
+	if k > n {
+		return 0, true
+	}
+	if k > n-k {
+		k = n - k
+	}
+	c := uint64(1)
+	for i := uint64(0); i < k; i++ {
+		// c * (n-i) is divisible by i+1.
+		hi, lo := bits.Mul64(c, n-i)
+		if hi >= i+1 {
+			return 0, false
+		}
+		c, _ = bits.Div64(hi, lo, i+1)
+	}
+	return c, true
+}
@@ -0,0 +1,209 @@
+package poker
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func mustHandIndexer(t testing.TB, rounds ...int) *HandIndexer {
+	hi, err := NewHandIndexer(rounds...)
+	if err != nil {
+		t.Fatalf("NewHandIndexer(%v) failed: %v", rounds, err)
+	}
+	return hi
+}
+
+func TestHandIndexerSizes(t *testing.T) {
+	tcs := []struct {
+		rounds []int
+		want   []uint64
+	}{
+		{[]int{2, 3, 1, 1}, []uint64{169, 1286792, 55190538, 2428287420}},
+		{[]int{4}, []uint64{16432}},
+		{[]int{1}, []uint64{13}},
+		{[]int{5}, []uint64{134459}},
+		{[]int{7}, []uint64{6009159}},
+	}
+	for _, tc := range tcs {
+		hi := mustHandIndexer(t, tc.rounds...)
+		for r, want := range tc.want {
+			if got := hi.Size(r); got != want {
+				t.Errorf("NewHandIndexer(%v).Size(%d) = %d, want %d", tc.rounds, r, got, want)
+			}
+		}
+	}
+}
+
+func TestHandIndexerErrors(t *testing.T) {
+	for _, rounds := range [][]int{nil, {2, 0}, {20, 20, 20}, {1, 1, 1, 1, 1, 1, 1, 1, 1}} {
+		if _, err := NewHandIndexer(rounds...); err == nil {
+			t.Errorf("NewHandIndexer(%v) succeeded, want error", rounds)
+		}
+	}
+	hi := mustHandIndexer(t, 2, 3)
+	for _, tc := range [][]string{
+		{},
+		{"AcKc", "2c3c4c", "5c"},
+		{"Ac"},
+		{"AcKc", "2c3c"},
+		{"AcKc", "2c3cKc"},
+	} {
+		var rounds [][]Card
+		for _, s := range tc {
+			rounds = append(rounds, mustParseHand(t, s))
+		}
+		if idx, err := hi.IndexOf(rounds...); err == nil {
+			t.Errorf("IndexOf(%v) = %d, want error", rounds, idx)
+		}
+	}
+	if _, err := hi.Unindex(0, 169); err == nil {
+		t.Errorf("Unindex(0, 169) succeeded, want error")
+	}
+	if _, err := hi.Unindex(2, 0); err == nil {
+		t.Errorf("Unindex(2, 0) succeeded, want error")
+	}
+}
+
+// TestHandIndexerIsomorphic checks that hands that are the same up to
+// permuting suits get the same index, and hands that aren't get
+// different indexes.
+func TestHandIndexerIsomorphic(t *testing.T) {
+	hi := mustHandIndexer(t, 2, 3)
+	tcs := []struct {
+		a, b []string
+		same bool
+	}{
+		{[]string{"AcKc"}, []string{"AhKh"}, true},
+		{[]string{"AcKd"}, []string{"KsAh"}, true},
+		{[]string{"AcKc"}, []string{"AcKd"}, false},
+		{[]string{"AcKc", "2c3d4h"}, []string{"AsKs", "2s3h4c"}, true},
+		{[]string{"AcKc", "2c3d4h"}, []string{"AsKs", "2s3h4h"}, false},
+		{[]string{"AcKd", "2c3d4h"}, []string{"AdKc", "2d3c4h"}, true},
+		{[]string{"AcKd", "2c3d4h"}, []string{"AdKc", "2c3d4h"}, false},
+		{[]string{"AcKc", "2c3c4d"}, []string{"2c3c", "AcKc4d"}, false},
+	}
+	for _, tc := range tcs {
+		var a, b [][]Card
+		for i := range tc.a {
+			a = append(a, mustParseHand(t, tc.a[i]))
+			b = append(b, mustParseHand(t, tc.b[i]))
+		}
+		ia, err := hi.IndexOf(a...)
+		if err != nil {
+			t.Fatalf("IndexOf(%v) failed: %v", a, err)
+		}
+		ib, err := hi.IndexOf(b...)
+		if err != nil {
+			t.Fatalf("IndexOf(%v) failed: %v", b, err)
+		}
+		if (ia == ib) != tc.same {
+			t.Errorf("IndexOf(%v) = %d, IndexOf(%v) = %d, want same=%v", a, ia, b, ib, tc.same)
+		}
+	}
+}
+
+// TestHandIndexerRoundTrip checks that every index in the early
+// rounds can be unindexed, and that indexing the resulting hand gives
+// back the index.
+func TestHandIndexerRoundTrip(t *testing.T) {
+	for _, rounds := range [][]int{{2, 3}, {4}, {1, 1, 1, 1}} {
+		hi := mustHandIndexer(t, rounds...)
+		for r := range rounds {
+			for idx := uint64(0); idx < hi.Size(r); idx++ {
+				h, err := hi.Unindex(r, idx)
+				if err != nil {
+					t.Fatalf("%v: Unindex(%d, %d) failed: %v", rounds, r, idx, err)
+				}
+				got, err := hi.IndexOf(h...)
+				if err != nil {
+					t.Fatalf("%v: IndexOf(%v) failed: %v", rounds, h, err)
+				}
+				if got != idx {
+					t.Fatalf("%v: IndexOf(Unindex(%d, %d)) = %d", rounds, r, idx, got)
+				}
+			}
+		}
+	}
+}
+
+// TestHandIndexerRandom checks that random hold'em hands, with their
+// suits randomly permuted, get the same index, and that unindexing
+// gives a hand that's the same up to suits.
+func TestHandIndexerRandom(t *testing.T) {
+	hi := mustHandIndexer(t, 2, 3, 1, 1)
+	rnd := rand.New(rand.NewSource(1))
+	perms := allSuitPerms()
+	for i := 0; i < 20000; i++ {
+		deck := append([]Card(nil), Cards...)
+		rnd.Shuffle(len(deck), func(i, j int) { deck[i], deck[j] = deck[j], deck[i] })
+		r := rnd.Intn(4)
+		h := [][]Card{deck[0:2], deck[2:5], deck[5:6], deck[6:7]}[:r+1]
+		idx, err := hi.IndexOf(h...)
+		if err != nil {
+			t.Fatalf("IndexOf(%v) failed: %v", h, err)
+		}
+		if idx >= hi.Size(r) {
+			t.Fatalf("IndexOf(%v) = %d, want less than %d", h, idx, hi.Size(r))
+		}
+		p := perms[rnd.Intn(len(perms))]
+		if got, err := hi.IndexOf(permuteSuits(h, p)...); err != nil || got != idx {
+			t.Fatalf("IndexOf(%v) = %d, %v, want %d", permuteSuits(h, p), got, err, idx)
+		}
+		u, err := hi.Unindex(r, idx)
+		if err != nil {
+			t.Fatalf("Unindex(%d, %d) failed: %v", r, idx, err)
+		}
+		if !suitIsomorphic(h, u, perms) {
+			t.Fatalf("Unindex(%d, IndexOf(%v)) = %v, which isn't isomorphic", r, h, u)
+		}
+	}
+}
+
+func allSuitPerms() [][4]Card {
+	var res [][4]Card
+	for a := Card(0); a < 4; a++ {
+		for b := Card(0); b < 4; b++ {
+			for c := Card(0); c < 4; c++ {
+				d := 6 - a - b - c
+				if a != b && a != c && b != c {
+					res = append(res, [4]Card{a, b, c, d})
+				}
+			}
+		}
+	}
+	return res
+}
+
+func permuteSuits(h [][]Card, p [4]Card) [][]Card {
+	res := make([][]Card, len(h))
+	for i, cs := range h {
+		for _, c := range cs {
+			res[i] = append(res[i], c&^3|p[c&3])
+		}
+	}
+	return res
+}
+
+func suitIsomorphic(a, b [][]Card, perms [][4]Card) bool {
+	for _, p := range perms {
+		pa := permuteSuits(a, p)
+		same := true
+		for i := range pa {
+			if NewCardSet(pa[i]...) != NewCardSet(b[i]...) {
+				same = false
+			}
+		}
+		if same {
+			return true
+		}
+	}
+	return false
+}
+
+func BenchmarkHandIndexerRiver(b *testing.B) {
+	hi := mustHandIndexer(b, 2, 3, 1, 1)
+	h := [][]Card{{0, 5}, {10, 20, 30}, {40}, {51}}
+	for i := 0; i < b.N; i++ {
+		hi.IndexOf(h...)
+	}
+}
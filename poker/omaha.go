@@ -0,0 +1,190 @@
+package poker
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// An OmahaBoard is a 5-card board preprocessed for evaluating
+// many Omaha hands against it.
+// The 5-card state machine is advanced over each of the 10 three-card
+// subsets of the board once, and each subset records the best rank
+// reachable from there, so that hands can skip subsets which can't
+// improve on the best hand found so far.
+type OmahaBoard struct {
+	triples [10]omahaTriple // ordered by decreasing max
+
+	// lowTriples are the rank masks of the three-card subsets of the
+	// board which have three distinct ranks eight or lower.
+	lowTriples  [10]uint8
+	nLowTriples int
+}
+
+type omahaTriple struct {
+	idx int               // index of the state-machine node after 3 cards
+	tx  suitTransformByte // suit transform to apply to subsequent cards
+	max int16             // the best rank reachable from this node
+}
+
+// eval5Step advances the 5-card state machine from the node
+// at idx by the card c.
+func eval5Step(idx int, tx suitTransformByte, c Card) (int, suitTransformByte) {
+	v := rootNode5table[idx+int(tx.Apply(c))]
+	return int(v >> 8), tx.Compose(suitTransformByte(v))
+}
+
+var (
+	node5Max     []int16
+	node5MaxInit sync.Once
+)
+
+// node5MaxRanks returns, for each node of the 5-card state machine
+// (indexed by its table offset divided by 52), the best rank of any
+// hand reachable from that node.
+func node5MaxRanks() []int16 {
+	needTable5()
+	node5MaxInit.Do(func() {
+		node5Max = make([]int16, len(rootNode5table)/52)
+		done := make([]bool, len(node5Max))
+		var walk func(idx, depth int) int16
+		walk = func(idx, depth int) int16 {
+			if done[idx/52] {
+				return node5Max[idx/52]
+			}
+			var best int16
+			for c := 0; c < 52; c++ {
+				v := rootNode5table[idx+c]
+				var r int16
+				if depth == 4 {
+					r = int16(v)
+				} else if v != 0 {
+					r = walk(int(v>>8), depth+1)
+				}
+				if r > best {
+					best = r
+				}
+			}
+			done[idx/52] = true
+			node5Max[idx/52] = best
+			return best
+		}
+		walk(0, 0)
+	})
+	return node5Max
+}
+
+// NewOmahaBoard preprocesses a 5-card board for Omaha evaluation.
+func NewOmahaBoard(board *[5]Card) *OmahaBoard {
+	ob := &OmahaBoard{}
+	ob.init(board)
+	return ob
+}
+
+func (ob *OmahaBoard) init(board *[5]Card) {
+	maxes := node5MaxRanks()
+	n := 0
+	ob.nLowTriples = 0
+	for i := 0; i < 5; i++ {
+		idxi, txi := eval5Step(0, suitTransformByteIdentity, board[i])
+		for j := i + 1; j < 5; j++ {
+			idxj, txj := eval5Step(idxi, txi, board[j])
+			for k := j + 1; k < 5; k++ {
+				if m := lowBit(board[i]) | lowBit(board[j]) | lowBit(board[k]); bits.OnesCount8(m) == 3 {
+					ob.lowTriples[ob.nLowTriples] = m
+					ob.nLowTriples++
+				}
+				idx, tx := eval5Step(idxj, txj, board[k])
+				tr := omahaTriple{idx: idx, tx: tx, max: maxes[idx/52]}
+				// Insertion sort, keeping the best triples first.
+				m := n
+				for ; m > 0 && ob.triples[m-1].max < tr.max; m-- {
+					ob.triples[m] = ob.triples[m-1]
+				}
+				ob.triples[m] = tr
+				n++
+			}
+		}
+	}
+}
+
+// Eval evaluates an Omaha hand against the board, returning the
+// rank of the best 5-card hand made from exactly two of the hole
+// cards and exactly three of the board cards.
+// The result is in the range 0 to ScoreMax (inclusive), and
+// is comparable with the results of Eval5 and Eval7.
+// The hole cards must not overlap with the board. Normally there are
+// 4, 5 or 6 hole cards, but any number from 2 upwards is allowed.
+// If there are fewer than 2 hole cards, the result is -1.
+func (ob *OmahaBoard) Eval(hole []Card) int16 {
+	best := int16(-1)
+	for t := range ob.triples {
+		tr := &ob.triples[t]
+		if tr.max <= best {
+			break
+		}
+		for i := 0; i < len(hole); i++ {
+			idx, tx := eval5Step(tr.idx, tr.tx, hole[i])
+			for j := i + 1; j < len(hole); j++ {
+				r := int16(rootNode5table[idx+int(tx.Apply(hole[j]))])
+				if r > best {
+					best = r
+				}
+			}
+			if best == tr.max {
+				break
+			}
+		}
+	}
+	return best
+}
+
+// EvalLow8 evaluates the eight-or-better low of an Omaha hand against
+// the board, using exactly two of the hole cards and exactly three of the
+// board cards. The result is as for EvalLow8: NoLow if there's no
+// qualifying low, and otherwise a score from 0 to ScoreLow8Max (inclusive)
+// where higher scores are better lows.
+func (ob *OmahaBoard) EvalLow8(hole []Card) int16 {
+	if ob.nLowTriples == 0 {
+		return NoLow
+	}
+	best := uint8(0xff)
+	for i := 0; i < len(hole); i++ {
+		bi := lowBit(hole[i])
+		if bi == 0 {
+			continue
+		}
+		for j := i + 1; j < len(hole); j++ {
+			bj := lowBit(hole[j])
+			if bj == 0 || bj == bi {
+				continue
+			}
+			for _, tm := range ob.lowTriples[:ob.nLowTriples] {
+				if tm&(bi|bj) == 0 && tm|bi|bj < best {
+					best = tm | bi | bj
+				}
+			}
+		}
+	}
+	return low8Scores[best]
+}
+
+// EvalOmahaLow8 evaluates the eight-or-better low of an Omaha hand,
+// using exactly two of the hole cards and three of the five board cards.
+// The result is NoLow if there's no qualifying low, and otherwise a score
+// from 0 to ScoreLow8Max (inclusive).
+func EvalOmahaLow8(hole []Card, board *[5]Card) int16 {
+	var ob OmahaBoard
+	ob.init(board)
+	return ob.EvalLow8(hole)
+}
+
+// EvalOmaha evaluates an Omaha hand (usually with 4, 5 or 6 hole cards),
+// using exactly two of the hole cards and three of the five board cards.
+// The result is in the range 0 to ScoreMax (inclusive).
+// When evaluating many hands on the same board, it's faster to use
+// NewOmahaBoard and OmahaBoard.Eval.
+func EvalOmaha(hole []Card, board *[5]Card) int16 {
+	var ob OmahaBoard
+	ob.init(board)
+	return ob.Eval(hole)
+}
@@ -0,0 +1,107 @@
+package poker
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// evalOmahaSlow evaluates an Omaha hand by trying every combination
+// of two hole cards and three board cards.
+func evalOmahaSlow(hole []Card, board *[5]Card) int16 {
+	best := int16(-1)
+	for i := 0; i < len(hole); i++ {
+		for j := i + 1; j < len(hole); j++ {
+			for a := 0; a < 5; a++ {
+				for b := a + 1; b < 5; b++ {
+					for c := b + 1; c < 5; c++ {
+						h := [5]Card{hole[i], hole[j], board[a], board[b], board[c]}
+						if ev := Eval5(&h); ev > best {
+							best = ev
+						}
+					}
+				}
+			}
+		}
+	}
+	return best
+}
+
+func TestEvalOmaha(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	for _, holeN := range []int{4, 5, 6} {
+		for trial := 0; trial < 20000; trial++ {
+			deck := rnd.Perm(52)
+			var board [5]Card
+			for i := range board {
+				board[i] = Card(deck[i])
+			}
+			hole := make([]Card, holeN)
+			for i := range hole {
+				hole[i] = Card(deck[5+i])
+			}
+			got := EvalOmaha(hole, &board)
+			want := evalOmahaSlow(hole, &board)
+			if got != want {
+				t.Fatalf("EvalOmaha(%v, %v) = %d, want %d", Hand(hole), Hand(board[:]), got, want)
+			}
+		}
+	}
+}
+
+func TestEvalOmahaTwoFromHand(t *testing.T) {
+	tcs := []struct {
+		hole, board string
+		want        string
+	}{
+		// Four spades on the board, but only one in hand: no flush.
+		{"SA HA DK CQ", "S2 S5 S9 SJ D3", "AA-J-9-5"},
+		// Four of a kind in hand plays as a pair.
+		{"SA HA DA CA", "S2 D5 H9 CJ D3", "AA-J-9-5"},
+		{"SA SK D2 C3", "S2 S5 S9 HJ D3", "AK952 flush"},
+		{"HT H9 C2 C3", "S8 S7 H6 HK DK", "T straight"},
+	}
+	for _, tc := range tcs {
+		hole := mustParseHand(t, tc.hole)
+		var board [5]Card
+		copy(board[:], mustParseHand(t, tc.board))
+		ev := EvalOmaha(hole, &board)
+		h, ok := EvalToHand5(ev)
+		if !ok {
+			t.Fatalf("EvalOmaha(%s, %s) = %d, not a valid score", tc.hole, tc.board, ev)
+		}
+		got, err := Describe(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.want {
+			t.Errorf("EvalOmaha(%s, %s) is %s, want %s", tc.hole, tc.board, got, tc.want)
+		}
+	}
+}
+
+func BenchmarkEvalOmaha(b *testing.B) {
+	rnd := rand.New(rand.NewSource(42))
+	type deal struct {
+		hole  []Card
+		board [5]Card
+	}
+	deals := make([]deal, 1000)
+	for i := range deals {
+		deck := rnd.Perm(52)
+		for j := 0; j < 5; j++ {
+			deals[i].board[j] = Card(deck[j])
+		}
+		for j := 0; j < 4; j++ {
+			deals[i].hole = append(deals[i].hole, Card(deck[5+j]))
+		}
+	}
+	b.ResetTimer()
+	var T int64
+	for n := 0; n < b.N; n++ {
+		d := &deals[n%len(deals)]
+		T += int64(EvalOmaha(d.hole, &d.board))
+	}
+	if T == 0 && b.N > 100 {
+		panic("x")
+	}
+}
@@ -0,0 +1,164 @@
+package poker
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// suitRunes maps the characters that can be used for suits
+// (in upper or lower case, or as unicode symbols) to suits.
+var suitRunes = map[rune]Suit{
+	'c': Club, 'C': Club, '♣': Club, '♧': Club,
+	'd': Diamond, 'D': Diamond, '♦': Diamond, '♢': Diamond,
+	'h': Heart, 'H': Heart, '♥': Heart, '♡': Heart,
+	's': Spade, 'S': Spade, '♠': Spade, '♤': Spade,
+}
+
+// parseRankAt parses a rank at the start of s, returning
+// the rank and the number of bytes used, or 0 if there's no rank.
+func parseRankAt(s string) (Rank, int) {
+	if strings.HasPrefix(s, "10") {
+		return 10, 2
+	}
+	if s == "" {
+		return 0, 0
+	}
+	switch b := s[0]; {
+	case b >= '2' && b <= '9':
+		return Rank(b - '0'), 1
+	case b == 'A' || b == 'a':
+		return 1, 1
+	case b == 'K' || b == 'k':
+		return 13, 1
+	case b == 'Q' || b == 'q':
+		return 12, 1
+	case b == 'J' || b == 'j':
+		return 11, 1
+	case b == 'T' || b == 't':
+		return 10, 1
+	}
+	return 0, 0
+}
+
+// parseSuitAt parses a suit at the start of s, returning the
+// suit and the number of bytes used, or BadSuit if there's no suit.
+func parseSuitAt(s string) (Suit, int) {
+	r, n := utf8.DecodeRuneInString(s)
+	if st, ok := suitRunes[r]; ok {
+		return st, n
+	}
+	return BadSuit, 0
+}
+
+// parseCardAt parses a card at the start of s, with the rank either
+// before or after the suit. It returns the card and the number of bytes
+// used, or an error describing what's wrong.
+func parseCardAt(s string) (Card, int, error) {
+	if r, n := parseRankAt(s); n > 0 {
+		st, m := parseSuitAt(s[n:])
+		if m == 0 {
+			if s[n:] == "" {
+				return 0, 0, fmt.Errorf("rank %s is missing a suit", s[:n])
+			}
+			return 0, 0, fmt.Errorf("expected a suit after rank %s, found %q", s[:n], firstRune(s[n:]))
+		}
+		return mustMakeCard(st, r), n + m, nil
+	}
+	if st, n := parseSuitAt(s); n > 0 {
+		r, m := parseRankAt(s[n:])
+		if m == 0 {
+			if s[n:] == "" {
+				return 0, 0, fmt.Errorf("suit %s is missing a rank", s[:n])
+			}
+			return 0, 0, fmt.Errorf("expected a rank after suit %s, found %q", s[:n], firstRune(s[n:]))
+		}
+		return mustMakeCard(st, r), n + m, nil
+	}
+	return 0, 0, fmt.Errorf("expected a rank or suit, found %q", firstRune(s))
+}
+
+func firstRune(s string) string {
+	_, n := utf8.DecodeRuneInString(s)
+	return s[:n]
+}
+
+// isCardSeparator reports whether r can be used to separate cards.
+func isCardSeparator(r rune) bool {
+	return r == ' ' || r == ',' || r == '\t' || r == '\n' || r == '\r'
+}
+
+// ParseCard parses a single card. The rank and suit can be in
+// either order, and in either case, so "Ac", "cA", "AC" and "CA" are all
+// the ace of clubs. Tens may be written as "T" or "10", and suits may be
+// written as unicode symbols, as in "A♠" or "10♥".
+func ParseCard(s string) (Card, error) {
+	c, n, err := parseCardAt(s)
+	if err != nil {
+		return 0, fmt.Errorf("can't parse card %q: %v", s, err)
+	}
+	if n != len(s) {
+		return 0, fmt.Errorf("can't parse card %q: unexpected %q after the card", s, s[n:])
+	}
+	return c, nil
+}
+
+// ParseHand parses a list of cards in any of the forms accepted by
+// ParseCard. The cards may be separated by spaces or commas, or
+// concatenated, as in "AcKh", "Ac Kh" or "A♣,K♥".
+// Errors give the (1-based) character position of the problem.
+// The cards must be distinct.
+func ParseHand(s string) (Hand, error) {
+	var h Hand
+	pos := 0 // the position in characters, rather than bytes.
+	for i := 0; i < len(s); {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		if isCardSeparator(r) {
+			i += n
+			pos++
+			continue
+		}
+		c, n, err := parseCardAt(s[i:])
+		if err != nil {
+			return nil, fmt.Errorf("can't parse card %d at position %d of %q: %v", len(h)+1, pos+1, s, err)
+		}
+		for j, hc := range h {
+			if hc == c {
+				return nil, fmt.Errorf("card %d (%s) at position %d of %q duplicates card %d", len(h)+1, c.Notation(), pos+1, s, j+1)
+			}
+		}
+		h = append(h, c)
+		pos += utf8.RuneCountInString(s[i : i+n])
+		i += n
+	}
+	return h, nil
+}
+
+// ParseBoard parses a board of up to 5 cards, in any of the forms accepted
+// by ParseHand.
+func ParseBoard(s string) ([]Card, error) {
+	h, err := ParseHand(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(h) > 5 {
+		return nil, fmt.Errorf("board %q has %d cards, more than 5", s, len(h))
+	}
+	return h, nil
+}
+
+// Notation returns the conventional form of a card: the rank followed
+// by the suit in lower case, for example "Ac" or "Th".
+func (c Card) Notation() string {
+	return c.Rank().String() + strings.ToLower(c.Suit().String())
+}
+
+// Notation returns the conventional form of a hand: the cards in
+// conventional form concatenated together, for example "AcKh".
+func (h Hand) Notation() string {
+	var sb strings.Builder
+	for _, c := range h {
+		sb.WriteString(c.Notation())
+	}
+	return sb.String()
+}
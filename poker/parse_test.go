@@ -0,0 +1,144 @@
+package poker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseCard(t *testing.T) {
+	tcs := []struct {
+		s    string
+		want string
+	}{
+		{"Ac", "CA"},
+		{"cA", "CA"},
+		{"AC", "CA"},
+		{"CA", "CA"},
+		{"ac", "CA"},
+		{"Th", "HT"},
+		{"10h", "HT"},
+		{"h10", "HT"},
+		{"2d", "D2"},
+		{"A♠", "SA"},
+		{"♠A", "SA"},
+		{"K♥", "HK"},
+		{"10♦", "DT"},
+		{"Q♣", "CQ"},
+		{"7♤", "S7"},
+	}
+	for _, tc := range tcs {
+		got, err := ParseCard(tc.s)
+		if err != nil {
+			t.Errorf("ParseCard(%q) failed: %v", tc.s, err)
+			continue
+		}
+		if got.String() != tc.want {
+			t.Errorf("ParseCard(%q) = %s, want %s", tc.s, got, tc.want)
+		}
+	}
+}
+
+func TestParseCardErrors(t *testing.T) {
+	tcs := []struct {
+		s       string
+		wantErr string
+	}{
+		{"", "expected a rank or suit"},
+		{"A", "missing a suit"},
+		{"c", "missing a rank"},
+		{"Ax", `found "x"`},
+		{"cx", `found "x"`},
+		{"1c", `found "1"`},
+		{"Acc", `unexpected "c"`},
+		{"AcKh", `unexpected "Kh"`},
+		{"x", `found "x"`},
+	}
+	for _, tc := range tcs {
+		_, err := ParseCard(tc.s)
+		if err == nil {
+			t.Errorf("ParseCard(%q) succeeded, want error", tc.s)
+			continue
+		}
+		if !strings.Contains(err.Error(), tc.wantErr) {
+			t.Errorf("ParseCard(%q) gave error %q, want it to contain %q", tc.s, err, tc.wantErr)
+		}
+	}
+}
+
+func TestParseHand(t *testing.T) {
+	tcs := []struct {
+		s    string
+		want string
+	}{
+		{"AcKh", "AcKh"},
+		{"Ac Kh", "AcKh"},
+		{"Ac,Kh", "AcKh"},
+		{"Ac, Kh", "AcKh"},
+		{"CA HK", "AcKh"},
+		{"CAHK", "AcKh"},
+		{"cAKh", "AcKh"},
+		{"A♣K♥", "AcKh"},
+		{"10s 9s 8s", "Ts9s8s"},
+		{"10s9s8s", "Ts9s8s"},
+		{"  Ac  ", "Ac"},
+		{"", ""},
+	}
+	for _, tc := range tcs {
+		got, err := ParseHand(tc.s)
+		if err != nil {
+			t.Errorf("ParseHand(%q) failed: %v", tc.s, err)
+			continue
+		}
+		if got.Notation() != tc.want {
+			t.Errorf("ParseHand(%q) = %s, want %s", tc.s, got.Notation(), tc.want)
+		}
+	}
+}
+
+func TestParseHandErrors(t *testing.T) {
+	tcs := []struct {
+		s       string
+		wantErr string
+	}{
+		{"AcKx", `card 2 at position 3 of "AcKx": expected a suit after rank K, found "x"`},
+		{"Ac Kx", `card 2 at position 4 of "Ac Kx"`},
+		{"A♣ K♥ Q", `card 3 at position 7 of "A♣ K♥ Q": rank Q is missing a suit`},
+		{"Ac Kh Ac", `card 3 (Ac) at position 7 of "Ac Kh Ac" duplicates card 1`},
+		{"Ac/Kh", `card 2 at position 3 of "Ac/Kh": expected a rank or suit, found "/"`},
+	}
+	for _, tc := range tcs {
+		_, err := ParseHand(tc.s)
+		if err == nil {
+			t.Errorf("ParseHand(%q) succeeded, want error", tc.s)
+			continue
+		}
+		if !strings.Contains(err.Error(), tc.wantErr) {
+			t.Errorf("ParseHand(%q) gave error %q, want it to contain %q", tc.s, err, tc.wantErr)
+		}
+	}
+}
+
+func TestParseBoard(t *testing.T) {
+	if b, err := ParseBoard("7d8c8sTs"); err != nil || Hand(b).Notation() != "7d8c8sTs" {
+		t.Errorf("ParseBoard(7d8c8sTs) = %v, %v", b, err)
+	}
+	if b, err := ParseBoard(""); err != nil || len(b) != 0 {
+		t.Errorf("ParseBoard(\"\") = %v, %v", b, err)
+	}
+	if _, err := ParseBoard("2c3c4c5c6c7c"); err == nil {
+		t.Errorf("ParseBoard of 6 cards succeeded, want error")
+	}
+}
+
+func TestNotationRoundTrip(t *testing.T) {
+	for _, c := range Cards {
+		got, err := ParseCard(c.Notation())
+		if err != nil || got != c {
+			t.Errorf("ParseCard(%s.Notation()) = %v, %v", c, got, err)
+		}
+		got, err = ParseCard(c.String())
+		if err != nil || got != c {
+			t.Errorf("ParseCard(%s.String()) = %v, %v", c, got, err)
+		}
+	}
+}
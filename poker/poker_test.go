@@ -1,25 +1,10 @@
 package poker
 
 import (
-	"fmt"
 	"math/rand"
-	"strings"
 	"testing"
 )
 
-func parseHand(s string) ([]Card, error) {
-	cs := strings.Split(s, " ")
-	r := make([]Card, len(cs))
-	for i, p := range cs {
-		var ok bool
-		r[i], ok = NameToCard[p]
-		if !ok {
-			return nil, fmt.Errorf("can't parse card %s", p)
-		}
-	}
-	return r, nil
-}
-
 func TestDescriptions(t *testing.T) {
 	// Hands and their long and short descriptions.
 	// When the short description is expected to be the same as the long,
@@ -67,9 +52,9 @@ func TestDescriptions(t *testing.T) {
 		{hand: "SA SQ ST DT S5 S3 CA", wantLong: "AQT53 flush"},
 	}
 	for i := range hands {
-		h0, err := parseHand(hands[i].hand)
+		h0, err := ParseHand(hands[i].hand)
 		if err != nil {
-			t.Fatalf("parseHand(%s) gave error %s", hands[i].hand, err)
+			t.Fatalf("ParseHand(%s) gave error %s", hands[i].hand, err)
 		}
 		for perms := 0; perms < 10; perms++ {
 			// Randomly permute the hands.
@@ -149,9 +134,9 @@ func TestRankings(t *testing.T) {
 	prevHand := ""
 	for i := range hands {
 		h := hands[i]
-		h0, err := parseHand(h)
+		h0, err := ParseHand(h)
 		if err != nil {
-			t.Fatalf("parseHand(%s) gave error %s", hands[i], err)
+			t.Fatalf("ParseHand(%s) gave error %s", hands[i], err)
 		}
 		ev := EvalSlow(h0)
 		if ev >= prevEV {
@@ -206,9 +191,9 @@ func TestToHand(t *testing.T) {
 	}
 	for i := range hands {
 		h := hands[i]
-		h0, err := parseHand(h)
+		h0, err := ParseHand(h)
 		if err != nil {
-			t.Fatalf("parseHand(%s) gave error %s", hands[i], err)
+			t.Fatalf("ParseHand(%s) gave error %s", hands[i], err)
 		}
 		h1 := []Card{}
 		ok := false
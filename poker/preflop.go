@@ -0,0 +1,354 @@
+package poker
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"sync"
+)
+
+// NumPreflopClasses is the number of classes of holdem hole cards,
+// where hands are in the same class if they're the same up to suits:
+// 13 pairs, 78 suited hands and 78 offsuit hands.
+const NumPreflopClasses = 169
+
+// PreflopClass returns the class of the hole cards, from 0 to 168.
+// The cards must be valid. The classes are numbered as in the usual
+// 13x13 grid, with ranks from ace down to two along the rows and columns,
+// pairs on the diagonal, suited hands above it and offsuit hands below
+// it. So class 0 is AA, class 1 is AKs, class 13 is AKo and class
+// 168 is 22.
+func PreflopClass(hole [2]Card) int {
+	hi, lo := 12-hole[0].RawRank(), 12-hole[1].RawRank()
+	if hi > lo {
+		hi, lo = lo, hi
+	}
+	if hole[0].Suit() == hole[1].Suit() {
+		return hi*13 + lo
+	}
+	return lo*13 + hi
+}
+
+// preflopRangeClass returns the class as a rangeClass.
+func preflopRangeClass(class int) rangeClass {
+	row, col := class/13, class%13
+	switch {
+	case row == col:
+		return rangeClass{hi: 12 - row, lo: 12 - row}
+	case row < col:
+		return rangeClass{hi: 12 - row, lo: 12 - col, suited: 's'}
+	}
+	return rangeClass{hi: 12 - col, lo: 12 - row, suited: 'o'}
+}
+
+// PreflopClassName returns the name of a preflop class in the usual
+// notation, for example "AA", "AKs" or "72o".
+func PreflopClassName(class int) string {
+	if class < 0 || class >= NumPreflopClasses {
+		return "?"
+	}
+	rc := preflopRangeClass(class)
+	s := string([]byte{rawRanks[rc.hi], rawRanks[rc.lo]})
+	if rc.suited != 0 {
+		s += string(rc.suited)
+	}
+	return s
+}
+
+// ParsePreflopClass parses the name of a preflop class, such as "AA",
+// "AKs" or "72o".
+func ParsePreflopClass(s string) (int, error) {
+	rc, ok := parseRangeClass(s)
+	if !ok || (rc.hi == rc.lo) != (rc.suited == 0) {
+		return 0, fmt.Errorf("can't parse preflop class %q", s)
+	}
+	return PreflopClass(rc.combos()[0]), nil
+}
+
+// PreflopClassCombos returns the hole cards in a preflop class.
+func PreflopClassCombos(class int) [][2]Card {
+	if class < 0 || class >= NumPreflopClasses {
+		return nil
+	}
+	return preflopRangeClass(class).combos()
+}
+
+// A PreflopTable holds the all-in preflop equity of each preflop class
+// against each other. The equity of one class against another is the
+// mean of the equities of each of the hands in the first class against
+// each of the hands in the second class that don't share a card with it.
+type PreflopTable struct {
+	eq [NumPreflopClasses][NumPreflopClasses]float64
+}
+
+// Equity returns the equity of the preflop class a against class b.
+// Ties count as half a win, so Equity(a, b) + Equity(b, a) is 1.
+func (pt *PreflopTable) Equity(a, b int) float64 {
+	return pt.eq[a][b]
+}
+
+// PreflopTableOptions configures how ComputePreflopTable works.
+type PreflopTableOptions struct {
+	// Workers is the maximum number of goroutines used to compute
+	// equities. If it's zero, runtime.NumCPU() is used.
+	Workers int
+
+	// Context, if not nil, can be used to cancel the computation,
+	// in which case the context's error is returned.
+	Context context.Context
+
+	// Progress, if not nil, is called after each matchup is computed,
+	// with the number of matchups done so far and the total.
+	Progress func(done, total int)
+}
+
+// ComputePreflopTable computes the table of preflop equities exactly.
+// Each matchup of two hole cards is computed only once for all the
+// matchups that are the same up to suits, which leaves 47008 matchups.
+// This is still slow, taking a couple of hours of CPU time.
+func ComputePreflopTable(opts PreflopTableOptions) (*PreflopTable, error) {
+	return computePreflopTable(opts, func(a, b int) bool { return true })
+}
+
+// A preflopMatchup is a matchup of two hole cards, which is the
+// representative of all the matchups that are the same up to suits.
+type preflopMatchup struct {
+	hands [2][2]Card
+	eq    float64 // the equity of the first hand
+}
+
+// computePreflopTable computes the entries of the table for which
+// want(a, b) is true.
+func computePreflopTable(opts PreflopTableOptions, want func(a, b int) bool) (*PreflopTable, error) {
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	// Find the distinct matchups up to suits, and for each pair of
+	// hands, which matchup it is and whether the hands are swapped.
+	type pairRef struct {
+		a, b    int // the preflop classes of the hands
+		matchup int
+		swapped bool
+	}
+	var combos [][2]Card
+	for c := 0; c < NumPreflopClasses; c++ {
+		combos = append(combos, PreflopClassCombos(c)...)
+	}
+	var matchups []preflopMatchup
+	var refs []pairRef
+	seen := map[[2]uint64]int{}
+	for i, h0 := range combos {
+		for _, h1 := range combos[i+1:] {
+			a, b := PreflopClass(h0), PreflopClass(h1)
+			if h0[0] == h1[0] || h0[0] == h1[1] || h0[1] == h1[0] || h0[1] == h1[1] || !want(a, b) && !want(b, a) {
+				continue
+			}
+			key, swapped := preflopMatchupKey(h0, h1)
+			m, ok := seen[key]
+			if !ok {
+				m = len(matchups)
+				seen[key] = m
+				hands := [2][2]Card{h0, h1}
+				if swapped {
+					hands[0], hands[1] = h1, h0
+				}
+				matchups = append(matchups, preflopMatchup{hands: hands})
+			}
+			refs = append(refs, pairRef{a: a, b: b, matchup: m, swapped: swapped})
+		}
+	}
+
+	// Compute the equity of each distinct matchup.
+	work := make(chan int, len(matchups))
+	for m := range matchups {
+		work <- m
+	}
+	close(work)
+	errs := make([]error, workers)
+	var mu sync.Mutex
+	done := 0
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for m := range work {
+				if errs[w] != nil || ctx.Err() != nil {
+					continue
+				}
+				hands := matchups[m].hands[:]
+				eqs, err := HoldemEquitiesWithOptions(hands, nil, EquityOptions{Workers: 1, Context: ctx})
+				if err != nil {
+					errs[w] = err
+					continue
+				}
+				matchups[m].eq = eqs[0].Equity
+				if opts.Progress != nil {
+					mu.Lock()
+					done++
+					opts.Progress(done, len(matchups))
+					mu.Unlock()
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Average the equities over the pairs of hands in each pair
+	// of classes.
+	var sum, count [NumPreflopClasses][NumPreflopClasses]float64
+	for _, r := range refs {
+		eq := matchups[r.matchup].eq
+		if r.swapped {
+			eq = 1 - eq
+		}
+		sum[r.a][r.b] += eq
+		count[r.a][r.b]++
+		sum[r.b][r.a] += 1 - eq
+		count[r.b][r.a]++
+	}
+	pt := &PreflopTable{}
+	for a := range pt.eq {
+		for b := range pt.eq[a] {
+			if count[a][b] > 0 {
+				pt.eq[a][b] = sum[a][b] / count[a][b]
+			}
+		}
+	}
+	return pt, nil
+}
+
+// preflopMatchupKey returns a key which is the same for two matchups
+// exactly when they're the same up to suits and the order of the
+// hands. It also returns whether the hands are swapped in the
+// matchup the key is made from.
+func preflopMatchupKey(h0, h1 [2]Card) ([2]uint64, bool) {
+	m0 := uint64(NewCardSet(h0[:]...))
+	m1 := uint64(NewCardSet(h1[:]...))
+	best := [2]uint64{^uint64(0), ^uint64(0)}
+	swapped := false
+	for _, st := range suitPermutations {
+		p0, p1 := st.applyMask(m0), st.applyMask(m1)
+		if p0 < best[0] || p0 == best[0] && p1 < best[1] {
+			best, swapped = [2]uint64{p0, p1}, false
+		}
+		if p1 < best[0] || p1 == best[0] && p0 < best[1] {
+			best, swapped = [2]uint64{p1, p0}, true
+		}
+	}
+	return best, swapped
+}
+
+var preflopTableMagic = [4]byte{'P', 'K', 'P', 'F'}
+
+// Write writes the table to w, as gzipped binary data.
+func (pt *PreflopTable) Write(w io.Writer) error {
+	zf := gzip.NewWriter(w)
+	if err := binary.Write(zf, binary.LittleEndian, preflopTableMagic); err != nil {
+		return err
+	}
+	if err := binary.Write(zf, binary.LittleEndian, &pt.eq); err != nil {
+		return err
+	}
+	return zf.Close()
+}
+
+// WriteFile writes the table to the named file, as Write does.
+func (pt *PreflopTable) WriteFile(name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := pt.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadPreflopTable reads a table written by PreflopTable.Write.
+// To embed a table in a program, the data can be compiled into
+// the program (for example, as generated by cmd/preflopgen -go, which
+// base64-encodes it) and read with ReadPreflopTable(base64.NewDecoder(
+// base64.RawStdEncoding, bytes.NewReader(data))).
+func ReadPreflopTable(r io.Reader) (*PreflopTable, error) {
+	zf, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	var magic [4]byte
+	if err := binary.Read(zf, binary.LittleEndian, &magic); err != nil {
+		return nil, err
+	}
+	if magic != preflopTableMagic {
+		return nil, fmt.Errorf("not a preflop equity table")
+	}
+	pt := &PreflopTable{}
+	if err := binary.Read(zf, binary.LittleEndian, &pt.eq); err != nil {
+		return nil, err
+	}
+	// Read to the end of the stream, which checks the gzip trailer.
+	if n, err := io.Copy(ioutil.Discard, zf); err != nil || n > 0 {
+		if err == nil {
+			err = errors.New("preflop equity table is too long")
+		}
+		return nil, err
+	}
+	if err := zf.Close(); err != nil {
+		return nil, err
+	}
+	return pt, nil
+}
+
+var defaultPreflopTable struct {
+	once sync.Once
+	pt   *PreflopTable
+}
+
+// DefaultPreflopTable returns the table of preflop equities that's
+// compiled into the package, in preflop_data.go (generated with
+// cmd/preflopgen -go). It's decoded the first time it's called.
+// The table is shared, and must not be modified.
+func DefaultPreflopTable() *PreflopTable {
+	dt := &defaultPreflopTable
+	dt.once.Do(func() {
+		pt, err := ReadPreflopTable(base64.NewDecoder(base64.RawStdEncoding, bytes.NewReader(preflopTableData)))
+		if err != nil {
+			panic(fmt.Sprintf("bad compiled-in preflop table: %v", err))
+		}
+		dt.pt = pt
+	})
+	return dt.pt
+}
+
+// ReadPreflopTableFile reads a table from the named file.
+func ReadPreflopTableFile(name string) (*PreflopTable, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadPreflopTable(f)
+}
@@ -0,0 +1,7 @@
+// Code generated by preflopgen. DO NOT EDIT.
+
+package poker
+
+// preflopTableData is a base64-encoded (base64.RawStdEncoding) table of
+// preflop equities, which can be read with poker.ReadPreflopTable.
+var preflopTableData = []uint8("H4sIAAAAAAAA/wTAByBUfwAH8JNKklBJRKgklZJCkr6EEJKEltGQlEIqqURlZRRJ+EsoSlMllUoIoey9uXu/9+7sEUnW/7PHZM9OFovFYrE6MO7TOXZOpgenVdmzSya60UwaFiq0dMM8ukLu2pdu2OvOHrc37UH570mBzLU96BBz+1y4tgfYqGD6V6sHrju0IuisbsiWKHY+a+tGpdUi3bPT3fBvZ8WqyvVg6Wz/348reuF58tq2WZpdEHt6RGtJcBcW7rht/fdkFxa1fnZvMOxCzq2Lc6cudcFg7Xfu9aYuGPzom3E0swsjbYfWH//UhRL7U7mXSrswyFou9KGvC+KPGvf9FumGpl7EQ5WN3Xh6euWi3y978cZwbWNTRzfsb6V/sFrShQcrj77fw+nEg+zb5Sq5nTB02XXSeqgTFcL3DZosu2BFHDLcMrsw4hVkrB/dhZJ/91v2v+zCQNzuVfdLuyCuPdutuq8LGm1ZmaKi3dhcmbd5x61e2F9wVJ+Z1Y17/616dFS5Gzue81SejHZC8dFR5VtbO1G5e9mP16c7YTPg/Fi1tBMdPzYvLFbtwqyYf+N7o7tQEpU3uiWkCwPqV3QvvuyCeINqSHppFzS9umoG+rrwTf2YVYRjL6YfJUQqx3ZDuchhOkSwG4d75U93eXVh9S+ZF3/qOnF449AKAZoHdpU2X8jOTpx+Nsdd/mUnwi+XHXgh2oWWdLXnhiFdGKh8etg6pAuLPOyfRL7sgsYiiYGK0i408y5e+m9GH3pKM6ROlHRD/PfFgPxt3dBZsmVo+asuOGqP2V6X6ULTF1NryY5OBJ5sXN86wIMQkh96WHdik+ipwMnPnfA1rqyPVeuCxGN2m93XLijnWkac+doF06NCzc9bu3A6RfpUV1svTBpT7/7Y3INzU85TK1O6kbBi7amb4t3IN+qpZft34bav0VvftV3IZt/Tc8nuhJdeAreknIdtFwqX2WzuRMjr9a6/X3dCYQ4uflLrQtYRc2/vr10o98ndEPq1CwZ2VN54Wy96j/dhvlgPpIX3xc8Q68EOVXHBI9e7cdam7nz2YBe23VHdYxfdBUrdeyRLoAvt1RcUJnI6IZv0aQW7jAeTiZ3214w7wbWyOaFX0QnfNKczc2y6sETw0vnS1i4sMCk5GzXQC4UvW+5Ni/Qgn3ducHVPN0bCG3bL9HRD/kPs5yuHu0F6R6KkZnQjOkpH6/K5LhyM3DKaO7sL0X0v3y7K7kS7u8o6uoQHDZE7gi8tO1FzMkHUvakTZ76nSagf6cKU87HPBid7cVivSuTbqh5Muc07w53Rg9iHO3+KtnejdudLxVnt3Qi8Noc/2a8bWGWZyj/SBdZKk+Mmt7rQ4x6yLGtmF8R7oqeOcTqxT03/vxk6nVCxWvf2549O/Hq97dMdsy68LnbZrRzai2DZFSINu3qgYGbrwqfYg7rL0cVr+Hvgnlq5al97N5SPVf0OWtSD9rkysTy/biTPEbdSkeyGv7WVyKN7XfCnn07I+3ZB/flFE9fZXYiXKjyoxOtErEzc2NDZLniajgusetOLfW++/BBy7cFQ68gKNZMeRAqpXLdT7IG65qm2QP4euHvhxeCiHigkr9/BXdSDnqRVU+ufdaO6/8SnxJXdyL+WmSIn2o2Jg3NTtm7uRlAlmfN6TReyTshIHAvvwtLOq1VqNb0Y5eZIHI3sQaT4+PkQ1x5o6qlVvTfpQbWb64Y2xR7wv9WfMNTsgdKROZHnxHvwl52ss0ezB60b6H+577tR802Dp5vYDbG4IO6h/G6Ur5VPuMPrhs/OdWMbzbugwHqvcnJRNlgsFovF6oDS/MmS2eUMMLfhqEImgz2z0//qPWaQrvebUipi8KxU1e5pOoPOGjPxowkMcjn+TZfeMdizoPuJkw0DsatDF8wvM2hNEqU94hmk3TQYrslh8HV9+pBiSQcaLxRemayuxfX+8vtnmxlEdj9fF1bA4CnX//uLNAaylXuV0gy42HCn7iZnIxfmBYHL7izjYlPTz/irK7jYOO62wmEnFxtuSVgOnOICd7q/j93hwla499zVDC58hGT+3F7Jg9tg8nl3FR50P9ooRV9qxblluS5b9hHEu2003aZFIHZu9eEfCQSTKizRSYqg6nzqlOp6Gqc72pJdRWnkCzQWbVhNgzPrZcpyfRoZbHm/CAca2+K2Hr/qTYM1ltwvO4+H4bZ1Z2cs4WGksOWkkh4NRQ8DBeNTreDY5ze+rKew/8iXTvVtBPpykfFnfAkkj2uFPv5J4N4vMXVRlEbfAePUTSI0RPZL+69aS4PadPdYlCGN073P9XyP0/Bn1nW++c3FtZL3TpHTXPAtNrpyZjUNm65cx4RsAkdLt9g9J1oRv2e359MGCsEiq9a2SRPYWZZJLnIi+ND2PIT7kuDfyRt8WiI0tJ6HHl+zgIZIwAy92PU0orB0hZ8JjSij0poEWR6k5M1OqD3h4ZWI04PTd2kIkY6AeCUajp8OupfnEFySevjh4YE2/Ln33nOvLIGer/DhnwIEcUJdpfFmBE1RuXqDaQTsP+7nPBfTaBCXredI0rAr1/hYqk5j5478XbFzeFgr5YGuOzxoF5+oTWuikRDfkc12pzHpfvD5QkEacTI/M/eJ0PDce/X8/lNtuPhFz/xNCoWs+nR1v24K00dCXtabEczK02q2fU+Qw3dHyGEpDZepNndDWRrFfTCtHeSioeCGlNxlHpIVG6qsVzPgm7DICsqmcbzi19PP1jQ6Bjdg4R8C+ccdM7/cJji974ngZf82KF9Saui8RWF7xpfX23so6C92ac3fS8CyyhYuzCRwcc+AgTyNwc4OQa3ZPFxd1Hdrrg8PcSVMzfc5DBbpaGR8ncPAY9HbhL4EGn1q4hqGoKHGLZjoqye4Jdt6SMKd4M5d0YrNAW04sa/3flsoBZGlCwOdflMoOnDio/NBAo2Lhzu5uQQlZTeNkh5xYSfz/vrHxzxkPhyvkZZnUNBzguswm4Gmw8fCg7MZZFxfap+eQmNMtWTloe009pymbt6sJ0j8/tRQ4jyBdgLvye+sNjjOyd1fnUjBb6BerkmAYHhe2d79rgTNQWXVPkVcDNfmGpv84iG01uzcHDAIXZHpZ7qSQYT7yuhwQQaKqvYr34oxcKFEuvY/p5G7VNH4uh4NUY/ueeIdBDprWGL1gQR+5Vl8l5a0I//GcpErKwlMZb6tZIUStAQFyWX3cbGz5rHxtd88DF96nXLflsHED8lPTTsYsMT9fy5bzUD+TdOtW+IMvFhHDcYWM6jc4pUs/4aGQrj2yQxTGpaGe108hwkqDxkKtS+gQeyvrerxbkdZVcAOjWcEDl/DZp0W56Fnlvr+w9Kd0Buuyam5ysB4h071kqMMLMJf0IcNGXiu5Usp3Mxgv/Xju9eXMihVjuC6yzBY4m/1YtZnGqbbTqUVHqGRv8d+9wZfGr2VR29qJtCwmhQ47N/XDkv1K6R8Xg5eUbNODB2pAovFYrFYHWi925us+IxB/+2irTsjGfTOHpvz4gGD6WOr+WoCGaQ58A/HRDAQDMnslL3FID1s/ubPygzCf866braHgWfewrMyHgyOXdoyrnSfwYmHbPH5stUYszuke/dIDTbYiGQGNjHo/NIjPz+fgsR8W74FLyhUH1CXOT6PIHvJ/Mr/9hDsTvioumsHQXXc9d2BKwnW5V05Z7iNwDR3t/nW/QRy8fnKz84TPLVuFb4XQfB1ffqQYkkHMp/2rHywgYeO/mUWMf218JpxWEf8OIM7U+p1m3Yz4OPxa2sQBgufBEafqmDgfKrPj6vMhZfAuGSsBBeXViclXN3KxYUPhuXz7Lm4+XUpFvtxkbRVpj/mGReJzReyQye4GG87J5/3lsBAZWIt/YqLhG0bPn60bYX6N/P9D7kURs6Wxrw2JuhQTjGlwwgk9teO5lIEmtKHUr8q0vgYELrXaxWNWj/7DS47aKQ61Ao329FQlerrLbhCY2Xx5CcZwsXkurZNAtEE5TJ+ChejuIhwfrtMr5ygXfrg2uwDrdhq57BKlqGgKLOlyWoNgZ3hSX9VXwIfoT415XyC7KiCF76raPDVl893U6LR8M6gt82Ahp2LXWnxURrvf4b8Gh3kIm9NrfiLSYLiFYvuz97Gw9TG78xTdRpHZm+rbCwlkF07/6PkzjbM3uUpPe8XBZx/WsiTI4gImRF0zoSgSrxpsuAVQdPF4HWmi2iUR2lEfJSgsd98j/ujzTRmiXf6xzVxYU1qdvcb0OBJb3X9osBDTHeubM81GqPZWnOXLaYR/ETS0zmDQO5Yz/lq6zZ4pLV+LvtG4ZNNycD+Tgoj7McnXpoQTO3YF6X6juDD9acFmyVpHLs2ulZahsb7ov49CTQX1frzx45r0NhhNqnxJ5iHnM6GcZtGGjLf9jK33Gi8TVaxrBWh0W/FXuKdSqBjWBzJLm7DyZvXtsQ/o3A5R1RkSIjgunyu9qVzBFoOi85ebiRIvTo38a8ODe+I/Q+qmrlQbi8czZOgwVnyc4D/HA9q8x+eS5BjEMORsK38SAMda84LbKNxM6JZM62G4I+WEd+nuwTwbJhX9bkNHzYJ/74dScHunyol8Y+CpGbAQqkjBMFWVw1iigi6fhyRpTy52Pe2ZVRGhUaefDjvzX0euJ9Lk/WWMbBfv8xfexkDgXUbH3g8omHQyj6yVItGVuBupZVjBKOvG5aOXCbov/mGCh9qw0dux/ubTynUl49eDZ5PoNfT+3rORYKIygX9p+O5kOKtjxLcTeNYU/wb/W88pA/K7tiwjcFbhOzzkGdwqeX8Eff5DNLDFBQln9JQ3nK3T96Rhou9NHuIIbi3eCP9/jbBlvf+28XN2rHo6I7Ta9cSxE20+r65S6CyfJOvVR4Xt0LuFEW70Hjf4PPGk+FBLsr3QtZBBiup7kA+HQbuBwd+GC9gUPTc80bmQgbaVsT4eyUNr6tnt0Va0ni+6aaO1TiBktGl9q+SNCL1dx679a4dcu+ePOSmEZg+efjsRScXLbM/ULUhNGznLsi2EumEs+jT8HEvBu52Cx7DnkF+7WJO4EYGT1mZ5bpLGGjsmghdAAZu7gE+VA6NxNVxN1NO0lipHb5cOJCG7rdIG4knNAQGnUwjxDoQ6fLgzek7OQjsNt+aEVYFo6aVH968qgKLxWKxWB1wvtx9kn2IwVN+vjnBpxlUbpK7ZmfNQNnOhCtgxOCvd+6SWFsGSvH3Ih8zNORfXbHjzGEg7+VVbLuWgRLf7Rrx3QyCDl4q0DKvxqshsfT2BzXwmGkjXtvGgYcf1JLLGESFSF6pzKewt37o/opVBJr3f+46eIrgn6vx15gdBCJP615v1Se49iiubHQHwYPEsy+67AjOXiW3DlwhGFObdNaPIahEVu6jU9VwEtt2KLSQgvWX7q68izWoj4vRZWwZFHxoG9h+ksLNGZ/mRRRTcMm9k1MoTHA7/HBQ416Ck9HUC7PtBPV3U3/N1SYYCb+SOrmfoNhlMMDlAoHFqrlONncJvq5PH1Is6YDsy6KQqOU8yA6kLn//lAvd2+kPg8Tr4CHO6/zSQ6Nz6v2e7BsMxr5ueTF0loHF9YQY1xIG0S+P+A6u4iLbqnJp7HousihPEyVLLn51mnxRvcRFp5PZ6Yx4Lsz+ZfJuPuPi9GCJ7MW3BBmWDf/kzAnkXC2p/Wu5sGT0spTNWnH7a3ey6B8Kl67k7TfcSjC+7/Lm52EEV6cDk0xaCQK/z7H+oU2jiH9hZoYOjQftQdErbWkoxiZ5ilym4SMcEqz0jYuI20fSJZfQoAdcbudnEDwwdujmVXIxsbJYy7uVQOzcWZFAzTYEj9c/D82lMLf2dkD/IoLbPg9+hx8nCN+7a5VQLkFU+r99EYo0wqqeZk6sobEoNCeWa0jDKXX3Lc8nXKw2OtJj60Dj0nOXwjlTBOk7AvLjv3AR3FZ0PleBRsQVL+3RZwTiz01LdXa1QUe0wKv2HYV0kxOHPWcQZCxbdGfQjuCzT36WZwHB+W8SfV7raMh9cdp3RIVGVMPfEKVoLs7SL46/VaehPF72eIUhjSHzfKdCOR5k5m3eec+bxvJnZP5PPhqOB6Nq3P8j4Npo3jv8oQ3HPZckf/lO4ZyOUvEPQYKLSY2jpm4EGzvWrTavJ3g8sfxg6XYa/qk9iSvTuLg/rhp/XJNGuvzhQk1VGiaJ+vObbvBwqOJhXGIljSNnzI/XWdGIX8Zac62PoLDPSPzySwKxU+P3NafbMLT9qOWOdAqxs4L0fkkS2Og3e5T6EhQ4VKaY8AjiXmS9naHMRWq8cMm4Oo0feep/KjbQWLfKxfz5LR6uT/jtkFNnsElm92/RDzScy6Y+fdpNQ1j1+AkyQnDkl15u1AOChJOX5NYrtmNWIUts82sKq9IkGreKE9z7JSyUeZWgeXZtgdpeLtxr7J25+2hE/AuK/GpGI7/NS2L7Wx5qhIfErDUZxJVGfJq/nEH7tR3XPnymcYXzdC3nCI3aK4EBkXNo9M84mnk3ioCOMbvy7no7nHSPFjZvJOhpnmxxiCUQST0UV+zJBfHMeXDBg8Z4fOePXS40lrceknBv5MHw7V4DYsPgnonwMq4Cg+64e43WigzCpscCbpTRYMc0sjddoMEn937Rphk0rm9+lv6fHA1jpfmJq7jtcIv48S/6A0H+VWXBn/FcqPxmPqbfpbFHUpQTFELj2/w+BfMZnYha5+Ww3JMB78cmTQUDBr8Ga1e1r2XgOyz2s0qLQUfY4Eo/Do2/i0psbrrS8FTK/dMVRiP2ef7akZc0bKlfXe1rO1DxKOuqwIJcOPyOnX8pswrynLPBHo1VUBZSWq9tVA0Wi8VisTqw8MYNpl2Oga1/RJO9EIPpt47KB//R8JheW1s+SkNiUXYIeUgjUmdlYGQKjbR2MbfPhTQqty4ek+mi8WqxyAVnn2rYBRStVG6owXfqors/zUH1ykntBRwOMt+6vs35zqBkX5ooBYIPCwPzJf0I3CdV39vsJ8jyPLnllj5B5quB9S+OEvT43BaIcyTIPTSTEbxBYCgu82P4IcHwuKV7xX/V+DrpsuFUGQUB80eHxd7V4PjBl4UVkRzIFBdlzdjLgOV7PmAPRaFzu+nWQEWCufMlZ8S6EEicvKD2YDuB2MPJtd8OExi4xc18bU8gaSpGSV8lSJq1Jk8glkBZ//sr/Xk1UJBaFvSrmILFAdX96y9SMDqQHWMZXQOfDvmf+mwam15oTmQFUJA+JfZm+CcFLc02z9miBKlBQXytuwlcbs9VqrQmSHRI5cs9QHB+27KODRcJ/o2o50hFEnxdnz6kWNKBkDfJQ7fFeQgZ9rv16j8uQrY4ypXIcsFn4X+8zKAOFeInjhvKM+io7vx2WZiBRsyel6OnGPjkLbjnVcCg8Z/GjUFtLvjEUgsNDLkQk7iqYe7CxcYob25lBBenl8VduHKWixmWexKCVGnsyzh0eVMFQZyEk1XbGYKe/zY8++XERaDW/qSRdW2odQ5/6l1KwVrP8GaHHMGK+Ga5OWcJgneSCKUmAtsO7em67TT2TXcd27GDRtMnfn1lexo9ggWfPKy5iJ63p6HkMg367KF0zyU0NlaeuL38A8HMe1Y3fC24cL12vc0qgyDEQ90+TbsNt655X/zxjYKBc8qtPiGCdwte/xO5RHD9pcDJrBYC7e6DMdl6NHjc51NPDGksmidjqabPRVvJnq4mUxoTdod07x6hoZd7Qlp7miD/gmjWsw9cHDIJPrJamMbDia2KFSkE7Q9je4qftUG1Yfro9w8UXDfnbaaXE6i1GcvdvUkwJB2/514XgZ1m5I0Fe2nc2f8+oU2XC7UHFXPebqFh8n1MNWAjjfkN0uf+6tKY81ho+4slPExvN34ZZ0dj+8CYu94ogdrviYqzDwk+Xj4d/b67Db2qE98cSijc80+cTJAgMM//rfzoGsGXjk57OYag4KjoZT82A9HPl9wf7KexoSMpzt2ChvHsXwU7d9GIesW4+P7HQ/fc2o0d5TQ2fPUfDTxJY112kHW6CA31a/q1Nh8I3A/FPY863Q7dKit3bimFo59dNPs2E1TUHT3t8oDgeUj/Un8eg4KccO2CQzQIk+n03z4aM4SpCFczGpspq2sayTx8jft7YVCTAZ/ZM63YKhqJ1fk3fC7QWLEqPs9yFo0DsqV2mz4SRHmLfzL/0I4MuRsrQrcQbP8ioymWSHDRu0UtT5iLLccH3Swv09gXLBW32oOGyxu9gsnTNALYGtdOlfDwSfJ8c7E2A7FT4/c1wSBtYHGbLIfGRr3+ZcPuNM5sk/1cvYJGjVxT4CVFGhOzT/exhTuQ48ZYG2URFD9axDuziYvQdonQiFgaj2fpfjhxl0bO2lMdWiE01i38GWo0woNQ1H9/BGwZ9GdvI7l6DF7S6+XdzBkobhE4qt9Ow3GjxuSKCBoV4kM/i+7RGLg/Ml2VTiOjdsOt9Ts7IDpSqsiKz0LMJcktL12rsHpIuO/Zjyqk/53s715djYbxf6o636rBYrFYLFYHFn5N3HtxP41z99UXfTamocqkpPjq0/BefavKq5rg1K7Xgpv+EojMllrxc4rAbPP8NY1yNNjXXq/evLUaodvLssfP1SD7iODPr0c5KHt0576xPQcRuZ+O7z3Mwdh+a9v2HAbLpI1kelcS1Mwanrd2jIJUuLXIYTaFPYKB0cJdFEQX83YenUfwt2C1fJkIga+PLl/mOoKJUKn1yRHVUMuLCJlhREFDIHW03bQG9g3b5eaIcbBi1KlPdD4Htm5pzvJ7GOw2FI48WETBbXHTQRdhgnO+x+a5NlNIHYu8J0coOM8e0bswm2DvB7VlbEGCZtfdU79WEfTsqbt3ZaQaCe07yDE9CgXLbN7/6OfAS6xg03LVGpye+9nLJIeN8mm1Dy3tNNT3XJir70LBRnZHldVbCrlnew6tG6CQUagXmdZGwbMmecdMPgJ7P1rabSYBV3tq4qA8gX+O9tJ5ETX43Hh/uy4oMEtezE/u5iBb7WjHjRgOLG0Vzn9fXgMLga4dYm40ytR9bz4TpdA/y8zkixWFQ1ajNRH3KLx+/OAor5/CVt/RHSITFJarbJG5Mk3heeeeSUdpgul+zzurSzrAP5Dn/UiCB8HNyYtyH3Dxn+fumMPLucj//H3OhVQGz2pneC+6Xov0O9+viCfRENyn5Hx3ioaFT/eeRQcYpH0QRWglA+FD6vVvTLgw7Kw+fM2KCyen2nl8V7gQThoMCIlmILtR93qwJI1TkaaH1T4SeFT/23LYimD5oInE9G8K9aGPuTYtDOQDDE6eRStWnLZb6P2YAtsqYFXKAIWo1+JFpgcJitaPDib+JDB98tvh5wYao4/0Ct9uoqEwuW9WbiCDk4O6kTHdBL43TbvMWTRu5P37cfEhgQbbJEVSmyD2rN3y67pcLOzM19x+i8DzbepI0ItW5NcIX7sUTWFXh42vPB/BFQfN5xfcCabr1SQT6ghch9eayGyn8d/S/zq9bjD4THmaKFUTNAgmXZj6RfDUa3fZRjaBbXTCyxofgsNudVtvPOZC6Kq8+tsmgpcztL2jvQnK5w6eONfbipnLNR+si6LQIBAeeHMWgdWdzPMvTxDkvUh7sqaMYO+j2PFSAQa5n5f8p9FC0NChmz+vliCNCA/YlhHUZ1xULWonaLJZp79TgYeTnnxvDxvTmD0l/XdHD0Fb/92uxVcJtDJUlh850YagfQbjP79QSC5qEgsSIBClK/RmuxIcruJ3uq/CwFh7KuE9H42TxxR/3vpHoOn0e6TpN0G20dEVqb0E0gvM9WY852H66c9q1Rwa8esZKcljNK7JHRbuHSJwwsmS7GSCO5m2mQKC7dD6vO7RTGWC3H1FH2/dIyiN+DT/kQaDp29WzvCdRaOgzmyd1RRBVJPk/iejBMu+Bvl7DBL4ht0N3f+eB7eUiVfMGgbC65aMlJTSiE56Un7oAI1hy/I9dsME3s/bAyhpGgVaeeFv7dpxoO1QZVYMwUcBm1mjhxmMrTWR+baShtje84aRMjQ4VjoeZDGNkxppiW9FaShkr4xTb+XBfFx7/OwGBkLyKltktBiE3P+24OUPGr1G3MjXR2icTxgt3hRM4/pAjvKV2zS0G01/7CpvR/gR0RyNR9/w7Ph/em9jqsC/JW3SZqAKtjHZ/HqW1TAaadml01mNhsBvBxea14LFYrFYrA4w/C6jBvEEGy+tnjJ4QFD49f2i8NUE9vPWfFdWI3DLspIoP0CgMado924HAlvLGH0rp2qMirELDrrWQG+bQt1zNQ7un440PazGwYHgr1KOqhzw2XD7EjM5KLfZ179rFRc5Wxob1s0jmJ2n1Ww7TGGTaSx7/hgF5QePlv+eRZAS8KQ6bzWBzqLgtBfrCIwLdm32yK5GWPz7sNNSFEQbvg1uvl6Dz4/6f+4bZ8Mnd0ms7V82Vt3WOxPrw4GK66vx0AwGh42zV52VI0hKUrkRNUihdM3toJ1/KBRfji5ew0+g4xh3eVyBoGXomjWlRDC719T/74oa3It4XjBLksJXskJQsokD4ebntnyuNZidt7fs6ys2Xt3xfvR3MwdJU5uMjAwY3NQRMbpaSGHwTmbl3tkELX+1A2sHKNQZGRZlTlLYvcnEK1iOoCtnvdW5lQTnc/6NqOfU4EXIlCy1iEJdm+exP7UcmFV7LJofwAFvldDDJPsapPqe25DGZUNxNq/mai0NWUWpXPZpCiEX83d9ek6hPd+5Yf5sgrjh2KL8vxRE2uO9opYSPHe5buUtS5Atl7bhiXYdBLe0UuKPKWwOsVZUOk7hmc/eBp1FFLQuv3wRl8sB+bpyp8+3GgxaXxA8FUTjz5NBQaXNFI78iYucPkfho76uvHUZhUrnbKd2ZQLhlVnGN7YSPH+bqHBqO8H0cPCMtSUd6FNWX3TVn4d+98Uv/pvLQ1BGznr1cC7S/25O2L2YCz2DTykn8hjYB2Z+0fxVi7evV1tKx9PQjEurfzhKI7qqpmCxG4PRi/9stboYOEaaqTce5+JN7JIJWVcuZBJ+SPwoo3Hh8Be+mdMEt/sK9n9ZSGPl7MxFSzMI2lfM/Z1lSTAavcg7XpKgJ1/H8kQ9g97LdwNHH7ZCfFPshPNTCg+3U1MLxAiWnDU50HuNYPJXuV9WP4GGvOvIUSsaQUPLyMIyGoW7BzPvMQS+pvZ5jzgEFl/nSdeNEkw12v67dJ9AfPdJv/2HCdJ+fDrtpMkF+/o6da0wAv2apr8dHa0wCx+MkUmi4KJQ/v7ffIK9Ny07fvoQ3GoY2uNGE+iYlIR57qFRY2T0yLabIHTnhxenuQRWu27Uf+0gaI2u2ujyh+C3UOsXk68EL2+EzFv7hYsH0s+PMuME8ne3aF8IJNgwEX657EAb9mas+1yZRiH+63nvJ3IE5fK6LiY3CKpGG1X67Gjc9Ft1e3KMQOPGvWihPwSCAQ55h/sJQp3KB5Q6CcTvDLZVyNNokVRiasFD9NXCyyvcaEgPWVm9Gydo0ZMOen+fYOPP0swbI23Y8vqH8IqdBF9qOtzt7hI4f5N8lBtI46WH1BoZORon3IM2rZWmseHCPvur4jS+WueFGovQSLjd7WipQqNTUk5mYwEPlZIJmx5waVjcUZ6/1oPGQvZvHeX5NExsO/5LUaeRsJ6RknzcjujONxvdPxH08fmuIrdpcL/NPh+zkkby1/PeT+RonMnReTYqSeNfUlpd/kIaTY31aWWbaYQVLbygWsGDcv/9kem9DN4cV6i43UZDdOvMbpMLNHKNhQWGA2h0z1tXvfQNjVG7YL5hvg6w3H3WfU/6BiXr47VauVV4un1MoqylCql6zhsHXKvB/2BziolQDSSr79aHuNRC46+i2PmCOrBYLBaL1YEIBavp5DcUbs76HLFEj4KTTcNqATMK8ur7hkMPULC5GOC0+BqFEx/uqIvfq4YTqfrv6fMaPAp++/XgNg5GMmU3zdvGQWaDEZ+4Jgf2KR61e3I4cAz8xI6SoVCfl112QoqLu2nH26XnE2Rv+U6XLiBIj/mb/HYpwZEfyVwxJYLQ2yb/2ZkSRHRW9J5/WY29fRM8LXkK15kblz2caiApygkS+saGvMxvluY3Ngo7ZWrPG3BQ/OsoO/MRBxH+647LC3Fhkyzi49xH4abyGX+p3xQuhj3WnxqnsPKLeaCdIMGem380X6wjkKu/N+ptUAMbzpBcnSwF33i320NxHKj1PX7+wq8GfzcO+b7yY+PhlLRVpxAHjzuOHFnvzAGfePqblv8YJN+NKVvFT1DVOXN92QCFH8ZavW/HKHh6cjcsECBIwG2O3RqC2Pe/mx5ya+DasvNK+lIKW1wFi+bEcSCeqCAcc5iDYtVqqTrvGrS2joXk57LBzt4eIyjLQaC0k7bYWgY3nUIG3zynUJuh4iMwmyBwJKz36zCFLSu2qsjMIDjW3cFxViAQPpGcvdqhDqVBCmCeUYhZygvRUqJw3nRm5c8uDh4ve3zTLo6DH0U939NbaiC5izWSNMKGxZlh96lWGge4R7f+PUfhtdqcot1lFIoOBsxvUCZQP3mANVee4IgKK91an+DWuLiraVk9usUjvkoKETxLtRR0DaYQ0Oi6e8qEQvqL+Xx3Jzh4MSHvq1TJQfuTeY4SpAaZqRZWiq40ZEr5wmyWUPBY+lTqtQeFMB2FIxrVFA7p7Xy2bROBeTMTekiHwKAg6K9qSQeOx1ikeF/iYf+I3K5MJx66t/wq3dfLhfhVbD/jwUVK0Xd9fzku+DPrVWKfMvBqLnp8XaAODmIdt0Uf0fANtbQMlmLQruLV/foeA3OzkjxTES7K2aYtx4K5YDXN0TtYQWByw1SFn49GqyM7+Gg7wbPa21TUMMHfnyuT3O8TiGLDWovDBAOHFlG94xTqqGef9rcwmPwvOO5xQiuMWCuP2jyn4BjFd23zEoK7j+Y1BQcQbGjbJFA/SpCzo7LB4wCB2dXHS0pm0eDYb/FYSwj2aBlfy+ggqAqKbTj+h6Bz4vFR/a8EBZK3pNiHCGoTrh6QtuNiRPxb0aZwAvq7jkzEkjbsVAvlE8qg0NR9cMRxBcE5xRvL390j2J0buvDfcYJZzRoPV4nSePCt5K9/H8GM/1r5RbsJQq6YbmwlBKdkrTWK5WmYbNPqd8wlCBGK/vmDw8Ueca+7bjNppHfZvFKOI3CcP7xc/30bXshZSZzbRGDbJL+6IJnAeabb1yseBHtvrpRzlqXReSLzctEkgc2On/57/xE0rtBMlx0myD90JFRJnMaidXapYYo0rvDmtIie5GGYnayzx4uGzcebh4RFaRw7sUYpQ56G7zbpdbyD7dDsH+TW/ySQMj8y98QDguO72h5v2UljVNljKnoljSOCfoLL5WnQXUPq/VI0ypcvFR3TouHNv0wiR51GzdI38hJ9PMyz2cG+NEjj/Dr5CbUgGtebC4tbY2kcy/+e+fc7jem6qbJKwQ6IXqx7qC33DT5bh3fNj6tCb2foupzeKojO9C4r1a/GEr5UKwfRGsRuev9+6mIt4kXS+M8X1qH1vRXfh4cNYLFYLBarAwnFJTe2rObgjbL5A52tHOyfCLYbNeQgVeSV+ToXDlappP+yPl0N1wZLe1nXGjzvIr17t3Hg2n4nLEGagws9ibNKpTmQzvzVOCeZA/k4UcaMjwLLcnnmz2kO3AdX9wwu5sKBF/NcfgGBhVG586ylBGP+qWscVxLE3JkX7biRwP397tf3sqthtVtFarM8BdnOxktWTjWQdRP/zP+NjVXeq1Q3fmOj3Nhi9hkDDirX3l/w7hEHgkcPLaia5mCTLN+RonEGt7cKBiuMUfD9D8aCMwhWNyyZ6yxEkM++etF5CYHusSSf9iU16NlYcD5ymgNqaPfX1jgOqtaVHvbZUIPpNyG3XuqwwTv9UED4Fxu9ZtTCvas5WOGXuvCcPwd8B+LUJ3oYCAjbrO1rpPDXJayrkkMh4fmJFxt7KGi9/ymhOkYhYPb3pX79NXjKb/f62RQHD/O5I7//48Dbdo5jqAQHchc3Rojvr8FFO51bkxFsXNbxj9rRz8bbi7uijppycNPBPE/Fm8HcxhtXFfspjM8uusfHppCsn2R1pIvCjt2zvh8ZpcD/fYXHwJk6CLzZdq4llMKo24/MVUoUPNhr5/xN5WDWQNdu/tMc1M2UcvbOrsHIp/UPpovYWNP/O052FQd73raMyqgwWG64wzXyMwW45GRISxE8vpWjsFuQQCdy5d3diwmihq4X9FTVQ1I7tV+kj4LY1EaNg8EUbp2N9l87l4Kst3WJegkHz1JerxFI5qCLoynE/lGDo7s7Q8sb2fj7asrTMJcGbx07d8iewoy99nOuZlOo2ue757oswfrtQi6WCwi+SOGK5K9GyBSZ5JzvphCp9SDxbDeF+S/5+nGawuPPOWomyhTWUEvuLZ1FgdsyW9ctnQPdzpSLOZwaCC6XL1c6S+NdwdJ3tcsolHXF/rW7RiGu97PO8TYKNcv+HrDXJbDvlYvYWdKB+6LtW8h5Hj4KlFyStOFB47jj3KqdPBx92ni5q4SLsd+Hr8+4zIU5cT+zmI+LrXNFFgQ9YeC5wVexdVEd0JbSI/yMhtzckxvVVzC4s+qLYE08A5EvWsu7l3DRqGzs67yawmTnJzv1WTSmNVa6X+4i2DN2qNSqmKBs50vFWe0EPnfHw2VSCY6ldRh9NiQ4ffPE6f9mEHQfzgzxpRjUTrmv7XrbCufUz5tGUihM2t1S3LOIIPq/N/se+BOcj1Z88WMbhRdGZiRchEbm/U9/aoYJpm0G9r6pJpARS7b/UEHAl/HK8ZEwDbryrgxeEfSmLNGRPUmQUFQ9seYkF/lB73yEQghsdylsjDdrQ8HjN4WH5Agu2Xo3vI4geHK06HesKQX+RQPTYVI05hw9tKBqmsBiReTZV20E7i07r6Q3E9guct2XNUHAf8Qy6MRCGovVGp/vLCOYf/l7yvleLgqHr7R3TRN4K2pFmQnTGBzJY+kMt+FH+ty9F5IIDqZJ/JW+QOG7jKDnf0o0yk+fv9U2n4a48ks6s5dgE2M/lN1FILks6FexEI3iqeCq8Nk0nO5y1z7XoFFpd8TW2YOH0fWRVrMv0Xh5ZK+Qsz+NIKeKn6X3aby5Elr042U79vxZ8MV5Ti4cmpXeqVhUI4ZwokOSq5FWUNfwSbUG9fXXeerfazAnrffgvAN18Ai4X9f+uh5/h0Nayrwakety4t/ttGawWCwWi9WB9dZq4k2JbDifUDIXe89GTo7Mta6vbKx3X3Tl54YaFHsKtslb12KX4MRcleMceB5y6dpkwkG/8ueQibUcNPFKBG0/cNDyU2zxo4UUvt1Y0blsAYXlib7Troso+HREHctT5iLm65K3a64QbP836B7sR0Br/FLtCiPQPfDH8INfDYTC17TSxhS8gu6M2uyuxZqewcUxPWwkfcMnh1I2gr32qufacHD7UPSO2Rkc/Ig4vGPVAgrmQwNtHuMc6M4/2xC7gIvv82+Frz9KcNr0qMXt0wRiwVoL+s4TqB94rN8uWQuhtqh+pQ0U+BNkNc7/4iCnab94j0UtBJRPMjqubAxwLx9PGmNjpDjbjbHmQJnl7zb7PQfz2ks0R8c5EHvvEdY5yiBl+wP318YEZlcvqM63JPidufv3mcMEH7L2pha8qYVlQqnJrPkUdjvYRJo/52Bi6maLuCEHIg53Jj0UanFt7EZWzFU2fhSnecxoZWN8+rzH8q0ctLR93bbwBgcDjlqP73cyqOwLXfR7DcGldSdq924mkDmF+++0Cebi4qeMY/UwXjHY55ZAwYBcVv+iRWHwxlOf6w0c3Bw7NRBlxYHP75exhl9rccdqWUFNIRsFIVT+8EoOUk6tvU27cJD9oaj+5gMGC/f5q80/TvDZ/oz5cWsCh/idovn2BI+8/L3vlTXA8c1hl2QWwQF3Jr3vAQVB0YWT7zUpqNstNZvxmQPFvEjsesyB8c+402oltdjemGNf3MzGfOMHDz+v4MApvcvmlTKDpavnqd6dR8Bbo3w52oXAzEi5q+gQQXqAbJlNZhOOabG0BacotL3eI8M/RSHQzPtE8X8UMuL8PyxeSuEZayrVbiYFvioVtZ4MDpIqBOoX8WrhrDl7R+8AG5qnD/lHNNEgOTuXX/hF4X2e06nLawmu/wh65xNCMD7Pp25IqhUaDuu0vFkEmq+jzXJpCiengzdoMBSizCJ9/E5QCHwndK7EnEL7ze1TB/goFG0uFR/6ycGgzuHddqw6FP/IE1B3pfF3dseRmUEUCgX5ngvyKETOWz4034ggYsdxNbeSDiy6EnpaLowH1XNeL6xceWBOqjQI7OVhOj/SWnsHD+FiErZW/VwwfOuMnS9yUalY3Nu0lIvZeZOLHz5lsHrJKR0363rcWis+V0uBwcfLo5NqCQwOaK+pWiPJxe3hSotfkRywRaodf7nT4NpuvyRiQyPxlmlo+TYaCasVu1ZJ0/ApURlYcIDGZM7BQN44gWBUx7OiDwT+iZ9+bHUluJfw9Z3uCS7+Xvt5NfVjG3KnBz8cSyL4dtLg7zZRGiNuB0WePeNgS/OF60VXaWgrsSPnHaUxuq//aYkhjZHfxbNWKtLw4tf4dsKEhtyepUMiR2msXZm0aECaxuMNXhuP1hJoDHmS9i4uOrL043sjaNxZ42xqYteOnxVdhW3SNMJfzITTew4+JWrdHAukkdf3JEr7DI0LUhnPJvfSOJ95R8BKlcZo0UR2tDWNlGfTP+wsaFx/5edocpPG30+Hb2Rp0Yi/JLnlpSsPRXw15KoAgyVJSSo3xBk8dD95X3BOB26WOxza75+DVoGli44IVaPj1PDkcftqZKfM0dPqq0bDtY+DDe9roHT6cU+sSB30uC+uJEfVY5OF+etBx0YkJh8pvBbSjBTLqFqTby1gsVgsFqsDz0jRYl1OBz6dNrI5OdqBTxdrDB+QalSdDn4UK1WLTqFTE262HCy0a2o+r8/BSFjv1+G1HOydr3184D4HMW3OUgdHObh0/0pv3QgH7ekN/Kv/cnBot6Fw5EEKW9IduU80uWidsD/2y5vg5lbNlWsDCR59n5hS21qDBP9A67EdFK5fFf1avqAWP/rs5zTSbKQu8+jcX8rGzITv4fu2cGDk2r/zWwwHN1Le72wb4UBwtjG1tp2DwMSPPfd1KYx1aB/2XsaFoHnFjvLjBG9Dn/FvOEtwZNUAPa+iBo+qh05qr6aQcO/qm8B8DorLGhuPLa6FSFichKYrG6eFu79tbmIjrVXiwsOtHPAWtp7/Fs1B0dDK7WntHDhV/CxdrEShNEBPrJSfi65r1ApzU4Lwr1/JayuCWS1j5ysf1eLgNMt7UpiCVU5wvuFzDlj2H4XnG3Lw6igzbqVQi4ADH/rCr7LxU4lK/tfCxgyH1GSprRxQ2wfOCN3goPk/4zWL11B4k3xx9v1OBj6hsbNNNhOs+nmu8Lk2QeSyl6b8i+vBPZe3Y70PheZNZsGXllLwr/Kq3v6JA95u42XXrThYolpnstSpFvdqPpZW32RDZOy45xLCRl/9Sx3BdRyQTbMnF9ZwUJ8gkT8+yYBXa+3lrklwZ6HK1sodBDNlvX86ZjQgpt4j4T+KQnjMJEN8KGzVWKPyQprCw/Sxy72ZHNz2vRi93Y6DROF/7PNPalF3QMB2xks2nhZz4n7/ZuOhwrb9U3Ec+DzQ1Fe4w2Bx0uLkZHOCby+nsv7pEiiqF4UJPWlC/FEJt+kOCmJ1TnvGOihkn78Xm+1NQXbwGOFbSuFVlEJHyHcOzjy+xIrw5+DNPDXvtp+12JG6uXNXPhuCH41m27hwYLHvubvgNgarVmtufylGMLjmYMBbd4Jt3cOrx0da4Biod9aDouBa+9r3YxGFB6seHVUupjD2yP2V6wkK/oabno0JU9hhFVA8VcFBaeuBnPJ4Dljet4YVqVp4Rbv4Uas5OKmRlvi2gobG2SpvrTIKs9x/F+isJ5j4mS1kod6Gd3q/KSUjgg8Rdn/OKBOczXKZu34hwb6eGX2yiwio8PzOZ18oxD+UmJt2jcJyxW6WrwmFGNU8mSF+Cvwr6wZW36nFZLcI6xxN46lL7HLfAApKZrYufFwK/a1vV34t6cCMBVO/z/3Hw0rB7k2pV3monk5yOGjPg8yf5Zdv7eAh+lDU7W8HeBgyeX65qoOLFndDWf0ALkSErxoJLOAi+FzIlWMruXiW0D+2KL4e01FhuodfMHB9YWhwUIGLxqVlStU/2CivqPrXfIFG5bLtwnKHaNzaZSrfoUNjzHJSXVqRho1xhM4bZRqz7184f0CfxnNdnYvnZtBQNFsSST4TLLgp8dbtLIHirU4X1b9cfBHMeh6u1I6T1fszZRfTmP1UX5jVzobV/ZiJeddp7Cf8IhYnaIiKLlghZkrjmmTeFjNVGq3zbXawt9BYWfhwNVudBuvXisLSkzSu1I1TkKexsCue36iWIFgkJGfqKg/v7089i5rP4Nj3lSH7m9vxKfX1+M4ZOTD62qa2pKAKC70aVhRIViNbWPiM/rNqJOm0yIa41YD6/Svz9Iw6yPnvqzxiWo/sSBsJxrQRewS6doi5NWPDpldHWqNbkKZ+tspbqw0sFovFYnXAKFvkCl97B16fiSjrjKlGq72Qk1h7DQLnN/S+teYg3cGoNEObg7vhh16rKXCw/6xbFO8+B6Izm8731nOg+rx68HYjBzGFKnM6mjlYfDRBzGQ7BZ62+EDmQQqD/mqngw24kNd+Ku7iS+Aow3Lb2F8N1SukfN42CrfcInwN62twYrB0TLKdDQN5dlPsDzYEp0dyTbdw8P2efZKFFwdamfNM7jdyUCCZxKW+c6D3XmRwtwIF8XD/8ixdCm/ThTsc1nCx+47EiKsTwSjR1P3gXwOdYnPOHnkKG4MbleKyOKj8fvBhc1MNXt4RFLx8kg3XM8sG1jWxocgfVL5xKQePFNW8Ll7h4OZs7x1OeRzIk+VVZfMojGTHvNFWohB4YGHUGxEu1mevqevfTXBi6tbn1Qdq8XvY9o+oAIXuDK72occcRO1bFCivw8Fba6ec0s4aBGd2yt66ysYOc8vpsCw2sjxYz6pkOIjfa3Quz5GDe6+T1pcJUwgp3R2pKUbB2XNnsNcIg/ySjk/d6gTid+9nbZlTD27VH49VPhQ4kb5f3JZSCFd9NVPjEweDr6+ae1lxkLFZedfkiVrEbe6e+nWTjUUHYlJFCRvDGh3ufOs46InUXD+vhgPNjf9lS6lTiG7bqzg+yeC+x7HTxTsI8iz/zGh1aoCK4s7fNnkU1vLKtz6yp1AbMHHz5AQHm/hLyp7HcjBcu69AyY6D7aYr7hlZ1KKpIdGR7yQbL4yuJg+lscHczZq7/BAHqirLHqhkcRDjH6QSP8CgZzmPK6BAkKHC7zM7rAmVt9Y/HsmjYDh57WZ/HoXBuBclGQ4ULJZckfg7yYF4N/98z+8clDoc2u+vw8HMPZG/ZVJqEbs1cpt9BBuPNe7wh2/jYLCm/97Dhxyc0R6zvR7PQC5J7823fQQvb+Ze3dfUgvjXB5Ocf1BImihIf/WCQqlZZtSKlxQ2/AzqcDSm0P9ArJ4IU3i5wFn+dwgH9+oPv9Q6xYFNDveUU1YtFi5Jvy49xsbMpbdqlA9xcF1uKlRJlcEmbku/mRjBlMifsgfz29B5aq6E3FqCnk8XlI6LEjwhN7VX/qHgt1BKfvEoBQPmkUTUFwpxm6Dbso1C2F/VkpVLKUzyC3Xer+ZA1IE/esi9FnvtzofcXcxBwpH58wZ2Moh7u15nUykFQZ/rWhM17TjlM50RaExwqvRU0a/1BIpjl1pCFhGEz1gzVPiPQl2g1/U/ywnW1Yq/0E6msOg/QfWAGxRupbD3/t1KQTRdYU/WIAe6B2QkJRpqIRvkv9jkBIOnCbkLbbooXOfc9tMq7YDygumev095sBDsWbc+mAcW6/GBDhcedv1Z4SGyh4eRkfTl2w/yoDX9UdrVhAclBavcjgEuDuXc6np8lYsahVgZ1h0uyoIeJQm+5cJ3oL+3pa8e8mUv3R5v5OJNdvGsWQVsdIaMXJC7TKO70SHouD2N5FmuD1Ya0JAUkXvnsJYG+2ywR+MWGmLCY8/XSNKgRa8ZS5jQOCRtfeIWP41oAW3Hn58JCsdqZr1uJMgQCc30C+YhIulSZ/yTdjTzj2mcnvqGpL/nJayvVWGLyB3Bl9VVeDVJWdccq4ZtcLVw/toanIymXph9q0Vkv+vRY6iH5cLfKtX8jXjTkeLwVLEZvtfy+dcGtCB8tavFBrE2uF3vEJzUbQOLxWKxWB2YYW0ikm5aDbZl6Npbj2uwSmxn+5A5BxbHPnz7o87BxsjihIvLOPjj1hagFM6BxHHhJ3Q9B8/K9UyXf+ZArDfxqMdXDsovjIrzL6eQYnFm2H07BU9lqqrjIIVn+2f1n7HgIsSZFZf6uBplbrrGcmoUIo4vMMiOqwH/sFb3wQY2yArzkqZsNlL5jqeHbeTg1/DdKmMvDj60XU1R+syBNsR2eD7mYLLQaWS2IIXyx63VFxQoJPtYviO6FJwDWo/pq3PBvedr3by1BrXfXh46LkWhxEf98esPHDR+aD5qmVCDXXc9/30+woaIWyj7TDUbG459Ya1ZyoG0WUq14GEO/q3tffDvMQeto5frAgY4eFM98H5kHoWAtBORx5UoYB215N5SLtYvkPg8Pr8WYV2fXVeyKNx8of/x7AMOlHe5s9Q0Ofi6i+dn8LoGSl9txH5eYMM4LUAlIIsNjVg3/hNTbIhf+lRivZMD8+9jqgGDHOxkP4keHOZgSZb0RQcxCvK7Fs+35ePiteCpp5nf6uBfZNmreYnClVutm/0WUVi7eu61XW858EtuLArdzcHX1a/nX1hbi6R7y1Tyb7Jx+9oc/uR8Nlxs95Tcm8fBk6cRqvkfORjRH8qrkqTQcyxh9XYZCuZvl79oE+TivPTKJdsONkAj0GvlnjwKykb8Lv/ZU6hnNmQ4THCw9dj09KNYDibVgv7I23HQuPvy3TUWteBsqbk/5sRGempabVcaGz1DA1pShzjYGm5RujqLgyDld3YashRs8vMiHwwwSPmcfegpmuDYyBp995hCi6lF/cvHFAK7veU8dCh0Opmdzmjl4NnWuiObH3Gg8UEzwE2Hg8e3v6YMmtdi9xOnf0bb2OhLFv2QPs6G5g53w++2HAS2VzZUZHMQuMpo19gUAxf3fL3HYS3QmFo3suoJBQ3TGIHTIRSc4kM6O0Io/JQ8tHmFEoWQHmrNo14ORE4amreFcBC9X9bt00IOBqZEZbRO1uKg/+bNOp/YMA2aG2ghxUGswuklDlc5iBc080qKZCCgkzF4c7IVsnfldy2eTyDbEXr44G8KDUJxblKNFDK3btw3r4mCl/DnXSEPKQjcknEt3EYhJOwg/95GDmbGqsrlR3Cw7IGK9td9tVAufaQ60MnGvUFnWx9TDj7usv3rlMpgRDj1z6xn7Yh6srjTex3Bo+Hr47liBPvkwkVujFIoVNJV+NZKYW5a+PueWQR31ww3rE6mILFh45l0HQp3tgoGK4xxIHb43b//8jnYE7Uru+NzLYKO2Jg9cOaAP/+QakkhgwJlBe3h1nbM2eUpPc+EQCiGs36PCkFe3sAO0cUEm0uT9ptOUJhcN0+qQJBA6JB6/Rshgtv2Hqe1UinMOW0S9MqBwvZT0f8erqPgeXhD3A0TCtVC1tGT6+owMJi0cheXwZvNBhKssg4M2zcuyc/gQdTmx/6xaB5yzLz9X1zmYZ7+1MMmWx4y9W7vFjzJgzI3jdpkysPMVdvufz/Ew7Zct2x/LhcvV/kOsce4eHbr1sXupTwUqcYbSGznYTruroKIaQNQXn+s4/JbBD2KlXrj1IYSFZGRS24MMqv0ZI7uZ/Dy/CUDEx0Gd3NSu+mjDCzq0m2tTBmUrPx0fESNQa/Tqv/27WLgXHjhuxSPRkK+eMtbYQZrjT8uCdnEYGrfwUtPDjBgsVgsFqsDzvN+Of3YWY2bjwb8F99icFg+MajPjcGmRPOQH/sZLNtbtHL+HwaRL4yX+HUwyCoykVn6i0F1sMCZ6XIGa87lbPn8h0G8wYDLxmVcpCuu4K7bycXxJQeevjzLRdzpKz4HyrhoLFQ/eqWXi5x73QLVL5vxbp6ad1swhc+Ot1ZddKPwcjQ2dmY9hdr1MdGa0gTLHN0nSpwJ2lP77qrsIvA/vUcx8DiBfpBvjcQNgoT09MJ1iQRxvZ2sH98ILuRlzg1O5+K17IXeF1VclE7pGazLJrhqvT8+LL4ZqmUNjyQFKaxw6BRPdaVgEc1d2PqeQnxpziW3GQTMR8UejV0Ed/KTK5btIjBj13inORGkzpvjnu9PkLRz+3PbZALDpX+jPsdw4e+ZfqnlIxdVV0o19z0iCL5TFN2jSuCYcmjO7thmJBRtuWsyh0LhtHq4rxkFCXXRkYVxFBzlPE82cyjcrW5VWrWLYO9WJUVDM4I3joelDp8meJlw13LkFoHpHuX9LblcrN08zybNkYcR2YD1F0RopG+6HiqcSHDV8GpXigrBg0PX4uwTW0CTVC/Z3RQOHM86+02NQsrulRx/bwqtfCGPKwgFBZEcbytzgpt+83P/7SXw/ab/L9KdwCrpQtXtVC647YcWrTfjYQZ/db7CARq5i8uWf+skuLam2Nf6MsEDh6O7Wb4E95JuzzjwrgXeVnlVE+UcnE4MvZcvQSHR8reViA+FfofVKvc6KdReddgTv4+givWu8cd+gqMXdMpC7nBhvD2qWlmNBxVu2+OolzTY/xr51mvTiJ9fa/+jlIAcfnHkx3ECzoHskbE/FN7R6+XdylvQdd4z+NY7DoaNZ2nUSlLQtdkqdtCPgorHuY3H+il8iH8VPXCQwPzqc2WlFC6mk66c6tPk4eq8bR4Hgmk8SznpuTOYxtnMY7VtUjSSBbbWt70leDlz9+kF+gQWT4TWl9ZRuHbbcemzshbkZsY9eJbBQdVcWTMVOQqfVtmc0QyhoG9xt/rHHwrVCw+HKG3lYjx7NNvgKA9sgWxDOoVGi5/RNuFbNEZlLuj8C6Jh4GKy458sDT1n+zrldwQdc2ViefoE7Zcdr7Q2UiiI8FDzF2rFz4M2uy+WcjC2zenajS0Ubjo9IiLJFIQa51OpR7mw2ZIx714oD8ZlDklCP2iYmnRFbXpGw6jII/hQKI2ZD66ovrlLY2bsnR/UShoPtprdeviRIOF51OlIU4IV0eG37v2m8Giq917g6VYkhXpXfrKmEOJwtXzXdwqPnp3fej2EizN6ile9P/IwZWM2Vs7QGKup6x0tpjFp4cCRfUXDX7Dgctp9Gv9C5mZoxNAIUi7yHFpD40686EFuNoFIsNAl3gmCdfZvJCIDCRR+ybz4U9eKgB3eLYvZFIRXyl38/J6LOJ+OIUGKB5noZypacxiILdqkcKybhmjEV8mQMhrJRvLvpD7R4L3Oa2l9QKOz9p1B70MaPQbjipxNNK6p/jGgmgjCBeLosHGCloTgm3XSNGbk3J8YMWvDSrvZ87uXFmKBgfQOvsU0/PfsbVcvp/Fqtueq8Lc0ar7GnemMpJHZsUTuxg8aszxF4vcW0SgNu6/68jmNooXWe9yKaEjqj4v276Ox6Ofn9ennaIjvuWzqGU5DpE7zlNZrGoVeUiUPDjNgsVgsFqsDGy6G3uy9042E1X7hO127IdJ8JT5hdzfk/d96Xm/vhu6hSodzJj14K1x85teaHuSu1ZJrWtMD8z+6V36t7gHLd331HeMevBVcunbfqR44RgrcXBLSgwyfPSvH/jLQGZ0acNDoheyP6EuSW7rQw9ra8MmqC/1xJ37HbO5Cd8RqnQizLrxYPnT67JkuqORrGw+VdUHF/tTxqlddEM/mBX970YUtDok14yVdOMy3f5lmXxd8H4mcvCjSjVstZV4pDQxKDj8Ue7OwF9yxQ2smUrsxk1sy5CzRBc9rWy0GX3XiwvmJ+M4fndg860fxD6YTX5+IPd1l1AUd75bF7FddED8o/uRhcBe2jJf0N7/owqEHfpqSpV3w1d5207qvCw6aZuUWnxgc+K6tOt3fg5uK9wMV/LvxztTm982RLhCBGWc+/+nEpHXFuK5OJ7K5sYqyDp0wuEXPfJzbiXL7N+c1FbugcClw/6vgLmy5Pzb3a3AXDmm83Tf2ogtXG04+VC/twoqhwq2dPQx21+to0qG9uO4Vm6eQ0I3XDw9u8BPpRnPe0jiOTxfyl4pcvdbSicPealbucztRqPfd1de0EyZSYe2L33UiRfV4XrB4FxB6/qf5gy7sec5ctXvQhfMmD3/EZXWB3RvT/6+PwYz82Vde8fVhV9AJy9Lsblx/pZi9VqUbb6t4a4ITuxB0b8vb+Tu7kPh9V9JoRydU1tS0OQzwYHOEL37UqhNvvBp8T2d2wqiaF6ii0wWybuz2yI8ujOw6rTuzsAtXIi/dflHGQPTgNs/p1l4MHNjYrLK6B/I+QzvvxHTDJjn9Xe+cbvBZnHV3De3CcHDy8zlruzD/wo7Xe3I6kXdK5IBlOQ+sFOHVRaqdiJmS2RCW2YnN+9er79XpQvnb7doShV2Idvc9Nq+fQXfBcmZRWy/kzq1KvTazB8ZSobM8ZvbAB2bulee7sb5HQMXybxeUVm137rnfBdl7DsvMBboA3xvlPbmdCNIKPGJTygO7gd6+bF8nrqj+MaCaOrEwdLZZ6pEuBNG1nPZzDITqtuq3N/dCT81uqdmMHtBHYy5dbOuGlNBU7am2bhR8/HSv50g3cgf7Sv1Z3fhkL3aT59aF8c5PduqzupA1aLrbOLcTEWyRasdfPLjx/e1U3tsJwUNzBocaOhFyl9N5MZJBppp+w4/pXniIpUk5rerBUnR6hs/owReXFbWZbd048+CGf+VgN47Uffp2KrwbVvrNHqWsbiSW3TBfGtWFL/MXV1ryd6E1bW3cProTjT/uRoZ6dKK++ISq9b9OyA+rP1N8z8DvU1VE9oo+6DwyrPpvVw86yq9vyF/VA9/JL6G9M3qQ8q5/29ahbjxy/enkLNeDe6090iXh3eA43fsrqdCN6aeKl/dGdmG13yUvl49dUK+P3R8p2IXxBZ3mP5Z2wW9ilG+8hsFKq32Hyw370NBoumLwbA+uCQT6Spn0YIlabqueYg/UuobDwhf3IGVd8qdhuR48kTlt3yvXg6GnC7S0MrohwEv56rGiG5vz/3wJM+zGLKs7HrmnuyFvyjaSWN+Ft8e8FiTUF2BazqFquXEVnlqwwwZ2VUFy1KLWakcTrm5ZIDOyuQl+23pPB2xtxpXADMvn95rxtetBwK7AZrxco77yYGAzXHoFuhI0mrGBt1ZgmUEzhjnmKx/sbcbHVg9dKYdmzPyybLiqtwpGimWDxjm5YLFYLBarA7c6Go9ue8mg+sa94bdRDKZJ2LKNDxkkbU52d/rKINusS7D0KQM5ItTuGskgXKNfIy6GwZnzjN/6Lwwszgy7T7Uy0FkuOT2Xj4sX3Qlpif1VuHKmz0qxpAMON0/KcuLrILP0rBdeMHDKWi2UcY/BnWCdt4WfGGik6Qm9XceF+yeyQlCSixzN9KtNs7g4MrLsvvs8LrytM/ReqXDxeIPXxqNWXLT7nrIPv8xFx5rzAhO/m7GuaLlWyQQXfh0XnmaPcbHFcezftyetKFC5tzNenyBwd0bw1iiClb066092EHy/9YWSUqCx58XsmhJXGj2nzAe22dAQcl5eusGDBls/5sXDOzScpt6E3HpJY7DqTY4j3QyutWfWW4qLba4/lVI6uMh5Xfz3/Xoa3gYrXX88asVPbaMlLzYSnG6p/tJwlWCO1273kFyCe//FHtGeR+OPV5Cxvg2NrV/uvtx0kIZQ9JyQRxdpRO6RPx0WSWPTf6mK9X9b0DLmwkka4OJd9rsFT4R4iCPp9Up+NP59Uv5+aCkNnR0LC6cOt8Fwgi/giAzBg53Wng4mBAe/XPRMukqQ+Z+jfWohQc64+O3162l8lMobit1IAzXNVX67aWzq+Ph+nW0rVGb/mVJfxIOmjUnxdAgPz5YuJMLVNAQHQstwisYczi36iCANdy/Ftj7ldtRb3m6v2E6Q/0pGq0CRQCFs3oqScwRKfV8t9jYScNeJ3tinQ+OukgNPS5/GvaNLdj3e3QpD/gZ9mUkublrm8tov8GBz2OtD1VIGmSq/E2em09CYq07xNGkk3UzevW0DjdiZP1zkDNuxcXdky5ofFI53bFPYJEbgrNtrmelJsNJXx+9LG0HkA/UPmjtpnCnuNviwuxU6J+zW7mK4OJWhI2vgwIN29yJ6yplBYvbtchU+BpvezP467ULDOWjzLN/FNL7lmBxPTSOYXqfVtOpUO65NyUdLv6GgVGAYmLKYgM0f9/GpN8EeuYhOBZrA/qH5+eqxFmwZE8306eZipaGFx+AJHl6abLmYac5AOTvIOt2cQW4aS+/GFxrDgetDZzjSsMgx6F4zRaCoFHilIZpg1l+e5foX7fh1X2nGyU8Uuq/p/DwrS3AgTn2iJ4DAK6579ticVrTwfw+aI8aD9Z4Cnw23efC+Ebww5QyD6xUjCp17Gag56M/xVWTgvOWcBauSRrbbrTerT9G41/hTp46fRiS/sevdRAKb+Oo9nawOKImFfBLZRvDmxaqye48JPD7YfT+9pBVX93Ekh9R5MEgQzp/3gQeuQvfOTaEM+j2sD3i6M1Bqyz6zfy2DS1b5LxO1GZSHNQsV19F480f6wUU3Gq/k4n6qCtNYqRbu/N9aGnf9mTcOGzuw+Uh/968cgqICiV07V7cirsFMz8iWh1vysyWi2njYnCR21P0FA+3+q+czwhl4HWCC7u9isO/FrH+rdzD4deP3bkl9Bgndm9t/tNJIFE8XvnmZxoo1qS+7YmjovnjBHv5IQ03oQa+EUwfSR3+kLDv8Aw2rnz+hz1dhbdCqr5RnA5aM+kZ9PFqFgx9m+TVrNeH4544qF91m1KxuPGEY1Qzx0JxYbmAzVup2kJwfzRAzVlwb49yMJj0To8WezYjd7up4z68ZDpqRNxbcbYZpVnbf8mXVCLLtz6J3fEfndtOtgU+rwGKxWCxWBzTiCpOT7Bhc1/ogn3mGwZH06uO7zzOISRC8Ym/P4Mza7pB5zgyOxJmKp+5lcODFpg7mPIMDnhtNjkYzODJtYC31mcEh/d2bUmWbYH1sQci5w9Xwe3Yk7W1QDTzfP78smsJgx6bjchszKIgb6q9oFicY3/CuqsKWILZkXdDNRwStKl7dr+8RnL1u5S17lSDUZ/XBBTEEdocebrn+noBZ9HGJSyXBqwet90MEq3HlTJ+VYkkHDiYuf3j7Nxe58pMuVXl1MKtpXKa9iYF70mC+uQ0D2R/DyzR/MThQHqUR8ZFBa5skpSrOxbPl5gtus7jIvUlnF6/kovfws1fXjLlQfP1f88uzXLwRSgod7GpGX6Y15+wzLk6He0WLPCQIyYnlBl7kYnD+fD61+62QflB9/4EugUAXfWpPMEFygIMcfzlB+O1NpYcUaGQ/+fX71lkaLF6ttZc7jeo8EzVeGI0D3sfFq5/TeCNWY310qgX9Mkp7fbO4WH72kqPATBo+9JRTUBIXYfWf102vobH5loesy542LLTt1D8pRuD8VFvdCQT70kSp7CgCz/rawN4mgqtbAj546NI4Z7Npabs+jQlR0/HiIzSE6rbqtx9qxeEXqrP1Crn4LbjgeoQ5jcEDC8Yq5HkQ2H52/S5PGtcY7STrcQLx4Og2Z6l20HMs/IK7KOQmfXxC6RBICyu0JroRrDhbK6RYT9D6cvU2pe00gp5deiS6g8aR/XGNVmatEB+7HF6dxkXCrkuW1jE0NK2DlrwV4uH9tRqWSA6N1/O7HraupRE+b1jIopZAW1z9TNymdmzYoWxk3E7hkINVu4AIwdHcWfNvXyCQYh3YHt5CECJt7jZfnwb7g6Cx2N1WbD4WqlP0mYuY5ub1FsE0qrd92bbUnYcjr8Tns5YyOKC99mVZAI3tWmqSO1Ro3PUwvXSqmKBS1u747dft+CLoOVpeTuF4xQPufnUCWZEBiUPxBGFrGePGmTReadg7B69vRUne7MO/krg4s/H51ylXGvdMlJu6DvBgHta/8L4TAypR5j/Zchp6qvrF34/Q+O/UodXd/whuGwzJeWUSfGx5E/6rvR1SF43tlmRT0LJzWCW7iuDZZev98WEExZvHx9O0WyEqu8xt6gcXaicnDnSF0fixL4lZfZ2Hxw8PU79OMThgccReRovBR07g7ZxSGiJLE3p5J2hMjqgxFxVo9PR76bk9JXhzR6NfY2kHLg88X5Szg4AvZOcag+cEueZnPy43a4VuTrLB3R4uHgvZhl16SeP94841s5/xILYja/HBWwz2lbfkj2xnUDTd2OmwlYH0lkGjW9U0JBcG6UrcpTFTuOSh6CIa1+VztS+p0uj03GWeZtIBfx0Ro6uFBLL2ipg83Iq/9y6m313Aw0jAzdQPv2j8VuB4367kwTaL2hiTyiB5F3/HJjsGyVZLNTq3MxBTFgxt1GEgOCfRz2maxjAfYTtcp3FpcaNvUQKNmOiWjKpvNEInp0NOXO9ARsgizYcdP/BWZfhyamIVKimDyeZnDWjklQjaXmzArQdy8dcuV6Hl6m31Q4bNMOsO+KkW14w7u89O1oY1Q0Lx27Djj2asTA7hzU5txmKyMmBxajNa2owe38toRnyjS86CvGbEZzt0nttdjaJI0eDrBd+x/1/d4gc1VYgudvvwdUc1WCwWi8XqQF752eThFQxEtyXBaAMDh/Ef1TOlGLS+/3vwvhADXSkpuW0LGeTu9FnaLcmglXaZuWAbA5aue9gFOwZprbar7fc1QYqrJjIRVg0N7SXy03k1KLlStvBvOQfZ7KY/62IZROaz4v/bQOD56qxpuCfBkl2dfHpfCUL5hC5M3iN4Fvq8tSqOoMXO6MP3hwRvt367t/ELgcZw3XnpBoIisRDxD0ubEFjhVpM/VY367zsqG9IpXDVUs9V9VgPZsU38rqsZ5PcXLJhTTeFZw6bvk1IExhdee76xJ5BZvkx3ewrBDO+PTXQUgZq5VXptDIHA2l8R+hkEdwnlvqGK4LXZ0msXV1Tjypk+K8WSDvxMnpoZxHBRzGuLe3Kai1EU3iwdqsOBT48a6kdo8LemqOY6M9BhdppIWjPIHfn553M6g8LCjEuMCBeCb8W3MUu42BjcqBSnzYVr80+b4qNcbFP+dp+e0Yqr0aOiuWe5CFN1sri4jEbHvXPr5L8SlKuXKZzW4OLZXtu3TXptOPgrSfeADMFb8T+q+00ImBJzUY0EgvO/XH8LDRPoOxY7ZO6noeV3sWDlYRq52ndSRLxomKs4cI84tOJetXP4U28uss88XMY6RUOw4tnYcwEauT52u9M/cvE5iPr9fjYNkcpR9Xrhdpz8/HaBEJvCnRkJjl2bCaT4so2ybxF0PDfY7NJF8F9nzPGzFjQMGN5PKysaL97lXG2waAXbfYk9x5GL069nDua/o5EpKlriuoeG0NWaM5FJXHDe3BnU0qDxgG1Z1VZMoHL4fsEcxXb8e7RIYE81hYP7+netWk0gM3VBvTqIoHlb8YnafgLjA9kxljY0XO8EmH6504rr+0t3+ltzoXLV67/4WzT6Ix40ivxH4xZuuPTM5mFkvsJw5B0aL9BZo76chtAl1mb7LwRXVMqOC8a14+Ok6LE+HoWDezKSlDcTSNyZU7UhjiDgxRT/6xk0ugU8O1rcWmGSU7V2jz0XtWbiRxMiaAx6bA89H0xjsOrpaM4pHlJvJzf08Wg4Zp/MjLhCI2vUZ8nnRTTs/w5t1qomKOQ6h0ku6ID19YfffrVR8HTOCKgyIGD8X3za84qg481MRzv3Vih0vi3dc4KL0H3K64vu0Xh22Xp/fBgN5QPeZsvP8+AlWyTNsmTQXJ1M27JpqMz7qh9wmYZJoskKjeU0DsyjeaLFBHNuxIUFqHXgV/lPNzdjAstTJwJG3hA0/PKUq/RtxUDTQ+7tEC4mRsOOWKfTWLTsY8jalzTkUgsipuN4uBSvd6PBmkHPwB+dKTMGRkrzE1dxaRzJir9Wf5vGGSUN/bdqNGqEh8SsNWkYnHrdO+nYgVrZDcnrygjUmlVSX4S3QpdVH6v5lgsTj/dX71XROBnb+sT5Fw2bNd9IYD4P+UtOxS++yKBw55bbAXsZaC9bRV3cx2D/u4xcxQkaDsvMA/ke0yiZMdfqyRMa/TeFg98U0GD6j63JiOvAnEaFOgHpHyi0Pfgq6XwlDphGFmhw62FnIVX1Kb0ejtZJbZq+9RDcbWmge60KrL0bl38LbQIylljZ+TShKOykad/HJpiuP9FjENkEW8pfQ7aqCXtWyNaur2rComV6Qy/bm1B8yPUDtbAK3t2a8v+u54BfnXyPz6vCZFDk17CV1bjksPzj4k/VYLFYLBarAxF34w4vCKUxufLt2csXaeR2hLQfdqRR6TEpxd1HIydq+d5ELxpHLG6f1vClkeRz49zORzRs965QLwprhMPuUCYtoBrOIzJfS+1qUDjm4LLBjIPgJcFbt+ziYDoo95FJNIPWjA0px5YQXN77/OBiW4JOyTGTio0E4OnsVFtBwDeSNHLLgICXzCkfMSJwsxtP63AkuBCwZ4WsVSPemZd1K3dWwyTISdldmcLz6a1/RfRrULG2peDcBBtuS4z6ZysyyP8WLW32lcLRQwuqplkE6nNuxIXtJDD9MKCfL0MwP89ieIkOwZ9zd8v89Ah81r147epAEPTZW/SidCMkJwdX8G7U4OyNL4G7lSgYJpw7oFzHgZ6XBHvu5hqwn5Zpff9A40XhdKS6AwWj45eNO5MpyIh1LfDsohDx/smC6+sJpCP4fj/dSsCvu6N04XaC0H8HX00dJNDP4H3ev7EaX9enDymWdECXyITH8bhoUSxaqHGWizOnz0VX/WbwceZzjdtKdRj8OGqhOkFDJTfmVDCPxtsK6cXrdjL4+EggxuYRgw1SMq8Vpbm4eeX520QFLrJL4trLdnHhZaRYNmjcgo+Pzkt7lTPQvXunMGsODf0q7WTyhMBoQY+vkAHB2e+LXlnrcmErdpM3ML8Nuxjl5HO5FNzXLx21ECfY2ZI795UTwaXDjzfzNRBMVCc8XqNN41lFv+ACXRoVPwOPndRqQULErQ12HxmMVI3E3rCgMbIg+eKzfoLhvXstK8II3nwLLlFfy0XUyc3yGakE1x/Iey5f3Ya2svCYj+kUAjYMzVs0m+BjWrX6yYsEWxUrUiKaCZ7p/agX0aNxiAm6v8urBVq7el6LpjD4mCfXKr6Sht+mMjVjTRr+HlcXuNUTvLbOOHonnItZJ5xq5/HRsHt4bbPafwTGfeUt8g/asEz664fTbykM8y189F2e4OytbXe6bxC0pqjmOncRLGmtCI60bMHV9VG/bkUyuL47I3irOA2bvdc/hMynUWhgOOolS2NOoVXR3mEuZMS10spsaHiY7Z0/OkAw4Ovz8GkMgYgNvfXAsnbQO5S0HYoosD5+s5RZROBa/d4/7gpBlvfYjayYFgTYTavv/cpApPK/8I9babTXnPg6ZzON1w2qvAPKNJjKNqHMSzxkGsysSi6jIXpt6cZSJxpBb41yvUVodP6ouzvrHUH2mfF82ax2xKaKklhNAjm9l2PLkgii9e1UQmNbMCzzvs02j4Gzfu7Qje00thmFLavRoCFoemCXwkYa3Pig2MwbPKzWU3+5X5OB3xUzoetVNJJUI2/2XKBxpVlbIpmPBkt1t5nSGhpCS6ilywU68LEECy1TCHiWEp9OPGsBJ3Jv7Tseg0/ZXOFcWxq38tI1F9jQsC30cTy2h4a03PUe3Rc8CMR6jnVoM7ji5ri7EgyiFV/82EbRcK6wOjh8lsbvVSfCvaJpzLq0YTgnjsar+Jj9jds60Ll31OFvVD6esvf+3fqgEn5llO1Pu3qEVJ86ZbK2HncbBi+WjNahsNC1QOFDPY7ssZE/lV6JLYO3t9XkNyK3uG3T8clGGJ1qqf7S0IiKHet+mYg1oWPUZLHCniZUa9Dpm/c0oTrVpXx0exUGFC6MuSRnIev+R+mNgVXQjN2vfkyiGh2BWb6uSdVobyra2cytAYvFYrFYHbi+sXZRZSxBvdjkv55QApWnEnJFXgRFf0JLHQMIGp9+Wi7wgiBhaM3BgLcEJze0X9fnb0TT8oCOKrNqeNACG5Wla8AveGVGJsNGkWJyRS7FxtnhsuTJUxyYa9US9XsMXOK85L9LENQdLVpwtYfCKl3tkI5GCvIRO0vyGQqxV3Z5RwkSbBZcf8BbmMD32DzXuJ8NKOsob6ulquH9bmS/WD4HH/tXnnHlq8E8rT+HJqLYyBCQV9kiw0HOz0Mbh1YwsExmx7z4QmG1hcVRTT4CVZHeW641FFTs//y0ZFNIMf53ZeVMAt36Vpt5cwiiojUPD0U0gJSPFO3wqEHcS+P0vmwOLhez/EtdODB15rM+NViN13/Fs/rK2dCrEvm26j2NnuAeMTEHCrkah5sfplBQa17SnNpJoX+u8S+7FgpOI6ZXN0xT4L+psl+Cn8AmWq9j/8FGzCipi5Uqr4VTFqYW7qZg2PSnfOgfBw01ex6bpXLg0+N2unB3DURXxA8tdKKx7vDru0LzKfALLjsca0nB82XTocfRFO5W7n+0bpRCeYi1Xzk/wSnJrQ4fBQh0vNdkPtpXhexNPxzXlHTAUyu7y5bHxSIf57NjZ7jY/r21TWyYQca92j6JJAbV3wRn+L2qRX2sbuJzbxqxp4J5ps004rddOHVJg4HE+2Bfo7cMEh6X1kWs5ULAO0jKT42LzSpqd+5cbMaw8uiFJb9o/Bf1szd9Lo342ks/m1IJbH4Xp3obEqwo7czeN0hBoUJJ8MFHBljglatyohXT0bq3Pj6k8PTvo8mFgxTkVwvt77MjmB1w5+a3cgKd5vXDx9RofDVR/+7+vhkhS8+02mfROB4womLYSrDxz6Nbl4cIehcOO8lEEHzsXegzvJVgxcl9CcPSXMzZeneWuB/BzOOKiipNrdAYiVU4HUNhX7CnrtFsgq28cZclngTntONas1oIdK5nSn6+3YyqyrwIXioNjc06l1b9JJi9ZV6yZx7BLf/DU6saCSztr4pP3SCYeOn+4KMvFz5B/BF1TQSxMBbccY3AaFe6yYnDbfCdr372QjSFn+LXNu6aQzBxeZdcvzOBSqLmOaHSZtheq9xs/5EG23XZ8R8NBJkeXXckqgi2Wh3IIr8IPDjhW27yCArvXfg8bxYPXjy5ZwvNacQknJD3GSIo5b4+oX6NIHVNo/fW4TYcE6jI/aFMQCt3Kcy4TPDUTjPyxoIW/NEXe6LaQUPUfgGLmSJgH21bq/GXwEnf7MDMIYJZnODsWaMEbnSV2oJgHt74/z0S+oNG47btTaPONOb5B8imjRDs5PPwUlxGY1259WWT+Hbk7vhML0okaJQk8zIXtuBqkOcaKy4Ns+dTxkf4aci8/nnh3QRByh3Nxz5/CHYoewpeHyd4RdZVZd3jQfTQwIqBjQzWLVB/cKiWhkDb/eLSwzTe9ibQF87SYL/59jY+moZZyYkn/X/b0c3oD3tKFeDIhYHv7O+VcMoN/+/0lXoIyZ0JLLtcD/lZ/0XvMa/H/mEJ5plwA7xvm+1+wq2HzSXfo6q/KjF14EvYKVYT/P59Uv7e3AjR6jkKexc1IfbFfmmOaRNeOlxy+hLShNCzJwuKnavgdvdjRPKzbwg8vrbH8U0VKnJ6BHV1q6EjeG7/n1/VmPMhfrnQwlpMPrCqsy2pA4vFYrFYHdhzPPxo8hsKglE3+Z89ptDJJP0X/YrC0wKZEzKfKTTN2tP4pI1CciX78nf5RgSbpifKXKlG+ycpjdAlNQii7s9/msnG7YGnATWZbKhlVR0U1eVgb92S3xdeciC/tfriozEGeVGv2rynKYidX27D6aEws2b/4h8jFN7zzTqwip+gvfiFkMcqgmeZpV1/ljXC9KzerTKxGjSELjl0+T4HETlaTvlqNTg56+lsY182RKoqm/wEOFjdLeHRdY6DyOIDeba3GCzwU1+vtYjgmMrn6aguClbhA9+8flOY+e0BXxOLQCVA7/2qlQRXmWKBhr4GbMhNs+1MqkGI3dt74VEcnLpT2ihrw0GHKtfHeH0NzN6+FnPIYuPEz+FnBas5WFIpvOeuJIP+/UoWBV8oOE3Qe8qnKUhs08v266NQ6NzPR49TGNh4//1GWYLzT2NW42Mjll9q7XSdqsVkueD6Dyso1LNWeRjQHMyqzldojOIgYktH2dOQGiTs+zDbbpqN8tvEteENjb9r657FnaJwpvzus5TXFIKGRQfWzyf4w0fYDnwE8j+D771aSdBxe+x1/+1GXE2oF1apq4f870eftt6jMLUie7LYisK6P7ZvbGdT2DSveyaviYOZNxSHBTprcKtHTMzBgYap6ZZq+w0UcvuHq5w9KDQt8pD8XUXhhcS9I39VCf5L22wgoUOwz6K34OHZKhgUBP1VLelAzyx2orY1D9eMQ1Y2NnPxLHT63uARLkJWKC/7NcDgws8TdyZiGXgsfx9UwqqDYXnap2YvGsI0T1Swjkbg4alVjeYMRE11vZf/ZPAs/e1CWp+LMJH5ds0ZzXhpWmBhXEHgNng24d0Agc4R6b/0LBp3rN9l5D4lKHtyOvvaXgI/LHvruojg3y6mv/0dgyvKYpkPS1txy1Ih+XAqhRTejBzNxQT1I8LS4X4ErhvVjFtGCEQMIjJWhDdjeIHGUOB3Ar2ETCaileDF5Z+DS5sIVIukTH/1E5xcUmMV9B/BircBhl6HCWrv8Pc+WsxF9bct3+kwgtyF8a015m34dbXv1InHFOw3ZdnlihGMniUhwjcJvjwydT9f1gy/iKF188sIBifNXHq4BA6Ndp4XCQHzuTRZr42gd2vAGmEWjZTqLdJTuQQrTf0ejf7HxYZvv0OWz6DBH7wv894tgkR3zbqn3DaonPzoWypPwJH3i5CLJAhqfzy0QLgFcTW1tXdaCAyebO1bP0rw9rrJ2KffBBuPfV4V0Etg6Bnhc+Y3gVPeoVqF1TTeex3+2y3LQ0mw7NYZnjRSrX2dzk8RvJ+lqCYtSqOy/cflH7fa8U19n8WmTILDdzvbB/e2YJds4YrLs2hYnBl2n5Kh8d1olneQFA2DNeefWy2iYbXdhF9bikb9f0v192vQSJx0vvEghYfe6a9Na/ppFP6SmRN/kUb15fxa/SAa749lF9i/pRHRrh/zYlkHVvuF73RdVoAYk1P5szMrcaho4ujgh3qw3+59FOxZDz/RDXd6L9ajon0v21C7ASNeQcb6Eg1oTVTX/UHVQ1LmtaJ0VSW+Zk+x5OoboRuz+2+aSBMq3B72w6gJ7QrftkoGNsG2a0CBz6EKHUmrptY/+4YcDZ6uXGMVVj9T4jHK1TC6P39XNa8a3tt5OjvVamHZMmvuKqYODhrtWz8+bACLxWKxWB2I1hVflhjDQWpKtEbqcw5eB3ztufaeg7Dh7XU9ORwIZasM/XBoRO7MPnWB1GqYh8yb12hZA+7GFSPWOWz0bd98xi+HjSghe90cQw7erkxpVEjn4BQJ5TKiFJSebLf+1ssgwcHkUNJsgoic61L+IgTqUwebyRKCh4LPZXNXEagFHHzqL9OIAu487thUNeJaHT7siueAr3y6f4Z0DQrPxeZP72DjrfLLHQ6lbPzcOdRUsIWDm7ObeAH3ORhtb1g71MZAfFPshDOXgkBk+RezAQpvKlKvvhqjsLhlNOeWAIHW2Z0Sn6Ua4c3SOh+TVwOLtLF+hwccqBhJ2Y8t4UBwZ0fJb70aQG53/p57bOzXvHf4wzQbYmNXTnnacnCJG/pplSuDpdP5Zo8nKMxXnnvCu4/C+1PUqtZRCksuqp7OnEXQq0icXnQ34nyupAffqjrkyO29FbCGgkbEx3qb5xwUJ1xcdsyFg4v6qSYtz2pQ/Vb7mEcjG1/y//nmyXCgknKswWU2A8ecA3sevqbgQ9ffV5xPQGY0zD4wScFovqKJmhDBfhGDvkN5jWi/ydr633A9OvW6FwrFUbB7v+doixSFoe8iz7gtHIS9Ybc8f88BL6zfbZtALXzs0o0aBthQzrdaPJJB41PHF6+jHhSqZ23N6a6ikDTn0JxBVYK83xXjD1cRZMnc/5gd3IgFNt+c//5shPz+rDXZExSiipZ7hV+lsLKenR23nQK7mRdvPM7BaYtkv6FfHEjM3bvkikQtnl2fLffAkEa0Wa7jDkkK787h5a9LFHwvOAxXNFDgt165fa8mQel1O7+gi1VIfE0rG5Z04OytQ+4mxjyM0MMzZmryIKfk7CGdzQVfzv2JETMuek9UnFEcZdAhWH7o6zUG+4MG258b1MFXZc1Gf18aPhtafu//R0NAVnbXo/MMkgLudBzvZ8BfP1VWeasZ4gY/3mx/RCGvapEt6y/BnHummc11BMHsBwtDegiOCsZN+P5HsOiRWZ/7YQKxnbG4M0Fh4Q9Lr8RMBh5Npr7R89vwvUduc+JLCtaP+xXHpQi23o73L71F8HTz5+DlRc149zq5Oe4DBfeNzzgHZ9DIGurYrs4maH9xz2RbGwF3rVnlLBaNqPzp739yCe6fNFm06CjB8Rs160e0uWgVbtK3vEtgtaXz0qknbZDnLS6arUggbXFKsCOKYNMbU9ec2S34lacmrfydQuGEyZSNMA2xbzcPbuoh2OSp4aTZSaATMj9Hrp/gu2WO8rLVND6MCvloFRGc8VOS7vzORaPX42RVARrhL48ejFpEg29lLxVo1I5NzzgHbzwhOP16V7K/aQv4Q34mFVRQYHlPOe2SobFju3q+wgTBsdHOirVjBF5CuUoLpwl+O0WcWi9N40j2sOzCDTT06be+QUY8HFkWtfX7VRriFvsGVgbTKHAR3Of6gMbM0mzdVSPtKPa1/if9Mx8Jgssy4VGJVK85S90D6zHzS9FR0cv1uJO87NGzrfU40jtYQdY2IM6pdBtHuAF+t69sPt5UD50NZc+/NteDDzVbvJIrYXLMnW7c1YgardwW55uNOLZIrGbsSyPSlvrcDllQhTizAYXkzG/oCJcPLy6rgnbxidq0g9Xw/vUrO7CxGrZLZv3caF2LxLcV2a7z6tFf+/fvq08NqLxam5dd1gQWi8VisTqg0bykOfUZGzBQlryVxYZTxv4ew0I2fC+lrpNc14jnw9GLvOOrIWAc4D9tU4O9nqoKswvYKDb2/VGWwkbGzEj9DSoclMs0ttyL4yCwNafLaoyDZR4Xsnf/5WD/hnTzsm4GaR9V7v9bSWA1ImhzcD1BmmiApPoWgjA5mr4h04ifq8UGzFRqEJlp0DPzEQdmceaPIw/VYOOn1OHXhmxIe3w5J1LFhloon9AFbQ76TDqXqMdx0GhvIVX1lwOl2k8zl1UyCOPqeV6eR7BJQWZLkzjBHZ3YfxmyBF+58+N0mxrwfUaZ/vqMGrRe9jbq8OUgUPJ5xBMZDix2z9h8cX0Nnvjl/ZptxcaM0EHhiDw2vpk0Lt0rz0Gqva5M3zUO5gT6Sv36ziAzerNUdi+Fo7XCzctGKXwbuBX/j0WQcvjB9oCGRoTeOjshZVoHOk8qd/sCCuFHDefOSuNgeePcK5LrOPCymL74M68G5RIO9sdes/FxjYx3+hAbj7f7XzPfw0G2vs1WsYMM1u2ZYeIsTFB1qOt49jQFZc8TinGCBAXbSoZzPjVi3Gq2+n25Bohw003yblDI9O+4d0uWQmc1j9QlcNA52fRS7BYHjyLFS94r1eLbu6y+niI2/rPJdLy1ioOsY8aXYgUZ5NqZLttbSyH8yJkTNmoEzw+LNsxUIFA2kfm20rcRMvOTWCGdjdAe9z/qSSh0x757oX2dwhLe5/FgPgrqSpwzb8o5sLvI2XT3MQe1Ipc8fmvWYo5038zMJjYmjxvt3xFHI/GXxVzVKxTcys7t1WimMPRa/cEnLYIBA9dcB99GzD7m4shZ1YKCD5bHj3AoDAkY7qznUJC0Nr+/8hgFjeiLc3ZtpGBPLpatnUVhi+OvRXzvOYgKfNstZVeLM6ue7U81otFz78Pa/7ZS+BRd75HwH4Xtl+Ky5MYp/K28n/xGrwqV/EpVbiUdiOaPFttnyMOuf2bbZ6/hwcH67VctKR70etsMxJ9xoXJvycede7lYriGR0tzI4On3LIcqHwY2Oh5k8X91EKjJ+EoH0ZDYk6qqxs/g7cclxaPeDDLnnI3MGWnC9Y484+uJHFSY1IWdWELjlidJWjVFELQ4e9q7leDufU9/w2kCMtvV2j2foOH556e3jxOcTTs1/luWIPNPoMue7wzEViV4xjm0gVxSKGVtImhnX5rflERg4Me6ZLe5GR+++Fyb+5GDFRJez46toNGxIzBrhSCNZvaRpVd4BLdKvS3jBglm3N+QG7OGxsytVxZ8+EkQvH3tiaUBBAV/Ww7oG3JROVfWTEWORp7YY86nGe3oMV7WduU1gdJww+rjHs3o2vc97t13Duw/s1+KbqCxhOnPqVhEY7H/z2Wrhwm+ujHWRpMEuqUfoo9J0ZiRxOcYtpHGfylvPw31EEiu51hRJVxk7H59LzuKhmDflY78xzR0tvTMtk5rh+aEZ7WiQT4e91YckF1TiRPeR4+EmNej6NvyZ2t21GM1ixoo3lyPjuoN57S49Ugp/PxZcrgeD8rmf3hQVI/DrkfWjRTXoyKgLP65YD3CYzdvfBdaCRW/WtnQXY3IOKwgIX2zEd/Py66Kz6sEixV64np6FlZLbPrz6FYVzJ/FQHVxNbzP8RUrlVTDt+bzoex5tXBbwBzf/LsOyqpff1YkN+DN+4rwtOdNiDAuM379sgUsFovFYnUg9v5Xu+PdHYicfSxXmo+Nx7/vBIsNNmCO3cNfE3urIawtsMtTugaDXomLDb+xUfEsffRHChvr1vAnfBflIGTjRrapDwe6g0v6hVs5qA8ozZvdwoEILrSJ/eFAdMbN6rejDAZHPY3iFAlMFg7P9dxI8CauRO8KpwHjI7YRV5hqrE975LgkjgObOxVOQnI10FjSovDUkI3ImLWlj96xMZBwQERZnoNUe12Zvmsc3PN4tiy4hYOBs0HZEa0csJ8GBfG1MqhSDFFaKkKgrCfQ82cJQY6e4CSpboCo/AWxrU9rQDe98Wrw5eCOV+v3hzIcDOw5oLp/fQ1ekz8TU/vYmNuzErfy2Pj+Yp7ZLnkO0rLP72aucXBEU0A6oo2DwoIv3j+/MzippjtPYpRC7p6ssiEWQXl0DZ0e0YhFzsnhJ6dqcWVh76KJQQ4U3knafb/NQeD6ztSZ6zhYGL5a6eHZGtTcH3M6eoqNqeiHG+rfstH9YvZGDxEO4hVip2RcOWhUfrLq1hcGM6qqb5dwKNj1mluk9lBYsWm4TzehEXbjkzecBBqgO//XtUxHCv0vRwt9ZlDYrVS44HsCBxZfsmbO1udgss5jxzB/Law2zexRiGVDtWH66Pc/bFAWd1XOWHNQbxpzXOw0g+x66/DjSwikctkv1IUIdLcu4m1wbcTdLOOc7XWNOLc8PupMIYWlucVtm45T8NOOVbrIR0Hzm2BKaxgHPwzXTKt7cMA2SZHMkq4FFSz9cvFrNk58dG76LsyBxO5o/c8TNDpU9Vc551I4vnCOW5c8wTy1E92nXRthqsv52MXXgv7WU9E2BRTkNth5lRZQ8H+hnC9yjILWuofw7OcgP+NbbEUNB1vm789uC+AgMa8tgujU4qyC3m6vZjYEo+8NrnhOY+1EqmGlL4W0FpeIvWwKuV9O1B971ICzf9Ydur+mDd8f9S4W7qDgUza+prSAwtHJiPnZPyhIXpsRvMqSwlOBFcRnCwVxh6+Ct7s48NW9sPfFew6CZk2fUnOtRWaZvK6LCY2W5pBRpdUU7L+a674IovDaZjjOdk0VNiRczP1a0oGg/+b892QfD2oR2T/2a/BwLVCnI1CKh30atp7XFvKwte0+L+0rF+uvR9Xp63ORPm/bXa0uBl5RwdY/bzAYELDTVG+pg/3p7RcPiTAoVFDfphjMYC2Hbbz7bxMyNlc8bq1m48u8uuWe0jQ8txB1jRk0aN0oUw8egevChlpSTdDTfEuPM4+Gr43U+S1vCEQO/yyMP03g03Dyobowwfs7uzn393HRP/BHZ6q0DcGmoeVfnxKI7v38z1KjGQtcDTIf9rDxO237qpWrabxpNt1KhGnI0JPmcsMEjz/oNEa0E+R3WY3R0wSB3xJ/iEjQWJi3R+pCFUEg+geEzxMIFli2qTVxEU81HGh4QMNFQscx7nI7nnnGvlTNyUMge/XleR0VyCu4mPxqcT2WC2j0mArW45rR6Kae8TqInX+ddayjHp+G3u2/9Loe2z0+qLS8qsfc4dfP1T7Ww09IWrr7Tx12ztbRdJ5ZD9nhe0rd5yuxMEXz4PZdjYh9k7nxiUclHN8pPrV79RW2ChLS/PpVMBOLd9aqrILR5lWjzYHV0LalVkdM18Dsze3Pg8/qkH4+f1NBSANShgJWzYhuwvULKaW9fi0orwrYoaHUBhaLxWKxOmCh/1ungtuBUAv3w3wZDSj6lLTtr0A1JDYGze/vqMbtq/PnBH1kw+2lfKdAAhubg9UrPotywG+TpNVhz8HkTPffBTkc3P1PoehbNgfFe0s5xY0cxK/Xy93yh4Pimr02UfxcdIkfnfi4huDe2Cvx008aEPL+ac/KB9XITZ5uW3uPA9ubFutukmrUS+2S4OmwEffXbuq/d2ycKih2zuHj4IhHrPyToxyYB/Kt2JHDwfMbHXnGuRz42Fk/+tTKQfzl/fNoHoNfBhdaNRYQJCV1ldVEN8Bdb5ZNhG0NrlQI/+724mCl69Zt7xZzMKHf9Kd8qBoZ+mvWj+5j46ZYVKx0Mhve2cctZsziwLm6ZO/DoxwsNbOXM/7OwQKXef7vCzjwjQnhe13GwMFybc6mfxQi64q+7A5uRHTLbb6HA7W47iG3c3iQgw0LzO58vs3BndvSTRNrOXh710fR92wNWv6siz14io3Zfysdyt+yMSi09ehpEQ5S/Es2LHblYHC5cu7KSg5iXNi3gr4wOGJ280ZiD4V5FqvmOtk0YpmK55e2zHrU2cZXXTSgEDhRvFSbwwF1KfaEjw8HGaIPVAf1OIgWjx8ufF2DQ+/ih1dasXHF4Lo4k8GGcvZCKlycg8uHz59P8ORgQI2751YJAyONX7V6fRScFqpbp+5rhNwnv7eaWY14Yvy+6NhzCta8DrE1hhReH39z2IVwELeQV1UUxoHiLGGbtes50LjDHz7xpwZXhI49M7/GxpTGm/rxVjbMakWd5+pwoKLBqKjaMQi/NRg7OpOAO9vm1lnbRvAMypYW9TVDcdaNn2apFCytL7zMS6WQUaU/OmsnhaTrV88c7efg1y6mYvIuB/HtCcE3rTlImxba8npFLYzvuY/UvWZja7ShEU+UA3lfqjVOiIGs7nZHv0IKiWE2F06EN6DMo844gK8NU8Uzd/HnUcgYWWCXl0rh7spnuhnPKNys7vgoZknh6YNdS1/94cBn48xa1c8c3OrimwwK5CBGd53YtEUtHq7+qSdQzIatvpxZ10saVXLy4q1nKWQF/xYITWjAa90o5Yiidni3jfFOsSmozZ0zuaaQQtLCzAVOrygIXPgo7/GZgvp2ef/5dhTmVTdtvydPQSS04URXHwepzQJHCpM46Lj8Yv3D9Fq0n4q2MSih0Tb/PY8VSqE1ze28u1gV8h9bym8r7cD7/wQj1x3l4WhETnabPg8vAnUbhVfz8LLyitDUQh58IpKDTRV5OLf58SX2ay4ms403N+zj4ptRzN8TvQw+vEi66ibChfxpQ7lla+pxySjOye0+g5G0uBK98SbIyc5re1/Jxp2UuAQNeRq7Kp+lj86m8bbpYPGmXoJXi8/PDW4neKzy5soGDoHTzHGjA2MEdzv6r4x+JJhte3tv+AGCLearfntcIthSsK/+C5eL2D+ReR0G7ZD5/dlfWysPRrSClEBUBd7sNSD1LXUQuzdrnWd5HdxqCzwWf6/DJMt7MuxJPXzruxNTn9SDyzn5a4dTPao7mMrN8fVQC3f+by2pw9/gsI/PBuuQ7f+2RnG6Dq92j8ivtK3E5nKzt0+WV6LieB5X6+kX+H4p5euarIQ233nLXyFVYF2ToRfpVSObNS7l8aUGuVLPTX4/qIPxsL3Zux0N4C2+YbnqWhM2Ru1WdHNvQfOQpvePyVbYe0pes1dpA4vFYrFYHVC7a/qI59mAnpmPnFy/VmGZgmD1iZRqrPe5Olb0hg3htDtNRjFsVIYm544LciDwscalwZ6DC2vYZgeSODB97V1y8TEH/icUuNezONi/I65goJEDznh6SvMfDq4/Xd58SYQLbL11aK9zA64f7+PbZVENq/8O70cYB06eVQP/UqvhIfNpjtJWNtTHS/qbX7BxVmHyv098HLTGCF7V1ONAKO6A4r/HHDje31LEl8LB1nOvn7jlcpC7tjDgUBsHNmarw4uGGRhzChZ9PdiAzTZ3rY8vrIHYj1Mu0+c4+HI8OqNAhAM+zUNfDD9Ww8IwRnbLbjZuuRf9XJTMxiipeRFFs7GKu9LGeicH9Q4fV/I94aDM5/gtl2ccWGt6O9v84GDL6UP+EU0MNCWS+dTNGrFh39HuZU9rIeHsnS3QzUGBwPM5ZYEcrPDzshRV4OBrAKtnVLUGHGuHn3tPseEjs+PL0btsvJS9e2LFbzaOxK7cXLaXg1fq/gUt7znYv6l2bf4nDizwVtilkMGDc4v2je1uRMnf5Artl/XoyKrgdzWgcOvwpKkah4O+hpJoTx8Ost3Kj3XqcbBpyfDQs9c1OLagUkHGio0bKe93tmWwoSZruOiWOAfXvz5NifbkwE/jxZblTRyknV/+PqiEgcSio3S+UCNEdujebnJtxLbYAGn5YArdGumO3FUUjN/feKOUz4H6sc+rAs5wEHGw22/xeg6qStftqEiqgW/wxNvdW9kwStkYLBvPRuSOu0vXT7MRnRX1+tMRDozl1GSeZzMwnvep5Y9eI15Emb7tqW3GwXWxk3ohFIK9Q9szQyjQY4fWTKymUJkambi/ioN1iflTvLscCMbf4HiKcTBb79Tr3skasBd9GhLxYaNS4raBPJuNursRDS/0OICz6s+FZxh42RstdL7WgJTSWXvD+lqxfnCp91gKhW7pNRGZIRSKTXLOvwil8P7faJeAOgWxodXGMX84uPZj7TyHSxz0fjEuUrbh4In3gY9Fm2vhGf236/gDNthfssWHp9jwVHbVPDqfQfRhknI7rAFKduc+nYxrx4tZYtJH8ikc2yirIv+cQu3WCgPbcAp4ULbPOZ5CXOT0uzFbCiInZ1zMbefgvK2c6p1vHDzxuyeUcp6D/g8NNU73a1G99tUsMWkOcp+c8fihzCDO0+YIX3wDVHU2mSlXtaM8YJHFaYpC6LvljmuLKYx+qb7slEahe7TnS1YShV9J5N1kAQVc5F4oMKSQfHqWauQqCnV3vYSetXAg4i/2MnScA924goED/HXIMWWnZJkx2Pw44Z3IWCUGRgTfs8o64Mmjvo268rCq/b/uZ3t5OFu7dFajGg8nZa01iuV5OOf1wEJsNQ/71ILN8iR5aMtREfyczcVDY59So/1cxL4Msth7mQtTqxPC4w+40B23fiTuV4+SRy12TjHvsKAqRDH/TDmCHsVKvXFqg4SDfMLrXQwEZlgXRqsy8Msc4ilrMLCNb3LcKcdAPUTiVoESg4KUhlQ1OQaHq2e2JGfRePXwOovm0NgzOG3oNIfBtl8+79auZ1CyWpDP7FgFQln2H4XnV2HdXJXUDUEMalv3M2sHOFgtVnm6opaDMus+slSTgtFJ0WzbaxTWSdz5vsyVwuVEbsADcwp5eS+cfztTUJ39aatnIAVtm0ILnxQK3A+Nr4XyKbBYLBaL1YF/egInfRq4iOkoen7/eDUO7nkSXy7AYHO5VeLTfhqLrpdp9ocyiGoyV3b0ZHBODfvLmxnse3dyTDCPwZNY+YL8TgZyZyzGtMS4UDH389DQ5ILG500fj3DhOGyotucJF6kru97tkCWIy1baKnqEi/iuQ+JZEc34lpnu/FKawhGrba9f+1IICd36kiqgsP/M/cGtMgTXj95xFz5OsNx1nvXwMYKOkG38/tcJtD+6/olNINAYTNmw6RvBFtOAHN8QLtKTPJ6emkHgIXGyQGcnF7gRmeVkROCrae/rHdaM+qyQNXslKcwcubU60I5CaS19pi2DwuTPtt43ExTWXDNIGz9GwCXhL6WdCAxE8xM2+hPo7vrX8PMxAVtNOpr/Hhcnns1xl3chiEnV1lo4zMXyljZJ6h1BbX+j5xU9gpr5bp2BYS0wWaHxaOMYBxIzI7lBeyikbP9p43KVQsugiuRHisKKacffWrsJrp9/r862IPB93eV62Y3A2cnRwPIyF4rvNq4tyCDYsXFOtW8zFx1EoCZgguDe3xmqsmEEKsxFetlKgtDugXazhBZojcSYFHZxIPdh/vqHiynscb7Qz/Om8PDi8+IzPAr+dzmdFy0Jri/e7JFoQ7B9d9Js2o+Le7/rByWfEGSblYZbGfIgveLM/vkHaLSon/zwpJPAe2WL8xNfgtWGJfKlCwiuyTfOvSLZCssIzoY1NRwcO+HWckGLwguPlA//UijE3Wmt4xcjkP28RDviKsG45aMx4sWFyfyOrIqbBJmXD+z5uZaHVwpbBs6m0DiruWm3gDqNcemfh1+nEbzH50W1ugQpvyzmqo5T0Fmbl6swpxWnQxop7lcOvFoO3zurSOHk33vvPe9S6F9eITVrioLE7VdjYfO5SBE8dvdwAsHnmNoU+X08sGcuyVBLpsH/UD9zeTKNmOvNhcUyNPSSf/kMpBFsUzl2wcyZ4LxXur4bm0Lg+spmPoNWeK6Linpfw8Hj+SkFOaCwVrNj7e4XFNpGMn9nqHOxrGJmz41sAlmxyCHXazxY/sqcfzifhpWRHr/fUxqGb4U++YTTmFsz7vpkOY0h+0hH/haCjc+ozav2EXyIaturOE7BMc5Ea2ZKK0zsviwZtaWgofp58a1iCsLOXr3q9lyUHhjUM+wgUJCedfTAMx7mWT05IUfTWFC14ZBREY3mZVYsn3s0/v24aPcvisbw3vn7bMxpyD6wW3G5kOBx0AHdK2cJcmynOhTuEAw5XbHwEG3DxkVfVljxKAgFXPu1JIgLEmN25R0fjf37vzXQNTysvjfjYchsBivFQu+md9Lotn2r0ZVBg9e5ISDxPxom43/l9+bRuKpvPHhei8bMjfoiFzkEsrM7X8vx03B42GDqvJJG8eHhR+cC28DP3ht372oO1m91Ud66vRwqR8xc7uiWY1NroDHXrQE3Z65KWnusATFeLbx/ro3ozr9PVv9qxIEVs9cHZTViz7GEX9FZjRhof26w2aURucMDe4UvNyJOUMOBCWyE0zLvM9lRjTB1u6slqFCBGTvnXPqr/wFCCiv2vXVqg0XZxc8/LBicmef4l0+TQVlD5rzUbQzu9H29s/gAg9wDTza472DwYDLo+MRaBmmz3l/Q3chgskLlv+vWDOJXpLH5rzIImVxvWpTEQODS4AY+8wqwWCwWi9WB8Yve4xIravDjRG3awUMMKq5vL/LbwUDG8c1/KscZSPK90T/SymCXhtitmUUM5Db+bit6xyBYM/nAf5kMJJrfxHzuYKAXkiNjP4cL0V3VvEAVLmL+3o5JzGnEkVuJUYXPuRg2/jfTKJMLrfZh/raWZqgP6Ew/vUaBslsinVVBYXbErs37ZAjK60wuCR8jyFq6eoXCW4IX09EPN0QTOEsXmeS8JygwHN9eW0WQe33jpbODBLh7LMrwbSPcV911NL7DxUxud01xPBc8jkUD9Ypg5fq/Zx82NMOh0CnGwZmCz1jsm9EMCiaIG34zTkHdzzOhyJjg8/zKUfVoAs8L0iM2/xFUJZrzXD4R/Gjyl59dT3D6xoWasPImHPGpLC++zwX30hO+kQIuHk6WSWTNonFI7OcFq/sEZx93Z59OboHJjNnhbnso1M64J9BxjYJy2OtHc95ReCoxZ/ziNIWJldq1484EVv+FLEk6Q2Bak2uxNZRgWN9n5t+tzfi+Vnir9CcuROyulLiY8NBituNspjWNuCPaJRZcgkWfa7SXXCN4d17zKde/Faz6T0vF3Ci8LVBN2byfwuY9oZOPUihct82tVxQlcPUaG1W5QuAyuev6QR+CAXp5Z+7aZph6uKl+fcjFx0DumqWbeMibdergYCKN65KOn25tpOHkvSlJKY0gW0qtoeIRgdeqTUKcxFZc/bn7+9gQB20Xy2c561BQ8JvZ0fucwqLEbaxRcYLEn54n/K4TyDcY/vy5thmP5i52fObPxVuVST2zpTyI+K9O6B6mURizYvKmP43IoUW6v2iCDe9ULOSDCBb4yWq1SBEEBnyfY/2jFXH5Bhc/NnPwUrx41fadFCI38hkavqUge3jbqxppAvEVOqbD1U3IKUtQrQnm4oFcODVzOQ+SJyIFbjbQUJ3OFnJroDHvwFvzj1toxC0ptRduJgju+mJcdIZgh+K6c8J8BAWhDt9vCLXB+8ga3RuEg1d6W91vm1PQOnuhfukXCpRD/OMPVBMC1F+um3jHxV2tp1XjJjyErlM78uwvjfBn36wqm2nkBrFx5gUNC6NJ8zwzGtukejSvdRCwbA4fzfMgaH6Q+MtiLsFLv++DR53asCdyT37+GQo6TubfrRspzJoTfPrl7yZkPju9yruZi62d50jYRR7wQT7zjCSDTZtevIqaoHHgz5oglzQaFlqXtkvk0tAWdlpdZUlj0Lh+jwSX4MedpZ9TvAnaj+cc2POQ4LyH7+Xp0jaoGu8pX/6XQvKE+QkufzO+/XCbaT+Th9lF/pPfk3g4OOI90LCVwf5zAjRrGYOTaoHZ9RU09O02nsgqoBGcYfGnpJDG/qHcZQsO0VDfMjsocZBAV/iFoskCGiEp/xVEbqKRKb+efQXtCGnaqPDsYSHsV+47/V6PDdEgt/pNc2jsWx4892E+De+G1xajT2gcm1g7M7yRxmikXKBjNo2c0G87dpbQcBeK1LMqoZGntWVmzX4a2bmj8jEXaXw1/IjD92h8Kr1oK/eORoLa3Kv3lTgI5SbK/CdbiD3fTAbmvyXQWmu++ukXGsfYHq6shzTMZg3Kx1bQMBS+6tQ5SCP88TmS+ZvG4c9jXgvraPg0b9Nm1dC4dGTiYP4gjQvcL5eCRBi4nbly31SZwec7cc/fmzG4oa8743wfDRaLxWKxOqAi48i49nchsfzw76KyLtwJr5Dfb9+NNycG5hr/6kblNtFhTY0e6PYL8EUu7sGvzPmH/Rb1wEdvPN1IrQcbS7lCwtY9YFvXHKu82APFKY8bIb0Mli0+NxZHGMRV7dl9/1YPPGuvMW+3dmHOje3cE8u74Cu2J2daowtL91YsFNHrQjUnFj1Hu+DgR0u75XdB5ciyl8pJXbCcUTdy6nsXvB6HIZXqQpy+wS16ZjemDw7Va5Qy+HNuYLdRPgOlLQNnaccenJkb3G5wrht2f9IdZkh1QXB4xbL9bzuRvDnZ3elrJxwKzsTrt3SCeyLzcpF2F3R9wu22JXVhX/wMMZOkLpzf/vlg0PcuxLe7JxdQXQjcm/qkjI8L71NPZNr/Mnijq3Px3IxeDJ/2C/ge1I2NEQZDcmNdSL7qs3DpeCfk+31b9A070eGza85yp06UUJ0qDvmdWDGRs6hGsQs5VpVLY193ocJDr+zp6y6MLBqXoMu7kJzeUb2TZnCi6MF6RoqL7bxxlyWevdh119AhI7Ib1z/OKVk4sxu9j6Z67wV2YUdN09+Ojk6YnDjsOUOoExZhJh1iZp14mbI83/9NJwynVX/t0uhCx369KpFvXRi4mOG37FsXorpfzN74m8HJYIPf/6S5OPfaIpPT3YsTTj3T9u+7cS/01elvK7sx+9LjMKR24ehkxPzsnV14LPBD24HuRM4lt+/DAzxMvDsb4byvExHzfGLWN3di3Yk7Cb+PdKEoO+HJJ14XBJ2Xl274zSDia83btxMMAkef/NxS1IuwghNPi6V78LVLceHqsG5I/XJcMC3UDeudnn0KYV2I2+mv8X1tF1ZUSwcX5HdCVHHWjZ+lPJzJP2Yfsa8T/MvOn7Bu7kS8p9+ZpUe7MN3iacrvyeC5zzwF338MEgWyV/P/6IXcfA+p0j/dMPvQ4Jn7pxsv7y/2jLnQjVGx1ZbZf7qgskD9waGoLjyaN8c9f1YXkvS5c5bnd0K2NOx3wy8exnsuzE3a24nInQFiJxo7MXXLz/K/aAYv10muk1jKhZVT0deuzl7YdOVJcqV68Fti6qLoaDdMrnQ4ec/qQfSfQb3kgG40/JkO1VHoxt7ByiPPorrguNGRYvN3IWfGDytlXiec3om+n/boxPEMsrf4XyfiuxsWmHxioHf7THehFhdyXdYXKeE+yFw5XCmFHmSn3l+vv7QH0RFmssysHpTf4e99tLgHouFi6sjoRkQwNTdVoRsvf14M7YjsQuOTwpB/H7sgvWbzQpd5XfCfOuGmKd2F0d+xp5c1Mki+v14/7jAXOQENOr839uHzuyPL3Y/1wKojzuc/9GBF8V63bWt6UG7aqr1eogeyFXcuORj0IO7nEbPijG5kzh0p41vZDTZjULbUqBuZnm3+5i7dEEy4wj+g0oXQM57rsy4WYVme96o7NvUQHjf0qdWohk1R2dVN+tXYYvLUza6hCbrmN7L7W5sR8lnulLVgC8rbtfp4N1uQJ1sb8vdmC14qSleVr2rB+eU6/WabW7BZ5vi8Et0WjEsEKe0yb8EDJmqWXlcDTCckEqSf/UBR8Qrh68+qIBb9fG57RhVOzTnWIOLQBPd52xWTHJsx1is4HqbagoEtVZy3oS1QfZApvtenBYfE4jJ+X2jBsnnZVe43W0BmU/394S1I5hMQPvuwBXvt0/MdBqrhomqVuMu4Gjohp0/o7MgHi8VisVgdUF5M2qqsGKQdfmeje5xB1reYPwPODG7GZziJBDHINZixzNaDwaeMY0//HmbwpmDXOQ0fBm8ijFrfJTHIlLTlncpnMFLy30rhyWosSdzGGrWqhtZLpcxVJR3orD58zeplPd4sObN2hg+DvJSBC1sCGWzSHVnRkMxA8K34NmYJF+6zahYcm8XFAemxbOcBBnf2+HTvWcRF5YSqzdstXKyWX8QfYsvFSYO/2/aEtCA0f4YFZ24LeM/b858+4GJ/NKOpWcjF27UifccWtmHLzwWRCUkEjbW6Ww82E6zb69bOr0jDtyTsvqoLjRdEIcshg0afgorcnk80vvM9mfpWQ8Pky7e2Z4M0nEzWCuQ+bIX/T7WllstasfTNtSzhN1ysm15l1VDNRZie/4kbYTTohoUKLT/aQOQaqlVMCTTjZ3s1RRMYK20LII0ELhZ8axfI0nB/9/ZAjD+Nk5V23/hv0egP9nzYl0LD5pdyalNFK2oUZKJ8R1rxu63/JvOJi3UKHWbf/nCRLvzfK+efNLQjLfe+UaexNM9cp/J4O9IG55hX7CB4/jzyo4k7wezFfH1zkgiEPN9K3xGgUZAx0yziLA23d/vSL52jYfkiR26edRviv6VbtB9oQ1rfMbeTLVz0Zxft0vPkofmXvWWZJAMnC8m/iwJoZB3cIWK/gcY97T284mUdWCojVKblSBB8Z77JK1OC8IFvXpvSCYw3LHiuJkXjs8mclvTrNNYuuCcta92Ge8zynSdN2qD+aE8cCrg4c+51wd4jPAhpD1gvOcZA6PBY4PQvGifTl2w/7UDjd3rvDljTgDK/24wNHXjy6vfX+90Udp+XfCW7l2BOukurfCaBV/lRkUQ5GqN3O7fFDLWiszda3Ea3DaUzfn3Y8ZKL4sqApyXGPPyV/nn4dRqDhOzuC3sWMTj/XLGvO4JGruDXih4tGtHnJh3+qyXgmzr6gn9nB2ZJ/VjR3kRBYUZrN61LcG9ZtczxZwQLw/3Ls/jaINS9KfXqnjaI2HF+XqjkImrtkb/v3XiwWq+xjrxjMM9tg9ZuXwYdB0+nd07S2PR5OqrrKY05K3RXxejS+C3tfzMij+Bm6odfjq4dOH5PsSnAjqBnfV6/0A8CkRd/g1XE2iA0++mxfvs2GEyfTBQc5WLZL5Hr22J4yKqMtj9XyYC/73niKj8Gw55Z84NPMdCttxgMXsRAUify+pbXNAS21rdtVKERtLvEO3Y3jWKVq17/xXfAnYQI61EExz+/XSAk3wYHcYfuYtc2jFVvnT9Xhoeh+LNLXHN4EDH7dvnNAIP9zpVfjj9mUFE6dWiBKwPNEufNzdcZLFJ7kR+0lMH0+n75G/E0ruiRnM4vNB7nc0d+N9GwPrN8/9eKDoTxFeRuGy9C4bJ4p8Bb9aBm3LWMOVYNz7s7lt3Y0IiiM2f9/h2qxhcxlRVtVDP4vctK9UVakFsgORV2swUZs9Lj4m+2IPuG753tji14811SebdjCzyztHeVnGvBlk9HnHb5tiBkc++7hbKN2BPuqPpAuRCb/F93RtVV4XKsRnZ8VANubtu14ElpFbrLuoPvnG6G+5uPdle3tIBa9mbIOrQFK29EK4xea8FZl+lzR9+3wOFljOi59y2Qf/pVeSC3Bbykjl1ny1vw4Mi+7DdyNTg4ccP1z+Vq+OaGqrbk5yO1WmPHwX3VYLFYLBarA/UyVw5XSjHYlBRQG6nAQLDgctp9ZQZHdD5Sd5YwKEppSFWTY2BYpZ1MVjE44r42TMiYQWDJhsWupxlsmTWc5tfYBL62lqu31ZtA7MY0befWgPtnxzvxPzW4qV0osvk8A79L2bKXFAiuK23+UOBCIDWg76SRQXD21NFlJ/4RWBaMfxTuJgiIjg2f7CXY7yHi6jKXRse61eY2ijRGvRuE/i6ugbjFZS7btRpaL5UyV5V0IDR7bZ/NVy5kh1ZF7Wmux7OvSbdyFBhI7n+i4aDHYKDwh/nZjwz2S/uv6n7MwDPXftX3aQb13mlOZ+ZwIbnmWHXpOi5cd+v7PLXgoueEuKH+41aIMrKH6pa34t4hN/eUI1yk9JnZvBKl4b3nz4IvzlwcfjT5YdfnNqy/E14hv5+g5LPBE4skguadV9IPUQQnDeLuq2yhodk+zN/2gIbKVNJJ40QaGR8/G23OohGnYGliW9sKcd8dW/j/teI8j/o26srFsVP9diGHaPzbte5xYigX59TGkufvoCE6903M9v3tuLJ3QLhencBT6MrFvY4EM+bGHVD8TFCcLm9QI0XDf+j8hbrrNDb05zdn+dPouKm98oNlG9SiVaxu2bRBt+XdbPMrXBiH9S+8/5TG0+93Li8S5oF/xUKdpts0lO3//LSUp0EbBJ0UEO/AmqrScyOiBEE3Uz/8ciQIcqvfNOctgX7amuNzl9B4+0vufpAPjYwuUbliyzaszU4wXmDcBseOWRcrjnFxdcpHomOAxooLF0ZWjHKRovy7PL+GRuvHkjH7vTRat2mz9k0Q3FHTGoBCBxKTwlZnzCTYObzRadicgLXJP/rPBwI38yvF55fRUDnG975rcxtWfEsLfXazDSpGQofiXLjw7pD/qc+moXKAr+DRQR4OvDr3XnY9g3sapm2fX9C49/UreW1Fw1lh6vzhfgJnz/NuGic7sENhqd/dfxSOCKuaxh4hqFJafU2imEBx3p0tLmptWOqkLbbWrw0i7l0TcR5cbOJq3j9DaMxU0bZ/fYwHle33/6rEMJhlGjQ3UIzBlePi1c+TaViXWh/bv52Gg+bAalWaoPk/qVPvb3Ugw8NQXcORQHs+ZfK1hGCl4ozhNv02SN94UxIf2YaOo/WLm2K4SAr+r3h8BoOQ8m6uWggP676K2Qk+YLDEuclR8RqD8OtO603EGLh0pX77+5yGu/UOvyoLGu1bJy08rWgsX9Eh0fmxAzl5L5x/dxJ4qn+5krmvDc4RzvsuPW7D6iCRYr9vXAzOyiWayxnYP9rlczKdh6saW7wMvzD4/W1petoNBv4XfdzjrjE43J7DZ7yEwUmTg4XLs2mUbhCPKvxOY+TNkp+VbBpp9gF/5nZ3oGN3nd0T9SK0LE0z9Mipw9iGv/4RGlX49+8O0+rSAH6WxG87zQYYySaty7KvxnvqkkIpqxnu6/KtFl9oxjEX298KF5rRM+9QpPeeZvzNvdxmfacZvXyLrp6404w3Exqx5EEz3oiWlPyxaIDlJ9vpz8U/wO26Fu92uwr17p6ZxjX1aLo4VfAzsR78fVc/n6yswkqJox9mrmlGtP/BXe3ezajj6Xr7uDXj/p/Gi0kJzXj6Yiz3clszYrrv2ge3NcOO+XB1Tk8zSiaPhsozVShWS/j5UKMaeVlZlxJufEfSzrg93hrVsMkXNLT9WQ0Wi8VisToQJPzpvt5NGrHUdx2ZCBoiqv1DuVdoyBQ4rDc9TUOQ6+84K5zGq0eD1x7cp9FZQfu/+ESj2yszq3dBEwxmU3eN3RvxPoVSWP6nGgvTjMxIeA0si/QGrLZzUJqYf+jOOQYxIjGi4vMJNtWl5gZYE/jv+XEpI44gbmrz8p5zBIeF7lfqRBHofal78TKGoOx8f0h0BsHkK+a5flMjbLeK+iZuacSu4WpG5U4NNF59u/lRksIx66Q2Td8aQMUkddcMBt5LWEFTbylItotquv2j4HJg/cBDEHRMdPWn3iQ41WhQUX6HwPJWwHOtSIKmHQnBq94QxNApRk0ra/D+k+HKfO9qXDnTZ6VY0oH9ngv1nnzjoutz1g9dfS4Kz/zruiJSD4v4254lNTRWC6fdaTJiEH7kzAkbNQZps41OrI9goGth9u/ZXC7e+v+8UraQC4HnLxRTt3BRoC2l2vutBboxgrKRVAvWy71a9uk7gziXm4IF/wjiX25kumMIlu/ullcT50L60sehd/vbILEvfWnJYgIx4e1+hgYE3M8k+lE0gbjWj9w/AjQevPuuIneGhmHagmgBdxrlTThroN2KFX2qCUt0WzHHOU3YqoYBtV7erXwnDfpsuekfQmD636/w1CguBu9U5L+dJjjkeXvw+pp2TC/WXHC5mcJTz/8C6pQJ6kevvFnzkMDSJyXecpogNy6uvPIEjWe6C0/t126F6OJHkUmqrfiedTLX9ysD8cS3HpLhNBZ22O/RWU+jzPSt0CcfLhJ4T35nqdBwTaw8w84jSLUeZv3e1Y6cKJ+/2SUU7l2poxZrEyzgZ1XiIcE12T8zvs2icWTLIbskiVbYRgpqOJxoxexHYm1jzxl0fDs+fMiRhsW7NWq7LtL4KvwpQ4HiIiP6cqlPII3b5imGj6VoOH1i6ve8J1iUfPFZf3E7Ar/2XHtPUXikciPKfQOBqNmlwv77BDMbb1r/OtyKv5tUNwS/aIVY1RXj79UMXHR3/WsIpqFpsHjVGj8aWs5PtdWdeLAo0huwmqKx2vfsKa8IGjM70/6tWkvj2JR2yetSgnBm68LTqh3QXF6j0LePIPerq5bHV4LGBB7/f7atKP13v2X/y1b4RKgMljYxyH7vdjj5No3oT9uCRgJp/PT/KMJ246G44MJPQWcGunyZ63ijNGRO/fsaFUGjf0HKHjMZGp7OGQFVBjR+cPf+93l/B/bv5Ld6mkOwKS0p5PyZVqyYu+f4pq+tOC1Qzl3O4kJMJdqT84oGd9Oxx5ue0ni4f/HThbE8pC79KHH3DAONPxWuWxwZzLNfwGKmaLQLZEXGB9NwtistXp5OwzvtvvKpjzQsVo5dagnpQF7R0877b35g9sPp9llb6tCQPlCxxr4Ka7w7rrmReqy74RQy+KYeJ9zpxl2uDUiUT5nD3KuCy8mt2qGfm2CfWt0U97kJlFHl5d9RTTgrL3/uZlkTrtTpZB0WbobL4qaDLsLNOPSovCK0sB62FzT27Nz1AxFJlzrjn1RCMDHuoJ1+PRakLHdqF62H/r3EjC3l9Th2y0r9bn0VDluWSS562oT4Dxmm72ObcCzfbGddURO6HsRrn+BrxphFVhB0mtEfYb3KXKcZdbscUtTEqlE7mXBR26AKOo7ba5qrchB0o2zBsnXViMka2tfwvhoD5E7VpbEasFgsFovVAfvAzC+avwh6U2u7b2UQGG4fEHV8RNAW5vhtRhpB//aIhcnVBOm3+E1WNBEMW18QPBXUiMitjtZJbQ2YL3xh0/OhasgnqH7X212D8HrbgPZ6Ng6rfTzQaseBjGVD/GVXBitkLwWpihFcjI3CEWuC6zYbnaKVCK493Zp6RZ1gOGL7AdMDBOGyy9U22xIIhDz6k2PeiFdn7+r9TWmAv6b81yzfGjjVm6bZvOHAbWeIZax2DWxWvWQixThIPBi6Jm2axrdZN/liMigs8E389OMfhcAVtp8qthPAMiw1YCNBhnbEAZt9BBa/zqthP0FJ8MvLikuacPLsg0K7s41w9fi2PbupFsRBt37rdgqeh+KeR3VzsFDhd+aL2BoYJqrNvXqfxq6xJN/Fxygc8K8Pk0uhULb6XtiaTgpdZgtq0o0IFOeIfrC2IsiL+h2x/QDBnVfcnSuNqtF4NHavVGg1fM7xFSuVdCC07H38yW9cpIspzb+uz4Wvt+CO1ioG6VFaYztv16FzZqViZQuNCKl9cbuLabzJXh+noMBAb+7mDUkPGXSWnOC/v4QLZy+xgk3Ludig0xvrcqkFPWK/XiYca8GOK94BySwGYYMjeSwWDUMRw0ePHxIcEcr/OGVIEDpS9aNRjosKqaOky6kNJ2T9R9d8pyDqy5wOXERwK233jyM3CNyEqwc3/iZ4evTc0fr9NASf5kT5XGpBu+bWpNVWLfhPLv7aZR6NL0cDCncZ0/D0rU/p5RLMWpxs2BlGIJz6SbdzLheP67SuKD0jkPtX+PtQUBuUHv5qdUunYLTp2ZiZPMG6CGWFZXcJHJmSqLxRgqAWvd7CvS2gR1qeMkEtIHW/VflraIQn97MNpWiIDWHb6AYayYHz2oZqCa5rb7tpfZYLSYVXs7unCbrD+u7HxBIIFOQctRFoh8dHp1VX3lHI/pbWab6CoHfF1YZhP4IL43kGkhEtqFxky9pS2AL3m3x1Xhwaeh2lpdzVNH7kbht/v5xG8Vx5vktaNHgXntBWQ1ws8v+5bPV5Gt29Qmm1wjTk1PcNh8YSFH0sDzSJa4fNG77LJUYEDXVL2wSiCHoy806E17XAvPqwfNq8Vgjs0jU0E2JwpjzXWcCcxsAblegaIxomc5cG/TOmIS9xKmWWJw97lj+qT+ymsS6EctrtT8O+eu2rWWI0YsyXCFzTpKGyu/FOt2wH3nd3x0t9IVh74WzHj/oW+N4WiKOFWxEc9vSX6wIGT6Vep9+wpLFhQpptbkYj2Eqg6sJuGhMn1gQcus7Ds9HY2Jk2DHwdmpXeDdA4sG6bzcpLNGZtM/AouUVDY/oYht/ReMZfZbjapANJZ6+u0ZYqwMHFaTP+e1wL5bUr/qgkV4LbtrPf9GA9eqjS8dJV9VhRbbJLka6H8Znr71Zk16N9+IlGVmclerWMmw+xmuDesvNKenMjpt/fP6e3qAm3whjnatMmRO+YnfEypAlf1WQETqyrh3b/nGb1/HxI3lH/1FtaiZITT/r/fq5D5WnlCa/AOsw99GJXtUE91hTPy7pSUY+skAMLvsyrwnoNRkW1rRFD+tfW7BNugo9MmTwxaMLsPzKS5681QfyF9nGzrCaIR103P2JUhXMRrb1/XKtwRfKavcrOb/ibf0+F3V+F3gtzk6qSq1G+5fK1gtYa6A2GBoU614HFYrFYrA6oZytNmCRR6O+Xjt9zj0K5rtZmPKRwS+7jdP5zCjkOned2l1MQDIwWXjPRAOd/UovFLRvwWf1d5Z/Maqy7I63xWKgGJYyntLM7GzH6lf0n/rKRYSsRVHKMA9dZPe2OLgxOcI7PFhYjcE9krdcjFI6zNHpX9VIQX09tCPxHQfvPLU61DMHKL/n/fPMa4LXeiN9FqgFNB97+wqUaRPM1N43u5OCa+oWiv/+qcU/tx1WZF2y82j1P/OZiDgbmJbr8N0FjqsjV51wGhQsu63yujlFQeHmrdyOPQl2jmsrdEQozHrVw2pYQNH4uu8YNaYTm+r2BVZwGfAv6YvSmoBaLQspnNNdw8NRfqU7tBgdy4q3rhWxqsHfNp2dlVWyk/rwY2hFJY/qFsbWRAwUP89nWe5MphJ33e9DMTzB+3ea28CgFxV1TOgelCBzXrXjjfawRM+c73Jn0aISsIule7lKPvR6co3sNKLSd2ab+aoiDWysdd2x7wYHAhM/jne9qcGx1svJJPRr5ws6aOfMpaL1V0Py5l4JN3WMfy3cU5BvzC/cvIFjs7vjCU5ZAxlZZn32nChy9reFuaVWYNTP/kGpJB/TezVqy8RsXZ0a8goz1uSh3WPlseTUDpUyr0B1XGXhtEHr/WaoOmFz+0cOOhixjEmb6mcZbFQv5ok0M9v++OfL1FQOR9URw7WourgzGvSjJaAb/DbaO4Z1m9LVXLo7KJnBUOLT06gwacnovx5YlERz7sDT+viFBUYTyjfxBCnN2CjzcF82gxVV2+6kfrTBxulrm9pCC88SBLrN5BPcFHXcmXyZQMQj1GOgm4FOU5RcPb8a87X6Gs/KaofVE72P1WwJtp3w1r2qC7PeLwld3Ekwq+m1KvENwlP/+kjJNgqvNwe25/xhEK804eekmQcHWJa8cDdoQHG4QdTmWwjLDRbdqBQi+3ND6IO9FkOLX9M2xrBmZceC35mtB4crpwQNZBN0F+79UtxJ4PC3kJdYTXN+lPkdhhICnYNS7N4HAgefemHONi6HVWRszRgnKhmXsFX0IZr2Ij9nf2IbBK/NfsCUIktWav2y+RXA+d6POTOEW+Dbc9xtGCzqcOP81/yLQP2ugvbiXoND8/SmKIWgO0LX15xG8Fj/95PIsGn4n3njY8/MQPcJrv+dCwznXWFhgmMDbbaDp9SwapgfCGwYvtsN314srhmkExuc9lam9LbAKaXGv9m/BvXfVvIJhAnO16Rx6IY3KxWf73gnT6DQb79gnQmNp7xrxV4toiGit3PE5iIe3pwJ8swiNMHXuqw0uNGLosCv13jS8yoJODz2hoWYp1fliQQeUlrQ19j0qwDrs+CDXXgvBQcGS0opKbKjcknr8Zj0qN4hHFd6oh8z0Fq68cQO+fn04XiPdgH0fO/iUVzQgJndS23VOFRyWLtuxRLAJvb/PaKbqNuF8SZbKlstN8L/eekUsswl6r5c3PTxdD82b1mc/GBXANzR2tslwJXY+FFZ6VFcH3kSn+Ky6OmwsET5p/6AelltdmwQUG3CpJnnHTL4GpKXfsLy5sgr5kuLXj4o0wWLo+KUhwya0/HzvesO3CU6PZzotyGzCobj1wj1eVciJl9xx9r8qjPvnjDqZZMP7/HiegWQ1tH0itnSUVYMbJy91dU4tVr/et7rnXh2ir78UOJnVABaLxWKxOhCtK74sMYaD1JRojdTnHLwO+Npz7T0HYcPb63pyOAh7cbBCaXUjTvpI3Ax2a0BsxnL109xqRB/doD1jTg0sciNX2+izcUy4NsWrjI2rq5Zub9fkYJfMzIacFA50Z3a5V1YysK+mx/RHKVhsks5czSIYdRvxChIkOHxV/9txCYKoyJ06dgqNOO3E//vbmQaEbDspdfRJDXqbJ1scJDmwEQj9brahBr3TFxVPRbEhIJmVXc7ioE7kyYG3LhzkCT2cmnWUwdEEMZNr8wisS62P7Z+iMDFXZeUnAYIji31O3hMnmBUqO7z7TyOa7VuepG1vBMo/XfcdrgW/tga5+pyDaJM/a4JcOHjb9n1/V2QN0olW3dJcNq43r3SbXMdBQ7OtmMsIjUdu1b8ufaaQFWWxYIYUgXy6xY1NggSB3xJ/iEgQ5A3WN60qbASXlWbSXN4Il9XrO5Li6sHxf3l3WIqC+73TjdMtHIRsEXV+FcvBEqP+2Yr9NTC40KqxgMXBMas3hsphNFRrWgNKnCmYjB0qtSqmsHTiqvghJYJt3KE5gjIEG4Xef5Yqa8QSrWi+qKeNaHRMT07uaYSCyssPYyEUPm8/PTlzN4VSjkjYu7kUBK89jd7dzIGD7Ujj13210FDbKLJJm0bClSfGYhsofEpcGf7fbQr+jw0aEvooCPoJLpc3J1ic67ji49sqLNr1Zal/cRUSX9PKhiUdyIsKEJZZx0Nvwz9vtXdcbL8ddb9kGxciR+aN85UwiPy84ez7Cwziq+z3Dl+og6TcjR/0fhobh1YIOFbRqH9v3XzAlEFY/4f+wh8MjN3m5wd9a0YysbI5kdcMvuW6IcqPKBhUaScThqBE9vZU5ATB01M7rus/Ibj5tXf/fgsCi5bZpHghgV39FdfMcAZaM2egZksbWvsqFro8o3AuJUGgUILAKtzMMTaAYLy02KdxohlmO8QWfuRrweMQ2Q/BHyhMqlQpYZBAsstf3aCPQDls2KpYiAZfe4Jy6weC8qlPn3Z7EZiQo86fFnHBd154ya/bBN7nA9cUlrRha9ePp1UrCNQtpTpf3CUIND583FOrBZVZj0f36bTgY+KbP0u/U9gzcd5g2wSBa8bqvTvGCAJ7MvTJP4LVZx1iZsrQ+BPmteJiO8GQ+rI461guBDrqPJ/z0/i84L6ysBgN3cQBFftT7TB9cXfr7scE2YYHY7ddb8Fon47BIZ8W9DkZXH1fQeHaVcW09fNoPFNr/rJZkMZ7l5Ov6+bSOHxjY+0iMRr3rY7mm+2kcYQ3Tz9xGQ+BfkXCW71oaH4MzHsQQKOzapsiN4ZGyKG9zp1zOlC+KDnswe4CXBowO5uYVgvRtKyc2SGVsGx6fbLLvx4teTNTb+6sx9t5DXXfNBpw/DnVd25hA8aSFUZ+SjaAG9zb/V64AT7UCvOSpkoMfwtfVXW1EVeiO2SOZjRijpuK+FBvI8ZW278dVK9HGr9tzSyLAmiKf6+W5lZi1eS+WbkC9UhjnusfLKqD34ZrHlGZ9cjw9u/cbtqAdokvFlqrGpC33dk4a6gee5JiHY6trgLnurhey8ImuB503Opi2oQ/qhkbJ/ya8Krk64WL66rQF/rR9FdoFVI2Bsu2HspG+L0tb+fvrIbb+92v72VXo+jC9sdOSrXwuCnbpvalDivkfhTY1Teg8mptXnZZE1gsFovF6oBG85Lm1GdswEBZ8lYWG04Z+3sMC9ngGdDzFVc3Qub010id5AY8/LMK2Utq0L7eZ+CuYQ2+zH68f7YxGz/3ktrV1WzwTq85e3s7BxlHF85xe86BPOuhxKs5FDzHindN5jGoDww/byVN4F3grJahQFDfQY+GbyAQExbJp0kDDFUZo2mHBpRtH+8aja6B/qswkfnLOEgUea1UIVmD/cd71stZs5FxWsr9eT4bN47yC4WqcDA0HSZVfJsDixcRDs8zGPBtuS7x3ySF1AsHG8ZmE8yMqPuvVpQgJ6f+pxLViN/0a3M9+0ZYRAsL/SdVB7v5hYvXpHHQ5xccuH0dB++7Jf0aXtXgzS67KqH7bFzTNXTp5eOA3Tc1pnSYg5NXLDxEjRmcYl39oSdJIDbveEesEIGLYsejC+IEG2Tt2SFfG5Hhs2fl2N9GhP/b1BJfWA81m20FSbIUHFP0xfsTOGjxF9RcdJaDajPxowlitfAr5rauamBjkUnzud/SHAwOP35W2UFDa+/ZtV9/UZhtdccjdy2B3B5V+uxSgoQtWrk+eY3gZW5pelfRiD7p+1oaQk3w5tvW+SScwlNzfc1hUQryKoK2ku0cTD2id/a940Dy2FI1Nc9aiO6q5gUOsBG0xMOwMZzGkzH1PlYEhQsTNuqzByn8bVnqG2hBoN9+7YNERiN4Z2JmKMU0okX6qFTOqhZs2LllY8cQhcP3dK4re1C4+lcmTFibQtHUYs0F/zhwG9+SqfWLA9drhRGL79Ti2Iqj//hX0BCIPiF0QoNCXWyg5eloClaXTeJ7RikYiz3Ua79WhQi+/dpLy6pQya9U5VbSgcXP241kZXhQma1XuE+YB98vre3d0VxY3N6nL6/IRTXX82N1BYNjAz5fe/czGH3w09j3Rx0y70+WDzrQ4NLteT1NNOLldBoOWjGY98g7yHNNM1x8ktTyi5rhkrV9pVsiBwUZtaEb5tPI3/emK6CXoHp02VwhIRpm8iK5NpkEErqrTYuvEESIPyw7LU2QuH/x04WxDDYd1Vb6G9CGycu1L0s2EPyjVvelPCSIkhE9I+7cjN//NkkdFWjBo5h/+8c+cCB7/VLqOkkaS+UsZ/v9Jfi5XqHBbpLg9JvytT6yNDQreB8G2AQfk+00I28QGL2obHgrw8WE2165VGkaE2FPNoSvbMcs07IswxcEXmE8maWpzag/Ne+X084W1G7Uvpj0nQPLlewXjStp7M37IrhxJg2+qrBmIUEaz7jWM3aJ0NDXcBUsMqLxUYgOOdVJoL5RW3L8EReN79lOGndpbNqXlr4tkcae5ZXRM0ragfCCE0+L87Ft+On7z561kIh0O8LoVkK8bU7azN31uChb/OexTj3W/Ra/JP67HmfmVro8H6uHOP3pIv+cBsz45Xumd7welzm9bY+06iGy3c9wVl4l6tqHHfyuNuLIJ/39izIa4dM/ZV0xXgdZn2uvREvyMccy6lLKw0pwWWkmzeV1MNrhGnn5ex2mxb8u6jlfj+zWc04XVRswYrO72mNRA9qvTfwc6ahHcvjD6rkf6tExPrb/gWgV8h95ed9e2IQ9V4rPLzNtwncFW+Gg1kpUGT8sC95eBVGi4PDZ6hsSz59aGsFXDfdDfh/V31Wjd+Pw5pH2GhiSzTf/JdXhyNVb0dfzGvDmfUV42vMmRBiXGb9+2QIWi8VisToQe/+r3fHuDkTOPpYrzceGUG6Ji2xvA3SYx4NbnBtQnOfR0VNWDd5yVsbceTXIO1F3a8qIDf1L+1S+prOR5HPj3M7lHLhePD1v9W0Omhb+WerYw4GGSO1Xl14ONpTElnWUM5Asql/1ZxnBBcqys3I1gYSz4KpbbQ0wud3x/NHBBkjbPvrIH1wDU+FcndnLOMCCWavfSdbAPl/WYYk1G19+mT16lM9G8Hf1bX4qHIzY55rk3ubgRaOlpEsfB2JdJXnPMhi8qQ0THJpNIDQoWFIqSsAbFzln86AREvLcP25LGtFxUS+BW1KLEdvYU9zbHFg1ncxQWseB9Ro99Zf7a5BRPNo0dy8bha+LlonlsqF02Pel2VIOmmsXXP57mYPrxxPtg54zwA2zuU/7KMTGF5fc+EshTDywed7jRkhevLTscnsjehLWKrm+rofzNcu/0TMo/Pq3XK8pgYNdej3hc5Q4UI7waGjrrsGyXeub7VPZeP5L3m1dLxuHDN5r15tyINw2FGW8g4HGr0vxZfMJ7r7nz0zkI/ioqNod/K4RM59tmPktuxFWJdvHu0Yb8XK1pXS8BwWRlOVO7aIUFAtU7u2M5+DYeeUFkTc4WJ1g1Dx1oBadn/jC6Tw2XOjd3yJWcJDE932TxRANnoPS3C31FJx1ey0zNQgOl13oWJ7cCD7bO+s2BzZi+Xi9SxFfC46dMT9eV0shp3NZ2hIPCj5hBvlbxzlYW2E382MxBx7JBcckH3Lg/XlYo8O9FrcUfysbNLDBd88s0sePxs6p/Yrx3hSK2qTdl7VRWGR1sUSqvgFZl4LujNo0wvDNctHza9rwdV6veF0thRStm2OFdRTs2p/N77KjILI8b/cJZQrWf5ReF/FRePE0VWHFWw5y5k/xWzyoxdtfTZxCBRqjrVr1IyspeH2blvP0pzB1f3xrqGMVdAMzNhe9r8KGhIu5X0s6MEvXotJ9DQ+mGlVdicI8hMw6LGY1g4fDDVUXgiO4aN/4h7dNi4tzW6d6rfMYbMozfUcfYpCaLLVVamY9NvBdFFjIpfHmtq1J8BEGV68Mbp+l3IyG/eI9Fr+akShmcq2kmo3PF2vldRbQkPcN0Lj6lyD9vM/B1HYCqz9Kr4v4aHzuDVziUUiw6fvkf+InCZK2P3B/LUigtkPvyMy1XCif+RdW29+GlN3b0oKSCRy6i/vnujQjq6j3gc3cFkyY5ESG9bAxJLldoVeGxoEV/VuVZtJgJHi2Vt0E62b9OVYyTJC7b/7pXetpbFXy6+0pI3idsjzf341Aoeh1ktkbLoqM5ynviaXhyafwxzC6HS+FuHinn497276Otmyvxd+AouvVoxVwXSUztEq8Hr+cWjV/CNTDNvJennZPPXzViucKvq/Hx1sOgzt66/HWvKxbubMeSk5attTqevQfsb98RL0e8TEE219U4iPrQJz61UYUxMS4Pcmuw71/CQFLDfPxKVE4XsuqErrGebcn79chPvhm3YNbdVD5sNVh0dl6VM45Pau2px7/TYyYpY/VI4J/l7F6WT2sxXssTB7XQ1ZU1LX4TT3OWB+0qP1bCcyJeW+0oAkPjowuiQirxMXOa/m6/ZW4cj83Mdo8C4ktZw3++1oFbZlnNO9MNVI/S88orKqB/I3Who/769D7LYJ18XkDUoYCVs2IbsL1CymlvX4tKK8K2KGh1AYWi8VisTpgof9bp4Lbgd/LXm8XedsABX3/vNXKDbC/kaV1368aA4uve92oqIaDs/4V7GDDtCHwyPt0NpakDJU0zuBgbXCeaIc7B9GKu+VkKjgYXK6cu7KSg4KpRwmRvRzwHjrvzW9mIMbkNi1YTjDxo2v00qMGqPTkXr27rAE+PqqTB3VqwJmx7LOkBAf6swN6lNqq4bTSMkLUmo1Ng1kTailsGNbd1tm2iIN3HpJ7vM5xcLz3hczKKg4OzTtw404tB6VfHxiG5TJI/e2ZNX8uwcutM42G7jWCtm8uERJrxPEuZVGZ7FqMZpXEtd/mwHbrA0Z+HQcD6/7bfHt/Db4qrZ3Hv5eN0vkT5oK5bKh8TW81WMoBR23ni4HLHKhWP9k23cKB7uPm74HPGSRNTkxe/kvh0qbMPxsPNWLmveeaCdGNeFGTfIRxqMesMgVTKw4H9rtG7kf7cJAW8/Pb79UcFJY/CH53vwbLiwspu+Ns/N7yePmel2ykvFMb+CfEwZD5/euCZzlQLNS9kfuYQe6aXs4rQqGFte8Af0wjVtywOKee0gjeD68euq0RxPyUeaQ1Bf0Szf9qf3MQIiXyfWM8B782ip4K1ObAl/PEYvvmWlzXNApbdo+N2Seivvz8zYYl/x/TRisOsrpE5YotGcR8rPP+IUWwKfHENYW7jWhY7/tT/UIjvk3nVaT0NSPi3onokk8UhkTVOfOtKbA5K4YUxjlQdQzQIoEctFwQ3vDtDAdBf+Tbnu2rxbH78rWPXrBhHTuq+F6YAxnvBz6jNA1NvT79PfkURlY5nZIpaMC9XV7K/wwa8VS5ZOoYXxsGN800LvlEofzof+u+ZVIo2By0rdaOgujHLv/Ibg4yC929zlRykLpHrYLlx0HFrrXPpXxq8WHN5RXVBWxY6jb2GQTS6FleOZx6msK8lfyTO2oa8NFQPEfWqhF+48Gcs0XtOGc0xt1UT6HV4vmkaxYFkzq7J+o/KCTsz+0f3kvB0NmIKttCQX1IaOvRbg7KdbU24yEHG/JFjm1uqUW9056xxCc0RkSPF5UEUVjd77kw0bQK59s3CrfEVSH/saX8ttIOqOru/TmqwYOnRjW1aikPJOFySs0MHp6EdEgoTnChRPOHNKZw8blXKPy3MhfeFw/uu/GDwYXGYw0hPAavLtDH603rceTt94vVZxgYGb/I/behGc6CxZztpc0Qmgj49LiSjQCRuIcsCRp9S0zfb5sk6PFcesesm0DIZA8jXk/w9L+TvZGiNDJ2rjB3fU+gmJerMMeawC7jwI/u8wSWRWn/VWdxkdJxaV/Y0XbIBc6RzMrOg39xsIbArFpkBhfIl72sgG1690aL5jpkD7/wGiitg6ncMf6T7+phLDXDUvNlPY6NLQh99qQenttmL1Qur0drd1DzisX1SGBe/k1aUY/j7ApxuY31UPqtv8UzshLVK9dNedyoA9/FXPtV3/OgO7VGt31uJVTqIsoUbesQKbF3caF5HeT0BBO3b65HXO9js1l0PfRcUnmS6fUo7HmR4/2uHo/bfpdE3qmHc6NEl1h8PdbWaAmEP6/H5JvPxvPZlVD3DYmJ2FYJ7ukD6wceVqLDyJZ93vgr7pQYbFjjWYXfJxKr5ZWqoWS3eTj1dg1iev46Z5rUwXe7stSxIw3gLb5huepaEzZG7VZ0c29B85Cm94/JVth7Sl6zV2kDi8VisVgdeGB/y++PRwMq/BwERQfqYaE60+KLZjX+zp2x/d+9akyffufiq8VGZ9PI3pGXbMjNWjVVOYODx2mbTnvs5cBC68nK2nQOXqn7F7S85+D6gvHHJpUczC1Z6Kfex0Giu2bdUy6DpwI/tB0cG9CyLv67F7seOyKzJ5T/VOPC38ublEU5MP83+23So2rMVMy9YWHOhpbFoPL6FDa+jU/9Vz7Mhkug+76N1hws45u5vCWDA3G5twGGmRw8bCw+oVrHwc07Lr/cShlYXWkPa7RpRHjihwW/qxswfITvX/yFWkSmK4n/DuTg94YxezUFDsbklo7NkqlB/i077XELNnZudnn+IImN7nIhzuQUG/HGD9NS7TjoZqZ0PuZwEE3tfvown4OxCy92nv7AQMDqqU7+3kaEZsTc3HinEbbxVRcNLOshtP6AtxmHg5PPVxfd8eEg/e9kf/dqDo5V/Q66d78Ga5TGF9kcZ2Msps7S6CUbLxc4y/8W4uBfWvE7vrMcjK8pNQit4IA7y5vOecwgiM+XJ2veiPIb3nyWhxtRt0e9OCCiEd4J2x7vUafQvHRe391qDlacYov3X+VgU2Bf9DltDmTkK/gXtNcg6BM7SmYPG2bVi8/2vWMj3s1zemIRB/Hdl+8GenJQX20/TzeDQWJKYeWrS43I6Yp7+eRAI66b7zjxs7YZP754//weR0Fxi5n4LE0KEnO1FKTbOLj/+bhzeSAH2psGAt6t5qBd03df5/pajC8+WdV8hY0eye0Kva1sDNzT/eSpw4HGie7TroYMCv7azJFLb8Dm6WMYVm3E1cDx2af7WqF4aOnV73EUZga/tX//gMLwoQTXX7oUvOYVp1/r5iDrcOyb/+5wsHC5d1yKJQeNAfv3kCO1uBub+lMllo2xLNeR7HE2XNesbs7i0ege+f2fwfcG7JuYrpTc0QiBJSfP7IxrxzNX0aVrPlNY4JWrciKRgt/khcZ1zyjwv06Q6dhLwWDKVWriDwdxeZazBL5wUNFV2CbtzoEWS1tw6lst+pVt6ZYlHGQ+XiW+fA4DtqiT9afKBphKeISFWjRC4N/qGUJV7SgeWLhncyOFjf/yHd2yKSgfuKdz/RWFTV/9ZMhnChfmWUmx7Cn8rNwyS2gFhem/L9vkGjnY1K3vM/MvBw57hZwLVtXhk/F/hpKrGVzjPLHYvrkKxVUOJpdvVmFgRPA9q6wDw7fX/fluwAP8X4kMreYhqXdfZYIoD/c8Gtq6J7iYwXTZfxHl4V7f0PHaGC5sPHWVjm/kwrRp16szllzMuLnaefFFLvYn7a2eSqyH40X2gb6MdEwtddH1LS9HjtGi68zsCgQ9ipV649SG7tZlz1rHaATX31PbMYvBtVsHsvj7aLjeU5up20rj8O4NOuG/aRyzfG0r6kGjtEcgbCyaxg3hY+0rs2iEZmTvu8uhIekV2tUdUwHezqPzMnZX4XaXwQTfbA5ebYzYvPUKg6WeW0dWDnFQ+NXmrJsVBb0yL6N3/1FYsyI22M+NAvV17zptdwpfXYXDtrtTWPtO0u77bQqa/EoXfr6kQI5qVVn/oqAr4RZln16Brzc/pafJUnjKv2Sct7IKfrHvznjxMXCcnJWqHcnBXL0V8rf+cBC4z2D8pxaFy9s2j3Vdp7DteZVE92kKwrZhl3pOU7iaEGvsdouC/0Cqw6WnFDbu+ZI18wcFFovFYrE6UIVTHi4/ufiiKTYZv5OLPl3bPJewaqyfOpQRFEBD4k3N7ooVDO4JWu/YOZ/B+eMGZ96cYyAse3jzt2oGq3NvWJS3MIi7985/50wu3l+kp3XXceHotDQndx8XcW53RULNufhKu+80kCNY9yz+uuNnCkc+Si9IqWGQVHr078vrzTjx7MQgWUchvON4v+Q5CiK/tsymf1D4cur9iSsSBAWrX22u9Ca4eefvxiFfgvbPaxUEEgiah4/YJWYROO5+3nfangu33tmiE7cIyBtVE+mVBNeNhgI9b3Bxm5Mc/2c/QecjjyMfr7cA+/YcqB3k4Pv2lW6JOykca0uoUI+mICH3NsBwjMIRZcah5BhBzqPNye5OBN9aTrXODSCoOZg4dsyAi7Co0isJ1QST+8c+ZJ8h+JSi+ZE4c/E8+LTgf48INpydsURXmkBp8tSyq5EtiLCa/beyg4NGK/Lk5EYKfeqR870iKWzTL+JNTlKQd2AJCjgTyBGLuypnCNolH886s4mL8KFzubefEODQ+c97PxFIJ3iZp9Zy0THzkZPrBIEHb8vFxRcJQp//aN82h+D8aS2e6+xWCG78lDrcx4Ho1aEL5lspHL6luKcmmcKuFHu3VhGC8vL/6k5dITikKv6Nz5SLv8K7Lu5IJVD90qgZk0SwPjhCeEibh2dHfiRzLWhsNrBNv/2LYLdAt3SGLUH3Jzv1WRIEC5zOvFDZ24rmp/q3gto44Pwt1BQxplC9iHVwyXsKNtDKSZElMD790UI4h0EAp0Zj4gnBpzvHxy0SCa66p4dMGvAgvGyRqkk2jfA/QzJGm2k8/OQbePYHwY7T5ctEnQhKHo7J1c4i2P1r/Y1r11ohP1XVMtTMgVkHZ970Tgr1c5d5hL6j8OiCTF8rl8Fk6sWI5YUEqtazrS7lENR2ujWYuPHgVrTg6svvNIxWrZg/9YTGId4bz5WaNEb/m57/o5HgapmSWMglAt1766Q/zyBQ2/KM7dvWipENY/ZqjhQ4/H9tCysoOIaoBwuIcpGqeqyT4RK0tw+93MYm6B3mGKrF8SC9d7rajaJRE+liPfmcxr7mtScuvaTRFXZ5UtKMxrXc5Om2bgL1wKdz518ksD5s+vzTfYI3MxtH+43b0Er/PRXZT2GOSdTcu1u4yHfPltsrSGNimTl5OoOG7MYH33J/8KARcUioZSaD7qqisJMlNPSnSNiytzQM1B1/Lcql0eJ8p7TRnkbohrsNc7oI0mZqXEqfS+Pvg1WLJ9bSCB2zMO7PaUPgmlOr+oVyIeFnavgnoRwLd0hleT2og695a8WzC+V4+yuv/6RLA/J1Lmts8WpEc8vz88OljbDUkvpmmdOIlQ8uLG5kNYGrVyP3MqkRmful1vunNSLojIOWXVYjbG48MdL41YjkHHGF1IMVuCcp7OFz9COeuX6+5TS7AkIKK/a9dWpDVnRhVDyLgULDkrnOQgweiMSIis9n0GBk92XJKA2ZUK95N1kMEk+PCjRyafDNstD4Po9BpsLLW70bGeSUzpk+aMMg+cPa/y7n1kO791uN2/MKFFcvFS5Sr8JwGttnphWD6fCI3E+Eg+art9UPGVL4eLR0X1o4hdIbQ+cv1FHYrXP+2+dCCu4/5T+0v6LQMW994LFfFHi22g9Pd1KIyjIfHxUgOOS7VlLxdAVYLBaLxeqAZD67sPYhF1YNYov6TGpQ80a/1bGRhvS9D2li4zTEVD4c2HGegckJiYe0HYOWeEbSO4/BvCOzmOSXDGJmLFAoqGaQ+07+ufM4g1D3zRfuruDi/PH1ly0yG7EpVX/BS0suTP+ZBH+bT9AXkCi/fh0XOt/HmdKSZvS+22H/+yqF3L4eo6kfFEI294V+nEcwsMJsSeRxAvPMLd7z3hLMl/O7rPyewNnk45kdVQSO/r1PmgcIUvfpZEbXN+HF3pecjqNc3F3Z2PzZk6B2yaHdB/ZxITQzKq7iBUG0Rr9GXEwLerdFLwnaSeGqtkL46HkK9Dq/pdUlFGiDoJMC4gSqllk7Qn0IIoqEAjbeJAiZsSP7ZxKB9+jEoXGtZhjTbl7bTnKxr2G1gFkBgW/VyzcVjVzwzY07oPiP4MR+q1VRHgREce2IiEcrToob6q9YSuGC45xl0+cobNJR9biVTOG6mZPZfBGCs84JZyQuE5zsHfijc42gh9of9GJdMxyEa1O8dnMxmrFO4pIIDZHGyM4zuVwkch8679WncUI3/FVsMsGBRGPX/p0Enm5RvLqwVmieWqmVL0whLNQiYQco1McGeBU+o5Cf8S22YhHBno6xaVtfgqv1S7Ven2/G2Y/d8eP7uQi9669nw6KhoKPGd0GZB1buB8vjSTRKpFKjX/DRKPY6coAvmeCY+zqnNHWCfd9dhhxE2zCT/Wbp/kkOFqtuLmlzojBh5tLDraIQdOnp3gvbCS6ep58HCzfD4fK86SBtLrpPxqWyKYIjY2eKOxbysMvnjl/MbxrjpUUpauY0hpVt6ZYGghsKq38HnyZIND23+5wCgZ/St5PT+m1Q3HQyKKebA0vxS59KrCkQpC87+J3CoXc7ZpjJNCPb71zcAUcuTmzQnjFnJg2zRf6f6rV5eKB0u2zsD42cyb9R3bk0ntqG1d41oWFstZ/SaSPYe/XR9tUxBCfi+u4RMQJPebmY9sQ2jB2/LcR/gULnljDBqA4KJw5s+LlDuRlh/4Vo3AzjYlGm0sGSlTSmcvf8dD7Jg0n65VXfJBhon97R1lVAI3nPnHzmGw0No+rKexY0jF1llK8K0bC+7Xxziz/BxWPzV1ApBJqibXJXBdrRIXV78ZFpCit2j+R7azdj2cGuj8IfuFD+6x+hsZNGQeIx6mEkD/uH6myVNBnsf/5Q3JpLI9Ijtzs0j8a9gayEOwU0XkjMGb/oQyNbfZ/FpjGCGqGxgVZJGhLJvNurtGhc/RYS+PdSOyqjHD0v7MqDiNvO9a+8anEuQSBVLbkC32Vff45+UwF7szjzxwsb4f7dIvqcXBPC1D/1lpo0gVWwjJIqaoKG1NFA/aIm/M3NiZBwbkJB3eyEgYtNiO82fVns1wQXVmTmo7tNcJ5x6cLT63U4uiTcWuRwLoa3L3rrO68C+tmf8zhLKmBx+bLt7pgGOParpMikNGJm3KLdrZ5NsGHKjIWqm1Civz3yVEETGkPPK/750oSUpM+by4ub4POBtSO1vgkWv3aaX6ebUOj7MqRduxKzXYT+uIZVQPe0V5hZ+heEPH0Z9s6pDcF7+I9V/qOhqDy/t28mA08vA+b5bAaPyKromSsYqKX0Hd81j8EHPxeHxN80pJb9uHNrCYPK7fKc99oM6hhvm+XHGNwIbZ2Vf64S5Z7b3PZ9rwCLxWKxWB24mrpyzdpdtRCyqVMf38hgm/eKZAM1Bs/fOl8UsGBwZI2xu+NPBru0g2erZzC4XebfOvshA7XtrooV+Qy8vh76KNPD4KCxSVj/Qi78eu13h55own/zwwOesRsxS5N1TXQXFy33F+Q/u8XF0mNHeCN7WvBbOLvzOIeCwkKdpvkyBK2WF7asOUWgn+r13ekNQYq2oyJ7gsAx+c3KGSwaeeWUhMQyGlmCUhZvttEIl341UOnXjFDt1tX9003Qmoxec38/F8as1Mb6cC5cXCULehbR8B3dIfJVvBWpYkvJD38KJ/fu2cGpohB79tVDRpzgfuDbbik7AuoeU5BQRLBjrpqgQQmBlv7pHW1dBNzhFFvJr81IsBZOjW1vRuSaW1n9h7nY+bVX8+JbLvx8v03ttKSBa2v2Cb8n6JuWvL7reyuqZ2483HKJQtL5lScrnlJwNt+ddbCRQsbhm9HtegSJl7585L0miJuYp7sgnWCYb61rgVEL3qX8GBU0acGd0vaUDde4sJNtT5HR4OFRyrwXP5NpOD++xIqYRaO69GPZq6cEcjWjAhIv2+B02F4tKJZCxeFX4vN9KSw9xem/20VhVoCU6AMrgtg3VheVcgk+7Xv3sMyoBW/ti5d2aLXArkGmNvs4F/ciFe2mlvHw8i8zEdpPQ7dw/sEKUxo71xVHGzcShD9MWXmplEBQM7A3q6wNrU37Xh1eSqF3bYMt5U+hxlD0Ws8ABYdzJsTrMEHJ4JX5L9jNUFSZc/PCxhYYx21XNzPmwrk/s2jBLB5+f8hWztVl4Hp5w8mD/9Hw6shfoTCfxu/zX27NeEvwh3240VCfQOmeZETXdBum182TKhCksEFEelfleQpfNW3e2HVQiNnQ6HhmsBkLzzo2aei2ICkluUrGnYuRl2sOvlvPg+GRb57LDRiseW6/X1SYwSbRkYXNvjSSk7u9Pq2g8UUsrHjyA8EPlUbZzyoEiySOhafatKPSTjVeIJJCserGuNgpCsl1BcrH+FogLb73kbJFC05/yTprGMuF5qT5/N79PMzsfl7OODL4KPEsUUSMwQZ+txkbhmkkR584OS+axpuZcz5ZrKGRu2Lf/PnJBDV//776lE+wYHVayLnMdmQuUk11lCE4/Ctk9UOxFjQKLok/eaQF865I91fmcnH72tfinzd5WLxGQGBvIIM661kNl1UZLFqltKb2Lw0BHQt1ASEGEq+G/E0f0lhZsr1lzhIaqknUlfQtNPw0qyUnDtCgCov4PyzowJi17TYn+SK47LQ907yLjbdHTq5cacFG+5Ynb7RGCGwtEzdZv6eh6ynD9DE0vO6usfApokHNeb3meRaNaOdYCx0ejcS7liOCNjTilixcWHaeRszDKpW7d2lErry72/oNjfway42aGzmYOFayef+jQhwzu3kjcR0bsq7Xixc/JNA97Ghh/4KGki+H/28rDYWgM8ZG0zQ+yh4tkaFp+C5hBU0N0tivun+9ehWNfZ8kTP/107DYXu/8TZiBef79wBtrGaTP3XtBXoODI+GzpsLFOFj+fOaJLeKFmHu/UqdWieCOX9uqET8a51MPNYZ9pOH2hlnW1kDjzCf34+v/0fi4+yR7apxG+MmcQPUWGmF9Pin/xmgEnUfeNwkGN/9NddxQYzDb3uKhsSaD2oWjI3OaaOS+6D3VdZAGi8VisVgd6G/p8zhj2oWYX227bFq6EPVpW9CIYTcybh92Z9K7UXv86sF62R7k5M0RXTjZDXezEad6mR4o1LOz47b3oNmhTMLBvgcuisVpi1sYHELhKsVqBuOVUtIbnzPI0eDpyjV2I7rQvkh3WxdUtnHe1K7sgv7id+H+67rQbq1K7ml0IY47LnLOpgsjPy2PVH/owpzOgoWcD11YG+xtK9vQBfO1ak8Pj3Xh7zKfa69EuWCre49kCXDhX1zRVdjGwK2hrXvifA9ebjy3t+l8N1rM1l45KNMFhUjOTpn0TuRWH/1v3bdO+Bxf90OwrRMNhn4Sp7W7cD7p3jKV/C74Z8+u3J7fhacO36Su0l34lr6153I/g3vVr53zlbjQOjHDvozFRZQHl2Pc2YNop3XRxle70WV46r7ceBeIhMHMC1OdeCp9dLx1ZycE5t++8OVEJ/LSNRfU53bCXniX5AOjLvw+cVDuSHkXBP1LqlzLu7DssMLnCxSDkX/fnkCYi7PBFT4Ny7l49FuVDNr2YvW+Ql8EdsN/8yzfxbO7cbvPIntbYBeEJswFdUkn3Mfe2Tyc24k7KzwqQ3Z1QtH7ZsOeyk7k1EW2i+/vwgGVZKaprQudpwMXvRbj4kS25tiGpVyMNqw+/nslFx0uqQY5Vb0oyViqM/C0G/L3D6BwVTfk9zy51vu0CwVxLiJuO7vga/RfWAmvE0lecjyPPh4mF/sUbL3QiRi3OyXT451Q+ZlQnX+jC9t9TPxlLzCg5TtsH/xlcEWrvMh+BhdqBzfs/PWyF8b6r8JE5vcgfWV3KBPajehqn2dHBbshK+4cFRrcBSpp1dR6pS6YXL6iMVTQCe3JAtF9v3iQkXmtKL23E28vflvPbujEtnX7Qz9EMeCU9RzfJMmFB6+5/M9yLroztzS9q+jF75SqULv5PdgtmOH9S7oHap+M/zOM7UbumGqAu1Q3wgMG+hZmdqFG/bWzE38XTm056DfZ2YkgjlHlZY9O+NPSbvr/OlEZ9tLE6QOD6yq6K4kmF/N3qdx5qsfF+22FkS+Ge5Gjflr/rmoPwmSXq22W6YGvxSG9nUo9mBepv8HteTd+yM6IWWDcjYUV2QtORHZh50vRBb4fu5BvX6S7bX4XUtYnhL1f2oVt988kb61n0L1lhvfHg1xc3jvIvXycC6tGI7svS/pw8KxbFM+6B8ttFAOCdXtwc77avUqlHiys/u4esbUHTfa+TZsauyEnut0qcEU39vbc3pdk2A1Zb8V5tae78bFGRDVHtQvHPS/sahb/hW06nqZP9jQg6qaDeZ5KA5bUblgXtKoGAvh6+qJ2DdZ0h8xz9mnBsNfBYwHJLRB9sHQq+U8LZjzk9Ty51orBlA2brsm3gk0XVYTJt+J5R0+v0IZWnG4WFQre1oq4Y7s/l/Q2Qmn24i9bjYpx8WH9uYKL9ThpY/rdP7YaucIxY/Jp1XBINTwvJdAC3q2FRgI1LVg/8nbozHQL3KVKL7IutyLhzuvFj9xaEVlu+P6tWyv2/jxdueFaK5YW3Ol7FdKKwNCYdU9/NCJa9hrfmxmN6Biddez4xUIklik8G+erxl8/945oiWoccL0ku+9mMzr26P/W2dOCk6qihcoyrbD3bnht4dmK4KHM3b/PtOKKqmXPjLOtgLKn4PUrrZi5Om7VdFArEviNovqGanDg4UWDzWY16LawvCv4oBpGofoqu8p+gMVisVisDpTGRN+fsYCBw/cbQtLSDI5UQFdzBQPz7ccqoMtANqd/a8AaBgk9+9TVNjLIDNkhe9iSQSuzMzX7AoNdr6NivebW4vh1jQvHjtXgz+ZF37e8q8Z413HpVSUdeBp108E8rwHOZcpeUq4MTkpkNGSdZ8A/Vad/4y4DgcxFSzNmcyH4WGmn0yCDm6rOs76NMWC/nddfLMOFxbPWJeY7uPC4eeL0fzvacGuGu8+6761QO9u1a7taK/xNxyzb9nHx/tOQS8pHLnzfjNuOqLejxo0qOvyeYPXBDPfIPgKV9wFLMrfSWKkwOOv4NRoj33Z5iFE0elpH668yNM5Hi35y4mcw74Je0PCZNkQ9iDx8ILYNmnOEV5+e14YHlzXX+B/lYvPLhYmnc7iItXNWDkqk0XypmOVf2g6pT007JtwIFjyG/fIsgu8K3x+lThIElxvdtrKjoT//wTebAhpDc3tWopjG6KE1E6mFbbBwlVG++qsNph+uVCZRbagVcfMbcuHiZK10+1WKC3YaER5opZG84eh2/sM0aue0P7TU7EDO4etU9EmCbet9z4RHEWBpWldJB8G/AzOWlhvScLz419QsjcZpOZXmi4fa0Tz8bWbOnnZkDcv1LjBqx3Rtn5VjOBf2qy5obDzOQ+jDGtKozMBk2V7x3hc0Ml+zCz5a0ShXeDbO96wDtsuc9s0MJthRwFz6E0owwb/12d/fBM5yKs0XD9HQSvIf7x9tw8aJTTWvd7Zj0UTUV77t7dgUWJ6xwJOLPXXptlamPOQ5PM4Ou8Bg0svtqu4ADff9f34p+9O4dGuEL/AKDeYkWhy/deDvqcj+DHECKcvnH79eJQhwTezcSgg27Vro6/63DRtTcverGLZDOGWF7s3t7Vj05rXNwmtcZE1abROy4uHe4A/+hHYGHrdfpgpbM7BnmX9WqqGhHC5clnaNhmSwxdpnLBr+4gfLh1o7cDYzO+mgL8GQ5aGP7VwCZccALSLQDoWczYseW7eDL+f+xIhZOzhJce4Wj7no6D0lqH2VhxDXHc6eFIPrL6b4X39n4CKTJP5Th4H2K3ulPd00Vj99HGB8hkZUiN2+PjcatdpVP4kgGxcFV9YunkUj8Dzyvkm040p5b5z3iXYcKDf3q7Bth2ph9YqJIi7MxVRoyWQeWrpO+mXM4MKWT8dCvZDByQWZ+6VeM9jyyNMlcT8DhQdfimObaET4Xoze3kYjne9yidEkDbONzibuW9l4nx/A1bf4CXPtW+vuBtZDYrlj2SOXemyYcd/u+r8qPHgWKuA31IBjrHuRO3VqkNwx88v8+834PuOHlTKvGSqr8wZo5xbIqszVyJrXgnW00o37Ji1YoZAn+8SkBWxZ7raVB1qQBeWaNLlGrF0/P+gAKcLI0aiu4No6SGZJX3SwqMYlufNN/QcaIFfuKj/0thrRA1kJdwqawTl0eNefgWbEJz+9DMcWFEvekmIfakFTWarj5/9a8HP+Dv6f/7UgUvCEnFFqC7ZZBT5+NNUAtR3lhf52DZjzOXuKJVeIS++XLbozWIW/86/niGTU4+S3i0ZsqWoYRUWYGuxoRvlFWzlVkRZso63ezzvWglMHNfa9PtACTxGbhMCYFjibD2yLjmnB+l2LDoo/aUF5gvVR+0/VKKm/m9giWIOQWZkfz0dVI7HSr+bR7XycLKuo+tdcDRaLxWKxOhB7KH+33GUa2UapdScDaQQt8TBsDKfhcaZ/wy8vGkfuLHLye0iD38TXd2kKDV2v84FrCmmcTDI3WBjThBJlLynXP41oqfBIPG/YiNc3DpsW/1eDzpWr75NfNSiWWBqwcweDl3NjZwRMUTgc++Y/850EL7WPm2nfIUh4XbaVV0WwOkRpqUg7gajWqZHLHIKY4Ru1jtMEL/4e3mUpUYviX1MK2m416LqWYn8nuxpaL5UyV5V04E1C3d6zQVzkupgLqPyphwxZ8fLcPxqffhos0VjCINP/Zp9zKINs1xhJaU8GZ8LC1uoNMBi992/NwjEGJ0N/7YuR4UItbK6rn00rvr4/qNd5oxWSUxdFPf+0QI8o+DzIYHDmy7S5BpfA4OHkrsRtXDxeZMa/r7QNi3+yOB6mBPuP96yXu08wl3v23JM6Ak2nPQ/+7aTxrc1UaOVLGs7ND9yF3tAg79bt3PK2FZt+yHoKZbSip0tO5lVpKxhvpW0pPxjofRMYjFKl8Tfupvxbey7qb66mH6rR6FzzafaFc+3QOVKnr7CO4KtqbZnMAYKedx/+uhcTnNzaQf+3hkaddZ2E5F0aCSO3/JW3t2HRzyVpFze14elTmViDtW1YHtK6LqqawTMx1XoFHxrNxdb79nVwwU1yedt/g8Zqxw+1neI0jCXWv3dV6IC0Z3Sc/iyCgcN9AXEXCJ4q5szal0sgrv9Bo2o1jfMX21OeNLXCsTLsmqNSG0Zf3z25Wb4NvNCVlT2ZDFqrWe8af9Co8Yq6fKmIi6jJ4CX6hTTa5/9qtjegYZfvuLCxn8DhYP696q0dSFhQdOXgPwpfAmzvSuwkWP3YMj/qFYGIxJyHFbJtYJ37cPHO2TbcM//iYHeiDUNfb2/L4DAoPdHzKLOGBq3QvXOTKQ9r1+/TktrOwBP3ptS+0hgeXq+edoJG6LmQK8cIweJLn0qsAzpgcSlt+b3TBA0LTLZIVhPQrS4mCXJtaNmydKWdaxvOLJabr+zUhiNX9UhOJ4MVTQtm+TfQEI08YhtkxUPC2gDxslQGETltOcnLGQQK3v9s9oHG0sChab7NNBJbZ6gHHqZhI3kz6djzDrg+aLMwqydAr0K1l0obNhnVvNji04Z8hcYoAc82mA2xm8RFuLhgpSmXN0rD/8MqTUdvHryuFx61fsXgesYf/pE7DHymXk3Gr2AgeHWu3t4kGpFVUl71xTSeriAty8poFM+TWj7Q1IGY7fvPqf0qgld2Y/7K6TroJGecUCN1EA64/UxqeTWK17YmLD7VgGh78W5PxUZkzYhxdb1QjS/bt2k/Vm6GwnHNhkNpzRB38V22aW8zHutXiOcFN+PtWL/Dg+JmPNK8NfWiuBmOlSoNBvcbsLGb20COF+GTwrXHG1CHQo/F38Ocq5C2O+bKzJ/1uHKj4odjYAN8TxnFL/1UjTSlCsm1Us1wObd01/nbzbjPnegU92+GfI4pOyWrGesvuKzzmWjGyoxZP29PNIPLBD/dcr4BI62nom0K6hH/8dubvN8/EOMt/sn8QxWmX0ZHnl9Vj4qO8rZaqh5FB8zz58pXI1a/sv/ElmbMTi++qRvajI2rX6ygbjTjG2tcyuNLM8ozpE6Y/mtGzkg6/+F/zWjssbziQVWjISHS9IpENZIerFs4aFuNVBnhdqWO79C58o9XWFiNciPPo9lCtWCxWCwWqwOWM+pGTn0nmPykn/G5hoCP/Wbp/iyCWJVVxSPFBK/YFsui+wjc1+VbLR4hOH9NKOKOcBNkjo63/jjWiMxP6i7LPjTg5h31P2/DajC6aIGQ/q0aGK5Lv3bbjAP5AIOTZ8FgRs7yY558BLuP14k77iLYUavDL3qbYGKm++8CXwKP/vPJ9x8TiHrv9732lGDCQeRWb00TEvSna1eubYJu3NbjV70bkXwqkW8OtxZOy5oWtq+mIGJ+7rsQqYH9c11X9RoaQvMlZ8S+pLD/Xcn7gBEKpis0Hm3UJDhulMH7HEOQMiP9p20KgU74/ScGzwge5Dw0it9ag+4Le/ZFXqvBfKXRMLq0Gni7bZ1SSQc0XHU8TwdxkT1R5HBlEReWXpP2Oe71yF03VbH6BQ3+Zyz9w7IM5kSKu86fy+Djm7nX1W4y8NypXF07wUDsR+EC2dlc6C1pcrfvaQFf10e/u/UtyJyfuWbzQAty/2YEUb9pJNn57NTrIbAKiFuyMJLg28dIz+pOBjITAo+2pLXBAc5Pji0gaBE9qXIYBNY6FcHqrwi2Pdz3YfYyGp6/f696eZNGXuy5Rfu8WvF4XY/spFUrVOYOCpaYteJkiyvr8FwGJy0M26q20JDxOvbmUTuB9GdK9+IpLmQMpG5n/iPQ8V7+MymwHZdjqm4vqKNwX/nN8349gsshm+uy3xM8H2AVHF9M455U0eZS8Vb83XSkv9ukFTfFTjR+1mtF4XqZTtsBGvELF2cG+NKw0FINsVakIXpq+cn/9nJR37f+3oY1NNbX30RDFoErv3mI87t27KBuJbwuohDWVXn0pibBV/OHFqvjCPw1jcKW7WtFy162oXZwK7xUmI3zbrTiYPOa0JopGpbUgkv6HjQ8v+183RxKY9Hvofn6dVzU7zZ96fKQhpJGko24Ko2v7ycfFKcRGPo9rtwu34FVLSt+T9sRpM7YbqP1gmBs1PB1VGwrbMXYJ9+Xt6Knj957s7AVg0mnJp8qMvCpmr3oeCyN8SwdNbs4Gj1EeuHDgzyI9KxwpMUZONVcEr39jMZvi1qrHYo0lMty0z6b03h437Bb170DfhLzJXZXEqikDymW/NeKoJIQTldFK+Seh5dkFrXCk664/nM9gy8yv23b42lsEVT2aUygYe+vt+XhcR5ccpcqGl9ncFz51ueXogwGn9PTDrdppHzuWyqYSKPUW5inUkxjesZGg87YDlwh69KbNxeiROMW99OpOlSaW4le3VIH+8mcMjmnKgSF6Yn+bq/Hvtwrh/bFN2DsGttVwL0BY7WxD3f+rELm6OuGpSNNeDo9sux3dBNUGgwW36xowrc3UcILBZtR7Jixv8ewGUXHr82XHa+HmGPmsdq2H/DqCdryM6MWZkdqwlvDq+Aq/lg0QaceW42bD7Gm6nH447ay1KAGNLiYeun3VuHvkMn6oaomKJxULossbsIbHe+CFTOasU2y9Gv61mbsLOsRCPNqxh6Faunggnoc23tShNasR9L5GD7z8z/QG18TuKmsEiWyFzdGRNXhP8vXV4f21uNrrkj/f631UBxR1NmsWA2XglHza++bELO1sV/2RRNWLFTiplFNeNZ9qQ3SzchMjFGSPNQMKY0LCglS1Ti/qTJv845qaL84I6N2ugqrC8K2nlDJhaa9r3dYZjUCz6btXT9Yg/3jlovWXKkDi8VisVgdsBnQyjrfROFSd3RS1ncKB7Q/bHlZQmGhtOpM1UYKxoePe36ZpBD1WjnK/HojeuTfxm9taEBF+bvHZ5Y3YHGAtdyiwBrwzZrwebyzBgFapYuWcNkooHVfYi8H7lsOPD+wjYHOq5QW0VkEm5aJ/vxiREBsz2xx1yC4FyJ5pVKf4JtljvKykwSiERvDXhk1wW1mRlRMQiP2vPsQ96uzAUeHGK211bWINjoJn2wOJHFY43RSDailBQ7r53GQU3Nj8L9KGhMN271fv6HQb/zs1IFhCkKuim85lgReV/89WmRI8Fg/yerIKQI/NYV6P8kmbCLf4/NUmrDkbv7jP5cbEVz2K7zQsx7+z7yuvt9A4WuIrsEnmoMNaR4pH/7V4O8iahfnJI35tl/snttRyGnXfqSSQKFx5lde5hSF5wKtKtl2BLEJ3ovaThOouBGDgzeqMWg9GNGZVA2Dnqfjhi3VKNgx/W1jSQd+740Y3BTExaIOqjBnERflgcL1lx4zePp6jcDxv3UofW703SadRkTDizyhhzR0/iTWqkowYIXmKVaHM6iMXfi5Zy4XWsRsTdKHFhScj8xeGt6CfNGbIbtcW3A21lMjZZJg4SJp1ZlDBOclm2ftiSVQktjVyadHQCf9F/1qhMEXuT9f5xa0oSNx2fm8rxQm1SbuJK4h2KAn0PMnhqDw2npJvbk0hBwf75KybMHSq3PuzA1owZIlfw9qOrbAfVvIcQuKwLiua94nTRovm5918LMJ6s+d3GMdSNBq0ZhV2crAUrjPVO4xQeeT5deq+tvwurvWzz6Nwo5HyZc+yxA0tf5KmX2H4NN3u9mnI1qQ15i361B+C/IvpC8OftyCyc87E+r7CASL4x1VVtNQnjqUEbSThm2UlEJzD8HhtFUTl49wkTbv2WJbCRp6wbMOi0UR+CpFHKZ92rGg/uF/P5QJvoeffiGZQOA7nREoWNGCiMVLS8ZmtOLOu7kxqzktWDSlZHRgksDSPZh7ToNG0FanuZ2aNKT9tdtmWNKQvvarKY/mwrKxJn9bKI12641zBwVp/J7VUB+zlMZAyHDc1XkdeJiidXpdAcHhd+PneeKtcCp2yGwwaoWD4x5/gdWt+GG09L7eKhr2W/RP77CjEcknf/uTA42Qw5OmakdpaD1O6S06xcOl3eccHWcymFlS9lzyBg3xKxcu5UbS6D7mdrLlK43Tzh+3Sh3pAEkQF33/qADS8hX8C9prcafQOC3gTS2qn2ybln5XibS+vgmDo/XwXa5o9FS2AXIdoYcP/q7Hh6ayo+/G6jFtVnxXYFUVunRPhIrNaYKb1IebSTpNGB+adWWjVxPmpSr8mPupCcrhbxTDT9fj79zbyVeMCnDs2ZDNCblaGO0o0fyvthKFX/K8b2bUIaJ/vIicq8c7W1utsMl6NI3efXyrvh4SOrtTl+6ogqmO7a9D85vQsOTV9+6dTTg+OJF5xacJncWmb4U+NWFiK1ua1qjHnaK0zIz4Olzvcw5FXz5kRmyaNEcqobBWK2+TUB1G7DzmRxXVYXFpja+2ZT02aan/plvr4TO6Vtr2cBW+TedVpPQ1wqRhYZGaYhMq3hzLLrBvwuFb6R+sYprAyTWMvrm/Csb5qiWzvKpwxr97KDKqCgaN0usM+LJx7Kamva93NQbKJN3N2mow5/bFqGDrOljKBvkvNmkAi8VisVgd8Hrw+qPhLQ7CWBsrzeM4iGBbBaxK4cDNvMH13lsORh1Ej0XMaMSvzaXiQ7sa4HjuaH1DVD3+zKw/Gry7BnNH6OEZM2vwfRf3eZIvGzNGDu0o7majvFu/5bkNB7ldLj36mgxWOLWHO/ET1D7ZNi09ROEKEc+5Ok6htf/4zAOCBO56iw71FzfCUKJSeM+CRnTsO1GubtuAkaRZJwMLa1F07s8pWWsOFDfsfPnTrgaya9v/fExho1++KDxWjAPRwPubAspocO5e2jgrjcLYxz+et+cT2Nb+4WZPUsjvMFj3QIigmyuQ8uO/RoiNtolfedyIV5vP1x/pa4CR27vReQfqkVEtkLrvBwd9GeVu+69ysCXBVmrxpxooTGovOFrGRiyr09nwBA3H2W9O7T9IwWft+rfsTAq71puPMZIEZyULeu4JE1wNqd+QmNQIpy+fL0pdaYSOk6DIfZ9GSIhfP3rHvREz9a4nmWpQ0FMwPrWnl4Nbrcf01Z9w8MxHi2ZL1cK1w3mj2WIa+v1q3D2CFOwXvVduOklhg0TthfYiCm9GY7IdlAiS0xLEByurkLhlRDi7swpO07M/fZiugn2vXMTOkg58M9d9MSeIi/aWyOtRi7jY7Bfo/fMxA8k7j46eNmPwNEPuxorQOtwKDzHS2kQjW01G4MRLGj6/cGD2KgbmjXe6ZRMZTG6+7++e1Yw9z1qXmH9vBksv9qz5w2YYZpjt8YqgMBKT7dA5RGD78Npmtf8Iti7sSOHXJUi/sH/lu24KzJzAA8anGCRJXy7qUG5D6fuAC/b/UXA4beX8eQ6BvjV/YagnQdpdC93v483gF4itSWC14G3ivjWJLc3gLlt3pS6FQlrIucwjbAJ2jorg52mCr2p3Lwc9IdCMmTumbkFw8kRN9FAvg14FSeXBawTaCbwnv7PaoPTmquCPRQSKkhW7Em4SbJ5PFBy2tuDmjbWZ2mjBZmnu/O1LWzC6KeuL01sKs2zEa+16CDbLDY3W9BE05++l0+bRsFX8dczuI8FzwT/CWue46IzghFb8JkjMT9qxiY+GtNBHo9k27dB2N3qge4/g9DzOQQXfFjxNL5qtf60FVmZ+h/UPtkB13EKXlUNBs75wu8UEwZnE5COFUwQLG1csz2HR4O9Pn6m+jEaGkMn4+lEu3NRrljs401Bwjmz4fpFGvZ/RNuFbNLLvmK43H2tHSeqgnHR1AeRNz6TFsGsROcQcFE6vxfV9tkWljyqxhup6Zbu3HlFulxoTdzTAaL7f4L5lDTiw7s/jY8saMExbPFth2oDG6CX0OtEqqNifOl71qhFZGp8PHWUaoS8613JoWRPIxQ9230/X4030cyf/2gIorDkiG2BRi1efjzRTU5WYmBWxa3NNHZwjl130zK9HLFuhR86mAZoJIayaNQ2oiS2dvW5jA15Z3f6TZ1uFZcv7fVv0m/Dy3/ZLLt5N2FJ923UiowmZ8lxLiS31SGFVfE+srIPOlQBz4ysFMCsU9l0nW4XDF+aEE6U6FM+4/0VRqh7Te+I3Vr6sh3T21GMvjQboHtg876JYAxYKu5yadakKd9lWAasUmiDzOcX7g20Tku+OnN95vwnvvP8dPrKzCr+1RzZ9eFKF7qPD+kN5VWANtmSnC+Ug10cu+PfzaixRkE90nleLSe2HReb+dRBVqvjqd7kBIoWPwt16m8BisVgsVgc0mpc0pz5jAwbKkrey2HDK2N9jWMiGi6pI763+BiTZMO6PTzdAK+rKpuAP9XgwT2yrs28NzL9bN6bMrMFe6QDWHQs2at9zdJuz2Xj84lfz17UcKPraeErFcFB4rt0z5wGDD7pnboQKENj77NTrESX4/N/3WUVSBB1FlU4FPY0Q7UvtaTvZiPS1218XpDfARThAadG/WqSVOug7ruKgdeyz37HoGtzsWdDNf5sNS2rcjx5jo/ZjoJzeCQ7ERASz+VQZdOxVHJ9cQRBoJyK+bzEBxyukfoMcwbwT99irfjQi+of/ynszmqAycylz2awRd+D12DC0HhqzPzpUx3JwYYJ6EHicgydHk5ZrjtUg8rV+0OvPbAgsOh47T5GDsf/0eZwCGsnvd7ZdqKJw4aPTqiuqBHfezY1ZvZygfLJQfsePRizxKdh6oboR/H/Pf05ra8T6hHvFl1IasabubuqvuRQcS7KJTy0HEuesd/jd5SCmYI7i7MO1mLXwsEjSPzZkJc9fW2FHwzzho+quGxSkHjVcNudQeHjjQX7FDoKrafeVT402Qnb7G68rTY0oqT9dfuVDI56utbFyMWpB/5uvk1ZXKSg+7dSca0ih0dQ3ev5MCptcr2mtreUg82mNTWxRLcx3bup/OZ+GpufzVYuMKMy5/OusZCqFkIN3Pv03h8BmU4L1rMwqPFxxYI/unGqc6jr1b8uyalTyK1W5lXRAID9RqPM3FyoWv9kXfLk4uaO+9qQQF3WFGttk4xgMBxz/YW3AYKxrmbPjUB3Kirp5IhtoLOzOOvghlQb3r3Bv+XIG8ssCBxXsmnF9/PnDcxPNUIk6MXyuoRn7/8ucskvkQNDo3iLzUQJN773Hn4rTeFP89/3rbILER3NX6Z0m6Bwy42iuJziduLrR0JFB1p2GexUv22C4geR5KhLsiNGYZXGfIDVWsi7vQTNmeWrfFNVqQYboWIOoRAvCLlzYxPvAgcNCDRXTGTSU3+9XHRegUbU/U7ZOgcaPjb/CT1USLLkx3+zIPYKXervMwzsZ6C4rOpq+kEb8+BN1yqAde1itNUOPCCIFhwKKm5vBY8Q3L77cghjfyluLrVuQO//TnvDvHER/SNg0JkzDySZXLXUhDeFwnT+J4jRKYywo3S00lnTeS9gyTqApJV1z8ywXCkdtnu+4ReMCn8Ifw2gaXyffcdbw2oGVC/+t2l0AtnnhyLtntbD5o5OkeKUWQVtEnV8dqETe9rQJb9N6iNl8uDw6WQ+Ls+6uoTMbcMHllsKDeQ0QXUWvOby1AR9uFEo/dqnHyaFLrY5/K7HIt+yh36tGJO5fdn8R0whnbeuZSSr1kFtYohO+pwCdyl8cEyVr4f13s6/Lh0rcP2IVu6+sDsTrsESZTz2SdRee2q/dAOv2ZTfmSTZgsNEh6LhsA7gql8SEuPXQfNPXN2FQhRl6ll9u6zchbOnjd8u8m6DLCxgbmaiDUqHrSZ/7dUj783T1vj/5oOz4lOzqK/GnoqRt5so6GHVWH75WW4cZb4PT5kTWQ3M6QuGdQgNsYwvDXk/XY99A22Krwno8dio5ut2hCudnfM5pWNmE6SbBTydtm5Bf99/wudFKlP1rzriwvwoPb8q/tb9YBcd7Vjn6YtnIW2UdGRVfDW123cbR7zUofZh+ZpFLHcz9I28/PdEA3lS8rUNxEyKMy4xfv2wBi8VisVgdiL3/1e54dwciZx/LleZjw+O2oLlyZwNS0juqdzo2QG/wQpTym3r0rfk0+8K5GvTlv6oPnFkDm6uZGwMt2GhetNijOpuNZ0LT8zLWcrC2Izh1QQwH9hT87P5ycPOtVHP2AwZO7V7niShB7r8R9Rwpgpkz54r8TmmEphI7ct7yRjSeuGj126UBf3q+ZB15WQuJFTi3ZxUHl7YQdY0dNbh1+uXvGbvYiNvjrSGYyYasgmD1CQUOLFZ/e/3OnwPlvzYvvCIZfKj5u/3IGAXRgfN82vwELoe/Nj992YjRr6+WiXc2IvLfjo7xTY0YJm4DSzzqEXco6va3WA6aM38MX5HlYDJlOuZSdQ3WbQjx5wazkXjv+X2DUTYKn4YOhFtysNAvsuagIoPHyhZ7gsQJphe3nD44h4Dq+WZiltGIDWe/Hvf93ghZ7mnyubQRj3dr31p3txGRa93rPsyl4HyEu/RVJAdX9R4KKx3l4DGfemn59lq0Xg/+MFXKxoKQ8hnNizjYMiPvVFw2jbDZz3dfL6Igyj0TM0OJ4N7DMJsLTCPUlJ8+DihuBP3j6FhASiO6BUufnl/RApvZcWt1r1JYv03MXWMWBb1lRUfTqznQWCLEzEnloJDimzr6ohbfBDSMf/PY6P2wLGi1Gw2vtgc7TG5TmPjmEFrSR2FtpY9a8dxGVA5H/E0JaYTvbbPdT5waIX1qSYHFmjZQ651WhHdQyD3eejLAmYLnz5c7sjZRaLeZF7RjkAM+//2ag9852C/4z5pXVAvBLVuD1f4QaN/d1ZcoTSHv3Pwty70pzMucMHuXUIV9zz4Y9vVVYZlb0SA9sxobEi7mfi3pwMqRDRvUhrnYGue+sL2Li/OhVgJVF7jgiH04EzSLC75Kzmh2GoOfdXdnnVvDIFe1ZthrbT3sZ+972PKextiJtQ7T6xncGf33NN2hGd01y6xY082IPdv0nNXcjLC+gv1fqtk44XHqiP4MGkL/3r3X7yLIfLjwkewcGjvMTw31fCAITXj1mvIi6O8We5/JInjQ9KWe84dBUdO/e6vF2tE7w+THpgcE7k7V+zMTmlGpPzpr9vYW+LS9OzRbqgW81Vpnr/awkbi4+vigMA2D+KWfBkcIwl+126wYJ7D4MKCfL0MjbvZmvi8dBH/2xsyOcCJQeht2uO4KF+Kj110PhdPobZmt65bejjcJm6s3FudjW0zzllq3WrzcbkWMd9TCed3JW/+JVEIyMA+JC+uxY3TzD9nRepyIdRbu/laPRWIbE5sH6/H9ueqzB4oNcBIQj6MO1EN5huanI071GDVtrH3YUYnRRNVG9VeNeMv0y3gO1yHidq9k3K986F4sT/3eUYPVO18lzzhTif5nzi0Xo+ogLtV89M+lenxwEZrzcbQef2/+mDCZqsdDu9ezU+Y1IDaj4I5JTT0Ovel8WcyuR9Fcc67a+ir4pLoPGek1wT9CbmFJTh1c3ARvWB2sg9y5Yj5793zYzv7PIv52JfZIsuUVu2vhdWtv6pOyOki23DBzMqtH/WPNxMG5DVi4THxef089Zl9YFxX1vh5lrnveLfxeD5Xv6w55GlWh16lh9O6KJsQuUGNdfVoJ7/++vlgnWIVFgUYR+YpVqNg5yFou9A13eJdzDQ9U46/z9FRRRg1Stx3Oq1xeh4bCuNE4swa0b9v3+11GE65fSCnt9WtBeVXADg2lNrBYLBaL1QEL/d86FdwOHOjpkpN51YAwse8p6koNUDS3cZI5Xw9zMyPTktU1GPq0/sF0UTXsW3qO+liwsW9rQOmJRDaOydqInRHhgPub8/LnFQ6kRqODFrdwILbYoPd6Owfw67J7nMrAdvLYar5FBL92TJGwh42Yc7LW+alUI5JSTq297diAqw5P1kc/rIXkzXOPjVZxIK6lpSa5owbhv9pXjhuz8fjNm5N8mWys9N82016Bg/1Bg+3P/Tl4qrDXPZPLQa965HyvSAaL96SqqvETWF7u+1NyvBEdTe2aW5Ma4bXkwrhcTwPSJbtWhyvUY9MLJ84VLw52iKQsd5LlIJqsNjG9VoONr1feZnayweVbZ+z8gQ0x8fmP3y/gwP7d2c155znYcIaTUxXEYF1YQZRTNwWBHx2ZBf81wtKwrerv00bo5oTt3ZTeCGXjS5cVvRqRtzdI8FUvB5UfCoxjIjmgWNcdZWQ4GNO/pyciWQvPGZXpGg/ZeCG0mLWIZmMs9WLEckMOLLrFbBbKM4CtzXeReQRn3K6NeNY0Qng6WvfWx0bkx1hG34psRND8TKG9oi0IUX5np2FP4X5E/8PlsyjsSO+P7Yjg4L/OvW9feXJQLbshed3tWvx30XFgz1c2Ar209I4u40Ag8cz5xEoaZ1aoOkzVUJh85Fb9628Dnv24cT/VqxGHHLb3pFo1wo+bm67L14aF1tesA/IoLP/85b67M4Xz9jVeXYMcGCnts+TmcDBnPU2VRXFwzEBx8u2LWvC1B229lsXGs1db7qfb0Nhc3y607xgF+cR5JwYFGlGUt0ypLKgRF2ybrpUdb8SBDN9o06J2LDe4rbilkMLbQ3dZ1RUUZvG9oEwOUCj9uSbLaDWFyPIvZiqTHIj3v7m4K4qD1ik3i50z67ChJi1xvicNFJxr9/SlsE3Ma9WPgCrc4NskN6OuCsZF+j/HuquQ/9hSfltpByxGVFZ/4eMhQi38qV03F99w7NnZDi4UC/JEFNy4MPMbiD0sxcWi4MSn86MYzOt9tnbJRwYx7r7H5rnW49Wt8BAjLQb7C96veXasGVf9q0x6WS1YLXs7qbelGX8a+CLvVrKhNk+lbaYAjT2Tuzs7RwjO/dZscmklUJnYVSjMoiGxsvqo9g8CdzeNkwvNCFKPls8ZPU2w7+1tq8O3uBD7ejAh41o75Jdav9ymnw8lheeXptVr8eDXipXn59RCWcVRKSq/AvMW3fX52ViHjX78s3dl1UP52iOrpR/rketLbd3xoh63ok9VxAk3QDL0qORKw3pwAgLUH+2rR9L155ZyR+tx86lmWX1RJbLO3Xyh/7EO/HNFfqfszIcq/0Nez5MapD/+afRkeSXa1bdIJx2qw8ynzx7JoR4+Fvp7uP31ePz7UvS7j/WoMxS91jNQj45rpXPv5dTjqdeg4oKyejifFzcIb66HjWNa+9nFVYiUeZ8Ye7MOXhMPRFbI1OFeb1eTc08e3mTVj3XqVwJP0o5YvK2F02J5XsW9OnTkfImKNqiH38Kerfvz67FTwPOmemU9LLdvFm1LrMcizf3Kh1/Xo3nT1V1NX+qRIx1m+UalCps3Fvtcta4ELR0t3vypEqZnnGm5hkpojYXqTs/MgpHquTTfRdXwFpYYfO5eA5mnnvuqxetwxlBD7NbMBsgdMZerf9CEjVG7Fd3cW9A8pOn9Y7IV9p6S1+xV2sBisVgsVgf4lH+cmOnWgM1747tleupRekf11UyNeiw4UmuS0FaN6ZdpDp5h1ZhsO7gvZxcbh/7LnLJLZKNZv/A0a4CNxwJab2/Yc1AzdyC0LJuDX0rxg5p5HOyjMipXdnAwav2ZE/CegcfNxjUXjjQiLfVN2u3WBhgzBu4XVjdgboPXkiVWtci8VC59WJYDyQ1fx8Jn1yB67ULr38ZsSNXJ5d2LZaMj5t4cCUEOFj4wJkedOXDQfH7B/RcH89X/Ne8v5+Cx4LG7hxMYdMS16NgfbsSZidKbJTGNmLX1mEE61YAjHInt/RL10BaKW3jeiwN91wZLe1kO1Lg+xuuv1WDL/P3ZbTvZ6D9if/nIBzYkL+jWvFzAgeOCR45fznNwMizlr0E9B/0vZFZWBTF4+2OeMceiEXxGc4+K2zeiNyeTbX6qEXLVm22K1jbi0KODtqdKOXCaHRNv5cGBqbV95BwZDqJuZo1w8mpw5fqpr+oH2fh9KfqdRjIbeov/6u2czYFexHhdxUkOnGsc9EJuMKi+0G7E+tKI35/TV4zFNeLxPlevMc9GGNhpJF0cbcaXfcURyjsoFH2aXbWEx8GrOWWFvyI4mPlUTfCRKgfKx8KkXE7WQmX0Re+tUDbk/13XietjY/kF/2oFCw7kbn11WLKJQfv5I+5lTANMr5xJfXSiEX8XKU092tGIvbqDX4z6WmHmHXXr6hMK+9lVxU6mFNrfv3taO8iB4bcrwYt8OHCZXpfm4cjBhev7Hl+5XYtWs4/s5nA29M5dm3f6Nxt59wNvrM2kMe0+yT880gDnS4JaRRcasWRBQXiRRSNaKm2Xr4xrh41LysH1zyl03ni2qTidgpP1LTGNAxRq7PcOX2A4mEzkl6wt4UAmRlt+9UkOerc+HBXh1kKdc3oiUJQDMdGAr3caaIyEumWfmNWIY5FX/2z1b4SY8hZTrSONaElf9pFX2Q6v3tQ7W4opRLUV/ifziULc8bNiWrkU+M5qDHvtprAujbekcTMFsyfL5ddVcOAV8zlXpJ8D9j+zuJfGdRD9MNYX8JdG6MzU48fPVqFx+IhdYlYVEr94zImprsLAiOB7VlkHFukJ7QsQ4WHfzVPVSmNcPFY85LaJzcWcH8/J7GYuVvjXn93uz0VPcODVgWkGVG846588F9H3z359pM9FxRaTb8ey6jFszOy7UP0ew6pPlB8uqcCHMzNv52tUwEUodP3flxUIehQr9capDd9kjwx5faSRnzDDnyTQyFQIuKsSSuPbxLIh7SAaK5Pq1sYr0pgSu2kQvZ5GBevLo9OWNLgXh5ffuETjbLFITXBZBXTCHI8cvlOFwPtPZc2EOVh9OU0zcC4Haw4M5eifZZC1PHc0352CxmaqcvZHCt/nLZ2IDKLAKTo8/OgchRWhl1Tb71KQsbtyXfg+BeW04Bjx9xSa5sePJFVRCHX6fW2KV4EO9d1qhxUpTBsv3RPuWAWT1ptsm89sCOQu/HdzmAbfs9DnrQsoXPn82k92PwXXR/E38JCCxoeW9j1nKHh6/ZUJC6Nw0WTc5Us4Bb8Hc/3yXlNYOyHNNi+l0LysuYPrUInCUt4OmxUUNAUrGjJLOFj99kxilWEVOlarbcq7TMN0w37bP+kc/NC5rLFljIOPq53V9oPCzXLP3R+vUSg9cUlQK4hC4ZYr+51DKFTdCnZ1f0bhaueDkslCCiwWi8VideClVum1A1+5CFE7PydkCxeeG5be/fqewRWVoLdGudWQaG869vYzjYgth20UU2icjzS5ESHIYN7uo8YJpxjQyhdDb/YykPm6cIHFKAO1hztDTWW54N6+alpiyIXj+BKj6HlceG2clVb3lKBrWKXrjy6B9afDChItFJx4T2qaRbkQ0PBsZC604Gye3pwVfBR6X0nI9llRMLARsvj7mEJVdsC02CKC0XozJsmbYK/ZAyXD6wQWgVVHOxIILjw/OaIxxCC88ZfU5UEC/vhRq/9CCC47rIj4rEAQa9DHb/yXQbGV+NIFygRWEWfvvwhswYrj0ftlBziQPtvboLSdwrO6k4F6rylU9m04Wy1B8HHuydstNwje386UmB1I0L+gWWFeK4OWn9b937IJUraLCinXESydsW2nmRuB/8IXm+Yd58LDtXMNN4Dg1kbPe8KSBBLeGase/mvB8QgFq2nCQcXSoj5JKwpj1gauxz9TYM553zmjQHAm/tPIv3CCRP5FSksaGfydRxt8TCVY//m8oPAjgpblF2dUphMENWuX6JdwsXlOu+6rRgKLny4vqk8T6EsfGD4/j2De2/IgBbQiylBqhuUYBxHzd1XzDCncWHPj9+A7CgJWWQd8lhEYmSve+OTGQJGzfeDMLwL7O2XpefkEj7fZeUp+I/jt222ksJ+HgmUB51fvodHSsbBtN4egXpWlOHCDQCJ0tlmqPIGiQOPpW0WtCJPafiNykoNPE2bvEk5S0FW8tUS5hoLH/pXvuj0ZNF7nqX8vI5i34dI/iSIC3RaBb2dyCaK+7/6meJQH8ydDAau+03A+6Ttguo/G7rT3i/UHCPwE7382u0iQmHnu7zJFgtGa2UGTEm2I5dN46uFC4XatWsqsBgr1otEDWQkMAoRqbwf0E3z7dHxvC49g2HFYXJVD8K40R1ksjIe6hdvKFX7QOHFovC2xkMa2RxtmxRyj4TMh+iypk+DBy17m3H8EXQf/8zB5SOCZpP7Q5WYbAj6qv6v8Q0HQw3zhYCGDZ7s2/hgUpVE9mnPLcC6N+Sl7zOL5aXQIXk6O/8TD6N6Y2RFcGtbX3s82LKGRskuq+m49jebk8YsPbGmM7Gt4f2Y+jW38/ro7F9C4E3e+/fYmGnvvp/Wf/teG2eevvzWOywW/032VRlYF5jzRixmsrIOCzImchfF1qNE+o5b/rBy3TKtueF9vRApvRo5mXSMkDc8JxhY14u1fvccvZzTBKvf4yRGqEcZ8U7NmkUYsWay/sG2wEdw1wXIfpxvRJRo73zC8Aoq9Ymdn+37Ctr1i/g+2VEA6dK/W8lcVEFJYse+tUxvc+QT6Qr7RmL/EP87uB41jsqsthNJo5PpwCjY9pPF4Kqb1ZAoNT1eD0aMvaRjZee/TqKRxsuZDjdkfGiFfl7xdM16PDenNm8+wKyAnJXvU1bMKn+Kv1d/+x8aF+h3zRIwYVHNGs9OOUXhWpN7G/4pCAe/QVPEQBTPTawFyxRSamdBDvuUUGj/def66igJPMCH3wwCF285vl+mJEERtlva4kF2PpLSLzU/3V+Lt34/b1opQML/u/u7tgSrswnGD3WU0JJ3zzhXNpDC8e0aE9G4KAq6KbzmRFGIdJUcCmihsqJlnuP4nhQ1PRO4fLqWgObrs1fFuClzrjXMHBQneBznOMAusAIvFYrFYHejJ1q/+Hs5FU2b34eF5XGwduWXofK0Gi/O+5g9F0Fhk//XsCiEGO6Jk0n+M0Ggq6Vlvd5BBfulfuQNZDBas8Xhb/oOBQdMN9fN9DMRio/jiF3PxRHDWgti2JpTpOktNinIRcrL+clQUwcRa/g8ZGwi+fBKJy+IwePprKO1naAtWvAwurLWi8PrlEhj4UShI6Y+710jhMW3LrtxAUD65suhILIGyh4eQQDzBqlfP9BO/EPi8zH0/saMZx4vyG7uWcnFYWvGJIkVQ0r6XbehNcPLckcnNJ7mYxkmlE34EM76uPwOnVuzf6TD+YyGFhYmxTxY7UxhuF+ixzKaQc4X7+eFKgufRss1P7xA8Xup+vDKS4G39fv2Xm5px8lft0Qo+Lqxji5z2KtPIPzz86Fwhga9ZQWmlORcORTpHT2UQ7F1dmyQGAtEbIoqzbrQi7GcEt4yfgtk6xSXCByl4OCXH2GRTOHKz5ZPDGgLuKwnZviiCFZ9Mdt8534yXZukpxf0MAiPkFpawaGj+k58XvoDG1Qh2duEXLqpalVb9FKGRo/3N0T2W4EPNKgXZdQTG88rLrSZb8cU34N7yJRQKDD84fjpB4cWxPv/cSgpbbiv17NYmuGWdfsfRqhk2KwxKukcZ/Hdy1hHLOTRKxaNWPuOj0TDnScCcVTxs/HNoQvw0DQXrAa2sYQLp3v3jpwIJiKbPZQNdgsOOJ/fOvdOGCPNq5SPCFPI2UrZnLlEwPbws/x9FwZth1zRZNSNw3Y6JZdMMDOekPX0qRMM7Y/vpyZk0FvYefZi+gYdf3kXzF7XSSK5KXit2lEa0s3D3t2ECy4/zeV1RBF9zNrsbqxNofPPefripDcnqJXt1r1KInPtrTzWXgl9Y2YMKx2b0kfdbB1dx8Tcibvr0OhrrsDYvV4HGl//WvzE4yMPebjGbhWwa4QWyUo61NO4eDmpccJKG5fNbddsFaeSkp9nHPyPgO3q458IrgomXWoV/ddoR1vPLNmE2gdGNe9FCF5uxxPCM7UpzLpZUjqrXm9HQvxb4b50hDT8v3eLL/jycXHP29rUZDPx4DbpCTTSGZ3o8FW6h8X7v+WRNbxrSh/9TjpGhcXiu4Wc3eRpPHqnvz9xBQ+tQp/KFd+3Y3X51RkVjHoRqXAUV0mpxMn/0/cLaCoTtN5/77Ug9ehb/6Un+WQG1smecj6ubYLVDVHPhvibcke917StuQsFFlUVLfjbBERaNDi+aYOPTddHtRRPW3lPxM85swljqxYjlhU2wnOMYavOlDh1HnrKdu3NxQ/70y4ztFdjcPq+yI68OZz7M+NOvWgHF8aCBo68a8euJS+xy3yZ07oiva6tpgm3SPLnthU0wnDvLFVNN2LLr4TzzqSYI2hPJ1fOa0eKxRpFPqhkLKjdcuOZRiYmNq61d8itQu/1aUE3FV6g+17n45kcFQp6+DHvn1IaWWfdDnT7R2H6sXz4pm8ZCS0WpjjwamexfQ2UvacQ53Jg78z0Nt8r1K6c+09DJOXG9qp3GcfVEvs6ZDJJf5U9sDmmAvdDJ7hucevwNm+ZvP12JeYd/Fsa/qEIgPfKQWsXgjsSPNIPDFFIek+KBZAriMavWF/dR+PVj972FagSOajuYzysJHAQM1DoVCVz37zMdNCCQ/HTimfdxguZ7Ev2dtyuh6HvxwOW2CrBYLBaL1YH8Yttp11Nc2BY8sH1ytRYaL5UyV5XQeNnhGsLj0jh6wDxg5xEGhi7hSyVMGehpeS358prBK8eK9KaPDIapr3qr2xnUub2U/Debi8BKd+PqkGYEDSVdH5jdjBzj8WxBAS6C3G9I7AsmUEs8vGqbLBeJITsDuwVbUeM7P3JRFAXjX1++P26ncJG7RDZ1OcEexcDj9CWCS9htcoFDUP/+wW0phqCyr6owfSYN7rNTH6TymvFIqIoVxzQjrEip0VSMC8/UdZLr6gnUDj8KaAIXbEdtm7Z8AtF7MmNeH1rhg+/k91kKu9IyBrjxFOT7V6grDFMYCuaf5XaAoOWJloJXPkHjmrDXj4oIjKIfbc0xasHA1u15M01aUG4xXOqwhAv5jTb/GSjQKI4UDb5ewEWWzrLTXxfSWOmy5eOaeAKrwE4rxLah6r9e2//0KdyZuHG74T8KSw4GOXp1Uph3Mcf/xj6C2MTxbpEcgktqnLdpRi3w1Rlbn6vVgs3amzbenmLQ/UxYdNyVRj+plrZ4xcXe4HBe30Ea+wYUkjPLCFav/PzlvjuBwM3On+++tCHq8ppViuoU4nUPjn71o3DT9q7Ej34KokGl3paHCCqrjX/7K7XAcEGd0x6vFlQtKLB5M5uL6wW+qxfZ0+BMDfpvleAh7dH3b1s/0PgwZ//lWWtohG3rieCUEOiXO89a6kAwUZ53ecS4HaeNPbMd11J4sDaw3/0+BQXr71bTfARfj13aMq7UArVHM18VeLVAUPp7ICXMhZLjGteTR2m89Z+jc1uOB6zZULllNYM1/vNOHYimMZl5ZTpQnkam6di5nPcEK6xlJ5ZZEfSl56grRLTDW8WNGPxHwWWmK6d+FkHrXEfWoGYL9I0Hz3+51YLTNZt/ZKhx0dK3uHDdTRrTtcrDRbt4KIy+JLllHQOFS4H7X81lMPbyT2/zPRofcf7IotU0Fhn/13WuiODg6E5PkxKCxvx5qv1D7XBiXPsfryS4Nr1mfO2uFoxrf3T9E9uCWPVeHs+Wiwllj6noZBqHbP5Le3KBh6a6mj2PzRhIxun4WoowMLVif6mbw0CIZ7dB5AENr0G/T2e20ShO2GiwU4fG3C3y1O0jNHbcH7U+v6EDD3ZdsrRe8gOGa69uzvWtQ/u6+O9eR+rwU/6A5u5nlei6rZbtlFOJK/e08K+sCccPLKmnfjehIfs9Xb6zGYIG/WrcimYIzfi7s8a9GdO6EYRyb0aNdf3gV59mJJ+WmY4Ka8ZQx9mKVf/VY33pI9UBpXz4PnKZf/9RLbqulagMLKjEufU7it6trsT5XCmzFMsmzDszNXJ8VTPWsY+b5lg0I9H1l65wWTMGS160vMtvRsc8g8vf85uRIR8SGFfVDD/1ynvnO5pxfbzBT8W1Hpu3HjbfklyHzXX113nq37G/UjU0zL0CHb6pK/YEVuBeyo2/4SWN2GxxImlPQhMqJ4x3iZ5rhqLRzJ3bS5txIYV0UHnNOE17X/ud1wytf3khJZXNEBOZG5vS3gzNiB/NGdZVkBz69vXx00rsqmryfNlTgTny9n+vxeVAaI16YbpTG15+qzwQ947Gtk9kpmImjZGvNf0XvtK44X2v7HMDjfdeo0d/ZdMI7Hp8teAHDZvG5sbYbhqXzcUPvhFlsCipuDngfhXsQlfvlGAqccNFn09ubSVYLBaLxeoAN+Clsv3lOrB53mGj8gz2LU6b8d8KBtcVaxVugQG5es99USaDbYr9gYNJDL7Krlj/+TkDCZeeojeVDBYXfnq2dozBMe34iFlbWuBmV1q8PL0Z1T8iz6jINyOq6cPas5MMHEbvnInx4qJe5tmOO2GtqGx3rnnyh0LS7965tRsJxnasSjvvQ1DD1fy9MJ9gbJrV9FiZhs0FcxnjjTT2pflbc8xpLPtj5L7zZAtUPmx5WXK3BS+XTZs3sVog7CEuP0OAC9+vDWVsby50tms8LFaiEdiySPmEahsO7Sm5F/qKwkGWU+C6cQrDLtXxd7cRFMxJd2lNIkhf2R3KzKPxMnXbYlExGqc9Dd7UFrZg5VL2FqmfLdid39YX3NGC1dHzFiwS5uL0n60VaQ+52LTyo/KLkzRU1xf+G24hkPBZzr7HtMHlh+3BV0kUaEOFLP5yCp4pa+4lyxA45h59/yqIoKs7XkprmEBD/fW83GOt0NEtuFVl3Yrd60a9BUxbcXBSe6P+ei7mJc+5f2slD37kIzI/0/DpK5zHv4FGaS9b5WklQUr8tlDb+naMpnbPNMilILh7215OPoVh5/PnujcQeN9La7gUR1C1qeln/EQLjM+dwEnTVjjYxmyK123F102jt88v4ELpV2mC6Vwe/C6+9M7gZ2BmcWnv1fM0rEOEmat8NJ7FPGhQ+0Pg+HOrbcCMDpzySVLLN6KQeOStY0U6BaXQnl+2sgTFeXNEF062QGNGlF+raSv29v64tGBHK/q3DX97KslF1LD2hNFCHuSmloSnX2BQU7puRwWbxtc58ZbLrWnYbNkpGzxGkBaY92CnBwGbT3mF8MoOXD/x38HkjxTObI3dr76CoODUSP0c4VbseHbdtPpwKy7cS9eZs7cVNiMnJr10uTjJ/bp3nTYPSaePNrzzYlCZ81RlpRWD1HGJHReLaexbmyvc5kLj/eXtIf8xBBOruyU8ugiuLZVqCtrbASfp/xxn7CAIt/9SpSfTCqGHhobzz7RiwS0vLb2jrbhqIyv3ypmLE0I337xz4iHj+TeRZXEMsiiH/T8PMJiTPEPUXZvB0+oXzQ0dNPhWKB2IOkjDsrKWPmNHI8G54OYHHxozX6Ysz/fvgEN1kKVCchF21xmm15uyETG495ScJRv18+zknQ+w4WOTVbqklWCfRW/Bwz4aIS7/VDZU0Ig/cX9oUS6NAX0njZFqGhPmW/h2nqHxqmzeDKszNJ7uK5GVDKGR1BSi3ZpKw1S5anz/Jg4cOkZNFisUQbf1TNGD9WwE/b3kQ29mY7aD9PbYAAJ7vfN3/tE0DphM1+wSYBB+Yp5OM4/GZMsdydltNOobpuh8Ho0gm2qmmkfjeuvdmTGzGfge27v88EoGOscqoKvJwW/ezaI3CzkIWqXi/Se6EIqn/s7Z/acDgwEuGTYLCMr3pYlS2TSKDm+13sSmkXu8IO7mDAaWE5nLDvBoqPxd3S3xmwa5ONm96jeN9omvgt2iDBpvXFVMW89gfWzZTSVw0L7sxrxYSQ4u7C4X8q9n4wnbt+3v/EKsV/tmvv8hhbDe7vfC/9EIHLn4z/YLDd9JPp3XLTS8ZoX5T03SaHi4b+GrSRq3ZJQWfZ2k4feYt9F3KQM/pVRzfU0G37laTw/JMjg6S+vwrxwau+M3Vr7UpRH6Vcm54jsBi8VisVgdsJd9+LDSugtiWy/5/cztgphiXe5J5W5snBx5fiC2G+U+z7cp8/egPV66X4u/BxGKgoaslT3QfTvyMF+/Bwr3AxX8JbnwPar6q1eUi8Bnqv3mPAYcz83qw9kMZo19H20m3XhIfv4M0u6Cz3Hx6ucruxC+nX53cF0XlHwcZ5/S6MIXC60nK626YHWxRKq+vgtO97aX0vVdCFH7s3jFWBe61uhF7v3LYOP0MQyrcqGz8/gx67lcxC068fwfm8GhteciLu3vwdCr4Lai8914/JpYPl7WhWbjLfY/3nRiYbcUX92XTjzOGkgMb+wEONfF9Q53oV7rrvQcqgvdB2aWL6a6MDX7bdIjLgOdg6Nf88W42PbsDNd+HRdhnq7rZ87kYjRhbmRhSQ/knktv0rraDdEDb80//u2C1qzXE3nTnWBpKtfe0u+Em/IaB4OjnXBZJCL3rqMTs87KKno6dSGxcMP6bX1dQKBqdPc4A8U7Ec8LZnEhZ6uszxbhwnR179lFq7iYV33hmYduL3ascNqmEdgN5arx/en83XDfK5fa4teF0zOW6D6gO+F/s/6i8uxOZIv+nXJx7oTNqTmzVQc6MZC3RHj0Yhc46zhx98MYDN9c8fi0GBfDFtnbApdysfnp4TGiwMWtAel/f9J7oZNRlRSf0o1Vx52+SS7vxkkSIqz3uAuRX/m+/dLtwpqMnuU8biemG6zchLt4aP2rHVjr1onze3ff/m+0Ezpetvd+hTHYV/EwLlGMi50Pt6p9XcpF6O/6QclVXFxVjlKRfd+L9sPeV+ao9qBUaEGM3+tuTPhey+df2w0+c4mKTS+78CAipy1nZRcSNnH++9rZidq4Ee4s907UPiwOKB/tRFvTW8urrxn0b/x90EKVC9707WGXbVyoWq17+3MnFwZvVnRJNPRCyqNL1V21B7NTVuje3N6DzVLhZ2Z86YYub7WX6rZuVKypCsx+3wUtTzPf4fddmDi4Z/+4cBdGuSbJkOyCDnXupXk5g8Nb36evtORi75w9Zjq2XNyz7RV86MQF1zTlveJEL1Kf998stO7BnKOTEfPRA9OD89+sMOnB3tVPv1iWdIOc2vOzQ78bph9/fRc36MZco+OXjZ27wZRHG/modiF8q4YbdboEP+yOib3d3YAQPaTnbmoACVj9oW1hAxxqNmm4Z1XDfnDt5VbZWqRNSSUlqbRgp/483sWrLVik59M7s6UF/ML//Mpmt2LYNHJ52+xW9ISuy+md3YpXgXtaTy9qRf7Z280n+xvBl/xN9O/UTzj7uZY/e1CPwsL9VT+u1sPszufdexbVQPvN0aArd2vweH6N/3KHFjjGP2qBfwuuZx/ztKtvQfqfSyeHrFqRrL5g9gzrVkRdfPFYzLoVdu7lOXeOtcLMj/f8UGkjdNxVyrcrN6Jjg1tgwtZi3Dnd1JjztQ6vAr72XHtfjZsJzmmnxqthkf5UtaGlGQ33PGYXPGuBImvB27W1Ldijofgo27IVOzxzUG7Zis0fD7S2Wbbi71vvf4ePtELtlaqM+pNGKP13WVdydiMkyPV16loNgIjntmcuhVA+liz8S74arhXFv89dqYb709gZ1PFm/AlyrCvf2IKrpzY+/zrVgsOFv6fYh1thIRD2YPBwK/QMV23ls23F7B27bHxOtaJCd9eW+OAaVHgFXJRqqcHtpy/D3jnVYPv6mbTo12qE3wj0z0z+ARaLxWKxOhBe1xFk4U6j0zl53qA3DfaKLY1XAmg4nI6TvvKIRuaztWM1qTQOOEYvcEmjERsZInmlksbVPuGcvDl1qG7LUPxwtBZTEYePzHlXg/NSJ9ntkjUY7zouvaqkA/K2Sa32LQ2wnytZ8249g5xTiqus1RkEPTixB/sYKPb+u/z0B4NM8zWfnpUxcFgTqKZQz6B32LDVapzBmVArgaqRVvxNT2lWl2/Docnc1Px7rRAtG3lrvqgVarWvj9SmMvg27Phjw3kuYlx1MjYYtWPD0Ijc0wQCxV1TOgebCMr/k142sZKGY5jR3lV+NHpmGuSad9KI4YtS1OilIUIvHv1h1IbjfwVX/2fahnVdpzP+nW4D+3PCN//uVswT9XmqmslgV97tycU+XIRImnUHBNMwWaS33JBux3zr5cFz7QhCDowXvkolaLfF3vSZNA7QJ9HiSKOIz979YCkNzzdulRJv29DjN/3P4UkbLh2dSW942IZb82UKy7634eW3/55vKWCQ+UggxuYRF2ZxQxMeRTR2bl412mxI4+DrK0s2GHWACn8p/XQfQdN9xd1y8QShR8ZKGngEE979U9Z7aHTc/ltjwm5De+eTV3NV2nG68EV0o2I7Dq8rykpZ2w7fG8JvrzMMVg5+ipOT52G6Se5F4WIGkx77Ujbcp8HdPD/QR4dGoprT8g9fO3BrGf8nZ08CLuPxvvEygRX/KcaGQ9CqEXCglN2G+rubVUNV2nHMTVP60Op2WNZa7VBc146J8b3R63oZKDrnfvNV4mHpbs38P1cYhPl+3TU9SsPnjssvtzAa2rUBHyqcaLie/jPfsqkD28xuPfS8RvCdu/L9BE3wdqpr9gNOG14diG0/uLEd0HhYrKTUjrUPPvePrGvHyS8C2DLMoHvVQ0lfVR4iTU4/fM4weMEnIHzWjsHC5GpzkVYasUavljw4TWOO8klGx5XG55ceG2UmOnDI/VOpSheB8Pzk2xeH2iDgvNdJTbcdkTttLGZqtuPmCw/Pim3t2L3xiVqYJBfD/xVrChzkwTnpZbdqN4MHf5bt+faLwZzY7rv2uxiEaxcc6mmgMS7vqxTRQkPEwzisoYOGhM245aI1bHi8nzNeTf9Eb0f6M70f9XhfcMdk34t6iAl67XYPqYfO/nU35tLVOHZ3+u/LtkZ8/L3BerZVDbb73ijvyW3GPJ+RmsvyLbjokrhk3KAFLi81P8zd2YKrRw7wEb8WnEkaevjbrwUerLvtU8cbIXQk5shNn59YZ7g/aPfsegyt3bzGvrsOfw3e/+e9rhov3VgNd9GIS4c6br6LrsHm04tfnn/djD1h9TsjWS2Y4EqT1kst6FH8cL33UgvGWmpLfnxsQZ+066m6jy0YFu95PbijEXzCJQ9F7zXAQ9lV8+j8YpjtuxpiYlyHUvP0cHhVY2b5dP+MBw3I2cV9nsSqwZOj3NqxqmYMlvr7Okw1I3r1L5kXF1sQdPL4l88XWxAn4zf7XkYLQm1lkh9ntIC9XV2+ZGkj/JevOst/rgGZjT4mnIJ6jDzRyOo0K4RKZdRuv8XVeN5V+u/3jAZk/T6/YcX1alzYfPWp76FmTIgbXTmzugXW97deNfZtwa66askDvi04EPL7uGp2C4x/hc7Ykd2ChtuGFm8zalCvG7g8s7MaydvlOter1MCsOunfr0/VeCMh21f7Nx8de7f3HZapBYvFYrFYHXDaw3llmEkgt2yNyexfBHvIzM7haoK8ET+9f40E9U/exMRNEcQPLq+QmkWjV9JF6EV4MyqkjpIubhPOuMef27G1CUFee9Vzoxsx/Xe1U+JQLVxO9flxlWsRlDCsZcTHwPnOwrCOAQpl46WrVmkSfLlfJhLuS2B165/e53aCSL2/CrY9BBsqm/kMBgg8Pph/E15Xi9zTfD/53WpBlqXyW2fXYOiJt9tzxRpM93veWV3SgYU2CYEx+lywh/I2sKwb4CNX8eR0No2ghTVnrFpprDh+MibLnEHmj+jabBsGkRuepwhVMjC+sfkYq4mB56785X03W6Em/UhN3rUVY8Iz867fbsXxr02lQfNb0fh1fWchQyM/8oxKUgNBdcp17fIpBmoLRU44yrfDPKx+Z6QOwWIdjYyvQQSn9K/gOyEwezR96q4VjYDRh80aX2gk7DvgsL2nFVe4Vae5Ra0QKKi3+pDTil+7IzUr6VYYDXaufzlBY2Vhwgz/lTRyDni6iiznory4IDRrNQ3ZRk2tKyXtePY5PM5AluBBjlDpNyeC68vC/95uIHh38zB301YaVm+35MeKt+H9z8prn0zaYJBadzJQrw3dXVHh5YZtWGHvskRKkEHE6Tk511xpSPg/NitM5ILaYqRy6TyNl+u++o8K0EjbH37FxKYDEQ++S38Zo9D4eG7HnVMEBqY+DvkfCMwP/jBaurgNbz3s+beatkHP4njvHP02sCNXiFsYtWGz0hI9VxEGaW1yja9qaCydfOGm+IyLSwZvag3raOgGSv3k7aNxxaLiw0mK4Mpt2ZO88x3YMFfup/JZgncqW9c7fyYoWfLD+e6hNqxVtV/5NqIN2SLH5/veasORvSvUi8LaUNFv837HFgZqbVIOKhSNEGzLXKvMQxon8HaOGQO/lUM3jpTQ+NT31JV9kIZC9VHtADsa7ToXy1O/d+C2wdy+Kx0Ek9VuVNHhNtj2Cj7k3m1D/8/5QR+C2xAuXJY2dbsN1pu+bXEEAw8lgecvuDSiVYqX16nzIPjJZ8/KTAY+3jOUfTcxUGiuad7wgkZk9p1nNa9p5N94fWGinobS8T2/PvM6YHWwVeuyeTH0Dhwrqd9YDwP3DSP/TddhnrVQ9AtSh4hyu6eia6qxfNjR+da+RuS9/eW9bXUjRn9s9G99Vo2EBadrtzo1Q4i1WPJLSDMmkPbhZ0gz/JdMvzqY34x7pcvGNvG3oD6yXbyhuAHdTc49HmNF6BU+JtN5tQ4fh7Znjx2pg++mc86lltU4pyF0RvlDA1Z3rWppRSMqbIl9c0k1WoXnrBUzaIbfSbOiQ1nNuJLKOJ7OaobAdXXb8ZFmiKlbp9ZsboH9vs0r9e414Mz14sUP5zTgSpdRcO/tIqQektX9+7YWcm7nHFZEVKFMty1MVqgB/sNjE+X3GiDHZK/6NbsGFh3ppvGrmjGxdayT+7AZvZfvBo4+bMY5S861wPpm+M+Z8dNhcQs6nG//VjvXgMH7K5hwph7b9ovfebG2HqHJ6q4v5Qvxl3/vxfGmKhiUPvzp4FGPSZ/PmmKT9ZC5Y/bCNLAax1ZfNHy+pxlfUk8aBaQ24xWPj4lKbYZWrkWrArsZxhfdV0/LtkC68eR4S081LtXmx55bVAN9syO/z2lXY/qN1fte32p8Ovb07+FdeVBe95GRmVmLTfZayW5hdWCxWCwWqwO7T+8fTamkUJrXf9KFR+FAqr2uTB8FicDmeY//UdhVKOy7TpZgzq7bIqdnNiO+SSLZ7nATbgZJPo940ojN+iIXj85qxCGjiQuyHbXwONgwtrO7BrPeKgt2ggO2sOwytyka55br9JsNU3DI0mn4qEUwluYyj/MfgVMWphbeJrjrz7xxeEtwpe/6gR21TdgYLyt3vacJoibyq96rNyFztsSQX1QjvhS8shT1q4e9md9hfSkKnyyPH3m0oxZv1lU9fBdJYwbfvYGeBAoDcfcarbkUgm7nCF/fSWATwbYKeEJgrHRDMyWdQPBE1JefHjXIN30kcDCyBhKc/e6fSmugcGp92zz1Gvhf37p8Y0kHRNI//YrT5+LO1eBwgUoG4VubivMa6mGfbtTgdpZGtuHaxqYOGhbPDy5u5dHYsPAMs/ooA8nhl396mxmIJmy+GKLUirgNl4Vm87fiwS/n+s2cFthbKXwxF2hF7+I3mmSA4KqTxH7VNoKf4Qp/9/sTKK/xUdzxnMGe57fqtgu2Q2jP8U1fZxJMWLUfVzMjWHesKyfkC8GPh2J99etohGw112b2tiLW4v31Ov9WxPU+Npvl2ApNlWMXzJxbkfJxwe3t82iYvZApXKtEI7pmrdPeCoI5a1bHj63m4tblCuHf3QSCLO7+A93tWHNh+87cYgrZa4W3Sm8hOOS02enkM4LrVEuuk2UrIv7pzt4f0Ip79zdWBTi2QqNxYKzMuRV7q1ZOai+g4bJMvePgbRoZhvq+19VozH6kknNgIxdytev3ki001lY9fBf5hqAyY0as8cIOLLi7fOVnLYICk/7Nwq8I9sht1pt9txXHNWID/+S34kh1kKVCcivGxY2unElthbv4nCfZcjQKDuqqfblOw1PDSfNZHI0juVqUaQYXOUkHWfmvaJQ//OngoUBD+6b/+iVKNETMjtSE23bgkUP2sGw1gUK1lwpd3wq1rrbztXPboBFQpjOzqxXNRWEnTftascJh7PczYxol8uOfglNp8OYrpjY/pyGrcXXrhCYPLYv/JvYoMOhdeJLvQTyNw3+Xl+sk0djIlsk+9JOGO3uReGxaB76I/4jZm1WIiB3PeSpP6jCjc8W9FM86CPINfD1pUAcBr6b6eReqYGQlZt1S1wDeAs9Td543YFylU/rh8wb83X7E6+GsahjtCAhoHG+C1g3ZlZ3jTSg46LrUa3Mz6kXunNjr0Ywt2WIbjLc2oNx3u7LUsUI0/CzZ5s+tRSsnxtEooxbei04tCI6pQmHr2iaxtQ3wCTc0/v6+AbK7X9leCGqA6Ebm9jv5arzR8S5YMaMZj30XH9s0oxnKB04czNvajPcbP1hYejRjzqkdKx/ObkDMqGjuWZd6HDouJd42pxB8TqTqP8Va7Ckceaf0qQqrzB7LfvetR/v0t9vn5jaA/6wc39KoBlzi5c0Y1qrGMhb5eYbThMU63ie9OU14sKTUXnhpM1YPStvEWzYjfE20qmBlPWQ+OZY3qtfDPW3eFZWEOoQfEc3RePQDv8PqiqOGKvHMT8PRn12HoxqWNWqn63Ez5f3OtpF6eK9ZpXgxrBpvp/eI/SpvwlN0vW4qb8LGJVufnJrTjK8Dt+L/6TSj85jWmdw11TA8seqRnkk1Lii2F1U6VWP97Hrp+rAqqAit3hf7Xy60P90xm/O7Bjl5L5x/O9fBSclc7P3hBrBYLBaL1QER1WOhOkUcZHZ+5zwo5SD7Jzfao56D+C2nrrMpDs5MuLW+9G/Cpd5N0ovLGyG+trh1QrIRQtv95Z+5NWBT2fOvh+pq4bvr+9knqTXQzTFMONfIhoaR/ZbFBhxszFF1ODROY89s0YRjfyh0/tWqDrMiMFu8TS9bn+C9fEhg3F4CKvG4op91E2a90YrZYt+EhN6KA7LPGxHOCov/xWoE3djaFXiiHuGeC4LIGw5y9XbX8bFq0en9svH6NBsWSxqyJCJo3P0++9G1RxTWZajWpfIRjH+0XPb6CIHM42Kxw1YEhz2rfz60boLx0w9ikipNUJM7EWGp1YR1m5bXTkc0wu/rSYHN3o2Isg+2+0+ewmk9/ZHheg6WNkvrbXGoRVJTiHbrahq5pZ3S43soLK5Sfsp6RYHU7O6fJUzglLFAM/AMwdWUPSFH8qrhuf7JzQ3N1dAa+aisOFiNq/bxPwP1ayC77vCwQUkHOtJO+rvpc/H70kyNA5UMjKoljO46MKif+7xR+EQ9Kpf55XScp9E5Z89z5iqNQvejl1X/0Hi791HwqCuDm3N3XNLOakHjtVtCS7+34NYSgyNLH7YgQrL/VN6tFnROz/XuqaUweWZIypZDMHz+8dfpWwQ5Y5c0VTcTtK/Zrqr9noHf488m31e3I8o7eO25NxTWbD4gW7qMYJ6sgHdCGMGV9PKJb1ktqFaUCH/wvQU3c6voBw9bEPL9ar9+cAs2nr6pcr+ZgjR77uZQQxrzeV9q1/cTWM1mt/HdJ2h7tNMq/isD6zmCOc1RBLIijSsnjrXD+7+zgqeXE1zk/75LP4JAaMNR9uGJFux//Oz8Vr5WCGg7/tza2gKR7SI1nKIWGPouclfkUjinU7L8qTqNLZ5XdaytaFyKSjJWmiD4fL9MJFyHi73nq2S/zKWRuz+s134+DdEv847e+tuOsENbHzBJBNrKDouX72jFlZqd33V0WqF0dsYSXelWbHF9Kd8p0Iqrhs/+ZI9QeJpzLTR2J40zIWbeJ4xprLAN7mIdo3Gk85bu+gIuGpbkZ+zypWE0ee1m/00ao0s2czXv08iryy2o3tWBmypKNuyqAsxfPz5xi12LGzXnU6fe1WLwcp+lh18tzOaGj0v7VeLRpx9bN8g1QO/iOnPO73rsu/eQb/B3PfrN/s1+K98Auc23MqvWVcH0p8uL6heNqHv09dEx0ohjV+b991u6CQ379V+mn67HSVlO/K7aAojP63fxtKhFwLuTS3qW18LD7d78TR2VaL3/rmnyUT3uG5cZv17XAHWBzXZHWA34xXfxeObsBgwMTv10vlwFhA0Wtu5oQqGjbvaZK02w2B7xYTK9Can8F5LmbKlHFMdW9G5lHUZmfPOff6UAz9Tf+2+PqsFCb530K6wqaM9eI/f6bx2GPhncsbtRDwnFp3wCwg3YntvizM+tx4FnLdtf3KvCS9P9pYormqCukJr/8VAT8idHvxjea8Kl1yn3bWfWwyns9ixufB2++Km91Z5dh5U1by6/W1mA3syXHhtlqpD/6GjwhoN1uGJ1baydqsN/ee2ng87WI3OjbpvKWD08pXRFfX5UQVfTd3SHSBNKxCr6qgybsL9Lljnq24StyQu5f1dW4eLx+d/M/Ktw/pZw+a1HVQh9Kz8g/bUKcXwvpe4z2dBRKv4h+KUGDhp/lBfvr8PJS3LrFTc0gB2nyb3m2gQWi8VisTogqf7u/J7/2JBP/PFO5TUbxqJD/v0f2Sg4dOcct7ARWx663BQ0aERtM5/BwJ0GeF+z0N/DrUdV7bD7tke1cNo897vxqRoE/SDXxt3YuLZ7QiKhhQ3WiqD0CSMO3o5/jrP+Q6PqmbXF0wUEaoXWuQ18BHc5VTffCxFosm5+/RbTiHyH89Nraxth+1Awd4FcI/h1D+Sc82yAsanYjOlD9bBczgs+YsBBsvJ9pZTcGqj4GWfOjWYjpfPkz5P8HFRaOowtCKWx6ITZvu/fKHBOnn1QuIxg/JjY/AvzCT7VDdo3xjRi5v7+S/OeNGKw4uHfnW8bEe/RN/pkTiM2n0yLKNnXCMu5DkckPnCwtk/yr7sLB6yC4/eb5WuxcM2yf29y2PhTeeeO5Uoabx26V90/ReH6sXmucT8p8OtXfJJeSxC44P1ibnEjNiSVHv37shEtW299+hvUiEKTu7d7Yxvx+NPIv/CcZqx7zh96T5EC/+2s5f2tHBwo3X7fKpqDeYl+TtPBtVCc3s1qrSFYeDmuZqEUBc7Vr9KSlykcsfU98V8ThWczq7+qN1RhaDj0wznFanz88tDhlHY1Ttm0ax93qUbEjuNqbiUduKI9+kxzBxdBsyMPLS9ncNHB7UCDHQP+yntWU3MY5FYWGKjMrMdfyaL6VX8IDogEF15ypWE4UFyv3UnjQcEcxdmHmzHyaF3N3n/NSNrGU7Ksa8YxLbuskm/NSFD+kff8DgdiI4HnwU9j7sSWxZznBLP/suQ37iWYGfK44vgkhZkxNo/OrWHAfqH+61J8G076jautFSU43rvbc6YPgbRunFDyf83wXZb9aWBLCzZ8ujx/cFELuB8Gkk5NNqNmzo2/m55w4LzP+NjBIYLNIWO+gmI0FAJzQ/Z/IlCUOq4VeoyAE9J+2PE1g4ayegXpcYJmm1NzZqu2w9VqeOeTEIKZbz2+PmtsxvHb0c5/PFuwaLVl9h/LFhSvKt7rtq0Fsx7li7e85eDR6NsTAlMEZ7772tSwaKj0GbZtkKWx/npX2LGfBLPTJVbxb+Li0wKnMy/caCx8tznzzTUaJYU2f5eXt0Pnk0PYk6oCBL9g1F8012LcwoEj+6oWrn8bw+5dq8VYa5rbefdK3J4WvcwWaEDJ4pKzF+c34PcisjJgcQMEhlzrJrQbYLucqZN8Xo/EDwt+V6+rQufxo42hrY04r/2uQnpxEw6EvHrr7lgP+aeSNSK1BQi71fQkxqQWfCr79grL1KI3viZwU1klilonJN8E12N51xnnHYYNqA70uv5neQPolO5Ns1Y0ILTi/q/rKxvgcT5o5unLVWhR5I7cP9+EIyyN3lWvmrBOve3Jj431WCJeKL7nVx3eBI98qb5cgJXvE7Lt79TAZmaSikJ/JdaZnbd36KjD9QP066EH9fjwtGflgw0NsHHcq2nO34CpO5MVv2Y2oHXtiUsv71XBmGs23rGvCTXZ8UNuYU1gsec/WDpVB1726NyU6Dpof3z4YXy6Fh2HeG88Vxbg73/KIzfnVeFN58titm4d5vyRkTw/VQd1fYU7MhH1sHkXwPdjaQPuXlUYZwbq8ffBT2PfH1Vor76gMKHTBKeXBdKhXk34GdjQbCtWhZoXkvEO56rwcLN9zK7wKtzIyXwc8rwKomJLA+WYbNx5mSps/boGlxb9uSRkWgezNB3vghUNuLPu8tYfx5tQHhm32P5XC1gsFovF6kDs/a92x7s7EDn7WK40HxvcvknZgNeNsN/YrJK6thFjZ92Gbl9sQKJYzmBfaT1+fa6cV32vFmMaNwa119Yg493T2o3abPSsfrrk3ws2XCdV39ss4SBTo3NpfgAHKA16m+LE4OGZnZ8XjlP4G/T9av9MAnPDNz1bMxpR/zRpQ+pgI9xp9cHb2xqxJChgoC+yARJRmwP1zOthwJdkT4tykLZ+gdmdzzVQdUh6nXCZjccFOdmTDBt90xcVT1lxYK+wXuToJA1jEbuoGUsIUv7y3tTMJQhZ3JfdntWI0ujuhgW/GvFVNG6/WV0jjqd32bxSbkT+Ikm+bKNGMDm24jV+HHz5dFW23ZyD7G9pneYraiHzx8h9ZyobmlSm5vvFHLRn7Iz5HkFD6S8lNv6DwsuGp4euKhLc3Llo3QZeIxRrlS/p/GpE7dnOUp2njag2tQwZ/taI2XIvFVQzmnEo99htiz8crM+8EXM4l4NJ2ucO+xoHhtIHhs+H1oLYy/uWdLEhXzDjddAaGhYLfaL3eVKo/r2DXVtPYaVfhNxC00b8evqY6i9phJeuveZAaiM26dSWOEc0oueSMGvKpA3TPraRg84U6jyvxk9sphCd7HXv+hAHF0aTnFfkc3BMvbd49lQtIk/2nRkuI3jCNyApokBhNSf9WLg/hY6s0Zryhioovn5bJrS6Gr/P513i316Nx6IzrSddqrEh4WLu15IO3C0LHTqWxcWj6NXJpru4uKj1JzanloHfKgeJCUcGdZzNz3/NYIB3wc5We+uhJCm+8qsrDbZpy7HgThpO1lN+ByKbUbBpueqTjS1wL20NfCLcgk0llkvl/jTj0i+dTc+r2eDv+S/UvJvg+3KT51OCNGz+2NwLeE+QPx2pnuhAMFBYcOGnIIEPb5D39jWDAs2/VqMb23Fi55fehyEExj/q1OyrmvH08T/OC7cW2Gh/2PLStAXi29aVKKi1oHrWB6PTPWxo3OEPn/hD8N9GCYcZkwQ2tm2M7VIa1SekbnkWEkyvNy1KciNIFlnzznwTFzMfj9s/u0bjyzPaYFF5O95uunHirVkB2L//eZU+qoXFf+cVdpyvxQ+dvooPBrVorRSIOKJYieyEOwWCfA2gdPPn1RbVIzwzJKFgvB5fLmbt017bALGnm6wORtdjBrdkyDm6HhvEPLrniVeh3P/HQrQ2Immz4S9DpXpELNd6JbWnAJ2VfZ2fF9TiwKWTURtIDWK7TV8W+1Xin/cVx4ab9dgsfkIgia8B7uvkJ9TmNCBmjeY7A9EGwIoYfxdrgEqUN7dSrAGsct7E46NVsAqbpWzh0QS3ds+cByN1OPLNYcOqO3WQmy0R1TaSj2sT6TNuWNeg/IXmgeNPKnG4d+h5UGMdov1aTS1s6rHX9F5C86IGzOlK+HhnoB5vFpmYzxuqx+N9pGfpUD1OBvxwo69WIdfURTXOsgn6wakZg7l1WOccsfLKoTr4OWypqMyrhUW9YPCNhHxoPmDkXfIrwb61VnyuVh2k5Bd/rPpUh2ZeiaDtxXoIatT4zBZsgC6fh5diWz02686T0Girx83RNTsyX1dhv+a9wx/QhIS8c9wTuZW4bPLvrsSKKizaEnApTbcKZYqKZx8ersKVvA+Fk+3fYKxW2aSYVAPLdw/LimbUoXrQiMmc24Drp1Qqllg0ITv73H8PH7egvCpgh4ZSG1gsFovF6oCF/m+dCm4HMpSHRuSeNkLerXy23spGZL06XqTk2oDje94X3vpRD9P1X73nBNYiemtjv+zaGmQtaJ69RpuNoaAm46EXbFy0PUGbL+EgN1ra7GsAB3e+JfSc7uRgWih/UYoTgyneyCvuTAItR46Aw5lG5BVU7yp91ojD/M7z3g83YOaTT0KLTBtQV/wq+zNfPV5b+4RViHJgfEZr+qhjDTTat358qM5Gr+qOc/JP2NA487cxbBEHM+9d8dL25ODSfO/xRjsGNWermti9FJ72i6zf/7gRv92EDUPeNILq/Pqj4EsjAkPn/VBY2AhrkX1DmRsaYb54m162HwcrL9p7Vs/lYPGM+Q53Jmvw8eKdsz6X2Kg6xtLoZdi4/9+bfQ8MOBh7GOW39TeNxt0R/rfmEtgvmfVzY10j+J8uW6yX2Yi3Ok2n9aIa8XSZvtLoy0bErYy5JfKoGbmLd3zd9ocDtd3Py2/5cODwsfBgwG4OLPcH/LfdtRZZZeaKa76x4fBaJua0AAfary3+Y4XS0N+/KEPgG4VJqxvfxLc24rHXvetDnxtxYOGe+qH7jRBZ8OOUi08jTP586Otd3oYTtVq5Lc4Uqucl1yj95mDWxVz7Vd85uJi4fhFfNAfu56TcKjpqcWL3+hM9BWyot/V4nVOh8d9Of43vpymMT4noRW9tRPzy5bPPf2mE2dfWQ+ejGyH8xbK/16cRmq4XTdcUteOB2eWG1koKIv6c7jWHKLDdl9hzFCj83PCotofiQOHX8S2HHnLQv6Z8rHptHVbsHlK/u5lG5hxqNuVKYf7i86+o7CpYz17/VFakGjKlsJBQqEbVSd/1Yvuqkf/YUn5baQe6T74IoLK46NmlvrPzPRe5ffzbbHW4KGo8kxlTwWDF/R2mJ88wWHXxlWSUOwOu3RLprPB6RLrq3qkeoaGy8pOA+f1mWJDa1ZGbWjDtHXEtUqQFX68KLBD+2wx2xtUz1yvZ2Navnjv8h+CmpzvHpJ3A4PEW56eTBCZ+mV1jLwmum3YrrbQmeDjfeEz/IEGlyNoXtTpccIM0kw/81w75tMf4WpSPGt/izNGTtdBX1HN02VqLjNKvomyhWiTUztx4uKUCr9bZzdpcWI/nqyb3zcqtxzal7VvXZdTj2rGRK9vEGpC/9nr1f371+Hhayv25Xz0undh+e/XdemSdrqYMJiuRmtO0X7ynDvwPDwco/MpHg12WEt1QA5NZGrUHntdghfGpPWe2VoI3d1fKjF31mBh7aF45Vo9lD6rvP8itR+nCOKWw4XoELOvJnztVj2t2/kelpuqhfeCZT4xQA8KVjEdadlXhymmh3ac+1cF51/DX3xZ1uHd9y9lOt3ycuXVjx0vpGqw45sn39nAlTC/ndr1LqMNh58VR183rMfVl59YdZfVANd+G+pp6/Gnd+3NfWz26ZHqcjrfV480SUX9Odz3+dqxyXuZYBYvF2/Sy/eqgObLyndGyOtzp+WWbEFCLvL1rj4VJ5ePAK/2yI1cqUbH4377NfHWgZplPz3hbB//9Psl5G+qhL6c+atZbj7Cfl4ddf9bj2txFd31+1kN/1pa0yfp6LNwbsnXofhXUObOdnNwqwZTWTBRUVWLvsqclQkOVqHO//TJVuAodF/bOaW7MQmkDr0TQtgZF0p/tf47UwtmdTD/JrUfR7bWT27Y0wbV96bBceAuahzS9f0y2wt5T8pq9ShtYLBaLxerA4bOlWnNONaL4zXPTNdwGHOvDfDHVBghF3BF2v1WPlpQ7Tre0a5G4/uD76P5q5J2bv2W5NhunXu9K9g9lY6P7t06BCTauvDhvcf4UB4ZVowMrSjn4U2ZoI1TJwce2yJggDwb5ARUWQ8cbYcrNvWef1Aiy0sZ6Z08DwgqfWD3Ra4D/3eYayeE6fPjw7luRKAdc17uT+o410MZZ3n11NkbuXngs+YSN7aVrhQIWcTBv+PVzNU8O5ru+085p5GCL2Se7RjsGBwa0ss4fbESiu2bdU6dG3OniWXHONUItbIvVjp8NMI0VLT/IaQDXcX701eMcBP7STvk2l4M3OhoLjqXWIGtktee1zWwsqbzeKfqEjVYBVYGcmRywb6j9t8uJA7Eg4vZuH4NimRMyn782oufo1r/v4hsRNzFilu7ViHtN9kJOEY04en6x7OZbzRjRPtCv2shB/OOgyfM+HBSef/7usiAHr+KI6DXjWlib31957DYb/9Yfj0quZ2P8mdPqDVs5sIlODLjUS6NG0ePwktWN0F3vE9nztBEDhVs7e2424tWPN1dPuDRCfA/zZ1ikDdETe4erTClsPXpjhvhvDviFOu9b+nLQ7H6xPeUEB76vDRf/l1cL3azDwrti2Uhlr9jSOM6GeaHWia4IGpUVLHbs6kbo1s9bcja1EYNuRWfO+jXipet2Pp5LI96HWNbzxbWj7nyEUM17Cl5NOZKLD1Hgzda5L0I42MjXlRiRzoHs+UHOFncOJk9MJMTPr0OaQsuK39NszAku2rvwPo3Sbo8H87Y0QmPIp7rjYyPoEC0ldmQjkoLffj14tRHvzixy+VHZDt+KtHOrMym84KVISuVRWCiucH+hKYUjIuWJUUsp7L5Rx9nWyIG7/uZ3tq0c5N8LCxRxqYOAyjb/uR9oHBF83H39aRVejh2ms/5U4VzO2TsZ86qx5nCaS5pWNQZGBN+zyjrw5IP/u9e/uKhXXS/8OoOLuP4uUbzg4nnTvtuXtbiYuK8kv6uBwb+L9/outjB4J3vuuew0g2e5Osf0G+ux/lzEpf11aXjZ+vOl7/Vy3D7FOykqXYHZdGfXy5sVWJtXvEhwRSX45UTZr53aEO+6mreOTfC3RDmxu5xgYtnZHwLFBF4d+SsUdhO8H3h2YOo8wciHzUnUFYI147vWPU4ksLBPMTe8WoF3S92ClVdVgX631uXeTTaURqNqynzZONqZuDnsGhv2KSGPLJ0YWG9uu1O9l4Lhy/TTDispCPQIm66ZSyGt8m3H14UUlrwXF16lQeHjvaWPGrZScBxWnj5+gILqvcLKv/UVKN5eIK4Zw0HXwbR3RycqYXbw1NUEFTZCTC+02K5nY4vwq+a7vTRiPz/b0fObg6hHMTrztlKY0hzwb5hJIV72ntTp+RSEZvzdWbORwjPOZHOAGoV9O0Q1F+6jsPnYi+WrdSvxstZ+xeZ7HGS4PxOI1uZg6Xc+/pS2Shh+M/35rq0Dz29WHrQ+T8PH/JLWrSgOLmk4aT5r5sCw7cLeOUsphC+VMI0XpDA9efnSbGUKD9p8hTNVKBhujzxlYE5ho2CZayVTiY1XJi+mh3OgJWF4aPFWDvYe2agxSbGRVj3wfqSkEgVzxmQfjhMI/ja+/FyDg8mmLZd+enNQeN0wQimLg6V7P+2cJ00hZA7fwNfVFFZ2zvZ3XUeh0kiSM76LAovFYrFYHRjT50YYZHJx+JSYn6caF33nvlgqv2MwM/iks9MGBvc8uBzjzioYj66Yf3kDDZW9CzVJJI3CeWtYQ8M04nu09eo8Gdz7VNR3cZyBs5Xz56JZXCzbmXDZaD0Xzj/mXbBXZLDBqydoSzDB9vjLCw6sJDB1fRY5+Y3Cb/Pmg6sOUoh7895/42UG/5291uZ3oRmvu0rk9tRy8Hp4edLBFRQ6TyyW54VTiFBy4GnNJLDZs0Z8z1mCfTVXHnqdIzhzbuu6mkUMLpzytPXeT9C6PbKw+yxBn+h3o3BBgvjB3yKZyRT8rrlnDNMMNm/fY+A2RcH2YMFZT14zRraKfewq4GBB+drlXpsoZEVc6Cx5QsHvExazRQmEqctXz14l+G8eZflCmEGNTveOX9sI3u1u3SevTiBeGfthoRlB3ttf3tt4FGYtvU6v283F/ouUsLoFwbYsic3KvRQahKyjJ9e1oHcHJ208h4P9xpfNLDZTkIn8t1DuPwrPstQuv5pFcFYla/R7EI1v2yZu/9hJcG9XX6K0DsHp2def/9Ek2BcatCHPlGB//LNtE41c8IoOSU3nE5SJhOv8cSBwFBS5P81QMPjPUHJ1Wgt2Wp2dGO/k4NSrNWYSmhR+1zumJydTOGy6tjc4ncbi1vPfJM4SdHedqDjjRFDwZKzR8AiBqvsdpSeHCOTjY95UO/Fw/7l81SIdGt6DVn+FWgikumvMxI8SNOSEpTUuIBg+kKX77lArBDInzN7ZU5gMHTfR/0XhHr9advZnGn/hzD/3HEGJ2SFxh9MEqYJ9y7Y6EsjV3DwbY0/g7/7GyP0cD0dGGjhv3tEw2iYsmLqbRsmaGi2BCoIIvpNLZU4SbPivryn6LoFTYOKiyA+tUFtZ/u9VOQWbOYZ+cRwaS3wKtl6IJOgP/Wj6K5Tgm9Eu65QAAu2FHSn81wlSXzlnT8bzYLbk7lybjzRekdG6R99pbKj+JjhDn0bns72dEi0E93Y0pt6dTUPufcUmQSEaO65Knrir3AbnotVRTmU5eHHl14k1TqVwf25V82xmHZo522JLymvx94B9bt9/tejEwJyLSeXov2OwpSazAcf73l/f9LIBwwkBSw2ZBpzOlFm+LLcBm6S8Lj/ma8TagwuWpPM14u/Z/QqhIo3w2FD+eIdzGR4YjKnVxaRj57xVWl+XVUAq/9Whab8KzKgUbfNeXomgR7FSb5zawPpvUfq5JTQG9cSEilg09GU9dk73EGh+Kbo+1Ejg8evVx18sGu8jtky/EaDRWbs72G0djc2v+mSyFOuxhc/WwaWqAvPWpufrzq9ClpTjuTo3NsbmuVXdO8tG8oqWXhldBr+KNj6oMKJA86y/3r9NYUFFxLpUBwoCYav32+2hcNE11K/Qg4LYnruSlz0pZKYlX5x9n8JChaF2gZ46xBx8unX71kpoHIk4PceXg613Z4n7DVTi6zXDnM5lbEj+xzrDLqTx0pYVdaaLgyJvhYxNKhTszkuM1V+kMBz04la4CYUjp0U+jJ2lMG4ibvLyHIVnLxRTt0RQWBA/krT3TR32XVX+NautEp9nb8gOvMrBzz2ScWdXcTC1Nc55ZVsl7osq9CTvphE3VBY2N5SD9AXDryoqOdATZAbPL6FAvzHefsWWgpXTXe7aUxQGDWOutp2hEP/0eeOpUApiFze2nA+rAIvFYrFYHVj+pDEnPoyLd69vhlvO5WLTR2UHwUAGkLNOt0iqxgOnnmU77tHQMjeorb5GoypgkcVpisaXQDm9EzsZ/JQPGksrZvAhdFbF82oG102WxFWPM9j+fe31aqkmmPz9rPv7KIN70s+PMlcIkvsaqODFBF9zBBw3vKWw96XbmxIeg6sqtM3JfS1wcuqZth/mYEWuwos0Ywof58777hBPwa7U6tZ7UQLZYdkPRZcJJC+5Y+gagUPvd9GbQk2g4xXOXDdiEBlrslLsB0Hy6cspGQ4Ez7WfrTo4TsEpsMbXuYLB9QvGfOdkCXz/FIitd23BZPCXeCfCgeufogTJLRQkMhc4nXlBobW9JfK6OMGJuUv+zb9O8HjeoLejaRPeFZ+rMdjEYOaqW3Yd9whcUw1yql4QXLx8XtdzJwFJODPXfhsXpmsqLlZ6EejmblH3mUewY2rc17urBaN3Q+9faufgUOzKzWV7Kayjkv89+EThvXCHw5qVBP3RQYulVzXhuucvg/ZlDEpWKOx+eZMgsv2jTYA3wZD11/tldwiWD798cfsJF9+uVa86XUWQIb6NWeJIUDOj+Z7+bIJ3d7wf/T3fisqVB8nGEQ5Gry5WM9On4PvUIq4pjYKWkpVyyNkmnIvU1HpqzEA6P9578jlBj49KYH0yQalWXvjbhwQ37o/kzt/Mg6tDbJuvGQ2HUb6H6zsIrpFE04PXCXzmdNfxyRCcbWr6z0GmDZFBPDXeKQq3DnM3Xa6jcHj5QmqTaxOe7NITbtnDQFjx7vf+1wTNnJUlxakEaQ8/1j5+ROAp7DSxbzsPm17s6ZfOobFuR+brn3tpbF2ZuNW0n2CdccEOg3MEjWyTGTkPCOavMe0469GGj78/c6gmCnMlGlvP+jTB0dyq6uMlBrIn+sYOVhBMyl/nV/tJUNK6UFgkn2Bbj5d4sDMP8mWCEnn5NMK7Hl8t+EHjfknwHMNjNMIH00R1GILNweoVn0VpZI8rH9ooTsOqYWxnN2nDp00rmd55eXjWsbFwdXMN8t3SntIi5dBatoq6eK8OR6KMhGLt6zAc/bHO+0cFHnTufftKoxH1RRt4Qa8aIbTMI/Tdq0Z4acyNvBXUCMMYx+75xY3QapgKWlbciDm9+vdH6xrBl/P7kTi7FmOS/w2evJmLHXxqGU6V5fiyQkRtcLQWzf9ttF2YV4srGvntResroObvy99wrBFCDj2PIz81wvfbod6h543QvPTT+0ZzI+Y0KtQJSDdhfEm2h4R0E0qVZ10fUGpC22u/W40SFdg0P31C/HwF7g1Nmrn0fER+wgx/klCBNiNZmYxtlRBSWLHvrVMbdv5IdEhcQCNJ61GzogwNh5gV2dmzaNhs9vr3bojgtr6dSqgkjZq5nZ7ucjQEzKdnbDSgofHwP+fCj/Xo+GBmFCxaj+BZFc+rd1di0sVujduOKtBFwU0OjmyE6ijv2SLHwPYdp1RXl0LDk5WqUiEU7tSaxhyvpKDU1bFa5gmF6BJ9q4BiCutf7eraUkqhaZ6tYUE3hVWLH65dcLUerest5tbW1OGyi6Bvz2Al7kurFzu5ctDz8j95hw1VmPnRonz8CQ0Tw7c/2jgcfNX6vebjOgrup5ZG8HlQkFjg0yrwiUJAUWHAp3wKK5+VLj1dRKF6TselUYaC1trWUdGoSjxPN72WTyrAYrFYLFYHioTcn4Y6cWG5aKnnDsJAPOHP218PaiB/KLGUcaVRWu1TpN9JQ+2L9siiGhqawauGPqkzODp0Kv1FJoPjawvumecx2Pnp/Sn5bgZx2TU3Bv9rwoZoo+Wh35ogcGIwcOcBBpYf3y11dSNwvMV+sFCIYHzQ3HF/NoMXCZcDhh61YOzUc84icwo/muL75lyjYLPSb9bnSgosbmmJsAHBN+GYMfk0gi8RnTdM0gmmXs4TiJZrRuSP92RkeTMk/YqfSToxYOm0Pgj6RjB/wbxlygcIgpS+nZzW5yL9ffkHIW+C4bs7qY/+rVgYNH5MbD4F/99zTuQdoaD8Ya+yRC2FaAe1HcxWgpUhbqkeTwiabwWy/eWaUTL3Rfrbhc04uCvDud+Ugdz+jfnagjRU1jgYcB4TKJtP3S1bx8XOhoNW/70mWCT8s6toC4HivKxla1JbsS06VH7WJAep8SuzD7pRcOUm+yvVURgX6+j+oEuwTVz28rZ/Tbhf/FFPflcztv4Vbg7awmDfnceHBpsI/pud+p9mL8HCndF1T+5z8Xyd3azNAjSuHSn972gEQVwkL/u/lQRXInzObNrQBlmlg67fFlDQub44p/EIhZIXFqucSin4P+kOf7GjGS0H/Q6G323GqkVXK/YcY5C4QTpkxwwal7pf35OeIJgRonmnfAUPh+evzDK+QWOTXuTeS/No7Lzw2vNNAoFF7F++/VoEWk75al7VbWgP5eWcD6Lwy4H7bfYwhScJz3bu02sGa98OUc3IZhz65f/I/xSD23dFKzbPpuG0+3HjfBaNu8kzXb4p87D6QHMpPUyDfeOuzqnLNMbev3J8JExjZlPVmbhwgoRzB5QXfyFY25jX+35lO2b//XzG7S+Fvfdcde9YNiPJaI7Q+cfNkKuIdjpyl0FypYtAoiKNm2HTopflaXAV3BUf7OdB5uq9kZAxGvprvec79tE4ZBuzKf46DbsbWVr3Z9IYltIfF91Awy9UKyBwE41anSfOr8PbMTNBVuO8SQF+a2wfyn9Zi7G4K6pv7tYizwVK6w9XoLBio5XeynoUix4r3/mlEmmsQ+bX6hsh7TRqe2pNEyS1U/tFXjdB5t8b1uTuJgwcnHfgxp0msP9L+RNxpwlpad38p+ObEBYzyZDsOuj4R+3oz81DsXrMnpejNZipNkOl/2sFdOqknmYF1sHsjWtDxIpKeJgscJCa2wTLqXTJC2pNiGgN37PnRRPYW927R5Ka8DauRO8KpwlJzY7sYE4Tzg+86Ds20ISvhjtKNP+rQ8HMoxX+w7VYtKePHmnJRSrfOyvz/RXIXH7cIqWtFofEGr3Sb1Rg23Zvi+CbjWjudPhnbN2EUf8IjdkvmmBIuVe9SGqCuH7pmo2cJszzO1Gkw2lCQ8zLWsmBJsxyXTogOl4BuzLjY2Y2lTg3nWa6p60CR7cJJi3Z/xW/Twjv17OuRMjTl2HvnNrw5ueG6SvzaFQEzDt6ajEN/g2TJtukaZiObesemUEjQHC5fLkSjfzylyffq9CYXJj328OKhumdnRmVhg0YezoWeS+xHj3S4xU23XUo2XU3dcWsKmwXjY6U8K3CzyETEdHfNLTz6o7LbqbAtf2bv9ubwqSDtuuLQgq5OvWtNvMINo7KBs6RJCirV5D+Ik3gpbHFy1CLQEvq1v6UuCokPPrbtr+7Ei48mbeMSiVYLBaLxeoAX47U+YubuRi9bVm4IrYW6vsC52xJoOHo+Gqt4hcavz67eL7bzGCpY2HYHRkGtnG8A2sTGVx9JLvz0jMG5ye7V/2uYNAulK4to9OMct200qxTzZhzWXvfnsYm/DtzU9nSnMH+yO/Pxu0IHmwZOLJrgMEhnyW265e1wjghEd1BFKb2p/NPV1OIKZCVchQn0A5yZn8KIpiRtml+6RAB3yb/6D9/CBTD0q6+TGhG5hObg0KPmvFOVuY3K7MZmn7rn105zEAjwZDv0EuCwHN7jy0R44L9Xntz+kcCIr9KzLaqFRUd5W21xyiYHY3WXRdBYWQle47vTAL78jwZ81MEnWNTN1tqCXau+2USsbkFq1/1yWQptmDpSd7wRZkW9FR8e7/LkcHcvJW8qX8EScMPcgbvc8FbU/lqqQANpbU/zMUiCMLt199d9rUN0oUHlU5uoeBfOvQj6wWFt5rjFOcfha3H1Xe5nSAwvZBmsa+0GTFKRks1ZVoQRHSHhha0oIF7aecSYwa5l0eM+/RovP/bkvz4Khfu6bYSQaY0LPpKVzflEOzJWvRE9zjBhd568/862xByw+3uwbUU0prWURcuU9gy66QxP0MhfPbVk3niLWj8vEg11bEF9RfmCx491IJf/FytDg8GD+0n9Cr30tjR0TB9XpCHZVEryuMLafBVRHb0a9GYfNhka9NO8G/O1ZbD+whMds/kZd1qhwkzRYkmUcDnSfajeQTvpzWXuSxugcD7Ku6eEy34e7bk29zDLRj/PCAsfoXB7e8DQXnWNGweTF6bt5CH4famds2tDN6KRJaxn9F43v6++L/NNJ4bWxslPiPYxWkRWFpDcIETsUCxrh0eFpXh88QIdg9PL3m6qgVPU2M4IRdbkHzsbqaBSwuKsmdUDzxi8ITPLGf9JRq7c/Ja7mrzwJ5xT6BjOwOZT94PWmQYCAclREel0pgzpLM4TobG6+DCWnEjGusmvjmEmtLIvV5TuXtZB0znLg36d6sQynd2yRy5Vgf8DrQ1PV4H4Q9ngmYZ1EHpZ+KrkyqVOB98uU88rQqBQk9Ti642Ift75t8dxU34K7vm6JhSM8bmrZGt2deMDUfP5E3ua4bMo9lHhK2aMfLB9CxztBlL3AXWvn9Yj+CB8MgLrB8QvJ18xai4Fh17gp/wPa+F091j75UiKrFCaWnlwSVV2GNtl3k8rQljBy6+N6tpgkvOgjxNxWZ8MesSLH3ajIFHyq//PG0Gm/PDZHZqMz6OzjvQntEMEzlTUUGvehi3nl5kVV2H41861a8uzQfv0ojqOKmBgP3CplvrKrEh2O/cVc9KLDIufl6zugnpwy8t7403ofrkwuf9q5qh8Kdr4MbTZliZrI96+bQZRiGFql+fNkMyUVgnKqMZXVs/5C/YXY/e0doiu9Q6rJUvvbCqrhb7NDU+3F/3HXG3pVZlhFfgfs7egyv6K7A5z6RB93UjKs9uuM53vQluUgvX5Fo2I+n4dk+19GZUZ15fap/ejJIxqcyz6c2InH/kh2FuM9ZseGdt1FaJG7c7iv22VuFh9s70wNxKbBfrWFk+rxKtS4flwl1yILRGvTDdqQ1Xb54uSZtNo6aZz2BAhEZPzHP5qkU0JKJmFbLX03iwPNrrvhqNFp587pVtNBYpbzHVOkKju3u98sakatyNSEbvYBWO1heLXdGowrjPT4mF0ZVgsVgsFqsDm91e3R+9VwcFv4Pub9poKJ1YMXKBQ0Ngn5Lz3SkajToz1NdcZOC6N2rp9DUGyYrPFowEMviyZ+/UkjQGFw42jO3sbsbl8JpbXPEWfC06KnrZrxkbUh1th6ebUDW1PfajAYPVpM/xryoXNhsLJHoTWmFws0NuoIOC1eyf3Y+kCZY+EduVfJIgt5QlevAnQcjwz21nN9MIuCT9I3oLjZVfA1+U67XgU+2GW+t3tmDZK+8Fa4634Gaor31yezO+rpcaVNnLYMQwp+WmJhdu/75GWSygsUd+PjfdpA0Vc+bbZ8RSuPHzaupHmgLJzNfZrE9gdfypFf9zgvbbnC/h4jRU+53UHr5rgbhbiMDPlBaIrvVvuxnXgrUm2dpHslugu7j+7+QBBudKpjT8DnJx+0p/cJYFDR03sb3nCwmcUqaOrJ/djlrB6ZHcUArTG4oLNtRTWPOgkrNvFUH6j3nGnAiC/P0X5Gy5LVgmPL9LV6MV84oEcsfXtUJnzUW7+A2tmJ96c2fZJQYj64zNddq4qLo899asRzSuuq7b81WCxoTgWGPXZ4InFzdffcrqwPwiR93sVxQwtWM+5y2FwtXXzrvKEOwznv2+ituCZ5MPvT9qtCL5U8w+d+VW5EsJDC1QacW7lZIeyT4MUuR7dnUzXKyvu5v6ay6D13FuntNXaWS4fXILnktjn9Xps6Jsgu+Gpfsl5Drgvsl2zYcMCkcEDq82kie4siPf+QqvBRV/Dm9y2NKKkrfWc6XWt2J0YcHTLyqt4DUvN2gMYHDS95TL2gEuRuR2ZTp5M3BodnUc7KJx4u3dllJ7Gvhknz6DJui4r+3UzCMYW37oKUevA1EPN9S/VSBQ0Ag+/uFvC853L18fZ9QKzyfSs6y2tyJqTsijPzqteNqxeW7GawZDO4NdDkrxQPT42cI3GGTePLipx45BwQHv50XVNJ477Lo+uZ+GPlar1R2mkfdGNsf0CA0N0euXD5/vQIpo6ZP/fIqQN/QmWaW6A+5dl1b+bunA6A+jS9uoDmhzLDf4d3ZAKepa7gXQCEvqPZyTSCMh7tayJxE0oifPmegm0tANUNrUIk9jiFl08JwpjceOnQevm9J43J16yeAUjQ1VOqoVaWxQ4Xkis28XocPu4gPfyg6c0No5UFzfgRfWFk8XtHXgyeQasqWbIPeW4a4HDTQ+UXeWrPtMo7B1bZPYCxoGNr75Ug9pjH483f2miEaquk5PdhGNpK/Tgn4Mjei/hkLbMtkwXD9X7ucrNk4Jz3gXubAItxde7I140IGq9deTmx51YFnxjbk7LhF4npm9Yk06DbcLEScv19BIDX9YPfcDDcW5v7MWPqNRf6qNPKumEdT5jv5UTeOm6zl+70Eat9bvNF+ZzYbG8TnH36WzoeNn91f+Aht+RXsX3g8uRK7iDAv/4x1IMN4/wZ1JcKnhx7J/YTTcOyyO70qj4cprefZfBY1FNx9p735Po4AKO07aaHgePer4u42GO0/+5scJGt7H9SPnFbCx5r/Zqf9lsrH58+Ftc6+wscD9hd0WTTaCztTUtAgWwtVig1hFBIXSn0Ha85xpFFYtvHk4mEZO08Oily9olNL/ljX/pMFHtgsc6aKRfnThHLcuGm94VQqb5zDQ3Bk6/1AIA6p/3yqbDQwuvJmp+N9bGje3WEuf3USDa/hss18GAYvFYrFYHWA77TY4YNKFhBur1+790IU414CTA/Ld2Di09V9eSDdGNtUGnVrSg1l7DbW8lvTgS/OmSG3NHjC3dNcX/GDQvq0odOMMLiZfMc/1mxgo0Lrmse8YPH78cLFKCAO76MD2yoZuTGkll27a0oX03DOudsu64DSRED9/VRdqrq1zHF/fhZS7WqsF3Lqg87HkQeZQF/bUbjcvGupCn2PaNvEPDPqjD82dwWbwT3Rg/e4JBsuMTKof1TC4Nzv1P81XDDY8/nHTzbQH03O+35E5243gu2cu7pPswvt5s7OuPu/EzLeZrqfSO1GZX6R6cbgTZzoPPF3t2YVZeqde9052oUPy/bn0AgY+LK8t+ysYBPxVLVnZzCBWY6bnuz8MJP44bDcuZ1D/89p/eTk9eBHBP/P+hW40z3ZTER/qwpoZ9+2u/+tEpPO+Syu2dWJF7luxjd6dWJF6vzJ+vBNZfE8bjt7owp8NNxe4ajP47rimZP53Br9siX1zCQPe0mavznoG2+pHVor0M1B9tDRx5aZebNFyTDe81g2HN5XtP/51YWVWyu4/V7qQ4RjMkmrrhOMtkZuVkzzIxWbqLzjSiY9DxSb13E6kzu962HqawXn9iP3eAwx8tpn0GI8zuPdPd/b+2VxMY6teqhgX+iLvVxX86oUO05k89r0b7oesKy+hG1on1+ik5nRh85+Q+0s1uyDu6PFm0awuLFkW9Ks4pBOS8odPRS7owguRV+brXBicNdgnWDLA4Kr2/PiYcQYx4zM/PpnNxbTO5O8/YlwYu769MfWrF1d2tKhfM+9B8kejY5O/unE483jaDaNubNbudIh/3AWydE+vUFUXvpv+jZEW6EKh4fj22gVdEFhyNnU6lEG9mfjRBDEuOgzKR05LczHC/0PksiIXR42/mf5U5aJHaJPZ/IFe+E5nBAru6cHb0R8pyw734MQYZ/BxQzcMvJ0ef9vUjb5DZRuUj3bj7+GIjitHu/E+F7cS13ah3/Y//ZBtJVhQd1/0UG49Bk+LFXKdG9A9Z89z5moDDqxrfzPTsQExHZfn1GbVQHaveO/a/hqUnp4W/7qoBZK3HxWyzraAsy/vgrVbCxqsw4/v/9uC4vtKM07+bUF23fcEz78t2Kz+el7usUYEzl3zYmvdL5wTmPi9raAeLQbKFze01mOX89erZgX1kAwXPRwnUIOOzAKpho21yLeOHNLja8GhB36akmYtOFoBXc3HLdhfNU9Fa20rjBc8Kd21thXb9uL0wbWtcMqM+PJAuAmnx3+3L7NvxHm9ibAezk+ofTPf/9CpHo/ZbXZfzethW8ARdbKugUf2vW6B6hrYvbjz8ujBFvynHmRZ5N2CxFN6h3jVLYh2Ed3VdagVQS+fcccOtcKrZ4e/4OFW+NnYm1lJN0E+oLKs/1wj0lqKNc4NN8A5/QIJ3lSM/cs0z+6MrkORxqenUkI10HtxVT7SpgZbiy5sf8xtRt4z3QXh71vwb1WT0l+qBf2rny75d7oV7JM6HwRdWlGd2mgp6dIKRa9L/ZmTjch+2uW2b18jLKc4jXW1DbDXWWfzbkEDhM8+T993phB63aU2/z2qxkDO4iDXyWqMxif3Kvs245qPW66UWQsGzqnPEFzWiqj/3ux74N+KgCbjoRf+rbgsRd354t+KE2KfKKGkWuSrKH6/Ul+DQuPs46pytbhrM9h3J7cGb0Z3ySTsqIGbw4+u/6p+gMVisVisDtj+muJeekNghlXXb+cSPOsvvhxdTPDmt4rK8xk0lHML1/TMoTEotPXoaREa/9mtdS6qrkXPwtbz3yTqkGyupnY/uRabunME/MRq8cRrydi5pBp8XZ8+pFjSAefWV/YBfxogOddZqKmChrxeXo93E42bcw+cfvCHxttqgdR9AQw8ru97fOU2A8OnZW1Loxkkmh2jrzW04oCs7t+3ea3w8EsvW760DZI+mjW/E1oRKj9Le1ClFR0V2wbFmmisrg5foS/GhV7vqZf3D7djnnq8muZVgsAnkp7OGQRVl8b+C15KQ964qELlCg3rh066dR003gdHa4lubUOojJhh5uI2/F7JnuOr3wYfsSFs82zD7/SsVM05bbj5JD97Vz+Ngg1sjz3LuLi+VJJFH6dh5PoscnKqHa2XXA46biUoe5OLW4kEdXdTf82dJJj99/znNDsa4m4eWwu3t8Hn1rwR+nwbGsNbPrx81AZN5/Bd9a/awOLqhPKoNpRkvP5S9o9G8rz0zBRwYdU3wdNKotFp/Fx/Up7GbNvbe8MPdOCzj5PEflWCFU+r5cyvENw2N0q6WUiQdnHTI/7qNoiElxxeLtgOl+gHyzrV21Houufdwh3tOP9k5pa9Nu3o0A0wEt/GQG7F+s/P27jobTtfO1eYwfWtyzeWhNFYJzpauFyaRlvvm6+TtR242t7l41lDoHOwo9ixgeCmdox5VXUbuNYb5w4KtsPIjq/ko0Y7ktQz7Dk72mFxxpmW29+OLoukzb92MPDZ6H5gH5eLF9/i/FrOMXifHuOyfYDG6fpjjz440+D3axEX+ElDrbxjnf9IB+opDyq2hSDr747iObVtWLKg5aaiUDs8JV8a9G1pR/2gfeMS/Xb4r15kb3OgHa5RApm9xgyUPI4LPBvggu9e5E6dNgYf5z1bbGvDoCEvfP6szzR0y4vsb5TRMJG7m1dcRcP52YuffJJsVPC7+m988hNXp4nh6YF63JR467b+Wz26rThDcnX1sPJyH9v+rR6dNq+Um2fUYOE76Z5X0Y2wVZCQ5tevwQv5JUU56c3w5G25uPhDM9jC6mLlGi2oNyt/3abRAjKjf+zjxRaYavgvv3mvEQMDf3SmSn+i6JylGJzrse6tX+TG6/V4ULPwP3PneiR6l63pXl+DJ4aPm3hUI45w1OXMH9Yg9OLcqUv5zYh4XjArVqoFMyu+J1o8aMEfkcPbjzxoAV/BlVtbm1tQPdqo0mfXCMt/9QOY0QhfVl2b57GfuFMwJGxSUQfH4PPph7Pq8IZO6th1thqnhL9OVWk24s2pBdztDTWYMPogO+tJM2Z6uysqTDTD/dHZ763eLTjBEXDo9W6BR7Tiix9fW+B346Ns9rlGzPLPaBdd3IiNh+yvaB1swOSb8/fNBIrx1iRKunF5HcJPnS+PKK9GN1U6XvqkAb7W/6R/Hq7B8q+ubSUtzTigOefP5nkt+Gk3K6I3rAWfHz5cPxnWghLLb151JS1Ym1yRu2JfI54+Kbt+YbQBZ5yDLn/SaUDhkpVdVg/qkTfr9Oao3YXwXfM23TKyGhvYDfT2ZQ3QJrl5svw1iHDVvVN9oRkzjwlU5O5sgdLrt2VCqS2Q7tvlsjS1Besen/o4zLTg6aHGMI2GGtw+csFq/4xaHNnPcr2oXIOtaimpb2/V4PWmTPFFWjUIH7G1/LiqAEbOM1TmJ9SBxWKxWKwO2GceT7tRRGFgde3UxzYKXkU3AzGbQMx5Lkt5HoHhs20eH1QIDr9Qna1n2Azb0g7xu/ebcTWG97SU3QSnxLCzJw2aoH25KeLA+0YY/Kcz89edekjI8yoW7ayFRPoppaZ4Gv7uHdESvygE13CdGsUIlg0+i/5+nuB07qXSkzUEhw9Ivg1kE6ye737Cf2kt9B2UXkV41uLBSPvigbe1WBtxurN1WS1UZMMKjdNqoJ9zwV+lpAMBiREjjc0M+Frex72/2IBn9QeW7TlCo4Kj7eHmTWP6RU/aamEG2Qc0X5iLMygUdtbMuc1gde05C1vPVty6c/NbeUArbq71CJ5ybMXCJX65n2NagZihtZvXtEJs6lV2I0UwQz1Q+tobgsNzvypHxjHYfPZC/VKtdrTWUpn3pQgks7LLda4RrEh4cuh7E0FWU2nQfFMapmO30hdebcW6rZ/Lx0krlOqexa3Jb0XIa9sNVuWtEJz79hxnVhvi+sU4CVMERz+PFvz6R/Dpy1wh3lcGUkqHjI+yaHiq26dp97djR7mwZsI/Cs/+eO24r0egr5rkynlPoHYt6YqnYBsWZVdG259rw7xDucduW7TBddOF0U+H2/DLsOSax7k2fA2qFk9fR+OLyPNcnWM0VqaxmOZDXCwyVlwb40wj0uKD2jI2Qa9ok3ikWwf0b7oEuu8jGN2mGZNfQCBkMFtp59w29PirG6z3aAMtv0rMdm8bdP81JgfatiFx/sZ1OzzacKBz386Fm2hc7a9wW1BM40aWnr//cS6MvsefmFtCo2zJCtF362k0G2v4L9ejoTckGCAV3oHbP0re/i0moEZNFivsaUPOlquqwplt+Fpzekorpg1LX8nePfG4DZ65FyXlM9sgtHnnvclDNLg/wtv1G2hU3uySa+dxcVJDS2CTPgNeWM6bpic0ZmbXKJh8oSEk+vDW3Wwad7Rfnwxr74Bmtg1HcFExinlrj+cfr0cL71+/lHM9CKtwSZt6Pb4WGH3rX1EPez4lu3qzamxbWNTAkmqE+catISJSjXCI3yman1CN92ueUZtXNUNhz5NrvU+bsSQc0pNPm7HawN6pjmnG26MftYZnNqLi/q/ryRrF2H1rgVjW7HpYJbVp+tbUYeMJh/aI3DrE2/2ofupRjYnePMPS/Y0QTbV8WbSmEYkZW8xX/a5G5o2RGUfsm7E2dShekt0MaV6IlhK7Gak5zaqZki1Iv3F6j6JAI17Qjz73xTWgwm3/Np++Iri8CvytblqHXecEY40069CxTWGTRVQ1zEf2kG8ZDeBPdbF0RSP2GOm3KorXYNvyGfNeGjYjzXD6yfHiZiQGxuifL27GGgee1swZLdiil2N3t7IBG5+MFh040YD65SnfVWvqwXd2N7chsAiJ0UNlYcG1CBv+u3wdtwp74k7cH1rUAIHdN2Y9ftiAjsGqmN9Ha7AvfOCb16Zm5Gb0HlDNaMankYBR3YxmoKyur7K3GW4WnbYinxrg9l6uqM60ARIby3LTPtdj3uX0pMV89bDhP8XYLC/EwA9V++s21Tg25/i79LB6SH7xkT8u0YB4n/9gLFgD1uf+mNlnmyE/67/oPeXNWGSwSc2hvBmJMjfXDQi04DzzYnrp4hocGaqzVdKsQeQtP/E6mxqcDl4lFne/Gt02r5SbZ9QgRpex3X4pDyevp09yrtZBL0w96d/ZBrBYLBaL1QGBVz4/3LM5WM5353XmOAd152el7OWjkM4JvJ0jTCHPVrzGr6gJ2zomwowFmxH2glF/cagJ+I9fKu1NI14oRhgMyTViwcVJ14ce9ZAXqzI4z18LlRlOD2WlOHjDOZA6I47G+vEDKnylFL5LSDt0bSAQ4XVFhd8hUK12uLX9JoH2Zj/PhKIm/A1TvS/W0ISOnAucuu4mhKfdLWjSaQK/ttygzNtGtI3tE0+41YjyB4sllwxx4GQfsU/8SC2ibzqY56nQqHdY9EvOi8IZ77p/gi0UPpmlqLzRIti63vnyxgcEyWbr1KVP12BMV1nJKbsGg7+Ov6daa3A9oKl2v3ItbiyaSM/PqkH7qWgbg5IORIrP9epsZvAyogB1JxnY93Tu9Jqux+eQAwu+zKPh2pI8fvEBjQ3Bg3/ik2lobJf3n7+MgUHx1b5TTAscne4c0JFvxcIvbVd+zWpFe3Tnyl/9LXD4ve3k/lWt8E4sSt5WTKHwoOtSr/cEikJkn9xhAofSTP2NegySBNJ28a1rB710ucDudgqmerdFDVYTbFi/7axENAE3tFyyzKMVgms5fo9ft+LLtY1P10e24vqhbXvWe7ei1TWynne3FUOPTdfmChN4mWcX9UrTaJzeNCMqn2BX1LqPKzkMzIWvhBnkExhsydo5PL8DKxYqcdNWEEhfvbr61l2CL5abCx+cbwUZ3Kl6Lq0VoQb3901GtuLQ8qTJCe9WpKv/9fsY2YoNVpsTfy0kUNvAeGbfoPGg/eDbE2toPH3sal3WxSC4huvUKEbjzJYPe7xEaMRL9n64sr4DGbNEd2TdJ/htoTpx5HMrZq0gPpf6WvHm4qZH/NWtuGq53YQ/qxW1TlFVWVWtOCFdrHB8OcEFvY/vyy/S0H2qt3d7BA3OzoiSxa5cCHs1Tp4MpDHc8pSZ40+Df737rj23aOQYx565cLkDfE2fE2OUCmFcc/WSTGIdDtWYzDyfXAfOwdPpnd516Dqn0fLSpA6P77Zd5x6uwr2L3lmWxxqQfK/f9eixBmS6X5FvDG5A4N2ze/7+qIJGzO9ZDTZNWN0Q+phr04QPb1r+8wxvgvFE5yc79QY4q7kO1TwrRNjPzbppbnVYZLFvYOWuOixxlpPkSdQh8vNA+Z4vVVj4dJdRZkUDFI40vXz/qAFbfEzt8x414PpFi5BWk2oUvC+dkS/fjE/DJxKr5ZuhW/oh+tjBZphKPyyN2NKAFxX9ggv4GnBaZOJlmkMh1tpOPmvOrcWGC8VVD+/U4vGQ66bHrVU4+rqePFduwNIjvy0PfWzAl2UHTs4PaYCKoK1k+5lqmOxrEhKZ24xt9zyeLZvbjEKXvMTXO5pRnR0/5CbcgA3n+9cP1tajYeDB6p969Yjv6EuynFuIzAyZYQ3+WtyUKy8pFKuGc2bElwdh9ZB1ebXGTKIB2k3z40eSGtDK7r0+EVcN27Sbj/4bboJFv7TO8+EmNBW6aGxVbsYB3yFWS309Pm3W1z6cWA/7t4PqavPrcUglVLLBsw4LMxc4nXnxA+kKc+e+PVeFW1VHO9qn6qDjf808PKgeDr/bc7csbEDCnOCivQtrcCdcuchzqAnXK344Bg41Ye6zxbYSa5vxc5Wfl6VoNSIGvnlt8qjGve53S2/frUahmjvpyK/GrmvjkS8WVmOTxiKJgYpcvJJzmVvpUge511Yzwnc0YDCF5SF9vQksFovFYnXAItPbWqmVDTkW+XmGw4aRm8jDdT1sSGZtcs6e24SxcLWRbT5NMDws+/BhZSNeNpVvs1ZshH32io7zdxrw6VtO0sipegiMS8ZKFNTg0pyZ11LfsrFg2uJ2kQwHG5ZxqMkoGs+K1Nv4eymMxsxa9NyUQOxeqr/NNoKYyEfzmuY24f3205Mzdzchzv218c8jTZg1YMe7/rERn2rehSTKNmJUgi53PtqIu4Y9S6MCODA1bnz6aXktpmLbWtMb2OBdPp6kuY7GrHn3LifEUrBsOfrGYIxC3ddV/34cIDg8Y2bLarUmFH3mTW/c3YTz87csz1JtgmbRDJ8ioyawX1ov575pxOYn3C6bkmYsdLi9omKUgxm/Xlu+eM3B7zkn8pKCahEdbP3zRj5BumHSqiQjCllKQe+Wp1DYPtd04vEsgsqXr50GfarhOWfR4edCNXC5a8JdtaYGZ69JG6tZ1SCpz8zmVUUNLK4nxLiWdGDd45j4d40MWpcts5B2YlDdttWr6Q8Ns+eHBnzv1yN63v2QsEU02nfeffJZmgb3X+7mtfdpzEmYoy++oAVbnowWHWBa0DC2s5v8bMHhGpllH962QPqpfWDF6xa4WCxZ5t7AwdVj2wSTGgiCBy31830Jmp5YDl1ZS6BdkzJZsJvBLK19LdnW7bDeZjFHXphgT0qy8b8rBM2OZwapBS2Yd+bwxdXcFrzMeRGT/qsFKnE3fvm9a8HvM/mbE9JaAMsT9QptHKjpHft0bjsN08rLv6MYAkG5n8p9YQTxHN4DVxsGgsY2BfGjBBmT6V/LotrBXb/l2Hpfguxag0edFi3ob9msuH5VKyJU/Iwz57ZikdChq8GDLahvfUAn97XAtSd1uLSTA7fLXbe119Mw28K384wZjfmBe3wLhgn2HQx8/rGZQX8oM0/5DI3R1jS38+40ss7oXupY3IEEDy8/v/kF2L5AeqlYdS32LOh+4lRfi/qYudatb2phAD++vTdqwTOOtHh4shK2666r6CbX4+yu3xzz5Hr4Kfrc+/GrHs02I/cojXpY5lR4zplZhbD4X74/IxshcXHppYMljXjL9plpdaQes8qV63JuF+DmnBrq0eNazLnz++4S71pcUjuzPWx3LR7/Knj8prASrYd3f3ki1oChxW2yj6h68KvtqXpD1eO5/bizMl0P8+T0d71BVVj2OcX7g20TUu6OnN95vwkZfJslg47VQ+SDv9WcdfUQePY5PM6gANJxD0zLh2twW3fxX70fNfC2dRR62VWJDcebbWLO1oNZPPrD6G89AmoON4//qofytIDHpdJ6TKpn6/Q/r8KDA+8C+HY2QUl1xrWIa03YlrJpu9OWekQf8L/MZdVDr071fM7ZOmhcfuWP/nxI3Tgl2apZg9U0SfWSrcJm16j1wWV16JR/G791fz3mhS8QpTj10D34VnDmp3qkiux5Nq+xCu/fH5IJU2nCjrAXi2ScmzBrz/FNX2fW4/jMD67bSusgev2Ys7hWHURYUyafkmvxbFXZvceJ+QhzeHHn5dEq2PTOvRSiUwfhxFVcrZ91WDc/6ADZVw+7l3tt3zbV46Zj34P3KtUgN3JWrVZugsch0WWfHJvQdPvs8gWCVXD92j8v0aUKO5euiU0NrcKu4vWmz7KroHSotohvRjX2OFhnvtiWjcQlDxNe/KuFsOvfAd/f9fgyyX4UOqcJ9mEzLV8ZtIDFYrFYrA74DFr9FWrpgNeRiYP5gx2QbcuVT9zZiLG3ea9kvjTCxPuVtY9wI96M246oH2uAuJvBPsGSejx+/Erq9mgdsNRZY55hDQ4pFdhd1mdjWHah639v2Nh+sLhutiwHWx4XPCt5SOOoVbvAnV4KV/bc8NrWSiH+vUrB552N+GQ/lkHfakT83W0zXsQ3QlCsN/Ho7wa4+ddMbz7cgMyk2SuMxRthd1FUas9iDnydV+98lVwD9ueEb/6n2Zh4Khr4u5GNwWlDpzlqNL7FbEte8x8FHS9W34t/FA6tuHtP0L0RJc0XPy0IbsSlDT+a4s82YnNnuMYzv0bE2l66f6W3Aar/bNi79zaDe/udfEggB/VjVRYntnOwTinjyP25tZA6oRaYHc3GJpGZ3mWlBK15M1Nv7qRgm1w0OPMpBZnuGZuraxoQvqWj7GlII9RCNwyqujUi68TslTNtGnHE+OmB016NaGy/35HzpRVxC1ZNyIxz4O+9c93YWw7sewcryGkOuM6Svgsv1eLpu/5tW88RJP9WJYOtHCjU/FH22E7hpq61m8nrKijFfzk+c2s1lin+7vfdXw35hfkWd25Wo+j9nsTEpmr0t75d+bWkAxFmts8LGAbaxRzbDecZFAUVnzs1k0HwnyjP1Uk0JHd91mUvrcersh+77y2k4VPrPW9+GI18vhpy1bAZI3PynHOXtiD68IJQU1YLlmp0fpPhNiNaYPStILsZZderZATy2HBSFS1UbiXwyVGcYeFPMNwjuV1BmaBx9Llb6DcKB/993LxtN4PekLTv9h1teBW88qraIYIX7i+jbgQ0o/5SnFThwRZcLenathctYD3NdV2xqgVXPMuPisi1YN1Xw01FzWw8cY6O/1tCcEPx9b7VPQR/LRPDJyIJGu025h1TIJA4Z73Dr4rBke7/NjwzofHG+dofi0vt2D/+5rPx/ALsqcm12FpSi6M14a3hFbXgHXwTOvt5Lfbzrdly5UotPJT37+S3qgTz0d9lPLge9zaYTXdn1eNzz7X3x3rrEaKjcETDsh7K+/YKy4TVo3rHwbeCM6uwekvu65sFjRj5w/Gee7AeOdaO8wJuF+DeTy+pkge1kLSILda9WIvg0QUXPhjW4u+cFw++va/EUd7eUYe/9RCf0Ni2fbIeW/6ZD5nNasAho7Xq11kNmD60n3NiawMqVmn39AVVYU+sVarn7SZMLo6+q324Hla/eGVZK+uRrTL0w8GgABtsd9z821uDRP6v689k1yB++NScYw2VeNvfHrnXqh5CE3soItaAL7GHx2N669HG1UAJrx43x493v13eAJ9Mo8sDz6tQteXo2+8Xm+D7+f5H6Y31aDzL+/1grA6B3VG9F5zqMMBHf5nZn4+1+qJ7Zm+qgVjd3OeNwlX4+C/2lH5GHeYLCH2uc6/HTqsFKr4zGzC4g11rU12P+9bzb2gLN2D13UaOcGMVSKSQ0e4jTdjvWPjSfbIOryQlOwcL6mB1/wfn56Y67BM/UnAmvhZOr2f9OpmYD50Xg/cyLKrQeEnkvYFiHVT9ffkbqDqcqA70un6xHvfd93rvnqjHj5UeIQ2CDRDgedZlqFRj8VE3i07bJqy2Ug45O1yJe8yw/fP9Vdj07EKdg1cVBL/keIi8qkLGm1dN4gNVKHAtUFDflo1Ub7fnin21aFfunk7h1sNq8UjGwclG5ExrnFqp1QI+mX0iPMM2sFgsFovVAQv93zoV3A4I7lujp76uEURxXXl6YiNUfotfEv/dgFj3H4tLjRrAYl5dSHhbj5LW0gO7u+pQuYx9gjWnBrfEgzLeiLJx7F+W64gPG682n68/0sdGxN2/M1TtOSg8H5m9VJQB+4/7Oc86CufbbJzXH21ExC25gwdSGnHh67EnyzIb0bF1kUHd/EYYpo0/TvVswEucMf04pxH7rYr7qtls+J1Kdo6Or0HH/dF0KXM2Jm+evBqUxQbF7nm6fz0HPpXi3jZPaDyRHWCY3xS6DYJOCkQ1Yv+US6jo60ZMWnx9HXe3EflCgUJPUxux0vfsKS+xRvwbnzlyamczvAze1Bpac3A6X82rWp4D3nJWxtx5tVih6jC1z4eNlyMHuhUH2JD9/dlfW4uGgGbiveOPKTTz2WYtGGqAmfnW/dzURvx+dOvyubBGXPB2eKV3vhGblI/+dI5vxPkH4Wkhb1rxUylgqqmYg8xUoUcnojjgn73pp5slB/JM77xbXrV4F3UqM/U1G0+9P9jyCgmiTmWmvtai0JpxYf9K1Ubs4bf8/ZfXiDHrR+J+pY3w2ehlZPW+EWN8gVq6rxuhlH+h6m9nOyS3DC1X1qUg9NHzx6d5FEwXJfR9/8mBda32hZX+HPByPn/5LVGHTpHQhhNdBAZOTbs321PYXBrrbJJWhcbV07YztapR80690/dANeoeCpjc8auGt8692MTmauQ/tpTfVtqBLsMbx1Yc5eJ+noBEWD+D2aVtKwKuMNiZMfDswBSNBhPficcrGahkVW9+f7Yen5j6PefDaPwOEBHZ4tMMrttfgfN7WxCSb7hmWr0FQgnLbCtkWhDkum7PV4kWsDOunrleyYYWX+eP3gICiexpbxmaQDFPPXZNOMFl/SPfPJcTnL4RWthjRNCr8Xf7zGoGiSbz9PUutaPoYlXkvlv52HEkPOL43VrsOWIv8+V+LWq510pU3Gqx6/ZfzxTdWmzaaMVMClbi2YNv79ferId2wnTsXs96+DZ+aD5aXY/tBefaPXXqwWlICLG9XI+aJZpKZy7X40j852c7eirBunlRqEG6HhurAuhwwQJctzE6+OdoLea0OQm6bKvFVT/2+w7hWtw8UaRz9FQllEpDEDFQj+zTXXXJH+rhSr11juqsR4rYprfFPfXYqeBgm7aqAdsdx/59W9UA++vxRNG9CnyP18gJydbDwebq4RBuHVReLkw8nZOPZV2iecvf1+BuePDZaP8aDL5IfZN2uxJXapa03DCrx/vT/NdMftVj6Ma3cx2V9VB6i8+LautRku9y4t/8BmTPnD1zrkgDhBc9O6cUWYXAnWsMun7Xgf535fqpr3WI9FS6oremDse9Z0eJnMqHZGXbHZWxaqhwT1qX51fCt73q3flXdci8mLVPe209vLaff1rIq4f2vM49idn16JBdPOk/Vo9K+7T/osbq8alHs3lnbhVsBw9uRU4dPqW8vJgdVAfb5QfdYv7UwiFpeK+WQy0alBSTPZbmY/ViN4N9glV4ZGs0cUG2Ds3Dqg+3x9ch7mvnQNHBerRuPfj3Pq8eon2XZ8v/qceM9YuSVf7UI3JHQ1nfkmrke+TOdX1ciYmT5cX751TB5c79NVlKVZg/a/U7Sbsq3LU7xF+WVAWV5UdOLFT/BhleiJYSuxYerwIkIx/WI2brAOcaaYSbLDvviWILRLQFdnlKt8HeU/KavUobWCwWi8XqQPiFJWFFKxpx2lhKcmdMIzIXzfz+tKsBCpHvHex1GhAo8CB747N6XH0yfvZVSx1c5bc4cgRqEHbhC5Mqysapg4OJvT5svL/3dM7BPjb+G1q7eY09B0tHLWqtvnNwM71s+VJRBortN//ukmyEZrBp54XDjViddUdNy60Rd7R0aoYKGjCSpripYH0DEoqVlPZ/aUD286fS2Ww2Vj4rXXraqAY88YivkkJsGAx2rn95lQ0l87MHr/ay8dry3vjTPRzEfD7uXC7AwMyk88udK43Q/xx01vduIzqFG8KFPBvxsvBR7+KwRohuCzbtHGlAjsnN4DvKzWD/d5t/kzUHv64vqSxsYQMn7NbuYmqQ9e7JeJg5G3oXE3kOOWxkBf8WCJXlYEuL4LmMBzTeVJsdEu9oQD+/wil2VCOibc6HulxuxEJVk2ztI42oYJu2HAtuxI4/2R/vxrdio8/xdT+KOcjS3tQYacmBc/3lKPEVHGxavjR97+FaBM+MdZh9g42UWfufFfxiI+fErF0OSjQ+BZ74s0quEYMftWf9rm1EjFCY4rXPjZBgjh00T2rEvQ/uZlpxjUjs2Gtb8bUdN8Iq9izRpTDHNGGS/snBR5rJdPqPg/3XfsrO2M/B8AT3SNa/Wmj5VZ/W72ej3OToMNeERsTuX49H5BpRfO3Ru9y6RpypGqwx/dKI0ZdFAsseNcLFu+6f4INGvDuzyOVHZTvsz8lMfXlGgVbpeX5rE4XNu03Nf0xxsOeyqWd4Cgc7P3n6zirhwNvGQXHMuA5btI43Pt5D41ZO0dPO+1VQkV7/4NHyaig+cVSU1a3Gurvqt5RdqlGzKa9+e141BkYE37PKOlC64fIa3lEuXPL7us8e5IJdWvDXiWGw6kP9NS1nBummTpX+dxi4nntSZ3GPgc+XAydPv61HbI1U2ss5b1H/p6/mv9ZyjNYqDxftqoB62tFe0fQKrO25cHuJZSV6sqSHeBVV4Fvc9e+ZUxu2zbhQ1LiEIHM24m8tJtigp52bEUPB1y1XyiyFwrI6v2tiJRQKBnt/s6soHM9adIjzugJaK8vk5i6vAiOSnOJswcb4PY6t6F42mrjm88rN2cg71LNEoJkNh9xTj1/XMgjfcnN10BYKVpda5+xdRiGJHeufsIKCK+M/x16FwuX6nsum+yhk/BixurmfgtJGtQsHhCoRYJszefMoB29itu8/p1aFfVKdL+5KsLHpjPVBC3E2ZNhDJ24lsrFVKbSl9xCDcuc+Rd3dFGQF7t11kabg7LZH5LM8Bc0L2+56raew48TjmU57KVyxetkeb01h367seVsuVELfj2/vjKMc3JqlbPFbnoOgmGDFufJVuGzQ9mVWcQccMy8Xae9j423Cm0NvOTR0Hc/c0/7Nwdx5V189UKUwUaJ2p0eaQnGl/IKXShTqvwf5xZpRWPguwr7CgoLii/Xi4UurkKWUkHLdjoO5L34mn1vGQa/focKWX2zIPfdqPChShZ+aVhOLZ7FRtLJBbsyZRv4cVNtFcRCWZtaTVcMB7fMtKXEThf0tT28dVaBwrHT+hLkxhQdfJeoDzCjIejDium+rQZadwv0hDjYnRm0PfMfB4surjgzac/CjauHNw3M5UO65+vjCuSq82XPq19LFNPY9Z67aHeZAxmIkKiuOAw/5EyaJ/zjoE5XtTztM4V/tqTbiTGFLgUfm0rMUWCwWi8XqwHSB82opFR7OzZfUsX3JxaUdOy8tVOIi9sDvMLMnDJR2XiOJpgwWu0sIfthSjadTdxblr6FRQJT0q0Jo/JevHfhhPoOI8CJVm7sMFE9br7y6mIu4xf/U9spzIdzdHVK9h4aKSO1XlzMEvVmL+Hk3Ccpku36KLCe4zpK+uyWLgmxv6dmw6xROF8pu3H+ewRnyiPWopRle8o1zrzRy8F/G7wuChhR+X8+YX/mRgqblDOlnawkSg8pv694n8GQf25mwm8Yd2ujRZwuCnolHQYm7CSbac7dEOhI8yPk9/YVFoPMqpUW0gMLgsQ1HwlsY9D4vZ1JmEJCoTUqr5VtwxdQhfmcFB9GH3eaJ6lJoVc6S5L2nsP1YcKivLEH561fzb/wgyLkAbyEbApmw2GODlgR3nt3+8suM4N7W3cd2OBKoK7J0opUIfnyuMbc/yUXrAzr57EWCkyEv60tHKXQkxblbPG5B/JsitZkdHCgZ9e5tt6QQnuhmr/qZwu9HnN25NQSF21x3JzkSLNtXvDnwKMFdt2+hRw4TTG/d15JtTUBKmi9+ukvgHr39ocIfLv77fFW9qo9gwTM9541uBKqyF/7tnEPQHTu25ZFRKwossy0vX6bwLP7UuaU/KTS62DLB0wSHE1WvhocRvP8YevRiMMGamuuf9/gRPHgoMTftGsH0eWVXzUcEv5U4m2P8ePC1D+vxP0Vj5u59snQ3wfJ8/zf/bhKcu8GLPPmaQDNz5ZJYVhvuaykqmw5SuOQ2G8Wzabz7dD9R6S4Bf8VAgMgdgis9dFtnEEH3hyvrjW4SWIZFRFY9IXDZoFvTcIeHV/bvW4paaWzefUVktT0Nvcxh78ghgrBLqu17Z9I4XsMff3oDjRkiFqvmOrVhQC3z1K8D37AvetMMm5xSGGtYH5U/X4u3rtqK+qhFLWNvfmJuLXLjvSe7SmqhY6SYJtJVik01rQElnfUo90+T61jRAK2EnYofp+pRsWdJ7s8tDUh86yG5x6sB0YPzPtp7NWCvb/hrw19loLMjzVfTadjBVZy5Mq0cOs/+++3lXoHm/eva38ysxIPPiwuFj1TheozH5hdObdBXWTTGXUSw8Ns0590sglm1E+R9PwXLGde3m05QGHHSfyS6giDm5isJthJBRtyDZxfu1eFGKh1SeKcCq1x/PNr0sxLiZ9wzMIuNkey2Y1Mz2GjfdMVN/TYbYbvT64Y0GUz+Nd/Ct5NChM/qgwuWUFDMqT9zk5/CUH7ewA5RCtMZa46pbKaglbJpu9MWCn01x+fk7q/DBbVlwoEbKvFq39jaTaIclO9/tM7zZSUyNn6wsEzvQFLKXruQ7WzMK6i3+pBDgxu2bONDLgcefqIXdqyn8FY6qzdjioMA+WduF4QoRC4QpY5uoPCDT/Jb7CYKHaYnfwrJ1GGT60XTNUWV2PTvvs+7eRzQydSz2A9s3Ofbcl3iv0rwr1K+e7y/A8t3TUtNGdJw2jjgmn2Lg0lFTvqxCg7iyg1Yp8QpSKzbwFsrQEFW5v5BybUUDsxLUNuzgcJrYcW+2911OCsSfFVyazVOvm/uy0zkQNp+tWTSLg6KP4przRthQzfAlXkwXgnhixEFcsMEFrEiIx82c8C5w6d56AoHNzQc/Y/kcDBDcZmS+3IKwj3OS55soLCr+dzvlk0UVvH220WjHCwWi8VidQCbZwdo3OJC5+Smb72zuDjq01GteJPBDo7jRVclBrGJ5sXBS6pRsqnmDplLo/3+qvNXXWkURaue8Kyi0bjxP+lcKwZ+TTMl7zQyWLTPX20+YTBGnU06saURT77+in9lSmP582/vPK8QlLaY35BeTHAz67qxwVsKm28dXidsQ+Ho0Rh/sX0MqG9XuJ8fNqP1P35PqwoOds0W8xJYSSGpqkqs/B6Frseysk/nEOxp7ImAB8Em/vj5xl6NeK3Piz6xjUaS+kTPzl0E/0kujdc8QmD6z/T40QkKfD8UDdc8otB2eaOIQA6DYJ4JH/OXwqMfb63nSrWgtiBYUL6Qg9+1/13q0qCg8ZTr//0FhYSW938PLibYV+o6d3pvI1gP3ij/WknjUZH36ZObCaTpc3k71hPIXFQ9nWlAUKsrvPt6N4WXj3sN1ddw4dzSc9THguDst+o+Vh+Fe7XPtvslt8Dz2ebH0Xkc8OseyDmnQeFsbEtJQTyFABOXS7vvNWJVOH1m7iYayXdebZvaQbDk5aN7DdsIwi9uIeoaBFe4PL9H+whGlr/dkvKRi/n/bg5zigmWtpzyPX6C4Gqi8lbbTgqP9vfXvjNoxVPTtbnCdhQC79e1v35FYdHOoumBlkYsPOgwq8aWhu5vt1O9pwieiZz4XnicQKlpXGyVPUHMna3eK44TzNz/2j3HgAe+hHvP7xvQmH/9ivoSDsFJg+0Doo4E7/mTqq/dIdD4L1Wx/m8rLmyO/K+9hkJJqD2vrbURqbaCdgscaYQK1d4OcCWYufon4+BMcGHIXj37GEHX04dJX08SiLGc0p/t4WFzwpf/Gj/R2Hb90apDVjQCt77KelZNUDvWtcyZJnhm9bXluiiN088ETQoOtGHH7P2zXU7nIvCMqlXirhqcC5XQW+BcDrEXq9z7+Ougtdw4VrS8FqsbTxhGRdRhx5YnllG15Xj1en+vfHcDmvkfRJh0N8BMZlPM8h8N6CibqarOasSbDQ7BX3Qakeg6pvhLpxF/xg5cfG9Wi9dtju9+snIh+riqiVVRihU9zZMtDrXwX5n3fXptLa40d7j59dVC6Nb1NfobKpAl+fl2cH0DlC8pNXQWN4BsjDnp9a8B3pTzjLDNjTDXLzi44EIjdPzs/spfaIR/fP+BKaMK/AhcUGZaVQbNb3Ix2wzfQ3aH3F/V2xVYumHLDVG5SsRnp3y/c7kK/Esnz7x0aoNHj3BwnzHBz26eyAZVgqwZjNhGaQIhlVOni1YTPI9SLg81JzB/e1/f1ppg3m7hu74r62Fn/nrQ8UIdap73+sntrMS9TeO6x6Yqwb4ydvrnWAd+++Z75vuxcfX0TE1hSQZ/P8i89ttB4RltsKg8iALtPRS64wCFzzfaRGacoFDkYXCj/xaFsaO7bVfdpvCvQHTfL14d1Arfpr/RrMPQ2ZdmJ1or8cKN1XB3mg0/Dw1Ny+5KWPIlvIhXY+P2wNqpfYk0Lh3s70khHGy4JWE5sIZCmcqVhD+uFIzCjgd+OkLB4tqvsRR/CnfO1NS03KLgXzC32exjPYp/vNi6aU496E0yA+fMqjG6g11rE8nBs7iy0e/aHMxJVn1+0aQKyvsd6ffKNHLShdZJ3uFA83no8TVVHLRbDLxRkaBwSPfZPh0vCo6zR/QuBFB4PDK57GUwBSmq1MfgaQVCon//Du+tAIvFYrFYHdj5KC5N/BgXnplGBeYdDMTnfW3Sd2QQbCpqqD2/ButmeF6VP09jalC1p8OOBl/u0k6/XBprlByee+ozGEx4+ravkME97szGxVUMIh5/ir5s1oRotZj79luaYHBC912VPw3Vt++8+j0I2iOIzhIRArO+A3cOvacwPmUmYFPIwG/o0aF771vgqfqfl9MIB+3xT69IGlPQPOW8iJ1J4brWB/lMZYIet5v3LGIIwnw3nXM2a0KJ123HpUpNWFkesMjiNA3r4tlJyd8I+J5vOVl6gGB7vfO3sHEKcStvb1yVxoBz/tJxZTmC96clo2fXt2C6o4h3kHAgM9hgs9KKgsN3BceJIgq/Rofq720iCNk1+6ysYhNMPev2B9s34fwq8+cZljSK79kVvgohyFdY5rryMcF8S/MCok8w0f943tQSLl6elPzx4xJB/n+bu6eECPzO7n05364VKfnnblR2cLDmcHTCy30Uot5LvZz+TOFL0YyWsyebEDJS9aMxsQlxUn5dw0do/Dh0O0bvPwKNVSt5SfcI8v+umZj1mqBNLDdB/AkXDgFvbNYMElz99OvqUT+Cpqu31Q8JEDy96rNw6XgrCp2cEw2vUHj9dlE27ycF6zy7Y2Jvm7BoYP3uq51NCCFT17+F0jiRbfFD9TtB9S2ueMRXgvbEq8MBWQShnRJXGjfxsGttrnCbCw032xbVxSwaefIJPfNvEkh28FVcfUVwoeNHvcuzNjw2r/SSHKIgNvePyfTbJvxQ/6F+qqsJ0rynTRORND7XZ3T9+kGw7JHJ1jW5BCqZHUvkvhOs27zG/vR2HnzW8iblOmhE2fKvOOBBg7d4/uengwTqdSZLnfhpLFGVafRZT2Pms8OiDTPbccfd0mboWR6K8rf7bpOuRdXqqzmKM2rx4PLvi/lBFYi1VaU+R9ZhxYG5tw2t6jFy79qjyPYK9JrKHeM/2Yju5ZXDqd2N6GlvnHU/tBEhES8Snv1oxKGh2X/XCzTBVDUxartAE3yrjn2+ua4OU8ZH+C2Qh5dFjRrkQA1UYz4s/XqpHN32L+c9H6iF+yG/j+rv6tDGp7xCeGUlpj9en+myrxHa0XZahj8bETa8va4npxErnoi6scYbMTLbpchfqwk9O2ediNJqwj71AZuh2XXQOX5lRdjeWrz+wL625m0unmbg+M0FFdiyVn5Hp1EtClaN9VyYW4eeo/fz19+swHFJra2HLjRCd/hJ0+KfjYiwCLzwNacR6/gPiFT8a8TYsfdOVlpN6HlsNstRqwmP3odGqK+rxJxDTx7amVbgZODK0IXxFTh6LJ/3JuMTfs4NezGytRKXNN7zFd6rws34gKevnNrwecl/GtL6BI2Fq/y8LAm2OOitb9EkCAkrDPTYSbD6d3C3xhmCsvnDDnPOEyxCZmvw+3oc1Tmo7TarHoJpT0qfWtSBlqzNGh+sRE3dphdzt1VhbMksxYYLbPityy1c00Mj5epwgLYahQwfzY3/+VDwsa9O2JlPYezL18A5HyjwvVrxb6SRgtqDdbXr2ii4y79qmHmjAV+W797SVVUPp4j/iuRl6zFv5VjygSPV6FxrVjnLl4M3alePlP5XhePBshPanjRKN2QWmbRy0KY6dS5VkcLLBTHsfS4U1s6w8D9eRkGt8MH52GYKpz+kmFe0U5h/xUOk8VUlrmyIvblyuBKOX4oSazQrwWKxWCxWB4ze5anrqnCxYfhyauI7BgY3krjyArVYs3F1E6VPQ/jdqduu32gQlR3bTJ/SWOZ0bA5PiQHrZaDUnFcMcnNzTRs/MHh185BweEkTPolrzbvwsQn79Ixs/cqaMLvG86/CVRozZeVW7jxKIHY0aFnPTIKqx77K1ncZPFqitO4WqxVxUT9703dRkJa4u2fuJQoXKcvOSh6FrPeJf54dJpDuOuO8o5Cgdblny/kjzVCUripfpd2M/JCF9v9Um8HnmqirdodG447JuV1vCDr9HWeFWxL0K/wK3rCEi+O3o53/eBKYWm98taurFS+0r+g8mEOh0CwkzdGLgohsw1ftXgoSDVkSEZYEd5/fN9D414TD9SdUHqk2g70zNfvC6mY0OAiovDhHYzj29LLGMYK5rdVLfB4QXJgKnM2eZNA1sG3421OC1hy7fk9VgtdHLDYWSLThxmr64chfDubnCH6tcKHgsSSsaEU1hV+rI9MDtJoRaXXs4aBHM1YWJCsXODfj775tbl2+NE785x5t3U2QeSPNPHEWjd/fp7SSgrlIml/jv1yORkzbiG/zc4Ih7ua3HbIEbf8cT6a9acOLX81fT0dSCBEPbJ5HUdi3on+rkl8zpPZkK9z72oxH2awMp3fN6Fn2UeD9CxoTk5sLDBbSqF3DZyQvTkNZOy1TeAkPIelEqy6Oxs2bszXurKLREmrgdC+OYL1J6q4ZPwi4vloJ6w+2wy9d7772XIJFpwJWSPg3o+m+9fwbWc2wnR0Tb5XejMvLHRyG3tEwXxzb2SZBY62eo8tnSRqn1yzVMVrOw/d0oXWSIgwSLl2YuTqKxsT195uV59Iw1R2K6llKY3l8nuUsQxrDM2b3Ln7TjpaW3amXeAWYI23yoqG3FuK+Qd151bXo3HF2sff7WrAa9o1PHa7EJ5mTlXxLGpAw/37u0epKRKVyJptnNyF3lqXTXacmROkVO46HNKEwZt5chdAmRI85HX1e0oQQTT7W55ImzHlxZmqvUD0sMg7vq/cuQKcsc9R3bS1Gb654fFqsFldZesdiwyvg2qw4xbtQj1tX9He0SFQhZaF+gv7vRiy93PFKZWcTNuxfr743pwnHX7k3Xclpwpb5pap8fM1Q2H2iQpSvGZz5/+Jy6ToUbfN3OryxDhPyreVv+vMQTX4lrztdA8FTTzO/MRX4+uTBptVldYhpeUVNXqwEVgZGpkg1Qbp+tRlrZxM0Mi7Wyuc04fTcz14mOU3QPi1eVcFqhsqL164drGZ86lrd++tzHf51F5u+FarD9NSNGecO1KLgyO7HjfO/4+HThuzVPhVQusVyiNhYhwfyPmJK/RUYezlPIDqxEdH7B9KsPZoQ+Tti+4GSJhQb1XJulDQh9pmK1hzhZgR1FjdICDej+PyKv2dEqrB+qfas+xsqcdvwy9Au30r8C9h9dNvMSuxJOXtea1sWQq0ebbN6XQV+OVH2a6c2rJBRqhvVJhBeGDH3qylB84HRa6y9BBczHLfZ7SeQWqqUuNyH4Jv6XfHf/gSLHIx3pZs04t61AbWk+AZo9W770dBZj22Nl1/O2VGPIzNulIafr8bXT9+rEjqrsC7P4+bONBrs7ws0qhUorHHRaA1wpbBHru+OwScKI4atVuOSBCxhJQMVRQLtiY1yTmsJvptKkuPpVVDlw/43Y1VYHXb2ggOqcCDu0l3vhEqwWCwWi9WBc+f2bw7vZSCm1P+QsOqwpXRyZdERGg+ZAD7am8apavuyqyM0pslQusUUjYHR3xVS1xmUNM+dHxXM4MO7TdvS3ZqRrr9QK+ZQM95XpF595dWMER+Ply9HmjDYLPhQ9RwNkX6FLhdzgpO++eXf0hhsTvuYvMGrFWs89E1SrlK4s33tiaU/KAwre0xFryTQnM4WcoshSNocdm0OP40ceuGP6+3NWNR+NnPTl2ak7H5jJPC2GW+3vwt2rm3GMtdZutF+NGbft31qHU/Ansm/NruCgZ3imLH0c4K9m1Jln29qw1NUf7liTaHcMT6kM5GChEZXTO4igmUD5b8XXSHI2VCzYqtwC04dTp6fYtCC+SV0/CWtFghMLV2WsL0FAdzxg5WhNNh/913e3EXQo/mz8fI+Lg63PEn7NkpQ47n74/YbBDb/sULzFNvxgE/jqcc6CitjD+XvTqQwutBJ8W8/hZTTMtNRwi04FNPbydrZgn6BDV41Wi2Y0HQc+7e9BQX6d7/oR9Jo88rM6t1L47oQL22RLRfK6wv/DR+moT+zy72yksDz+b5/s2wIDKsf1bieaId92YWO5ckUdiyRn877TUHxhFfAFusWVN01dn98uwW+M0JYnn4tuLA51zc+sAXv5gtbzv9G45+80IezR2mcWD1UrtTFhWx1/ZvUBhorn/1T9Dejcczc8U1mFcFLS9kg/zqCfG1+3J7VAZUPrZnDUgTkpajrKpsWxDV2HIi404Ltxss7j/u3QOfKfueQoBYYLvjwwrSAxq6trk0CTjRUP338O2+YC9PqqcRuEwbmD51HZL7SuGaQNv54NY1iY+dq13U0nqRsjQiyoeHdfGMgR7kDZZOtWyctClGdfzrUml2L0XclYspRdWiZI+wv9rIOCvyTO/ZF1cGU+aSyx7AKVy/cea/qVYW02V+nX9o2Ie39o+eDH5uQ7tXgezqzCfTUgsobes0Q32d94aVeM+bc61/wVa8ZX7drHZuerkP+KxmtAu4P9Gv1bN7WWwvBTX5ZewXrENO3Zcn9oVqsv1KXste7Evfq9i2vyKpCAlfpz4ZdTeh4uG3n8/tNUJ6560lLbxPsb/n9+e3ZjHtthf/JXGrG9WVHtihfakbipgVq3z/WozjE2q+cvx4a52cZvWQXIKxx/6GEu7WQjBL+wLlSi8JjSl+jaivBN6v3isHBKvR8ULsS+KwJvbfblVNKmqA/3BNWv6IZrl6KbX2vmpFcdurGktfNiJ0/U27162YkrBgv9y6sxwZzq/TaJfW4wv/Cho6ug1WH9RPvhfko3aGc0v+tBufzZp7KDqiE7+yz2wXrKxHIu3rhzuYmyN1zWGYu0IylAlt+DKg3wzT03OI5uc24ws74vDG3GR7y5vt1c5txcl9tcXNKPcZ8NrzaNFoHrc3+FnoX63DjZkSey8tarDWq9W9Z9x03F2/2SJSoRIdF7kDZxUroOY7u9PzViA7R5n71h02w/bKha9uFZpyfzNJRa2tGyl4+2q6tGffu/nfjbFszyh/eH34gVA2rp8sSTqyvwgxpBzXz61XIui277bRsFT5pLN8s8l8lvB+xr396kIOgR7FSb5za0PGu3VtqC8GoPl+Ryk6ClETZDf67CNaufza0xY9AWkyyuiGY4Pu6G0bPIgieevBrfDtRjSCtzQvlX1WDzQ3cdVGtGlESc9O+/VcFqaXm0e0rq8BisVgsVgfyrhRO/femDmWu4/+Gr9Bo3dg+0nqdxqqPU12zH9BQFxINvL+JwZWxG1kx2xg4vbg466sBg4DO/aV+xc2wG9SX93vfjJC5ug9jRVqQ1KNwsTq8GRI/0gykZZtR5FIgGH2ShobuaGjOTwYShuLHvDJaYb6zzijsCwXB5shTj0YoJE1+2PXZjoDe9Mx6OIfA0PlicehaGj8nuotNN7aA38VygaRwC6TfLgx6qN2ChV0JH++4tcArTymwYaoZSZKDQaaXaZwTFasKr2awY8hcs7mToDCNG9d8og3dvHdBC85TMDZfiXWtFEzMXGWUtxHYzLa65PuI4HOTG6dvSwuOepstP+/eguPfhWPGklrQ02Yg/uxlC5aOzR7dR7WgZt2iBL/rNH4ZllzzGGJw9N0DqeuKNObvT+1++pDAabgseXJNO2IKrub4naTQfrVa5FI6hTejbefk+Ql0NKhHO2tb0NGYzfkl3IqeZb77Lba34oLWp1qWcSseRb8acbNrxdDM+C//vtOgLwwHSsRy0X3Z5N/daBoxcja/MufTMLlr6JARSWAOl83mSzuwNGpey/b5BPOyGi5yFxDMiuB/2l/bgo+bOpjz81tReCnx8L/trdCMamh8ZtyK44OlY5L2rfhvA4Krimn0JqQYzX7EhV/ssiWjLAYsUWbgv/M0zL5ajAxRBNu1ZExhTOPj9O9TL7Z1IMqv1dRCguBb/X93WutacOGyZ/lRkVZczdYw4KEVA82u36N3tWL5FkeOgEMrlDfxOKKVNGyq8hfefcHF21iDOVvPM1g2y+rbJorG2XdxOq2aNKIsr7s1mNE4PvJsufleGjL/hJ9KH+0An9fLwd0zilFQl1tQ/bMDbmW3DO7XdGDk+YHY9qYObP1xfM9qdgf6t7X+MnJgw2LbiuXHpGiEv/QyGw6jETL/2OU7D2g0SanuoiVpbBibcadgHQ32gb6MQzY0ohq+fzhlQ0Mj7dO16ids+I7UXJa/WgRDvZ7wOc4dGNn6XvTXuQ54SfgdX+TVAQvPbyGBfzsQLTc+pa1EY3x7z6yrITSENYMKj1ylsU59JFbhNA2/RT/aZl2joXbh7awHqTR4v/1nv0iloRYsIHrgDRupBsfWzdNmo+v1PemrV4vgsazBPtqsA3//PU3vsOzAkF5ywJPqDiiqHb3Z8olgmk/ndcsTGr8PBV11CaIhcWBkt4onDWeNH5GiwTSWxrz9/OQ9jfLFAV8y3tM4df3ngO4HNua7nJp1aScbc1ge2U3L2HBI7O5bO7sId/XifLQlO5B24cCUVWwHbPT4KmQNCVxW73yVfIOGo0rl0PBjGq92p509dpPGTGXfdL37NNLDTjc15tBwmqfTzMuhsf9j0eY1X9lgPTpp7GDKxpiaO3/eSjayIi50ljAdOGJw5s05/0KoFdkr8A514M7sZbzpGgqKdWblr+1oyLY1LJr2obGhc/Np5QQaqbyLBz49omFrfNR3dykNkSL167alNN4X5YRrG3CQ7yRc86uWjbVvvn8NeM3G3hyPSp4bG/ucZM4LqrAxX9S1WEW2ELvElCq++lG40B8SPXKYhtvo4laDazSuzPDYdD6ehsM5E+LVQuOe252S6XEapv3HSudP0HA3PD+efIzBmw374z57MMhq9DzM/kujbfuGea5XaXieDnoTNk0gu+OB77unBCwWi8VidUC8lWdzf2sXRDIDm+6kdMF/7+UcUZFuGN1bZP4xrhtCda9bbVf3QHZYLtxldQ80XlyrbXrH4BqfsJLBGwYOpv0R6SUMRI+K24s9YZCrfendGx8GFz/pDPhdY6DyakRVqrAbTisuLcxe3wVnkaCFGxd1YZ1ZyN5syS60ilpoTxp04cVKB5ZgYBf0jAfPfxHsxkr7m0Je3xksOLXGJi6BwcQm1Q3BLxiEWJTNkC9ioKO80TonicH407HIe4kMBF329Xzd2oN9n39FWh3rxpzIxPFukS7IFXk9UX3YifDyMKdzPzvhQD1Yc02oC+MC6xNUbnehL7W2+5YaAz5++7VPQxkwJSf478cw8HVevfNVMgN5M75E/1wGLYXFrQdyGPRobB/Kf9mDEx4rX9882Q3ZCzr/5tBdmDppqdvY1wmjfw0/qQ2dsK/Jjh9y68ShL8m29v2dEPl7aKHgLgZKe+m9q5IYCEr3rhF/xSD50+sz6zIZ7Fr6St2/gEHN3U37eTO4aLErUdYz6MWEWkKnb3Q33mt8UZu9qBv7/l3eJefaBXnJw2/lRjux/8Dtlc/jOqFmuvJsz1Qn7ELm7o+4xOAsHRn9upyBVVqca3wzgz8mtu/ecxncf33oivAwA7zJXh+nwMWs/s7G4dZevIg6HWna0o1T0YHtlfu7MXLAcLg7vQvz/E4U6XC6kHu9pnL3si5w9zWdzFDqwvJNWx/N8GKwLSyPLVvBQN7mV+b8FgZZv0MnFHgM7KxDCq4NM5i5v//SvFVccE827TRo68XLmJ/ffp/swZH/mLledDcYi74JnlY3OtxV1tGm3VCY1e525ko3+D6q9sbJdUFh6MaRkpwSiN+6dCThfQMkk/SDj95ogE/7hXlW/zWgf8ashR43GvBZSa9xVmYDYh+9PlBYUIM7rw9bvZWvxbZjyoyDaQtuml3TOWPUgn2mgwbjT1tguvvb5skNrdh+26Zqnkor+K28Ds52aIKOTZrf1e0l2Lef0rG7UQ/1PtvRrjUN2BTyoKlTuwGnNyV/lPJugGXJ1eznM2vRuaqlFagFv17sWfNlLfD7z2/5qrMtsI4dVXxf2gLjuPzFH6xaodVs+77AqhW3xMtOuq9pAmvv7aJW2UZUn7i9/E/1LzyS7jtif7ke8w7JEou79aiyiS1yqqrHhj+2b2x31mBL2sfkDV618BQ3CG+ebsZfFxX9d+YtyD/92FgnrQVfzrht2aHRilevBOssNFrRsu+3yOHtTVCpdffMLG6E1Z94Q8P5jTgW5rkgiPzEzzu7Lgssq0f96SmtJU718H7Cb/f6WQ1E6zRPaa2vxcdZfs1ax1rQ9Ohi/6PgFjxL6hxW6WpBwuMPlOq5VoRT5td3nGtFlkbl7lzZJph7QPBcQyNmXr+ivmR5I4zsneqY5AYkNCy7yWwrRvLSRd/e5tSBP9v+3errNUh8cuj7fw012DDzqekEqwWJoy5hXZUteICsRzlzW3FXNzji+91WBFxfsaHqbiuS15z4Zm/VhE0bFVk6Ek2Yt+bF1rojjXDJ/ear1NcAt7Ht32S3N2DDmNGOgIBClHcrd5rY1aA6ds0rk/waJI5vzMv51QzR/lH+tActMH51YYeQZyv6a/rvPWxrBXfRLdXXba14OaOjzvN5Lc7F2HSod9Xi0biYQJZELV7Gp5lsuViLMMeZH1y31cJFTnDu4jm1SA05nPhEvBAsFovFYnUgesQg4H02BTuh+5U6tRQWRgl/4IxTwOort1o3E7T7LXkkoEXglz/DgjO3DgvP81+4saoOxwQ/t3U41OHwqUrr8hl1oISe/VcTXQvvn37Rn87VQj/ngr9KSQd+f9X0vy/dCOfwp96le2gUvTKRv3mQRlbv+NvBSBoSM4pelq1gwDe+8YTUOgamm7eJRi9sQ2lp8PdITisqnC/f/p7bio8ic3bMXNmGRS0KTw0/tyKDFxJT7tsKh2rf4yvrCUJdxFbevsVAn1xW/3KlHet3HRSuA8Gy/r1/Au4RLEuoXbpynEDWzNBy5yEaDiFDwbfF2nDkhUMPa2sbjhnu+bRBsg0Rd7RsCi3aQJmR3pGoNtw8uKvd+3AbpE+8FcEgwYWH51qT7zNYLKKRkr2SxlqTy8f1F3bgR9KBVXIsAuXxBCr3BEH3ZyX37DyCwZjZfWaX29D/6bx5zqM2dO87vrT/ehvk4s0/B3xug6Zh3KsV9W3Q2MB4Zu9oR3Jik9XJZTSc9ebLqTcwKL17vCTiGg3NqKvC6wnBpOizpM7zHci5fP2NqydB5L5zAnQOgW1W/LX6+e2wbxXqMNZoh4PX+ItLi9txJ5fZJWrejjenVbe/Ot0O/3nXTS9+aId+XiAv057G9oG8B36eXMwc6NrN303jwtCM8IQ9NH6vV09jjtPYfvw51TfUgQ1vBO4/lqIRe8nor7BoOyJjPhzw3dKO2M1PFNIk2lF0NSJ/55528Fa1fqRc2pFTuPbHy4/tcLTZ9zbgBI1rN2Tu3PTl4tnTetrPgsEtPaTnptNYUnbSfU06DXNu/2WZRQxsRxxX1C1kQ2gtx+/x+l8I2BNzd3R1AyL2OC3brdyA2JObDMXG63FROaNnOa8e2Pf7XcayBoQVJ+75LlqDfMcJek95I0STZ4i6e9eAPlP4PfhTM966bC1asqQFz88KfJRKbEHc68R9axJboJDN1F2saMTjy/9C5FN/4mJy/q0Lb+rh8zX83ZZr9SD/cjevvV8PqcfFYodr61G+pnv9CeManHS6c0AnqRGpnvuqxe/VoGRP2Obed82IN2dtvbKgBYIXJms23WvBxPv75/TutSAvkvM4r6oRD87/l3HfvBHpPyuvffr1E6+fN4SflKnHwk7BhYfU6tFO3xx1u1CP1p82xUfP1MCTKIf5cBqxxSrBLYWuQaOzsZTkj2a8PPlV9qlCC5bczvxs/7QFImV797s+bYHJPo136+MbIa3Wn8cn1Yiviz9usH/TAFJYqLTA8SdaMn11v/jXYY+G4qPs6jr8fTXlaZhbDbbBrR0vdjSieEp5nfumWgzVa5Qyb5uRW/e86rxYC1Qmnp5RjmyB/DZdIe3IFuStUNj98mYjarNL3lUpN8Ij6d4ylfwGmExvHHwi2IBXlvfGn4oW4/1p/msmJnUYaLBZaWVQg8nJ+mWrchpgW27uV9Fag4x160+vH2vGx/PXfGeqtmDVf1eWZqW3YHHTosyS9BakLP6ctSuzER+93z9LdGnE+V35y/v4GzFnd+xfvv0NOPT79OrPP+rhNN/3VoFTIbK9vFbNNKnBH2lUVDg3IHGradq/4hq08B2mzuY3QzKnUf7XwxZsFfKTE2e1Yp2JzLeVrFZ4qb/0PMSrgW7VOwkB+VpMLP1PcfuOWnTEraHkbtagUHVoQm1mLYwf6C7Sn6hB3lJ2VI5zAaoXnf9937cBLBaLxWJ1YMl4yLXdHzgwPHFef9YQB4Vvx479k6JwnR3wr1yOAtZuUTO60QyJ4ytj9S2asW8POXLnXjOMDwtUxnU1YUBNfzTVvgnDXmsfr5zZBJcybsGBqEbo7FR7POxci8GzCe8GFtO4MmNx2L5gCorctsdRPRTOsvVq/5kTuN7JmCfzhmDs5UfzSwdr8X2KJWdzthZE5Vv+r5xanO3exOiI1uFPqNCanSm1WGXyduFT31okPKv6rF/SgT86FdwWUwbuG/bqyj5qwJ73p6hVrQRFVz7KxS6mIR9+c+nFJzREb/XW5L6m4aX5rYlcaUXOh/kh+hdbgctRn7YFtWKOTeKRbedacUDc+O+pD61Im7boPuvdisTAH2tUv1LgS7rYkHaa4OCRJeHWIgwKFmhUpxxtR/TSxefGminsWknZy+sQzCIuvcviCQr8hZMek1aI8NtZ8Le3wu8HuTY+0Ir9j/sVx5ta4WamtTRmtBUKC5W4acfawG028VnOIthVp5JzqZqguEnlFHcPg7jtAft/ZxEMhM0KHl3QAQmuyzc7LQJejq14jR/Bg5HDXgu92lCeYrrjvUsbjJ2rXV9ea8OSnW1LXp5sw8YBpXnG/m3IOrtU+nZFG+6czbrK20CwI2bQInwbDb/jR3JXDjDY+suh4pcijftbdx/boUBD8Mf8xJthHfjhVnbL4D7BrzcCv40vt4HvyOiXrjNt8F15rZPj0wZDvlt3Oc5tcHpR4+8T0IYBpR9R+ZVtMLUcuGWvTsA1b+OJPabx68/n9BVjDCyl43/3BtP42OirEOBBw9nzsUpsEA0Fm5/tp153oNbAQE9VvxgztnU+2XO8Hou2fVJ9erIeUd/r2dI76pHQ9Hhuh0g9ah/VuJ4wqUflQevzVbLVWFr89ufjJY1QeaJ9VUqnERqxa/akJFcj/32gOX9REzSa/Lq+GDdjtdSyoF/GzcjZL7mPzdcISD6csJAqxieayXSSqkcRnWHwbVk9Sievn73eUwcPw6tdKSr1KAoQXysSUQ0fucUH10k24s72oHlako0I2nJGpLSnGhqHmx8qWDbjyfUGzbaaZtzPda/vrWmGg7K4/PqZjYi05878/L4BZs4X+nnaxRipnlzRl1WHqe5i07chddgcrF7xuaIOib6Pit9nVONW/Puw0wcbEW+jP3RiXSMkF396eXh7DXZdiPazONGMjP/uyc7lNeNZk3KWJK8ZCncbOcKNDYjyiZPs/dAAtSPH/HeZNWBQRfLj479FuPL+yufXM+vQu3H50vS9dRhorJGnuqtxXcfvy8PcBmj8TlhjbtII4Z8XN2w9XwP3N8yytv3NaK2lMu83NaNM3NvmSVMz3uxdP3jpcQNkdQYU919vQLb8p8gMiQYsXHUgvymgHqcFta9mxRbhyM8feQIfavFJY0O5n0M1ZIvqTPdubEDT5aEvM740wHY/hJu4NVBgLXi71qoZEQoipqSuGX6OqZ2/65qxQbU6a0NbAyK6uFe2FzRg38fR4ZDDDag4n/NLrbYehektpz+ur8ebmbtPL9AvxN8uM6XCBTXotlZRMWupR5KgacKkYwOS8rS2zKypgZ/Jmb1Duc1IuS9hsW1FC2Lq3vSZrGjBr4t+g3c51Thf8d8msUM1mPYuUv5ztQbbH14JdHpbgxUHdI7NFq/B64gZa4YKa8DLWDz1NS8Php3Vh69ZNaBCkDfjWGQTWCwWi8XqgPHm/8IP1rDhXpqWxJPiwLBi3QdnOQ4igq1/3shvQvUiW9aWwiaI8ckU35jbjA3Cy80znJqQVFoXsbasEVoFUwZjHo1QeD/Tyte1EYoq3n+i19bC0Xx31sFGNrY+Dz2+ZgGN7xcerFj7nIKgG59VuDDB3GUeoe/OEFiOfYyt7GtCZ3jTDMfCJjjvO6Ji29gE6aLsA79Hm3An6PwDZ7smKPs+2KMxswn3qOq/3xuaMU/8Xd2WVxy411w8vPN2LXxiKt+fTSXoErreX25NYdNsRk7tM4WN723220gRrJq39bqZaw22FXD3/ve5BodHk0fnC9ci4knc1kc6tRB3si1d/6kWBgr8H+9E1kI0p2n8bEkHanYq1nXsYuC/uid9bwMNlUMp6yc2NSD8Ld9mySAC973euyckaNibcAWM5Gh0RO/81XWjBeuueURlLm7FG91/39+uboVi3fHvwkta8dZP4JfPwlYc2zVyP9qnFXxDtQa5vzgYK9wzI/UOQVNqv0uNBkHa3pDomA80ZBYdEZK3a0eB0MOpWcsJ7tO3ra6cJnDwcpONE23FS/v4TufkVqisWClx9EMrUj7eXvwtpRUbT2yUVUlqRR5ZvuFCTytC+IavP1xKwUopzSD7L8HGubvfdCcS9LzZElBrxeDx9n+D7nNp0KyRqTilDiTzahqvHCMI3bw0tlKsFe1SRscSU1ph31YWHvOxFc3nNMOHn7TCWb4l5sSjVgwnlKa+6G0FWz2sOluewmBHXIuOPY3S3K2GZwRoKOvdvVRjx+Br67r3GftpdG9cvjR9L41Zd99sv7mrA27t/IrLlAox6hVkrB9dh1mXbWr/i6tDMMvTT/RCHaafDGzw31kHj0di0t9O1WHhAiH9W2urQF/L0I440IDxQ7r9Atcb8DONG9fM1IO/MNQzg12FBwl5hQ/9m3Cn/bDja/8mrNyellC/rgE17w53aE//wMsVAYcPmdUhc4UV761FHbY/Px+tuLYOooaZ9yfN61Af3TV1Nr4KxnWSpZIHG3BgOMRn9cEGXN3hlHfIqgGWJ8rVW5WqMd9QQiJXtBnTAW/eV4g2o3DBC5eVGxowqe+95017PXpnbK6uWV6ITrH4H+yBWrjGfL1g87MWNbc8ri4YqMWl7uikrO9V2Gf5iXUgrgHOwkaO+840IPjQimeBJxvA05OqcblSjaUO1RM9K5oxL9Hlv4kVzQjzdF0/c2YDJo5vdKTY9YjdOVahc6ke4T/r/7vT+gO9DmepfXq1CN1oXzj3XC0iHnZN3hurgumfwPWhMxrA9+xx/LbQBmTcP6e36FADGkYbrHe9rYZ+3PJor8XNUG/+svnW4mbYL09ccaCiHtfnbAz+/qEeCl1/R2TN6nG1L3aVwdc68Hn+ffb79g+o0WcPpY/W4M65in2+e6oxyLlGJs/V42nUf5avJ+pRv8nR5sqNBuhlPGZrzarBn/o5wv5izeiSePT8nlgzWl5iQ9BoPQwd9/Xeb6uHkOrxE+RCPXz712nf7qvDleNT7San6+CaIWZP4Qdi9w+kWf+uQqHMy4rLi+txtaIQaxPr8ersXb2/Cg0Izzz/8sT3GrQ0zf8Xp9uMUqnU6Be6zehoO/ufRXMVLB/8+uG9rxqvX+GFqGc1vm7U7N/wshoz1KViDvPX4F5n+2BVTDU6Xr/KWx6Ri4ZaErl1TgPcN0l4jixtgmv0r7ZdNi1gsVgsFqsDgd0vO2rk2PDMlfTgW8XGvRfkxjFWE5rVuj8t4W+C+PIX14/tbMKm9W8E7j9uhO21v7Ny5jZicymz0Tm1AbKLPnzHykbY7ym5F/qqBsZuIg/XubBxq++14+EmNvQ2uK0Zk6Jxbl2z0YXXFC42mfpGzyewlNq5992aJvS+PfnFgL8Jxy/087TFm7Ci02xBzaYmGGneF536rxFKQX7bnm5pBOt5mGyPfTPq9epqfm/j4Nlwwb+torVYIfDC/1cUG8tkfJw+vyWwSDy6+P4hCnt215h9+kKBT9x6blZgIxJ03buWTjVCdtZfiUWiTYiKPSrhNt2IhQoF1b2rmtDvkT59P78RbqVBFYGFrYCOUaj+Gw5eNt/tTXbiIM9w4bwGr1oIP45vp7UJjg317gka4mDFO882f3MKjYnsW0/jq9F/4M6hYV41FoWaspy1aqCvpac3cbYG25QdFi//XoMHpeIqd4Jq8VK6N/BLSQe2DHZzftswuF51+RWHpvHyDtq2nKSRKVvn+OBtPWzH1hx4KUxjgYH0Dr7FNIzF6xdsNmhBzDh/64zGFshmB88d72rBPcsRQZumFlROH3vwX1ULCrfp3MasVrz0XXe1T5iDW0sO77scTSBRH5+zXIfAeGbooHAlBZ8FbhJnTRhkZx15uSK+HZ89Njhv3k7gW3E99NTOFlR8eG2t2dQCo8tqfht7WpA/P/FmWHMLZn2Zv7iyugWjo10CF2e34r/Z6xZ8XsjBygqj7vFJgszbwTbJTwgOWxUtUtlMkJ9cLHhmHwN1a5P1UetoDLc3rB1qa4ew1/e5c+cXwGeCzUNxLSIm3py/X1oLiZsVK+Y9rUWA0q5y30u1cExfsv10Yi00Go9HmqlXwsjL41VAbD3srKftJnPqofw8RahyVT0qs6o+fnCoR8Vw38OCZVXoT47bEZHbiD2zYV9gXY8LpkvzlUg+Nj9pH7f2qYXBk9eBr27W4te6MvUVtrWYsz/n03P/WuR+Or63JbUSzyvqt4n8V49vfcb9Mv/Vo22bwiaLqHrc2LO3Xb28HnL3Ntr1nqjCaPiz219MmtDftHXdggP1kBMWFn0oWI+gB0MfcgQKcPHeH8fW5bUYk1osbjlRg6L/Lj/MVqiF8gnVseqySnwzu+i2u7keJQKza41f1WPIir3EO7UeEc7zC45y6pHufnX86qcqCD53PM+1a8JXTPcOKtdDQTl3ZaVQPXLm3wpf/6gOq4/fv3blez4uU4U39lyogd+OE50fn9ZAzCPlwL7ZVXg1YPDw+o56nHp8RFi1pB6LI1TSBePq8TnZ1r6/ph6PFbSuHeyrwkb6/fzzFk3w79pA7fxXh7SKHOM3HXXY8y4oQ9izDo8/KDGqTC0eiH4Ma/fIh9KfC94OyjVoSHzxnmdbhX2t2/b9flcHwfD9eY+31WPr+hWFbt/rcYatV/uvvB6i1CxB0x3VYMvH+fmYNsHC/kWt/Yp6ZErkRJ6dWw8vUj7va1IdxpNiKEepOvQuPhWwIroWAitKQyXV8rFk0jpzDlWFNd7zHdfb1IG9YU1NW0cdxMqG61+cqIe2le4Ae2UDPBc3+ha1V+On2GGrfVea8F7J8qNAdSVGd/0pZZtWQTHnzYPlblVgpQ787E+pgrToErufk1WYJfzPryygGm/Mby0Qy/qGm2mCvfr36+H+YN4WoZ+NkHVM/Lr+ZzMGVz/Zbv2tFSwWi8VidUAjIbTJs6EDtmkuaVpajYjR9KAHtBvRorDptdb1Roxs3LL5bFsDVtzwVY0xaMB7hxlSI1Q9dHYz7XxvG3Dfbemm6xtqsCai4trUVAdcRNx2rj/DhuWZYfepVjbM31sOFK6g0X5/1fmraRSWLiu7FuzYiJcZA1yZ7Y3Y6OUpvnhPI9g9tdtfnm2EqPYy7braBiQtTVy56XwD/n7XvpMi0oxX5/5VvS5hg1okHrvhbg0EVruciF/Nxu1uR0u3WDYUHx1VvvWZ4Ovc17rxhyn8WtKmHvW9AUcMxhYPb2kEe46vfs+uRuyP79Iz2tqI+tVmrB9HGuEsUM5dzmpEbEi6kLBGK0RcXYKnwcGVuR+77UfZ+Od09HnJeA3mlG5qTtZlI22d3azNhgQ6psPVzAAHPdZdV0NnNOLmzVm23ZcaIWQYGkwFN8K3+c8tXG5EVqCj9M1zjYisSWw6/7MRMy/0P/eyaofrjr1++h85YLc+oJPPcsB8+aa1R4yDhdFXdnlH1WLw2ybfd84Ex4KrNslNcTBnTqrHyZIqyOVaGWRIVGP77wVdSqeqce6RoHJWfDVO3VvFogaqseOExsHOuzW4zrntp1XagZaZOg5bHRksZ8rWKP2mwX1kazRxgUZzF5mn9oBGo8+sDbO06mFcI1s1OY+GswvPh/9KM3zVRL4VC7dg+m9Ud65sCy6EsbplRFqQoenqfUSgBYfOS4zVq7dAw2X+/rYcNvZnj4x9eEAw4K1xwt6AwGj5EgWnagq9jx7JS3IofFZYveCMCQPFszUbbyq1I5/1T6dcuABK/Duk3XNrsZVfLLWwoBa5t1RstBNqsVHl+Yx3brUQ+3zJ/UFMLRJLygRy5Ctx3FiVMfKpR8rNTsUDzfU47pqy9qxRPa4/meE392o9FD9vUBnIqIeF56WGH8uqcGAqzvTc7nqsq9n7r4/KR+tLbAjyrEXfy/lf2q7U4uKm9af2WdUizU6up9m7Fr9n9f8cvVeJn4n3L1T61MNrid2c7+/q8Wpnu/mfjHqUeG9LvdtZD7cI532XVjTg8eIv0X0nqjBZFS2ZvaceyrNOTe7iq4fOyjW9/gIFkIuzjp2/tBbJ8heN/IZr4JHUVDkgUwtl4dYLMV8qUS+j9kw0tx5BG6X6egvqkW0fp7a8uB6N9/+GfZuox7XnCluT1RuwaXiW2rVPVcjVONz8UKEeGxSWLbjGX4/q2Yx3c2wdSHfpe53v+VjWpfjh+pkabDD+Id2ZUINWD7em3N+VKMmZWa+3uh5ypRdW1ZF6cP7rKn/9pR78VYarTfrrkUqfr1FRasCK1PyPh/qqEMkJrfg9WIf3RTIatg112P+cfvT5bB3evA6obm+txf6Yvrxkj3xUTtS+iVCogfP4fc1pkyqcfTUemhFTh8JMlxZDq3rM2dJKiVP1+Dh//UPrrnocWn5o17aVDQh3vLPDbEc1bG3m5M1bWo9fIjLXl86oR2DLun8nY+swHTtSsWBBHcbmF3pU3K5FzvOZeW2b8+H59uiOosoqGJiVxg9sq8PDA9t7b4zVobX+9fCewHrMtR+fF2jQgO0LpJeKWTbgb8zRz8Xt1SisErp3KKsSzTYj9yiNKjSo/DGrOlSFoKtXT5jdrcIZoy6qtqsKis8WjASer4ac/d9rcV+/Qe9P2ZeBW/WY3jY01+FbI9ztn0Suy26GmHeJ5GR6KyJymV2i5m1gsVgsFqsDs+aU6xfLNsLgbu3la8sb4XctSq/YsRGJwf8Vj39vQEkTp1BhTQPsM4+n3Siqx4NW47zuxw1YfT7y6IWBauz3jqzd/KsDTZ1Shre2sZFtmHDuwCs2Dtz8kZCyjINGf/nZElE0NM9vnjP/RiMMv4uxbu1vRLF/jPL1U424OfbHWuJWI14aw0p3oAFYnbvt4e0GSH/gHtbmb0aKRfpT1Rg2eOIRXyVDaiA4M/+Q6jw27hRmGc3yZkOJn75g0cdGuoeKXsNaGlZaNXMjmQak7+q06zzUCI35bqHsM4149agpRNu2ESvXaxt98WtE1rzsDUtWNCKK8zhv79pWJCmc2v51PgfitZILS5vY+Hjk1aTOZA3EYu32blViQ+yasUTIf2zEiIrPf/ye4NkZ/dklso2Y6X3g4PKoRoTqdARKPW0Ef+2uwIv3G6FzPVPy8+1GrIyzyj7V24iygHXLzu5qx6MD7wL4AjgQFjx6aMFeDgJqpje3/GXDcuGe+qH7tXg6kd8o+I0N9T1Vb45lE9zB5p9rHBrB3W287HptI5wWVJh4cRvR+mSrSW1dI+Qsn7UuKW9EQZjFgTtTjUj+vnDo3u92hNes0/0zyQHLr0Vc4CcHHuaX+yvcOJBMvy6tGc1Be9bhUwNb6vD7SLLlNQ5B+/qHVUOlVRjkmiRDshoSUoLG709Xw6R4OsQwoRq6X6TtzwxVY1GYcqbHvRoMjAi+Z5V1IM90u2lrBwOh9aWLS84y+LjZdJ1tLw3l0SXnXozRuFp9deKeHAOLc5EmNyLqMS5Md8jPeYtjy32drKQrEHtWzi53cwVqds/nvaysQAm34ECUZyXezLIe8R6oQmHuuQqPozW4cKFv/n9ObXh0zmGHhxSFp9sGlux7zEEPWar57yUHKU7Xcq5mcvB6udzedR0cbBP3OcbrqIBA43wq9WgV3pf9Pvh6PxvllqoLrQ6w8fxJQu5CGzZipbaVmXDY4Do5bvmkz4GljWD8myIGKwc3r0zcSsHl4GBiLyi0ckaEThpRMPtDal5YURgc2R0mc5nCrWNZQ2p/KiAQdlZI9hQH3NWpQ/GSVbB5ntA/VtMBse3s3pV1HYhd0GFke4SNTnvB9OI8NtwsOm1FPjE4+S/l0dASCunHjsrqLKOwoudE8OdVFO5MW1lObaKwZTpbyG0/BfOSzGGF2EqI3K7Weu7MAb/PKaP4ZjYKDDNCFmlWYXZV1/W1Dzpw+0lnyzFFNtp/LzCsuMVGV6Fwa9V2BvpbF/E2bKAgySfd3yZN4e4tuYMHFChMPRBZEa9K4ekDr+b5NhQUmozfcw2qsOpedavSCQ5s37/mJDSzYaTfqij+gI25Q/FfP6tUwTPsvN+D5g4UNMX3zdnOBp+Z2iOpHzQs9O//96aGg5RFAnsiN1H4PDxHTlSewto5f3mW6ylUCRxNKthLYd3SkKc2ddXYfeuh5/Z/HNx85nX1vSsH7pqpuUoqHHTLb1uzpI0N6c1JAbWRVdhwYHdYzkI2ei4Vmmqfo8GmtfXfxHGg9uH12N1/HLxPcYp/dJhCnui+X7zdFE6Lfrt50INCv9Jq3n67WvDfNkorOUZB8/WK9zsaOVi1fe/Yx1gOklcxR54YcrAjxDJWeykHMc9mWO36rwpf46/V3/5HcKTrGN28nYOMzzX/fYvnQON52PayaQ72pRqvPnqUAt/VGNulbhRYLBaLxepA0uXIsCXLebA7VThFi/Dgmet1+FUoF8tmPm/iCnLxbGDzRq03DD7+l/TZZz0Dzaf95/MuVWOqbKA7eQONfRtvnwx+R8NicsmiteoMOuqfzNrzmcHCXA3tse1ceMl6Z/0Eges2terbrgQF235JyZgQyP0Tfip9lODhKeZ9EYtgu01MhEwBhZGYZV/2HKFwMPyt3GIvBgllpw9/bW6GQOCP0pAWDlZE122zMqOw7Fzc0qRvFFh7u89OqhLUH3m+8s83Cgkla1+3nycYL8kdObOHoGdo2fIGM4IYorPE3JFgXeXQ8GMlgqYFXsvqCyioca1F6JlcbEkcIk9mEdxrqk6mbVugPJ+e5udxcCrtet/eAxRM7lTqppVS8JrefYv1k0Kfou78X9cIdq6utzpwkAC7N9/IsyE45LVObvlegp2LXVrz7xL02AzN/rue4HCM9sjzu1wY2SqunnuNwKIo8f4FMYKle1JV1fhbIcv/yVnDmYJj6K99Ma0UHiaFn9ZtoLD+3ZfWqTCCm+8sFMKcCa41vLYYPUEQyf+03+oIwd/Gr/s6/AjYOUu2fYwhKBE4fGKePA8G2wU/CY0SGDy7scozmGBEwE5T/QHB96df3gylt+LwmiCR4pkEvR+f31rET6D4q6/r3geC27/8VFfcJbg8yLYLu0NwR7ppovsWQd9Q/5GLrwiOLWta2J5KcOjXzpnL3/AQEXPL7IQHjXv8/F8rZ9PYebStLHwJjSoq5M0DPRrn+jf88vJsQ35DKOu2ZzaiecNRPhJlsLlICatH1EJRLVU9/m4tNE/Yrd11ohYbZa42lq2ug6ThrYlRvjqcfph5SnBZGX65nvRZrNSALbem/Xv5G/Bra6Us3/YGHO95lLnoUgO2mE2J/fepAWT2i7dGa8txeoPMzpmib+E0Zn6xYFYFaoTntu9PrIC2dLmu1uZK5LX0JOpFVEE4WWxRuXMNLlzom/+fUxs8a+R+3ZSi8PtSms9WIQoa8s0JHYsoDD7WVjwmT2HMrv3TAiMKbUv2SJek1+GYpGxc4PcKrO4+Lzn3RyXeyq2yEa3uwIn3TlZaNR0YCjrxxsOeDSHP/5RUytgQbuN8NExlcOHoZh+1dRSEH1N3n0hQ8FnLm5STo9C/vf7HcWUKMdt1feosKPCkpLw57+qgTVu9n3esEmteSc+80sTGvLk33vnSldhv5N6p+18HuNJu+pcV2GAJJWep32Pj1j4BDaENDCwePco/t4OCh078UYPFFDqLkkqzl1HY2xJ+n38dhenmf7sv7KGw/dRe+Ru+dVBy3rfVfqISNzkOD72b2Dh77g419B8bPiZiARdbKlHKP979rrED3YPrHV2N2HjQuH5i7wcaB1+u9PzN5WDSMihvbB2FpZuDIy9KUQgx9Dr8WZGCiiG1aJ0phZlrxQxmmddjff57hcmz1fj0IEXY/ywHSetq9v5bz8EK8ZNfeC1sKFUYdY9rV8HSrZ1fcRkbzeanlTT0aczKZDaPRnLw2GHX9ckGDpT28CsIa1FQ+9K/11KZAufzAaECCwqRbKuAVQr1SA84mZ98ohYadPrmCoqDFRc4XgbJHLywvcjZtJcDs0Db/iwFDmZO0q8uJFThj8G1gm89BH9Kz6Tb2nAQdaFy+tgDDhY4Bj/2mOJA7djOMIEjFNrVo77nnKVwQ/xdcbxnOVgsFovF6sDWjCDpeSweds/Tulx9lYvx3af/Cv9jQDxvxwb6MJC4u2DnJ3kGr+fdlE10r0bjAuHzB2bT2HH/UYKVMw1LlbnWCT00ZjSe33vGjYFZOOde9jCDbrfrHYLejdDM+Db4EwTDG++/32hPYNDfoLPxIkG+/dvdrYsIDgiwDpd8ofDM2OrsxBUKZl3mvid3M0jVUHm2en4LGmUfa79o4uDvhvSX500oDM69FJLzlUJFV2Gb9EaCia37WrKtG5Fw/dSqSxsIJvIkNlkaE/R1nrfP2klwv/m+1WY7Armb9NEVswhuFmy9YFlAQWzhvMzoTwxuy7Zc9+AneLBzdcSjmBZcXcXv3lLFwdfCBPu1+hQSn/a/C/xEYeHfkFfzYxqxQ1Yk5MZWAlNZ5cjtlgQwCM5O3UOw3+2GrYAJwY4iOxP9swS35763Pb+eoP6Eqe7snVwktYgW1F0h6I1aeljhHwUFQ9JXrtmK80vrmkT2UchM7ixwK6IQ3i4RGtHYiKO5e87kGRH4Pnt69NxRAt/ymXda7Anu/ZtYp3WQYDAk7bu9A4GPR9PdTTEEtZfy94nWcHE7a2hfwzBB68XJ7lUeBEG1DVO0H8G8XP/2z3QrXux44pvUTyFc0Wyn1/ImfKw/FXLfgyD4e5PrnBACL57Gg3NBBPcWqmytvEHQk8wpHwkiEKiMO3UolUCofU5U2VEeeiQ8ulTdaWyu9L5+vZ+AveHAPR0+Gi5vZ180XUdD6ft6kezHbVCJthfv9sxG1hvzg3vTqrHg11w5xZpSvBA8+umTey1Edhh7N2+rBd/YibUO07XgLT+/+1ZDLV6suVovdaQMtUGF35YrNUD7kV3BGH8DWixV7IW3N8Bj1owxuUsN0Dtha/HkUwM+b9zxqeZJDQ6rXFjl9e0bht/+O+y7ugw/TokcsCyvwYrHf2Qu/lcDmYOLSv4drkVxzamVMV212Mu7clXwaRmKzP2fX5/dAF/B4a1iGg2Q/FJQs9K9AZ9cos9uedGAm6VDP7K6GzBd9PPrM04ZFrKP7IvcVA7f0HNqXYlv8HT5FtkNhhW4avjf0BHBSowqJxErmypcEpz1+8NUNUZGPY3inNqw2OzJq1eCFIIHNx89/o+DuliHG3NnUgiVzhMME6Vwr/543DYNCj7GEiEH79RB6t6TShF2LXqP67xP5K/E8m47lvnnSjSt3CGx4XYHgoNd3ZUk2Ui59LiLDmSjRXDdjBYxBg6mV/5u1qEwfbNgeaooBdd5P8OWS1KgZDOtT6yiECa7QqRhF4V3N9o7UyzrYFl6VuVQSi2sDksc315eiY748KDPd9i4+2rlRp2nleieuytlxs8O8I95L8vewsbwbcvCFbE02P2mGtYcDoKj39w8rEghyfOdZeMiCiIhSdIyyyl8Chaqvb2Two4bjW6rl9ejreTkx1aPOtSPurws2VgNRTf3AJ8VHJxXyv3TVcrGojbZR8cEqlCo/STm7FAHNs8KHxZQpBEuW3B7UTAHGwtXN0uVc5D+gX1tzUYK+fJlL91WUDgtv8WRY0hho1/d2Ia/dch8ceM/UcF6eG3yjjs4XIP9bYcqs2I4SIl+oaukx8GXy+0qm/6ysc1JviXmRBV2jT8Kv1NOUFsvbP5JjQNPH/e4b5c5+HN2DcpaOBA5w6w+CgpfddTslppReGTNmXkypxzSa1vDyqfLwWKxWCxWB+xUis6n2XExde7vUGczg201Z3cfO8LAcd1/oUH8DJKr0je43q7GvCdrf/V1EUSMxvPdM6PxwZ7zPaCCxtU6pZ+Tlgw4h85LjNUz4A/ukF3s3Qgz1qutvfsakXaDT+SuMoGvyofo2ecJfrjverVBhOD5q2vG599S+LVVojrXhoLkqu9rS1QZSCx1Uw6c3YK9x4882lHBgdt5d7F5uhSczdUHbN5R2LMhQO/9KgKRfelLS6wbUT45rHw4pBHC5q/0IEMwMi78TUSX4L/eDe8D9hN4Kh3f8+sfhYcfJhqXJFG4MFg/O/kBgzC1mPv2fym0yDzbcSesBXync2s9ijjYNs8u/7smhaU9ryRkX1OQFevukYppRMfnddPPSxrxscci1Xg1wS6bFcvGdxFY3lj69YABgc/Z1i09xwiej1/4rjmXoIXrvvGLEheJQyWN390Iel/Uu4n1U3B7Zur235pWdNvfw1NjCps6n66Z843Cvb8Xf8U3NsLm0adW5flNqL8dGRysThDCju9df5Dg3rz7IWH7CLaorn8jYE1w7/iD0b8eBC4P9LhnPnJxu/q1c34nQWtoJmvhSYKg7nsLq7wIHhpcrX5X2YrVgcfpLTSFJzov/f2XN8Gpqzda3KYJq1JepdoeIbj2r+Jf/g2C2wrFMSu8CTabHMmKv0aw7LttuZwfgWoKu7jGgIce5Q3Ty07Q2MzVvH+GELANxJ+t+0PgUvg9eIs8DVHWwSWbg9tA1F80X/mXiwmBw/59vjW4tm73nD1mNViZ0+fAuVoOd2/VQn++OoxfmntuQV4d3v4OqyuOqsPa287HrRUqQJ5OyPsqNaL7+KxNZ342wG9xfYnvzEbIFKWeuLe9Eb2rZ2ZL+jRi+1zTicf+tfDiVyff43NhaSgcebCoGvOetsVrVZYjrK3dSc6+FkubPi54JVeHnH3zT+96XYcJl84PZkYVaD77Wbh9diMUS/5L9ZtsQKG3y44YjUbYbNje8sK9EUrhsZs3vmtESaaCOWVWiwvDa01kvtXgyNHdLN/1udjHaq0ZWl4GF1quofpNDdQeZckeOVeLZulFl6ama3E+brg8/mYFPiY8vTuP1wDByUDld20NePz6QGHBkkZsc1h7pHFvI+blBmTaRTaiJnfekbR9FWgya1i30rsCcTdunry1ohxdR0+2uYlmwLpDdlRqVSW2znwVmHGuCtfm3+vbsqQGFy7z3Xjg1IbzF7J3bzGk4PxGLU5PmcKr/Ue+dKpTONWct8NFj8LZ+441S09RqBgP9HCUrceFnRKfpdzr8CLe4tf5jFqkVzLN9XQlaFH5x6Ojlbiz/I2c9lw2ypIWxH65xEbvT174GKFxhjkrztKgsOnHHL+XVyiYPpm7T8mZQm7mmPSZixScM4/Vtv1HQabtytn2nnp8XC2UcW97Pfh9DX703ahD6OijV3MMq/EpExXKohzkujof+GxdhQs6lPhsFTZOa+wSinCjoZGqHn+3nYPxwAohrKRw2fNW2u4ACvc4F1rvXaKgxWmPmh1PwTFn7amOL/Ww2+kw/qO6HhHx/82TX1KPQRtRkfcCtfiHH8+U/Dl4Kdirf1+Dg06tWt3W9CrU7IreFzNF0GRxVeJdMAfOY2f3K5RwwOUpTmxeT2E2l6KLwim8+ay69MZDChLe8wxTmApYcW8JHZhdCeME18VJOyrBYrFYLFYHqoz6tv9cy4V5bOqfV68ZVC7oyl69mcHzEqGhZt8a9KT9OmFsTGPt7aerF6vSOJ1fNPz2PY2dgr2zqzQY5B5eZH/xC4PD61YGy0c0If3RQPLfk01ID3a22mvYhLN3Ci0F3AiezhXZY3qCYPfhy+EXBQnUL7/yRxqFFvlfy/z/Y1DwYD0jJdmKoEOn1Hv6OUg5fTklw4HCg53w46uicHXVnXrbnQRS7/V+U0pNaLf1n3XergmtBlMZpduawFkRRBQsCTKPeB/TSiM4n8577LiXQO5RfeKLYQpH/wX+p+7LYPUDW+M6SQKl1N2nona2Iot232nQwcFowHy1exYUmk7YBQ0UUFjkfiVf9XQTKKmsuu8JTeiYoR4ofa0J13PXGavYEdz8c/L0/AcEQX+PhAZ8IdjG3XOrxJbgcOQVpz/zuNA9cDdU8y7Bf0crv24XIGj71uh0rb0Vz5r031w6QuH3sf1xjbUUHuwXeJzxsgmHZxhnGLCbYNuV65iQ3YTkY2fXursQ5PpSW3e8IND8+o7/0SsCpT2e30IKCUK7nmRb/MfFxq3npbWEaETppZvq+BKoPbptPx1BsM07Wjv/ehu4fOLWcycpfE7cZhs/0oTLu665Kq9pxnnVUdnAOc0Y9Aoy1o8m+Bq72KihmmBjLS26pY7g9ast99PrCdqiHuofWc2D773ht1GBNFrjN3H+myAInZg/cmA+jQXXvtY5atKYP/gs+vvmdqg+erJuhmY+dOxPvgneW4svNw9u6llWi2JutEf9zFp4Lmr3f3m3ApHaJzNK/Ouh+6lb0m9/PWoFrt9UkKxEVvC1e84pjbhXNHy3qrgRuTJbNCaKG2FbLlgpMrMJyiqOSlH6TSjmWOl4HK/Dz7Mb1Yxb8hD6UPLbn9gaPJxhM8X2qUGfgM3Kxq8VWHjw0AF7UgdLSZfXM93r8YRh1Ulvq0S6xTbfohuNqNgQPKdrohHjfqUvF042IkE9YaeiRhNco/89fOnZBNO7GyLfravDvxK1Oz0PapFWamwx+0wedE7qHDhWUo3TIznir3+VY93yIycWqtfBg+3+5dP3OsiKz+t38azEvRtKs/4ca0S6g2eYRFsj2BmfN+q2NeKKU+ivfUuaYFTEXdG0vwkr/nxRkReoww+V7+sOedYi8z3z7NSHGpilcSb4qFxssfqhO1uzApduDsjU/FeLrB18Hl6KdZA92Cod01+BYa/JryciGvHCsun1ya5GtKfKWN7tasSlg/09KSubYPxll8Faxyb8bXgWw9WoxFzHC8cPO1TiXENb98T5CigsDLZP/F6BzawXTnmxmeC28739L6wK+wMeKbup1mBkIvhLvFMbviaZTDjqUiAmqz/GWlF4eMHDJ/EQBcu/tbNXnKRwIN0zpuo2hXzpJR1hHxrwwO/VOxPBBnCauXkpZvV4kOs2afy0Drce/ZJ5eKgaxUNpP0NTqqDp6n0k0IkNRzdN6UOpNLzZrgLuihRSolZ93uBGwSBL3JeupGC/esbrtfkUBFa7nIjnUZg9Xdh8278BE34TLhfiG6Dbon6tnF2Psdzsjx5a9Vhp1JP339JaDEuvbnV348DHTyTuIasa8y+/eCe+mcZs2tZvbQUHqrGLNLqWUKjM4Z9MuEyh/1s2s6mVQuzd+dK9XRTKR1/sUumphOhRJREiVAUBKRGpdUZVcDy74sbp1EqwWCwWi9WBb8f5Rg92MvApDZDWcWHgvWdz/eWLtZj1PH3xs1k0rCUXy+zwpuEyrfDf4gAa9gsUP7bPYdC/aa2UcigDvf4cj/UizYh2DLs9i9uE/8xmw76gCbpD5prNnU1QnZyMPXiCwMPlnKq2JcGoW0z1yT8UarXULvLMGUyImo4XH2lFeW3ZZZ9tFEbHY3b1BlAYrLNV0vxLofnDq6tRLgTQvF7JbGvGBOc7ddG9GX9+at2rMG9G0tvMElHLZmy+v3b+82sEuuXOs5YmElRW33myU4+g0W1iZXI1g3dbNIW3nCRgXUp2oqzaEHpg/9nScQ6K/w0flj5D4VWT/ptLbArc9FmiO7Sb8c7WcHmVezPSDXKq1u5phuG67UIuls2wKjv7q8CPILP/9L/qOTSUhyPipl8QTC2OvqvdwuDi8XNePz8SvBP65LNnJYHn+qyLt++14blV9wPKjcLvtqlAfZrC0biIvvxrzVhqUM2ySm+GhLLp29cRzahZ+LOz714zLru9eTU7ksAx/at7/z+CdbafKr6J0/guKh2m68yFh8E+wRIVGk97YkX2xhPsDR+MkUkimC8imM2n2g7e1S87LswjcKF+pL4sbMb6sDlftk00Q//C9dP3m5oxeGj6cV1rM8oDfv5qzCJwLum/5r+CRvJnr1RGgcZnuzPvx/5w8Tmh5uGqVzSGdkqlvJSk8aDLff0lGRpqLrKifkY0bLbtWe/d3o7VeWrZAY8KAOnE640L6xD/4eEBq45afHylzjk9UQs95+5taXQtipfrfZm8WomFFXWzCkrq8e7s5Ysj3yrxQrOsvmikES+m+F/vGG3E05r34F1uQoPSX0rsShNeBltui/7SBO2dz7Z5qNeD9XXn1h1lBbjXHam5PrQWKZy13/VTa6HxsEM6KKIW/93oOnH3WSU+Oq7ztZZpAOdTSp7zsiroG63PdxFsQvQrrZUJDk2IuNmToU+aUNSw4eBZ0oR7miHvfq9sxsyPhuvSp+qweVZs1sukOvBkzxXz2RdAw7ZUpO53Df5mNvqYcGpw5tfJttc1FUCnZ/gMt3rs3/7x1ecDVTi5tYP+r6sRXxN6DUp1m/D2wsP6cwVN4P1kX3lQ0ITMtdo18rObsXzD7YXVC+uxyrs9ueRDHdZmzp9uHa5Fh3L3dAo3D9sT1Ymsfg3mF/39Gq9TiUO/i8oaG+vQgC1u1+oqcSnjzFp7hSaoOPmqs6yasE57Y1FKQxPs7553KW9owsbf5fn7JJuxVF75+cahOqi5XtNaG1WHW9fylAIbanHrY0Tys/W1uGUTtmmhyHe4enFmVvFVwqNhZULW7jo0xt4+WXSxEg063Tt+fW3E/m5oV0Y2Qe2afq3NnyYcqQ70uv6nCWqbqcrZGs0YnSETtXhzFTYOWk+ts68C9+XN9XwXK9ErdUizvLUS8918dv4NrYQn85N95UEWdOwCZFpManDJZ9HIQ6c2LLxpdUFFi8LM88JLfu2msDLt6tlDFyiscSg+kHyFwsalp7stH1EoUTi+nJ5owIPenU9pi0YcXmHKNopuQINW/3fFP/Voln+/dtWRejivutBTub4Wc+9ck/0zoxq5/2m/f7yTxky9U697Jzk4Es+y5d9JwSl2ntOvFxSel0+dmzWfwPKxWeq3pQSvlt2Pv5VQhS2iVMkAqxrXbJYf09SuxtHS1wuSH1Xhpvqk2C7lKrBYLBaL1YF59j+pbT4MyPSTXNcVdeirPpT8doogq3lmdfMSGuUbFruefkNj0bJbKWcyaWQLn1p4fBuD07NjPcccmpE/e93GBx7NmP3G+dofi2Y8iNc+weffjJGdd5aUL2zGklnhcDpM8NwmgWxfSSDfn3xK0pBB8kpVKZN7rTgpapLvs4+Cp+9mU+EvFLyv+ayZL0VgZ1fufeo2wU4iEx53shkyS1bpjtY3Y3/usdsWH5px5S6VJ5XbjNtXXYJM/jbD5n648LzzBI+1B8OOuhFcX+UgMeHIYI2uVn3MNQJTZ8kVZ/a3IfDTu9ovGyg0ugoqpF2mkGF+4JJQP4V18np5PZPN+LfP5LzRmRboTAXlPjJpgU35nNHT1i04NV4w5uDSgtVWu+s+JBJkOV/dMbeVYKHSrE8J87i4kn112awegjWqRhv37icIXSCWNdu2HUUhiHALpiAubnm1corC7h9LRa5ONUP+34qc+jMt2Pf4iLCqaQsiz7N3jFu3IDjxocGASwtOOgw2rH1KwP96rd+RnTQWqWy09Bbn4k4N16nRgkbD9fVdN1IJ7L+//eX9lUBuTURmSEI74lps9mrxE5yfb1F8zLAF63YcuJz9oQWe3fcLJaNa8PTr/wTAByCUCwAH8CuFiJaSFkrFi8ooEv1VZBZCykhKSVKUkqTMJFRWi7aGRClRZCd73fi+W7htVpIikfdbMCF4wMX9Htok+Z6LT2flR/61i3BV/qt7/B4x7qpxl3Q+7gZr7dEJ/RYxtLh5lzo0xMhZoufNNBJjs2ij4TZTMY5ckVatWsHDSQ35/2T5taj4uOV3vICAhrNpy/TXBExtGXuWNBKokb6a71VAQKxzNjH6KwHNsxf36TtSMfWaw/3mN1To7hgKV73Dxl/3++mr09jQ+qg42THMhvcWa/tfFzlITk38uewSB5vtIpVmzmVCmR7g0uFUi9LRwoNxjxjokij3eLoQKDfZ677tOAHW2lul6XkE3nq9+lp7gwolfz2+dBMVazyLfi86yoblvM23OR/YOBOYMyVFhYPcX2LH7CccdNho/aY+4SA3yPfTYTaJ8IZ0+/3FBILlPtRIib/gik0Yf+sjBh5snXdsbykDKrz9ZzMpBHqU3E7ZlbXjbZJz93kZGr5K5bcdtGZjerHBqRV32dis/JTDGmPDP7NrzY8YDm7/WN62KJaDgZenzC5/J2FRflxd/RQJK58bB7PeEOBZfJiqIKxB6cPN0X8sGXAazdtQlcZAwg3m7H3aVBwYFDkqfqTip7lRSOo7NhiLncJG2GxMD60W5W7mwOjfhEFNDQd+TltZ32o4+Jo5hxQ1k9j/2uUTN5LE+9XXk1/WEEh8EiS3YAcBboZx5fKln/G76bDWUB8dHgXjMd5TqRBJ93cOelKxLjRk/gIHNrJXNM1aspqD+GvSlVsPcPBx3tY5+/o4+H405n1UHweZ0V5nVKWYmDI/YGJxJYnEmwdSeqaTML0Zc+vODQJr5S/TPTgMLOKOVMRbViF/X+eHkI52TJRxleysqPD+Qm1uXcwGP3B1HWOIDbN5XY1eNA4cXAKW7zXhIvLlmy+RJlyoBlXcs1emQW9z6zDXlQaVqT4/HIOo8PzwL8uJQ4X+5NkDUQ+pSAifI7l4jApLWy2DW8IKnLuk9Ou+byeaFs5c6rRBiIGn4QxYCTH0K2sZL0CILubM2ZeeC6E/x32ZXJ4Qtxs+XaU8oYHKYZ++/okGg+eNkm+jNPiw10Tk3aJh2uGhL5dW0yDa6m90eQoNFAqFQqHwYL4goVyDRUDt+9cDR4ZFCA7J9t89JsK/Q1PWnzYQozZtaMn6JjEqvSyyvBhiyKbOP6kox8WiFDnXIjoHf75f91jzjoNMZzdjgQoXOeu/pPXlclC7J5x15QwHI+m2OY27RSh/bNg9YSyB2Z2x9w+IDki1tq17FCZEoGxyWTNViOebikR+JiJEXXy1UfOBCCaOO8ey5bhojy5iyetx8Zxv0nVpNhfTTzW+f2bHBeVpp++RFC7mNqa/mubGRavZBZdKPxGeWhgPVW2XIE7jwoaMLBGe7jum+udGJ052McwObBSih6CdnXwgRJxQPSNmUgil5YY9olAuYpwf1e54xMXBt6uaiy9xIdj2/gm/hIsT+4KfeLG4WF1xcOyAdQcu3j2y6Fi6CHdtaDeSYiX4musxKjdVjNdm1edE7iJ8Crk12ufTBd/mOqvrr4U4eVbxiOxfIZq6jVQCF3TAY9GT0XbTDmw5p/nPYkkH7sgW0Y/v7YCh6ll635kOdNbe9nvzuQPJauNMPiHC1LwZXme0u1G3aQ9RfEqMK02f9qQ1iRDOrDHfwxOBXpIyg72Jh0k1vQGfPSLoXddz2K/cgZLKzHUVWzpwR3X5BoOlHfj7+o28+74OpH85Kc862wHrkykP79d04MEt07XVHSJ8ynGvf72hG28U7tBquGJsCypxX6IvhrgIH411xZAfc1FceUsMue4//6I9eIh5o3ls1ngdGhnaf7eV8uDlsXjjrAweFJyF79uzeEhe/27G4hwezJ+vid38Hx/xVRtNYtbzsTVl8VqMi+Aln/v5wTUxXitfSPslL8aL0MOVNxaJMem6UTpIR4w3H9R1ZPaK8V/S0e+Wt/moWPN7R+m/OrybyKw/6cGD9y8fa70jPHS3G9wLO85Due7Jz9/6eeCf3f6g246P2m9PS+5MivBrjDi9LVgM5dHtO6QPiTHLTef0oyAxDhn9K4qMEUPpTm+n8msxtp5MPb/tNB+fjx8Zu7aODx9+t+ylY3VgxnitO17VhQ3B39f+YHRh92cjmfJjPNweakmSa+Jh29Gxp4+HRHBwnJ76yEWM07tOHT5sLsaJN+4pFCcxKq5pk7zDYgR9V/539oYYNjYC+boLfNRavk9Q2sTH9/nTLgxn81A1q4L990QdXL5trdqb1IWEvicXanbwMDRlPO/QCx7yXhwbb44WYY3ZQYXrp8TYeC9sYNxRjHWTPiq3D4gR2bFh/7nTYuhvUY9VvC/G2a1PB95E8fFny7njE1v4cDGRdznwjofgihPX3yfyoNZvqTBIqQPnTOcJDwUeDp8dtdt5kocPgrmL788T4VRKUKfQXoyIe80Gc0+K8VBNU37vCTGG/43aG0WK8cJLdLYlRwza7ntWn+UFqJi2dORoHR+xhR6KGqZ8KHw/HtK+gI8938/NXMXmofa99aq8u7Xwim/ILnDkYTJ55dunn4XY5VDkcsZejH3u8149OSGGWWO/R3auGOUNOrYG+WKcXKNyqoEjRm1S3mTeHAES1LYlPl4vQNnltdT3T/l4dbrfr+QSH4c1s3SObufjjMeZXdtX8rHzlOpbKYtaxKkfKi0IEqLL43DedFsxvvpWTjM4JkY7eef9pmwxiixPHUGPGF8tCnfVyEhwSPuKxf3tEmSFbhlcZynBmwWrjtYZSfBKZUw6pVyMCvJwWpW5GAoR2qpW30ToCjZ+GJAqAoVCoVAoPIzamEQ+XtWHfpc/4kvX+5CQ115HW9wP+0F/1/Mv+jFLT1qitmEAity7i44VSDDG3GOTf0uC5LCvxJmzEhxTjVkalybBnGv76+JOSuDHZlV8OilBjWTgeoK/BGFyFQXT3vYj55zOPVW1PpTKmuVOlerDw+wnY4I1fbAL8BOr7e2DauS5F9p3+tCx8uvY+dUSiIISlpXGSJCotDwn8pAExw07D8wLkuBX7tq5O69LYDOWLRd1XYIX0qUshwQJ4gMcPD1WDMArfNnBuY79eLCLJ5g52Yvym9uyFZN68UaN98T+Yy8iDL4fcR/rxasXvw/aG0pQMpx2OTlNAvdba+btOS3B+uLuuu4ICSpfe/Y+uibB3Gn2PbOoEhyZfbCos1mCufFr7kSWDOCC/Q3ZxAv9mCFze+wvtQ/aQc9/v5jah5WymUuYdb1wrzkR0jOjD0defd7WYyvBmdGDR/7LlkA148/QtzgJxkvfv4hIlyD23Zp64ycS0C5vM9QiJJC+Ma/v8uxuKNK2jM12/or8L7vHH+f0Axfdvs2d2o9uLhmhOK8fN6+CXCHfB4UWjVP5Bn2IlHSppZ6XIEHvojmDlECPodR+p1ACxdG2y2s+S3D/647n4nYJxBWe8+nTurHReaabh343FofbF7EGvkK/OQHJg/14HZOqO8WyH97C2Z/HXfuhXBXi4H+5H5/Nkv+7pdeH62FLg8I+N+HprFx77QEm1v+Zer3mFRMb5rQWXTnPRKY9xTgshYmze0RSs9qZIO40S2vnM2HWAUFnCR1fS0q9X61gYG3Sz7CVy7gIf31T51gIF81XWZe3dnDh8269/Im4DugumNM/cJeNHueqjLdVTXhwiLXj5WkmssKengzYyoRcMfHDax8T5gc7W27cZmJq24WvN6qYCPJ+eM1qJgO540PltYEMDNau+J1kw4Vx35fn1CdcyPduyj0g1YHJPunMgwkd8Owwfpjjyoa8/8GwmYvYoCzd8lvbpAn1Nb2Hrmwg8crdZ9H8ThLTqEkceWsm9Cp/zX4ZxkT09OLkhdsZSE2/x78TywDff9/awWVc6Fwb7zI4x8Vk4klxFcnFj2sm9M9eHRi4dz1vwXI2EtYUxYeIWFhjuTX9exMTuosMHl1mNCJix4RR21oS0aZOZ98/J2EWqfPOiEPi6MsTYQ536FgB7XuHaQz8ZlnyS+W4sBzvS5nqw8Xcv563b1RzITfxX1yKbQdMOgpcpmxkQ3SMu/7gKAt7GzTMG8+yoHPa3PbpJyZkl9jmML82QOBU0t+3nYS32OEU6yKJ7XdO2C9bwUCpTU+MRR4DrJxq+fsXuaidd2Lp6ddcJM/lL58yqwNXlPLmSN3qgMrVjuT/gtmQ7bvyeb0hG8zvskYdQha8txzY5mzEgtc34db0JiZ0m7vlFfbUoye1Ji1JhkSug121lykDtNEt3qH3GZjWtM389i4uTK9WRA1rdgDU2bcbvDuwnt5xuam3AzNLd/bN2MmGbcPoHBcvNpQyGmfqzmfjM/2Yxu1jLGSrhPi9l2bh8GmnE+IrTHhXdeVvfl0Lna430w7vZ2Dp8sq7y6gMbPQ+FGuzk4sAVX71s9UdeNWxf6rU1E44TGc53/TrxLSDyzQLHzJgxxYr7/zJQPe7F37L/yPw38OjegEBDAzn3KbmDjOwYq9VUeZUAtXqGlv0lQmo0RX/njlcCwqFQqFQeGj7+PtaZK4Aho1lDtd7BOBTFJruzxZi/YrI0khrIW67Dd05rUCgvWvf7ywPAj4nvc7lniAwqsW4s7yMAP+GUbTmFQJpB9Ooy48SCP67bvDmDQKq2h7DFk08eFKHDxqZs+BIzrga9UAE4/AIm8N1InjK7rozOkUMvq/h7rFkMVjE1xFrQQcyem/7nJjTCdNET3JQ0AEZv6MRg00d+MBLSs0y68RW5R2Rp2Z2omhO38mlcp041bV8xsXXQjSP6VoaNYnRbON27MKDLmwNommqsoV4aljau3OdCJ41gtm+N0VQ2MMlrM07wTgat/3E6U6EydsVDpp3wrZnj9fg2k5smsr2HDvXiff/LQ1/N9SJdSsr/fcPduIrPVhSNSTEgyalkoi/YjywL75c/EGE8g1LZY5o81AgLGMmLRIh6nu8MDdShKRdmjbzCzphkXaq9Ty3Ez0bDbdN5HYif962/vFbnXikU9MrLeiEeGvO4bUGXahUNtD5SnThl+yu3/fVReiV6Q91N5XgF35vXzdPDM/Nn0xvTIpApR8NdLjOw76U3q4fKSK865SdmRPQBflce+3jQV14Y6/04Nu2LkRoq1p90+5C0I7HO0bPdqHE7UqVan0X3gXLdceu5OHj61eHZQ6J8MioyHqSK8HsM9vyHCLEOBMo+yL/uBgPVGw8MyLFKPymTxxU5IOuKIqSZjcgNv3K46jVTMy41Mlf3ENi8aXcgFMDJDRuhd7cUEziOBwmP02QqInaNJXdQYLSvXdffwMNmcF1Gc5VLFzXsv7FtaFjIPnET8fVHHy8wR/tuszBqsLO1NuyXCgd/5Xs0sQC5Xi9V5dBI4xw+f2dDhK2cNk6yCexy1X92LvXJIYeLpaQP0m8UyyexlzKRM+GtZ1fneig+YQbhDWzILvxpH1YPR0Gydq250UcTLvxwEHWnIvA95n9FTVcmOirWirFs2Ax13tY5xILcwwsivflNWCAJ/B7eoTEj9G8lKMGJN7ObhzanUVCOKmn+YMgUa1/VHwkgY5q2XfHOx6xMNiiErSzkw7NDQejuR84+KXPuHJsIRdH9lFXrLjLRYfMYiXvBBZ6JB6V5yNZ8NrxlC6cZKIuSL+4jdGAK08mNbroBBLuuhz8vJOEZee6B5aRJDp2vTjXU03HefU03f1fWahuVpb6uJ0Bbu+BMWuCg+we2iS5gYtFiu13bxRxcaDu5PImFxb8nue3im1ZEEqPCXY1MLE+8H3sqDcTW21cL/WdbcCA3YzArDwCxKXk9MvfCEQHZu+02ULHRR/tLzP2s6DswePtLWLg2qbExFtUDjyNJpNX6nHR6jmu3fmOC5b3ixKFOyysnHeiouk6C38WftMPNWHh+Ob2C887mJDyl359w4CJ8c0qW/fo1WP4bdMcnXQCB4xjVFSnM7B7C+lXpsDCejnD0sTnDMi+6fyx0I4L6dG1Mt9juAhZqv34uXQHGtMWKn0NYsHvZ4fCjyIWTD+/kW0OYKGxnbO/fxYL4qhs/frTTHy1Zv5XosTEzkbq+6l3ahGYdbT0jw0Dcn9p4j39TMTnSDbmcBgYWP7YwNKWi9V81dNPVnbAVrsh6OvlDuy+J1zbf5eOC7TiCmcdBgSzP4+TPgzkCN/k0TIY2N1MqwmbzkCc1e99Vi0MzJ2Tq+kuT8DpxaKjlKoaHEicKxjPYoNCoVAoFB7cop5ZGTbykaqXbmgwRwBfEzav0FCAxr7orienOGApT5k+fomDC8U1IRcdOUjqfrj07gMOUOM+sFCGA6UZ+hlv8tnoN95rbp/ExoIPR7LDRRxYnlsZ6JbKANtW6m6EpwgR/Q5K+zYL0ZX7SXvBfSFYsZ+9RqaIUFXgvXfHcgbsHukUil8w0LVe3+hbMQObr7xOC11OoMoqaLX0XQLBi5rPUs4T+PFWL/LHYwKt/+Z7nWjiwbw+J6/pshg/r6Td1KUyEbrVa9Ogpgjq+7jP4w+K8PxDzYGEIRESpz7ENQEXnhdXf1HP6cBftQGjrAsd2Lny2vaAOx1g0dbbdaV1wGn8tP9txU5E0ovdy2d2YgWL1lhYJsC9HQ2zu5RFuEopNWzfJcbXGYFTXG50QStF9WbLkBBmO4Pag11FeHP8y9q7th2I6dn5XkepEysT5mq+EXcg6HqBXujUTshscd3cP9EBq92mRXMdOtE83S9i589ORGz6yZhUECKxJ+WaTYQITrNnX8u5IcavGfcUTjJEqBds2am0hgfr7c86LO1FuLFto/1bVgcOl5UyLB53Yop3lFXd6U44HdZwSr7RiY55te5SiZ3Q1swl/+vsRGwaV5RyoAuP2j2ltm0WolK96OOcPhHODNFaTddLQFfgLBuBGGcCvq9r3CTGdrWdfa+e8RDw4vGRobI6nKOttjjrRuLfp/CYWYtJzC61m31ElcSt7cfLOaME5JCUo7SURNsKv50rp5LYfl9B6zFBhe9/TYrbpFgwvHiqZL4fE56uOUu+xtGgtkeNqKpiIzFsaVCYOQcXHBM6bEeYyJx+ZXujSj10wlfuTZpNAuHDQqESic9SSqn7vhFIfbg3Zd08EmWve+1XbCPxc4fU250HaMhcFPn8qCIL5Uc9+jNmsEA71H42QZqOwy6dOv2XOGCf59Q9mcJF9sL3KXIFTOSO/IbGSyZym6XuW6TWgbcsauadOwQGlzne/n6PgONs2Qcvqwl8f3jwtMlfAvfue5aevE3DjbLCrgYBE29npqz418FE6XjYbc/FdLj/mXdkpzMHX5bNmSJNcLD96xsv10ImrJuGZga9YqJ6zWY19n9MHJYfuvepuA4x9Y/+O7KFgObdFMUlAQQcL3n1pTwlEDGvzPluOw3byVtZtmtZ8Km8cdf/LxM3/ob+Vx9Bx6y8uR3FQRycp806d/onBwq7z6gbJjAxX7eoxSGSiRrb7wYKOkzMef+SLPxMwjav+pJheB22mke63/nNgE7IjMiwjQQ8c2xoFmvpINyz8v89ZeL+Fq23tP9Y2Jp2qvU8lw56w/09tcEcmA9m/Lj0m4N4rpLOkbdM0JPYuiuzmXiR6va03YUJlULTb5O/Segnnr14K4TEuwGuweq1dXBTVGjoq2NA6sWqh3liGlZPd5IbsGRiuJuUq2xiYiklUPd7OgObTFa92fWEgzzvFbYyK7lQGk8TeN5gosTNjetNMBG7Roc0TWRCedNeHY+1TMzLUpFqKyTxYVrKsYRtJG5/KpGT7/mCKxqymUuYdLzV3faBvoqJ80q1H7JzmDj3SDVHoYEBj5XKS6TMuYgKrFy08ykXPtNbyOxUGjwe9DtVrqIj3Wzz+aH9dGiHnFiQeZMO3SkFjdSfdPg+pKlrPaBjmtHEA5VbDDAtPnLf3KhGsl/Ufv5aNmz/vj2R7McFhUKhUCg8XDpSv0h6IR8RJYHuDcf4+Cw/93ZMHhsdRtdLuSVshHOc1nslsZEsu444z2HjpKSxRNWGDfk5i+PUJCyE/44wmlPNgsaO3KypARxcvB2saD+fgbCxntpaPz6ClO6+un5EhH+Wm5aHQIhR1a0ue58IcUZ6ucN1eTbS6m4NvC1kY72/h9eGK2xEnvzn9y+LjYmC4SFpBhuZXdvLZILYmFGTfXJ0JxtNcmc6h2gduKPTeSN3owA/J17OnRnGwMg5ebvCQSEShzMuzKQLcLXpyeuvhkIEW8/wfuhAx+QGRhlIOtJq2GZWvXQMLnG9R3NgYBH1juPFVwzUV3V9nzxBYLu7xdjgLQLH3TX7i5t4GOg3il2UJgbZ8NRfQ0kM2d7lxVNsmAjaFDqlzl6Eyv7eXmqXCJmyOsv1I7gIiil48cWqA3NWhy62XtQB7zWLV8017EB68tJYnmEHHF66Leg42gGG5+Uu5ZmdMFHve7JligAn3wvuR6qKYKAYmMjPFeIfNdBqf7gYpq+Dfpx40IVFkTZVJ1aJENqZuc1WsQO2bhr1nUQHfoz3bPZ+0YHtUxJ2/FfTgQu+SU9HazqwLqJh46/fHUgd+LPdM7QTZ6dfDny0X4C8b9INVvEinLRMK3qhLIL6e4Org0skeOu0MUw0S4yF82vnO9jyILvN26bzyRdM2RMpCkokkAByhfxOApl49trbkcCyLzX7yf8ITHFIa67cTUD66IHfMWoEDh32PXeE344GjasXDbczkXFu/Rr5bBKFr5Oin3NJaMYqmE98puKnmceu/RFsPFRZfG+TOhOGD3asLvr3BSWTF0eubCPQMLkzumsHAbvLAXouagQS8r0KuFYEfv3kjUYdIMDzDZ9e4k/FPyUo79/FhPqhRe7J1kyY3Q15pxPOxMNk/fsZ22loj6E/vmbCQS3PfPdoBwla+eXJOXQS1r5VkbrnvuD4I05OWiMD5x+9NOxvZcBjyvvPe6cQWCHetyRThYCDyqjS5Swqfn8Itq9YzsR8GYfUn0uZ0NuS23/dkYkr/3WWB+yloVZTjjM4m4Oj781ULXgkgtKimvYTJAZ+NnTVOpOgPH0T+eLBF8y2+1vvHciAbgIMGl4ycKV6T+pQNQOUnnNv9nVS8bTdRWnrCSbqS3bJeW9mQjKUc3DiBBOeWlxHVikNYR2yu/ev5WDDoul8jfckTP/suLYlh8TXeZeGx/aQMA03vpX1jUC3ZdShFQe/wGbD2khLAwYOllD+4zgz8IS1Uj9vMw1Gew6qD4tJUDZ1q9cfYyJ3lhF3xikmZlXRf19VoWPLWFrjMV0OJq5F0Ew7SdiZ7WCO00nQtqSH6V8lYe597rP3GhLuhvnOf3IIOLjdPui54gsqA07uvz+DgWi7pOwDDBqSfd7ff3CFhJul8YNgBSYut1wuetnIhJQnfbrjOzqe7iVl3+znIGnf39rcFyTWfKiozP9GYvxK6qekLBIJN8vmvtpBInFKRI9qKwHT27m7lS8ScA249I8jrAHt78lnBz/QsMr0nIvcARLfVSdcukQkVJ5bn5j/hIlDt/aqBn5gYNefUxUFVRxscXg77JVAxfRdC9xXqtKg4PTKf9peGk58XTnkfoOGbZz8ZLNvNJDVXws0kug4Ryz68sCXjrYtDo8X7K8E/ol9qzpZYN5l3LjVwcEU/YCGn60doFAoFAqFB9wRVpkt5SNx1t3PHr0sZJko9xX8ZsG8i+NhWMeC08yiqU4L2NDvc+oZiWEhIWvjyVfqLJjwD94+95sJnw1f12Yu4+Ddh+pX22/RsX/xyxd3xnlQDKSmrQ3gI/RO+Kn9J0U4yF1kbWouhIHcywu+O1hwbFJVSfjBwsgJUYJCNQuWpy/fJLpYKP9wQbVrFhuLT3s9S33FQt3rhFavSBb8NvdavzTvwHGPqdePfuFDZ9nYG8oEHVNehZhIz+Pj9tSmoGljQjw/ovZ0F0sA+zAXzoNLLASYNoZU/8fGDN71PotxFtxFur8UVdi4bmPg3KHChomZ7h3xWTa+XvCsyrdl49ZB/l0z7y6EvZAc9jcVQPnt83MJHXx4JJ0bWJPCwN4Qeta2aSLMr3WXmikR4PmbVhvvSzSsvZfxgiJNR5X2o/9mLKRjWczSuLRLdOzYdGAzOHQcc5+yyC2UgQXEWWfXJAJb1Ru6jZt5SJxMnOr9SAyFzmpGt7oYwWe2f/mgI8aDjIdzpjeSUL57hPuUIcKh81IbRdu4+MuMWX9SrgOZYdZPfnC4GLjUn1s8ysVqydeZ8X+4SBz/vdp1dQcOrJ6jrJrcgfn+Rwtr5AXgdbxjSmmKsFdJVNtVKMSOosaq+TVC9F/6m/BprgRqkunsBF4XnnBU1zZc+YyVS9MjzpQy8Fq6v1zxIgOfpIttD0cxsDWJNcZ2Z2C58gu/mmgG0krV5xu7MHDI5IilUX8b9lbNstsaTuLfqW93Kb0Elry0/Zm5hER56bkHUXtI/Hx70l9hKhUJn7PaltmQaDOaLv9E+BmcLympiacZ6PvirSoMYSBoZ2TLXkcGXp077cALZSDK+rGyUToD2bczmRvOtmPMqSjaPYnEkscptdeukNjM+q665hMJwcCSptm/SQSUp9fkRFBRkNAS2yFNovzYbP2RcQJ8SuThpZ6fkXT0u+XtcTruHS2jfaMwcKmu7oCHHgNvjBdvdtzFgPCJ4UD263Z8HxkoKfUmMWdH3eSgBwnt8JV7k26QaLmxe9rpBhKue826w9OpcDnQsPu/GSS8MJNiPkmAed95Xm44gcwtJw+nxXzGxnmzjhx+RIfjbqe2Zg4dC1QWvn0+SodnEzNhVV87Hs5uidiSS6Jm7MvZ/edJ8HQ1WsdySURZOIbxuklouW4J7R2jQq3mmpJcJ4GVWW2VK+gEuGWymrxLBDQ1PzfEKxOI5JtZzrL8jJPjW5faHqZjaHaV1Y14OpqVn83VsKJCP52d9EODxN8Qr2tfskk83/XGSiafhN3BjuGBPhId3zcuy9hDw9dNcjIrZEhs7m+VF0wQqOhd9nrhOwK6M6eO7nAmYCEwvtJJZ6D3554d1rKf4VC9PtxpCx2nRTpJlwRUJD7Rkb9XS8D58lv5U3YkIhQmV7yQY+Jdhaqe3kIm2q5u1PEepKHi2wHBBRoB752ruBEqJNYt+0N4tBKoWKOhExZE4NNN8dTvfxjw05kXIHnBwHe2sfZcVjUmZmTqNz6nYTk/TVLzgEB/tUr3XQ0SyrZHJtsEJMypb5VlHjFhPfzpZzuDDlr4jViFqHac6DImIhZQscNKuV3BgYoLO8it8+KpWHo7u/FpNxXRojUswzgaLpidKTJzoSP2ZEvr6J4yPPw4ETN+nAXdONstKTc4oMQvs/b/rwORzUbnL9Z0gEKhUCgUHi7NmCmrtIwFrRV3rsasZ6GxxnNCeioLXUNGT5Y7sFDSxrhSW8bErIQPsy64M3HO9dzw3/VMFHw+MBiXw8Y35lxbIxU6tpv4x/tf52HwRuoZCoWP+Kbix3ZBfEQv8zbSOSfCuIJeNiKZ6Hj76KPbGhYiXY5od4wz0bpP1vCZCguWD/6V51mzkLGPv+OQhIkpqx6tEZYw8dneUlL5h4tNCauSVuzhw3uLtf2vi3SIm4yWMp/yIMpfIdBU5uNR3GtD2lQRFC51yJwrZmJwxc6FqT4sJH+ZkzB/IwvihnfpnbtZWKJbOPzKiYXGVe82vX/Dgu7PU8s2XGaBTi+cO7ewE7aknM+qZj76eI1ndwTwcSu39qqaEgPfgzz2u2vwgdrj7FQFERZyKs5+GmPix6X1cWQWC7eW//qz7ywL3atuTVuRyoJK0p1DP1JZiH3y+GJ5JQu6nYuN6Q5sXDyd1DDfqwtWypM/FSwE6FarkF4r5uP6Ixsp3k8+1rMzi8reMDDB3BKet16EkbdWd1/lURH5QGnUdgMN89ctOu9sScOpyzeJrjwaMjezrl6Vo6PU87zf0xd0cGfYijbfZuCNgYUypYWH6gPFbwfyxLDEj9jT68TYTBgfqtwsxvChm153DorR8aLi1SlXEinP78U7h73Bmr0XmPO6W6HYsavWzaoNw3mrygoftUG861bFtgvtoNU1as/8S8XwoipD5iE6Jm2/hdp6Edi6sqzjlG8nokIr0i6f4sPraqz/1Fg+1mx5UMlI4cOqdun+tR/5cJXJycp934b2JZNG3epUWC2W68jay8d1jTD3kY18WH/4tG+5ER+0xJICt2o+lrNFy2pXCmA8Ouowd6UAKuVtpSWfJZjuafWgFUKEiu3kbKyESL7gXGLlKATrz5PkafuFkNpbMnPjzHb8nMe9u+iYAGKdksMPVajo//0+aJDOw/OrIycXEzys8frd4OTNR9gr1Q0V1XxsldF7qbJSgGDVnSc0nkvgcaNCI3ilEO0La65/0xGi+3WT2deNQujoLFHO3i6EawYjety3HbZKpwQvLAR4ynj25jaHj42aP4gn3HY0fvdNMd/Ng+70zaa9PB5U33355bKdD6EC69jrLD50NNefkbklAV4Y9NpME6Jo/SbnmplCNNWaTf88XwglixucS8uFqB4lc1t3UjGwgr33g4UAtmLF1S84fIhEL0JVd/ER9eNuu6YsFTXPLZWDn/OQ70Jr5c3g4/wbsbmxPx/mua7G9sskKPn9NHjmYiE+cX/59cgL0XZ6YlG3khBLf9j9eKwuRL1k095sEQ25oTJbm1kCzN1sMqv0pAAsv0VuRWI+jsnaid8842NL061Fd4KpeGZsIBgR8BCQvsPV0ZyPdaxeUVCDGO3/JpL9xQI4fivcEGYphHxHie2FDUK4u8kvN9omxCxTo9OVBxnwuZh/zn27ECWQ7LBlCZB24nOyhb0AKgq/l2C2ADenyGXs6+ajaOufHoUwKlhifojBbx6itTSY1bvFcJXS0L91RQDGEJd41ifAj8HbFcY7hRDUiP+ObhFCsXJ67LEIEvGW5bPCtgoxXjxrMHirEG29X41PFgngfkX1y9FzArRc0DePthcgfOOZutExPhiWDQ1rblFRQJXW3/FHhAy5LbPzdgiwbOz3u9IXApiMvr3nKC/E1r9//v4KFIJCoVAoFB6cTo5+llrWg4/z9mS0TnRj7tyk3eoD3WgK2fb7uF832Ncd3lfM6kbvptIt069JEG8C3kIdCdxiT41OxtAwY1Pt4ZqNYozPOBxztFiMNNHQmaQtEjQ0R+durJbAJ2xteddZAXqfz928+YwIJpPl8oF7RdBR3HcnykAENfZ9f2k7ETL2jFzrnCfCltvhH+a8EeLThyrqAz8hRNHGfK0ICegRsy09BjmY+KBT5c4RwMit4c1LOyGWGi+aJlMpBPtZQ8vlBAEMpDQzVl0SIcaN4e9+UIRovxN/AraIMO9+i7+HiQhPMgJDJi+I4NHx+6GeigjNE5tLKqhCLNqgXvtdoRvXBJYjxHQRLH+L6DnpXHxvK6yx3iuEkVvDm5dNQkhpZH01zhDgs/qVP6/jRZgbuqFBdFwE6SstriNWIjz4tLZBvEOEZz+fhQceE+GpuDjuR5QIK6Qpjy+ZiEAJ/dTqkNmN9oO1RsyLIoQV9ml+TRAhtPGEytEdHQjLsMpdyBWCv6gcOTUCXI/9F37otggd3Jxdt8NEaOk35750FeGQ2tNdd1xEOOa6dKpGqAjcKJmCxGARVig+oiRkixCvstzKZU0PRIIVQyv/ihC40VJgLCvGxQt/6gJVxBBnO1WXzOhE9yrj/VIR5VDJs2QypFrwcGvg7HNtDDTK3q3ZmMTAg9r93+5dYyDBaLZf7j4CgQeXJhJ6BIL/HnnwZ4KBV6XRS05qtyANz+OnT5JY/+PcCpVNTNQ/tinVPs1EqtSz+W/fMbHs3Jw9Oxa2IqOtt2XvrHzE6G/P9tdvQ+am7osnr7ZhctfhU792tyPZf/XjzvdU9NSttDCLpePZkTMeAV4EKh4bZAX5diK8xbr52gAfe7sUs+Qm+VDoWDiUISuA1vt9z2fMF+CAEDH7RwkU9NE25A63oefynsxMaSreJ37j2rJ58FNbzznL4eHP8NqNr4/wMVt48LU/g4/JLde9PIwE+O22/JRphgTy9e8u9usLccnxQ+9hEyFSe8rv+lgI8VDubba2kxAzRf7zwl4TWJys+Vl2VztUHRYPzRLwsT4yUeFhfTtSYx9OPHDlYeTu6RfmEh5ye2cETnHhI9Nio9pQER95h6aHqiVI8JW3envVXCGO+GnnmSwWIpRi4LVZQ4i45atOSOkJsVCyITg5j8ADnzsFx9WoqPSKPqoq4OOA19KSm858vOZzXwYPtyPBNCKq9RUPWxMX2CQu5kO/Z9vYhYt8fOnR3nJ0rgRH1t1pidYS4tj83mqvRUJcejUYvH+FEPEtguhVukLYX9Q673eZRO7xRa7Bd2i4c/6/VavPChCQLRukPsDHqKXx+KJcPqZPKly396LCOCjE48kwD5uNytRum/AxzcvP3+6hGPcey1O6mQIsTvn85LexEIyI+o8jmkLwHpfUlW8UgmtwouSqB4kXZ9e16cUxML/aaOepfgF6fyy4fdxbgOAbViuOLBfglzVx9vQfPoRrchzrK6hYdKd4WaEiH/cjJk/SrcS4WpRycWemADOe2y73/CeA1lOZmT8OCMFZNnKpxEmIZXHp+wRqJJLSo5YciyDBuJR9MN5FiOd5cbnRjQIY7vxoFJ4owN1xv27dHQJ8LzzvnDVfgJm7dvvfa6NCeTYtJrddhPnBlyammAmQesjo0ZzHArzyHr76dJoQx068Ky71FaJo27KBwbhWUCgUCoXCQ6ff20i5oW6YHO018uB142/zfe5zz254LbeQqAslSDepT9kdK8GpjCsr1aZJkPhX4/TLYhpGbCpGlyuIMbPPePnJG2J8SHm6zFRJguk287yv35aAtWbT8RR7FuyElmVlYwLI110wWOYrwgQxv2r9dhH+PHZPK3ATYS/boWfVdBHeL3k2tqdGCL8FP6fMOyhEaOKJQyouEujsfGyxzImLKVZyB+d3CYDeZw4+DkKQ1BNnDKqEoPcEmvJvsFCmKGo+PEsIrxUpaTOCRHDRW7N8yU4Rvh9JSJOzFSFMtaHN8oQITd6qwpC1IlxwP96n3yjEVl5ef5xAgtzkqf8NyYhQsXP/E9thLn55pR6W8hDi+9Cy5cxmIRRmGV06RGVhv1s6PWiREKKtQ0MpYSL0OiREPHUVISi2fyjVRYSAEAr5wV2EuceE9Rtvi1B5aH37kIEIlV+e3EwO7Ma0uVH+PyNEyDz81F32mghaFmfdNjzswAgTrrs7hYh5vDJSdzEbA1ZnTT9pCpEQyX0UkCBC5I0z6yJ8RVCpYTw6cViE2UV9san+IqwXPlH7c1WE5VFfxHsfiNA+41D+lslueLygsTPGRPj993fDGxkxOEuXchUWiXHjoowWxbUTQx4Gxh72Fag9orfn/Dca/DNJk0HDFqR/sgxKzGDgUNjMuz8zGXAaKFWSOkpg8sjW0GJjAtYGpn3GWwls4bcuufukBUlP2nzCDZjQcHn878YJJsqlg29nvWAi1bd1UKuXCZnuOJWd/XSMn2gqk+OWw+G/iaj+iy1os7J67NlPxyMZhcbPA3TEnCuzoBcxoP9T6X6QBQH1pcsr7y4jYM1ONqayWxCastfQdDMTC83/a3c4w0TBr7/HfF4zYfu8edq5XiYWzb9bRji0wt9UfrAhpRXU7XUvN5XlI61kf33EmTboYniel1E7wmPWhv+7TEV9Wc1U+XV0qJl5UBkRBCoeG2QF+XYi9UfL0IMBPo5PTatfPMnHcsp95VxZAbYwO5sWzRfgXrxdYusnAj/Zw+ccJxmwtP/sErGxHSq78gxVCtvxKPZk/G93HtZqB+l79vHQe17mr8o+Pu6HbCl2q+PDIWL8iUaEBIuUbBZWaQhxLXNXtImOEA/09xhs3ijEaxer7VLmQmQlLPgqLiEwyi1j+f5j4HHsnXvCqVSQftO2ju/jo8LiaYgprx2fW8IVet7wcHGpEiNLlQ/dRbZp4mt8eE3X8DsuI8G1gyVPojcLkWolf8dLW4jnXXNt9m8QIn/K9H2rtgtx56DFntJUEgPvvm4DQcDcP11b/QgNXxsTYs1/8BF1rHT2lrd89Cve9S20piIx4Prf8g4ecOxLMWUnH3J3m1cqJovxeVR44pFEgG09mXPzrYSYJB5pvt0ohMyfVMPz5kKskC7+VnaQRGOKzKpT/iQ+eQ8RnloMqBmfbYs6IsBL5nP3C6sFOLX6d8l6Ph+KtzMe9b+gYmFj1Ydlqnxc73649K6qGA0urb6OqQLYW1yR9/4lwOHt5r+GXYVYWqrvV24jxPHLfg01fiQWZtJuZm4lkTU2zudkkXhqbMvYwxVgHevCAYd7Avxb3VgxzVWA6bIfHI+tEqBFduVupXk02Fi1bc+uE6F6+dLPZq4CbJ1L+Dp8FGD/7BRH0WIhlix1jBy/KEQy06n+wLQ2bNHOGO9b3AYKhUKhUHhwy7eRj2F0Y5Yy5XXr7m6YnWp3e0STYF5V1euH+ySYl3znsNu4GC+uuc5u/07DhCnnrb9IhHU5et0HfMUYVD//jewTw/755820ExKsD+9s0IxnofTFZ6Wp11iwE1qWlY0JEJeltueHiwjBgUc0zwSIsMpkq7zpPBG0nu4szy8WIvqB3+tjYULwc4mDU9ZIUOXhtyA9kouBnPMNPzgCrNup/NFspxCVG7s6ZpcLYWC8IDOkgYW6w+Nih1YWyhRFzYdnCdE6uaGQ6yWCn8FNzUZPEfQji396XhDBtO/Lc+oKEW6LBLcSREIYZQUmLfwggdJwxOeQaSIc7Jlp/nBZB0TVUi1de4UQ7bl+taBBiNADHR1/5NmYmVi9mjabjf1u6fSgRUK4LbupdOSYCIO7f192PiqCAWvwT4ufCNFDV68lJ4nAGXh20WaXCLY65ewGi264BVOkQ8NF+FG74nfSVRGmT30XUFTTgV6TN+VrOUL8/tnQVevMxrxKzU0Zu9kYsDpr+klTiOn7I6rNzouQlMLbsO6cCOyFnBfZoSLc97cVO0WIYCFvmvrmgwiy85i31Ojd+Ci3+4z6iAjm+88c3jhNDIOIjLOpC8Qgh885Tp7thOane5+v2leg41Jy+uVvNKQLqxdVltFAXvz65dZwM3rsvyyedYGB/JmHDmWaEvCPk9Q6zSaQ4ywwoi8goHLyGFEkasH6KX0Pk/WZaHpbYvw0gAlvnyT6h+dM6Nf0aG/pYSKvYO2c0/10+Oo8oPlyyxGnRWyYqk1D7cx5CqmbW7CC+8WO10jH4O1DaQFJDGTOKx6QW0wg0rR2lsEwA1pHtYe/L27FysGg047GTFQ/2q5wOJiJvU7zn5/LY+LntG6zxB4m0gquqy5/T4fkV16l5gI6LtLqP79VKceujTt6C863YI4UpR3L6GCVZy5s7qIjzvhn4LEABnTeW9fL/GWg+4W4PsG+FVmnr9/DIia2rvQ2dHJmgkXotR+5xkTwlWn+5+uYMCA/1hvKtiJy8r+/a2xaUZTvHScd04oZdz5RJ/zyMd15ufOGrjbU9Jo/Y+ylQt9SbdKynwb15tnJ76cR2LqyrOOUbye0jzkz6jl8zAhTeWXxjY9P51aMESN8dDj4vDafJsD24nl/PW8TSJX/1N3FZsDglahrhhoDu6bH3At50o7Dr1cuJ9+1o+RcUObt+zxk7N14SFmBD0eXgOV7L/Cx1SF/0acOMZaxC41m6AuRvm9PcayaENmDnv4xWkIUzXU4b2coBNezKYV5mMTK18GX3F4QKLe/FxLzlQHt/VE3j22g4Zibmrf9Qz5qbozMyZhLhdmINM/7Mw8jP00fPNbno/zT3HQnPzEEGRcrMjgC+CYY+uSYCqF63uT8qzVCaGWujQ/eJISy2YVrLAMS1U31NYmmJDwChkoLowjILi+/kM6n4znvmbHtfAHG5NZrfKjlY8Dj0FjpSSpWjv7tnPjKg42cJLFrRIQfi9WcpGMEOCnP+j1fIsD1Gem8bCshTKNjnIM3C1ErL8r/splEmPqui/fmkZjxILVtSJmEOj9w7w9VEizpvYfmJgiwVpdvd8lUgCIGVaD4k4/llSOffxBUSPvPf/ftoQibE9f90FsvwIewnrRtKQKQoVkXiWEBUi8fyvvmKoSYI3YrXd2G+HU61yzRBrt7x3pHnNtAoVAoFAoPYmLRNJ/V3WDv3qth9VKCaZxMfcv1Egx3Lwr7RRWDt+mLzEtZOgpmjnxYVibCkOWa0U9mYjQl2st1VIthyzt7MNJSAsVQw4vaV1hIy1paNz2JhXszZB/G+LJQ/uYqJ7ZHALWzT/9L8xEhSPP0xzIZET6vPDx+5aUQomOfD3k5CkG3yrzElJLAb2V35MQZLibtcyrUmgQw+WH5+qSpEFT2LDpeCyG78aR9WD0Lad4ud5xbWMj8giDaCxb0uav6tScEEMkl6/k6ipAYMPCoK1AEwbFOUfY8EWTfmNpFFAvRwr9ud/uGBE4ihmbqTyEWm5y6JFHswIivwWdFayGGx8ojhMVCmHhsTJ6QY6MtbgmHmMUGfUD6nUsfC+2rmT+MZIUI0PpxY9BdhIia5S8c9ouw+vTjxqPhIth9mKIPDRHOKRiZ/FzajVc+LsfSjotg9OGcW3aoCIMhzxavye/ALx7vvWejEB++r0htdGJD8ily4L4jG2z145+XarPhvuCjW/QCIZT9OGj0F0FnuoLrmhMi7Bg4uHxNoAiPiVuWT6+JUJf+MuNHTjem/X3GMpGIkLqrod7xpwgXAgfZedPFMNR4pDTo0Yng1Xfmz7lWhQXkvYrlFXS0G7dZeN6gY49XVV2ZBx1Dp9tlnO61wqq94tkeCom/tubyijQCs44Vli6hETDKt5Nbc6QNLB8WZUSPBYXiJU/V9Fl44v9ti0EAC6ebrG99zGVhQ5KRy7YGBv5NaTQu1q7C5wjBgfsTNFg9pQsn62lgTRnZuUvUioGFMhxVRwLaTOX1NR0ERLsz0i68IVCeq6NSG9mGZidvWuEmFv68yArhbWLhttvZOcOnWVg/0ypHJo8FlaQ7h36kMuDT8mH66DAdM10OvPNqrMTXWNXdrZtoeDt//vwPc9rAhJnXpScMzJ90KNBYT6A69PnMqlICqrvkpqVntMGOvDiRpcLCJe2qRy0qLCzuTN0scGKh6Pphxq8kFp6bnC5c78HAaHjKXsNSOh4r6klL1Ojo1kye3HKgEilH1izffLIF2dwMx4IpDOh6Bm13u8ZAO1mbMbKYQPyB8Oxv39uw8v7lac4yLDj9rH8RLsPCYFbGtuStLCTsCeBmhbHQbxXje9a3DYodJhGBV9qwduPeL55P20A/2fGcFdCKh76OBQeH3+N5VMPLa2eoOLNU+/FzaTpWUB8kGi4jUPncUjnYtxN5K2KjilQFmPiu9fXISgHyv+pvHl0rQFNN8ODhzQKEZR6dFreOCWl55fA5p0k8zcGZzR8IDIYWrpsqR+AyzVFxmh0N7fO2RAd4UyG96tEa4V8e+O+k+8TH+Pj0a8qzpY/ESM2mn0hcLcS69mqDbfFC/Civs9keIsRffvE++Vghai4upFz5R+Ko/BHDzTJM/Mu5tu6wHQnn89P71twnUKn+K7z9Nx3my/w/pVIEuPT0ntOlT1T8nhOgzV3OR8ymsT4PHTFKSmp0G9sEcBcWjEToCRHCD+QrpQuh7X7ApStOiNL/9G+e+EfijNm9gxYEicAl/k8f8kl87ON7+eqTuD99WpONPolojw+nF54VIKs7872clgC9wm0nB9VoSCg6VNZfIoL3Umr86QsCNM5ctHyQLYBYjpCPMRXisXL65syHQhydHTZvlU47lHyqdrwxb4eoffLRAbd2WOxuGDHMbweFQqFQKDwY/V0zKRRJkHmq4NjOoxJ0P7QydfwmhrqKR77aCB2v+M3PFsiKkZPtH8SdLcb9T8evPU0VY0Nn4I+hBRKo/Av2WJfBRraW+zPZh2y8u2ZxMe48G9Vfx8idR9jgyiSZj+gKYW4+vCjYRYTjrkunavwRwklHmXHmgRDlH7lPfrlI4JMoVM+I6YDT/h6NOUIBbkzGzDvgLIRmr53R+i9CsM79uXs1gw3vrC7RoYdsHJjR8Elwno2Ao5cWZB9hI12glVViKMR7n9+zdMpEoIWojnC9RYhexmjPmSaCxCtkzvX9EvQ3u959LC9C57bx9TuqO0D7s3D66r1CtCZmVf6tF2LugwruzA42MhuerZLw2XixLvmfzyc23l7bsXfNCzYM1nJe1m4TIueT9oL7OSIsixB2ZDSK0L7qzodjJ0TQWhv6JKVfAutdJOdJjAg9P9YePnlFhP6HZfhwoBP0UzW/z7CFSNfL62Sv5CB3Ut4obwUHRT6/Z+n8Y6OqOsfvZy8bn5Z8mjfXUYh94wtqzpWIgD9PDPaXibDWYl1zG1OE6fssnm4K7UaaSNPW7p8I5lci6MpTxVj+8pk4WEmMTOlvO8ekuxBkpfkifaQaC9MYyUGRDMTgpfKPOAZMV3obOjkzIBMV8LxuGwM7VZ2u/v3QhtZD60Z2WZLgRT7K9rQkMX/hjokx+Xb8uNuz2PcACz8D7+TOFLGg9oXruFHEwvdVRi9LNNgoGExw3JZJYLM9D++WfcatB0NxlFUMuMVmPz84RIeK4L0yk0fHo93pxvzONqRqMKcoXyXh9VYzcqo7ib+XqNJKPu1Ia5/73f8eC0nPqyPmqLNRPi69crM6G155zLJd7mzIffHf8eQhgeZFRLifAYEM2tFCqbZq9FoqDFKO0vE6S+XA2T10HD9yr+c6pR3jtKJAHREBw9S6GmogiXghnXrkcjtmzvosFsWyICMODrk6gw0Yf1hcMION9v7497Xb2bhzxuKO4CgBj4bXbS/GGbgQJLD1OsJA8PM7U4U+1fhWtWejXRYN1sd3u7qiDc0Kmx4UmxGY3Ww97NVMwGwHc5xOtCM7XzuxP4iFrLiOpcm/WCC5alXFv1gIvDbk3rKOjevft154akdgj/mrd/48Bo73d65dbsPA5MYbEUlX6Rg9dUhxhbAS6cWDrQ4lbagIqhj7VcgARbfdPmM7gW0P1/5aFtKO3JzRbuINC1bhRkKPeWxcoIbFXZ7HxsKYnep3d7IxUj9h8OxQO4wqNPq3xrXjnrcU++Czdrxd8OXiEmobVHyn3hixacf67Bnawj8f8eft2Yhj6nSwpLIi7IwIbP2v3eGMbyck2olydBUBxFOdrzP3CECJCo9t8BBAza3M2MhPgKinBTs6zzBBCX4TlFPCRJSjdrRIlol1wwa/uvaS+EUzz99dQ6Coaem3I/IMzH4zk0mUUfFUvlj/NvgIdFt99hXE6C3PdxVNCPC9pNT71SEhdIZeB0hqhOD5P+C/+SBEuGMr9/MZJnb/mLT0vcJEftbUAOV7THhYynfN/Upi7urFGbH7SNT96orQtSTx2Hlr6mYLAVbb2l28rEZDYcv7m98aRTgTduTt6ucChIac+vR9phCluT51WieFOFO8YnQrVQhb3v70i7x2bC45o/9pExWXFnvalO6lYqubfdHJHCqSZryYYrSRCgqFQqFQeHByPP1MJkyC/hO2jDP/xKgycTi+9QEDDcltnKz7InD6hvs2bhTjSa7ogxHEsLtXs2pdlRh62XMWi76wUb+/TCpemgOzjI5Sjz42cls0Wl3pbFgE/LJ9LM/BwzPVV200hTCqvNiLVSLUhvsfHa4X4mG8NEtRKEan0uSl4MsdiHsyqdGlJESL255gqpcQfubL7jxuF2K6ZOOuDR4clGYIN7IzOFjMnPoj9xIHEfI2U7P9ONAe2FVadIkDLTN/Qj9ACJevM+53p4ggyv69JHOjCBsSc0Lfxkug8TnzyT5TEZaU/VZRTOhEotHbnt69QiQ1mj4taxBipN5motqDg9uXRsRHMzlQ+rTgoUkEB2e/e+YbHuNgXtvB4zsiOLBaVbLB8bQQ/9r2JG8ZF+Fa0vbZPx+IkOvr/Op6igTr3qkpXk0SoWD4YgBxRQRtp6/c5OZOVH1Y9mBxqxABnkaTyU85yC3wGFnfyYHKj7D8aVUcxGpUV03mcLBiel24YhUHtUqZ9zMihVhuo9xD+S4CL4elsU9ODPUN5x26VLuRZn0qXE9GDMWENu7FqWL8/nHKp0daDJNTjhTHE10ozp6Yq7zxC+7oBksvdyAg02GV+GgPgfKqyRypFQTiVGcuCZ1DgC9TmnrPhkDOqWuaukntULMiN2nIMnHEcMlK2kQ7to9GSdlNsHDB5efxqfvZyGHrlKr0sNGxLsh7ew8bT38f0uKEkLD2lXHflVUD7e83f01SGbgSn94c9oCBrAidPSklDIzr67pIJhgoWudaueBLOyYjqu+pkSS09bw08udQsdVw7qEXv1g4kxbVtH8PGyeP6e+fxmbjafnZqWvZbGRFJBAuF0i8zLjvWfqdQJpk+Y6jjTVYf6clWgsMWN84MtPMnYGatW8XxtxnQPT62gWdvnYEcbP+nl3CRI9p94xxXyqCplKTOPJsPPfaNpJ8nI0HG35eMRxko/XqRh3vQTZ8fmh9STcnETzTdOdEEQGn5wFtx3cSOF5+ZLrNgRr4VrszVr6gw+DTqntqY3TUJxhdmLemHa/b/XXGQ0ncGxmc5v+Bip1HJtsEIyxcqD5pfGcvG2F31Jq2cNl48e1Q4FEuGz7+q7L3apKIiIk2WNpMwEe1oCjxIAGpFYGSP48Y2FU0O1PvRzV86xdJLzxJR6TdHHV+STuCOwtm7fpFQFVxJHmnJxUUUUyxujkbD5LGWnaEs3H7UM6BAQoH9R++TVswhYPd8zaJUj1J+OZ4zfWcSSLU3sal8TEBi5eeWXU/GBiSVe+L8WDA7eOP78+1qnDhoelCr+p2TKT++CL1gACT3q+gY0UFCeEHr9ls6AvufuodZEPhzAPnQCcOtjYIRu84cbDc6JFp1w4qNsnd4/+4REVNoTDz3SMqVAv8+h62tsP095/syP1UaHZ1uHfupaLzgce9hf+VwWzfv41FjgSqX7ldPevbiY2KN6PvzxOAsmbT4AwHAS4v8zbSOSfAD41ER++LAixuqYrfb8rCg+MuK2RkWQi62r7vggMLqfJPhyMeMbFoWevetfOYeOZQuTGqiMQs+tHso24kTuqt4vVo0mA+tSeuoEiEeAHNc+9HASpjyg+FLBPi8PWWd9UxQmyjV0+P6hZiY2Js1C8hFezLAWy3X1RIws4/LLOj4Y/1l9tBD2hoXHTrv8PraLDyVHk3OIMGCoVCoVB4OMOcSS3uFmOG5ZlbMY4ELgXnqsecEmH2kYX7nr8SofnmjB0+5mI0eXs0p+4Uw2DB1tihoxycbrDPvHeAg9iPlHk9ZzjQy5tZKfTioC7dd03pUw7eJUh28wI4uBbust51mRCfbFOufWUJ4cz8qLL9rRjHM38vcyjrwK5QbbXlc4UIP/949YZQIVbFDdoWM4X4K/nvtA6TA/uMTVeZrRz4XO2jrBBzMOPS77xlrRysspk4UjjAQWPEu4cmnlxYmbUx0g8KIfU3RIVjIYJtx+4PCsNi1NX/WlW9UYQTxStGt6Z04rNe6ZTFp4Ro5ExVTSsVIrbfV+ZDMBf7t37T8TzMxan1D7Ken+dCfWXO7ic+XNgO5sttjuKi+2hmVGw7F9a5sWoKyUK49+1sUnsngtF86WW/7kqwTT7qeHOWCKYLjzB5D0SIYj3okbrbBeWrKyz6m4XY0qlyOfEMFyoGD1/pHuHCYIXjigthXJDKnu0hh7mYITwUPyuai8fuu6a4UbngTavLTrglxITw+NdlS8VgfqceXJYlQQHDI91KXoxDSz8FfhgUYZn6VQfH6WK8/9J5UKOrCy/eLl0xKqrFYIWFVftSEspXTk5cZxMIXn6CIf+cwA0V6f3LqwlkjcVOb59GwmT8WWq8mICj0atruyypSO3nc76/pSLw5mH64mNsHDW3u3TgMxsMhx3Pr6zhoG1Xpep1JgeB73qeHN7NRNzr8MvVglqcu3jU8PVDApyFvrPWBBAYnjV5a2s8AcvqqbfSGwlMJHCGGSMEpI/pq5ndpcJywNsqbioNNzb4ERO32LgutI/cNsTGZ7OKvtHdHPysGTOe3cHBu6Me/RkzmBCNVMRbNpDIFPepjzjUYvVtjfORTgzYXo6ddJUhoFzuoWF6jsDn3Vdm5OYS+Hn6R/Wedip4Vx+uuKVEg9qMa0lX/NhQKQo2aq5mw1pJ/Chag4OYO6pDd/M4ULu2LfVXOwmbpc91H6WQ6K4qKJx1mMDojZ1VkHzB1ccmwTecGXizIdVZm85A+ZlGYaIcgfWfGtqylKio5xcbax2m4ba6VHy2Cxuprb36316zUa/tdi9rNgfj+WlfS+5ycLp2p/KCbhKJe96+r3xOorQo4Qp1CQkPDZP7+R4ETAoveiUN1CDk1rs5VoEM+BwPlsTkMfAoZ0p+WA4VwfvaTnK20OBwOIrm3M6GrAGFbzKVg+3JgTY/D3IQvCDnrtxXDhJj2249XcnE2fBrqVO6SXSI/f87cY3Es+zTBzo6CFxR7XBXDiKQLsKWHMPPmKxrntywkYFt/XFZrDoqsrnBEU5raRj3e7w3+zEbjjPdPPSjOVgXkqaw7xMHvuMtnoE7uFj58oBewRgJ7tqjE/qKTGxdMMvwaTkJY2Gb9rzZJLbmKxyvzSFwYfGBJGttAq8WtL/JPlUF8aqOIuEAFUsueq3fcYSGr4yM3deesrHznNOehZc5eL5I3u3xES6KPO68udvKhV1XZhlNgQbfNQcm1x6iof5d2AvfuzRYf/Kr+tVAheOV9HnrDtBQrF74X3MQDZHFN3SnXaVhNTGp/dWwEpXPLZWDfTsx/MRuTaWCAAMe2bkXrQXYeTi+Jz9QgGf3WmVMXgowcDkSLU9pWF7/raB8hIbujiYDWxk6Fq1vmDHfjY6VZSdiPVfQsXa5n4uSFB3z7CXuV9fTQaFQKBQKDze1jEwNpUictk5iqhmKcCC1KvvvfhGyPMbKrO+IsORY47i+rhjGYmQ4khws7t7CfCjLBZ3uGP6DwYHKxadC6QoO3nB/b0/cxMXh6Tfm9UlzIfKQWbtNjgtfxTmZJUpCnP4X0PYrUAyTjnsHH493oGv0zY43s4Q4UT3hfNBXCCup1ZVR7UI43eT+jQUXOrc5RoxALn7FfutYuYWLreu2mW/W5OLTR9d760O4WLvp9meL31zU3JjVde8PFy19JUtt9wpRe7146/v7YmQy7fyy1olgoji2ZfGXTuS/WruX4iDE1/TFHiuLheCu7bQZe8+FA9X51nYeF3MrfE3Scrlw9St5nH2Li9qfbX/vS7jo3FMaeMq0A4rf/e/p9Hbg1tA9n4pYIWQbvJ9W88TYsfLcf2+TRFA9uvaFYYgIZpt2z4nN7EL1sr/dNg1C3P/zanPtuQ7cF6/7Oy+8Awdo2x762HTg1YWb0ucNOqAl88rrZHQHDI7dpm9jd+C4eRiqfDoxs4aavbVWiJVTNi0diZMg+Ai/W3ZSBKfsW4ev9YiQca7f8du4CBPU5c8y9/CQZWG3KVeqHh8LR26JP/Hg/Tn1z83HPIzYRBkciuDh6TabgpVXebh9Pq5pxww+OtNToq/P4iPyrbHe2vV87OjB8eRfIlzpame2TYoQsbVk+nFFMQjVRBs7dTGuRIQECWzFqDHaeSrnNh/TJn9V2o3WIdJPkDJgw4PyYFvzB00eqrtv7wzT44Eje3vO93weJnceH+iey8c2v5An61X4oG2WufFfhwgbTVZfHXIQY/392UVJXmJoUsaEmifEuNTZoDkjUowDx5apfg3jo/TT/b/0JXz4v0skewfqEP30j+rfR13gpcWrRrzvQi499tJlNx4Gpy6RppbwMMdqkNewhI/8BW3E9BoR6s2WFAUbifH58XTRLEsxPk37PjvHRYzZwnKXhKNinLGzftm9hw/G/frLrSM8XDhkMuPRbR6mdt6sb/aog3dBrux4bicC25/cUnvfhQOV3xatM+HhyfeYR+fv8bD26fct3z6KoP5qvvO7xWIsVpyM2PWfGMqBPbm9RmL47fo4VG8rRtjq25sDDvDBDV240GUKHxe2JNxd9ZgH7Xz+JkclHsx/P/h7za8Otk9kWx+d7ELQvEMHq1R4YP5XknYgjIebY//N+2Mnwp/XpnYRxmJwgmixNZvEIGmf3h6wEkPrbdKImbsY88ecDSJ7+eiiRKHyKh8DoY8G+9bwsamtp3DwEQ92uZTWngs8WI08jx2bXwcPqzUbIyk8SKwslE4d5KF9RoLHdooIMX/tlr3eLEaA/8rWgvNiJH279HQsVAxek0MfO1GMmRuO9Pt/5+PVkuf0sSE+NGxOF1xI44Pj7JvNluPDVbJm8D8JD0s0Z+qlNPHQ/C58jkNFLTqTqnyWb+PBsmeP12CeELXpn9u5RmJkT78XJQgR4/qZM/o9hWLInylSP10sRt7Chnb1uQL8J/LN6R/l4+yQpd+ncT7u5/cknjrNR/TIvqmL7fl49VVyir2BjwPvpq58o8THjqU2CZGXatF+M7ZF6CkEZ/vX2t0bxah8V3Nu7xkxnq+4r7W/QIz41LPsQz/FcCz9IhVhKoFRVMCXl8sleGVED1GWlWCucMn4y79inPjp+PxkjBgP+aS113oxLnhYFJiSIhj0H/L2uSgChUKhUCg8hLXr2Lgp9MGla1nUzDt9+GU44nNgZT9KQpN/Sr/txz2v7e0bFkmw7PzKuJ2nJFCtDl913VWCJafPlO8ykmCvRsBc970STPswM9naTYJTNpes210lKBx8bP7IRYLBedaqJrf6Ubz7aumwbB8+m71Y+ru/F+t80rNIpT5wVrtfn7+1D4ECu01y/0nQM772u3qUBAMWcdcO+UrQ8+vi6hVWEmivyux97SpBRWX+CTJTgvXOGqu97kpwdsxy+brbEtz60jDxZu0APumkZnw06seOM47tN2b2QUbx6dFFan1YHLa238OuD30aey483iKBp0HErNkpEniEM/McQyRw02mznLNXglueX2fc95VA/5zmP4tMCeLmzimV7pDgyweDDwRbgvXT13h1fxnApQK7MTuffiyWnXvsfmA/lKe8cDkwuw9bJe3kb/c+eL/UeqwfIMFAZ/GVxmcSDKyep6mQKMEAKVfZdFyC5bKa0fsuSFC+/FDIlHwJFpkEPd3Gl8Cga3fMo6XdmOmn257k9RXh1LC4y6n9mL9q6wj5oB+nNklv+/qmHzOOqvipqfThutEWe/+mJmxwXUndJmHi5m2xioDJxOKozfvC7jCx6q5M1BZfJn6XJNU+YzDh27Wk5XMpE0GTRZsdc5iI216wSpRLR8Cpc4Op6gwotq8aMcrjgNV6Z/yLAhd2v27eunSdi0uJdV9epbHxdY4w9HFDE6ZJfWr/8oCJ+IE5cw4cYCJ1WuemFWuZqK6NS9G8x0T6/KYCrVYmvu59X7TjNROUBzn3fv2jQ/Nd7q7XJxjQeB96SMGaiwByyfzdj7kAcX0Rb0oHVvfaGa2/zEbz2eB5yVZsVB7qH2HUNaEoa+FlqiYT5Z9Eeem/SYTTKbMijjDxuvqo+bI7TBzTlTm0rJqJ1vK/pwx2MWD0cbP86EcGvr9RyTpjz8Um2ieX4hdcyFPXuVvJdmDnm7Nfv21jY9z0fp39dDZsbfY83VHPwjmVi17rdzTBslrXdaSfwJc57XVnf5FQbHUTsJ2YMC2rUg69zMTXzp4T8XcYOHT6jvozAQOxg8kBKmu5aJq7QXV/HBcP57zQGJBwwQxs/LtPgY24f0NEcSsLbXI57/JTWVDK0/x9JpyJwR+PNGy6G7Gp89mX/D0kXl/trAuuJNHnZXgn7jcJz6qRi1sWMPBuysFJiz0ENpmVTh75j4vLBw/k50Rz4exNK9wk5GLl+c0PZS+yMY8toSpuZSOmXfLp13Q2GoNsctddZ8F1YIrSBQkTpfvylp2a1YgdiqttNySQ2OFslEhtIBHfY8eJlzBA69vtP3aWQLN++o+INi4+ZkBqz5QOxN99Kys60AEFX9Sc8mLDObhvoDSIjSlbTqy1MWTjecyMS7XfWHgfse+KrBMLmmvvfmMrsxDRtfDIhrh6ZPnbip0iSBy49d+mtxYEpFo9bi5/TeDjj+zho3Quhl6v1q/J68D3kRCrjO4ODP845dOzgw2nio8x2TZsuBualpz3YmPUak3qajU2DjgflJsSx4Jul18IxZWFEe/9D0tlWVjYMWnz62ctPB0H+scqCFgf/dP4z5yEvPzawEDtDpjX5+Q1SXciNE7rqya/E0NJQQZnUhiYc+ySYrGYgfFo67uahgQyJXOuVUUTeGbi85b6nYHj3P1WhVUEpig//TSyiMSSAPGusmQSS4utyJsNtaBQKBQKhQcyxmvd8So+LDk6iypkBNjeulnVU0cAH/G1jXfkCTw49julwY3AS51Fj1a+IBAs6GSbtxIYf/wwoH0HiZnGVw42uZOYKXlC3RNPQiop8wd3CRNzar6eCGriQSr2b0r7SRbm/qUMBb4R4lDpnIV7OEK8SR3XUJwvQlFp8g4t/w7ElRV9PDG7Ey9+Ez3vF3RiCbvQaMbPDry6sm9r2LcOxB5T6fh4qxOia7PqXt7tRI5Vta7rSCcKgy9GTHsrAO3H3Dl/G0SYn+F6+m1dF2Z/3paw8p0QHSb5d6iyInSs/rBhA70DT9zDK0tOdaImNXNwamgn1odcMNvj0om6larWdQ6dEGabtHm0dcKoonTAKLILw6FBe8QdXTA7e2zeY4oQhG5PWdS4CF77W8OPXRNBuuV3ws+tPIh8llICfwhRMrF6z86DnVhT3HthHbcTlkOyr84KOlHO6PlbX9YJq5iEaSbFnQiQzU5o29SFBLnmaYuru9Dc+vZJwEEeqK9NnMa0hYiscTz6dosYIzUn6Nd6RajZnnNBnSbC3vjYtltPeVCrrmbZ5zeAX8/6sV6Fia3ydze1yTPRNPz9nF8TCeZw2bSKNhL2g12Do8MkNN9735QTkDBkjEvdaiVR0b/uXupHGmYPwWTkOxPrkx+VZe6nY6T+654saw6Ofu/74dHIwb6+ysMPylkIyzw6Le51A/LOBP4ooJLY5PVl/a5UEjZe6Z+ybpFYOM4qOf+JhEv/7PCTP0kUrB27lEgjIfPIcuEueTq0iJQXjVdZ+NpOLPmQQ0dCe+DJdZ0c7G8TBpeacjHTcge5PJ+FXHrA2+jTLDh9C4wqzm2At3Lz0wp3EsHKmbrKB0iwZZ2MqLEkAu/kzvQpJbFexm6NTzeJe4s//NjgTsfPdr1e/WQWJkgfFmWEDpfm2W9uiThY3RT9fqU5F5sUAxP5ASyQGbd8t61nQfOFvfmmYSZks6T6lNY04MIZPcV5EgKqO1YM1SmRYGQ9eKl2jsS0NBle2UsScWk1P/Sf03FtTs5b8iQLmilV3/NUGRiaGR+6uZqDUvnxi/XLueAI/PetDWQhNDDV4LoeC/UXbOdc/s3E10Nx7pvPMiFVqjrNzq0Bu6hvDpXXEKi72HM4aj2J6c5bN6YfJqH1zStHT5oBjnz9HmYjC6Vnxp58e8mA2ZPPrZo/OJj52JBR6MCFY4D8Yc9bLFg0F9465MbCm8+FkTaqLPzxWXQq5gUTUg8Dgh86MhH41+jjZvkGHLl/IeJrGYHW2IgDF6VIrLAslJ1bS8edwNK3pSdYuM0fmX7Ih8BzJTutN6pcnJ9XvnbLJS7ad6h2d4WzsNC5QXHNDRbqlu5yKHJh4aRP70MDBRa8DWp6tMuYOOldFPncgomKyjrvjUQdqtTd5g0RBAJlk8uaqQxo90yoHdjNQm3NFN2ETAIiTYN9qs1c8AJjTvya3oHS9t2XXQ6zsPDhnWcL/FhQVV7a0R3OwkdN+fdpW1iYI9tybOUQE/Mmb/qK45lQ/94R5ePGRMS+oPnBDrWInz55bMNJAv8095M741lYSjY+4+mREKm6E49XdkC9k6k0OdmBf/0bRtYm0DG05mqJehcdL7Pv6m7RZWBZYPasyxcZePFKc6llJwO7/lvwX0oqAxL91p35zwiYsc6/kt1G4klUzEhzRg0cf5s9Wh3GBYVCoVAoPFwvYFAFinw8UdvzY743H16/NekaaWzoj+yyPLaJg53rTpzNBwc1c3d9mbaEg7Q5lY0pYRyc3rdgReZCDhYnrGndM8aGyYUDP38bcODSJXM9t6sDR09HOj8JY+BWzw321MNCTJ3G1dxwWgDlrL5tJwgBZgbO3vdtEwM7f4W8V41nYM+HjOrzBAMrAg5M3Jlg4HSDZqNJEoHlJSf8yuxJqPteZVmFkyhQ2Cm2ncPEmruHdhU38WDOrwl1DBNBc0HhqJKQiR8aMQcP5AsxY9fv++r9QkSO6j+rk+2AlfYfXfvnHfD3Weh9M7cDQsvRN73xHfi6VG/xyg8deLLx5Cv13g5M2g3WTdzphK9oh8GqkU74yvZoGQzzQdhF3FI0F2Jsmfq/3lYRfLM0yiKaurAs4u/L+2+F8Gx58oV3swNtVw9/rZrdiZGqmCVyyp04/MznuOfPDgReuE/cVu/EunSOb/ruTljemuR1cTqhLLd55ZLOLhw3jf081VGAF18UJ7RyhXjy9upzlbliyO7ev5aZLEK0wqMnImsePnpElW6+WYcAO/1kbVsSrUk/mY2mJJxDrBce/kfAJ2RhVslUEq78xAXP9Ujs/7MXxygkOMUS274hAvy38QOun6kIHpjIGVjHRPIK92nxh5iYKJOSvMqgoalAqxUyHMS+dVyZ1MuE8sbVPafS6rB5tP7Gm2kkqvmqbdY1BKj8b+4P6wks/3qZrzlGIGmd7KrWOSSclUS1Xb8J/Kis9C9ZQMO1NoeFlQ1M5MjkBXr+Y+LjpJF5XQ8NrmzurhfnOFg/i7g5m8MEI3MiKOgeE4Nx6448S6nD9zurLD4lEaDc/dU2N4VA559Jz4hqAs86st5XcwjQBjacLpIj4XRzt7WdLw17mgb3arUzESy1K79mGgt102spcwzoUCMuXJ99iYO9xKRt6y0mpHxWr17vxoRTYJfU6mVMPDetaqbMroPsaEKu4kIC+W8uDbuGE5jZe9Mp4iaBrNAfq+e2EJDxi9j58x0Ng/J+y5tfM6Gw/ct5PyETP8s1lMs96CiZ/2tvgScHoy//Llxyl4ksoxXDFzyY+GXOaTutxsRCbeXFWg9JPNrT2W2IOnSbKBzZtZbAZFWXomAXgeeb1lzsuULg9JLN8hnz6NgiHfelWZ6F/awp7XvmsHDliUfjv2465F6tf/0ogYMKlhyzvJGJV5sK5b5cY0JFt3D4lRMTmYkzjIzlmMiu9TDe845EqNfTj1fm1MGZnXe0bzqB4sq3VmE2BJ70L5n7uZCOuFff7xa/Z8Ly3O/Xo6dYqFa5OhwTz0Bc38LB7goObLNim9bdZCI1f/3w+RdMmIUVbJA5wQSv0v5yzEom6MlrWi4QJHwOq9c8jSMReSrRRYZai+pK57qiGQQ+do3WzXtHR+ZP/bL0BCb2lxvTaFYsvCsxfvr1KoHXfzYoea7gonZxfV1pFBMafbN6L15mYp13qqpDBhNNpyJHxt2ZWLrbPDdpFhOyUlNyhI0k3icmb9SOI1Fn43bswoMvsPp+qFlxnIGtWZef0aqZmB6YVDInkgWF5mmLt6uT+H7kavGRpR1gblykJI6iIZ1dUBVL0rD4k/wKh//o8JEc6Lc4R8eny6uEBUw6PmZtvHYtkoED1IHThiEMlO+QjzreTEBTbcXxi8eqEWSd1XhpkAP+TWc3Y0EHKBQKhULhwVCJMhGkxMfSsTeUiRYWfowRp7cFs6Gk//tx/Hk2EhYQQ9w9bLjPtx49VsiGj2fEkbtubNDPKKwr02EjeVG0+xs/NhJI2dYKxw48SG0bujVBh0nrSunHj3hYX2h8QOmEEPpbfI1aQwQw9Pfw2iDLhszxKOr5XDZWZz2aUvWOjYdxkY7zb7Dx2rOkxKGMDUcFu2bhCg7qV7LSZUbYCGvYVfVHj4PhB80vcgK60J2lGV14kI/+bQX1ptcYiJtxs3jnWSGW/AktXBclwCaS2Pb6KB3nPbY84BXREa56MHfzdAac6Ku63bQZyNL9XjBRwsDfBYXB9w4SGOaX6L8+ReLKlYt4K83ElAPTbY2beVgbIKigXhHBfpWcr2uKCFIpCuLHnkzE+43mPCeEsDsmVxzayEX4jC/OTuYdeLRVHH3XpgNzVtbQvq7qgOrff6ZaLh2IbcxYGZXQASXqgoJHPzvAM9892vGrEy/lV3XOn+QjKXW/gredEHMOij/L7xXirXS1ktyECO+nbbw/q6MLLr/NHq0O+4KUeZY66y8Q+OYsc6b1BIGQLZ4FR9cRSNiibVauT+D8i4dvXx4i8H3Xov2qGwhkbb/9w3E2AX78lRKtlnawJCWLBSdJ/FqqEnzxCYnbujvUfDpI1A0cVihlUhHkydl7ai4T+a3ixv2hX+Bd3qFdsIGA5IX+8Z2/GRh5MXX0yRgDlgV3w7XXEFCNrTeP2UFA/V56gKUSgdIh3paN6lRcnlUfUzaDicc7VnW4mTJRHR69YSSQiTidDH/mShqWFHs1/JJiotIy7umeLySS1L9Zp4R8wSxvY355GQNq3jMCFlQzMLVy3lj0MAMG+kfo52YTeHime6GqJoEetRS1wFNUOMv71ayazcSJbV+WVm9n4lroirNeIUzc0tfSVA+iwTIy/lJBBYlBxXy3+dEkzBb7Gc60JGFGRuQPDNdAu/yB9VxrBhghqTfc8xh4vezfmY00BoKmPmkO7Wfg0E6eZkU5Ff02/GsN30jMiNrSfWQ5ExofMyC1h4mKr5e3tN6hodsl1m5KNYkbwlerD8aS4H33mbbPmoQs+XCXYj2BTYOZjSs0vkB2mvrU3V4M3BvdoXs7gYGzVdwVDs8YuOdW0jdbjQYTi0m9Rhsm9i08b+rswMR5ezJ9MJoJp/ayIcWVdLyKSZN/OkzitghbcopISHGhmXKBRLxhqr+qPolbpyQBm7kElP4cuhf8uwYjsxYkPNzGwGUbn28CXwZuRZf+ElTTEN3vK/NhmMSOohNLfucz8SOpnNj4kYkblgOL07/QEbmpX/KvgkTFjpuKNjQSp2cvciBuk6DZx7R17CVRq3pgITGDhMtPl1mTxQSW8iyjTWtrUH44aN9pawZ4XzX2XHhMx/1bDvaOjSS8Drn6XL/CxJW+JxdqdrBwe+GDUPsXDEh5aC2ffpeE53+B277cI3Hg0c9zt96SkHrHdzA/TcIpJUuasYqExXbvaWuEBP5MfZ7yupJA0t+l+3dm1MB0fbK7PJcOu+GzZnPGScytCHex/8gELBsCYkNYGK0yvf50FokTPqNZKaFUjPuzTPktVOzLP3tl33Ia/DF380QgDTe3L1u6iEqDX03w20dhdBw1sTzM9GGgaY2f9O17DCxVKdYVGVbinPeVSounHJSUOS2lm3YgmZycvoTsAIVCoVAoPIgmjHQYM1gI4+9VmpvHwnOVc9v3FbAwb8I19dUNFoR/G24bD7IwsH2qzLE0FhYOLE/YepSFOT37183KZEG76nfsB9kOkO9UkxMv0aE+KJGcNuDB4ggl5/JTHjZtXrZKeFaI4ROiBIXtLCTtnnbappuFd1dXPE7+yoLWCakhUT0LG8xNPdRGWWD8bA5458mGVOa3H1d12Zi49d/NvYfZuBUz4PC6uhNFNLvIoCEe1A5JHdWbzUBDxGxLjzc8BOxbO3j/khCjDbVjjtEsRPAvj7WqsfFI5ZmW8mo2VCZiQ49JsbGSVi58qstGzBOPxn++bMyMu6Xw3zgbk1G0/JF1HMz1vlTMPdYFv0R8W3CMj6dGfs9Nz/JxfeIj8+lzBl7s1LcNvSpEIss+ZewWDS37i76t6aaBpc4Zv2xAxzPiwcZn7nQM6x+dZt1Ph73Kus89yQwUzb9RnRpOIPz+wcnH4yROno44P9nMg3fLrKD9N0VYFjdl2oGHIhj7uGi3vRIhSHW0cDqfhNIbkTa1tABHDo6eKC1uw5pTJpWbZrej5UVM5tjTdpw07pIfMaFid0mTrM8gDWf9fEWzvRgIOe+5a+FOEo5ZAZPar1noOm+YrurbiahFaudJEQ9TR2Nu54/yMO61UmbLFD72MC17XyW0o8fA2dhsEQ1t65kWC6L50PRxaCw+yofchc/Xky35OJ18y3gjwYc3Vvx8bCTAOU/Dld8MBQhPi1iovFkAqVsFuas7JVjy2E6pJU+I9Zuca5qKhLBocz/bWCEER5g1UyRoR8i3Ltb0mwJQdsz2/TOHhtiNCfx30nz47Zp8elXMQ9shqsO6YD4+zdk/I5PgI/x44MVfhgJ01o7eK1ETwHtk/f4bFRKEMj7fOZUmRMzxJpuge0Lcl6L/PflMiItayR7iS1Q0WiS9NA4VYIlM1bn/ZglwZYrfr4r5NBxaZzpVNoaHGvPZua/m8lE0lHFY5QwfYTvz2KcIPqLFBqaW6gI4H3njHP5EggBls8MZ54WIyLNccDdaiGQL+9rbiUKEeOf/vbaMhk/Oq4x2ugigtMBd2rSfjyWSlEX5kXyYPNtcRxVRceND9Hy36zy4PBcu1f/Fw6eDe/mJe/ioGOvc7v2Ij1fhNx+JUiX4YVp08vc+If7Ry9R+HRRC+fiX9p/+Qix6EDv7/Dc6ZApKhn8MCOB9ZrCKHyWAp4Um6aIiwD2nmpOOiXxMe/iHrR5Lwy/LLwczeTy4S21e9XsrH/szKq80JPJxM1vTTsZMgqCr/w7fTBdi3iXHR78vC6G5YN/uX9eFcH+cJfh7ioC7St35iD1CFLHHrQIHBCh8aeApihJAo2f+t4N/+MhKqb12RciHZp6z5kAaDY7Fl4uNRnjYLnyYorCNjx9/DmJfnRgXftHPq5sIcffnDZ/394VwFaSfL0wUws4kp3BeFBORAsach85C/HNZNXTHWYhvK/dLK/YL4HMItxK9BehS+GH41lYAL1fW1JwxPqwLVsrJ5dOQqjRjGkeRjycrW2ncE2LUjn9zNRoToHvs5dYmDyH+jR5Zc6BcCJMZTyLN5nIw75iwfuNeIY5U3rjrv1mIi9tFFb2bhRiMqHE8+laAgDUhQ0mZAvQHe5VethXAV+GAnquSAI1moZ5pjTTYJX3w0R8ToT2mP/3rewF+RD2/FLlQCMXIQ37zw4SgUCgUCoUHew6D/t6oB5WX9HMiF/agTC+ttn20GyVo+fy8uxus897ZM69147CDY4bjzG7IO9FMDudIcHuB0dictRK4u801TOfTERwVNsuyUgzzV4I1VeYSTF/xn/6mOgnqSh7tvcXhYwsyW5x7Rbh50/pBfJMIF8b/vN/2RoTpi27GsxJF+PbDPSi+WYRT1Vf4CBBhWNj8t3mVCLW6+K+3Q4iTDgUX/pvTjd35t5ZNmdaBnNDBnSd4QjyfeUFu+24RjH/OcRf/4KPJc1y786cIGu+zJ+aSIkgtdlNqKhYhxeh398FbIqiGEifDy0T43GhX948QYfNAsiDxhghLjKXmvLAUIYk1tYF7vxs7VxdqWKqIceGy+qHSgg7kz3dihewXQd1aSU5unA/1FfUaV/+JcOb82V9lfBE8eKtmnqsRoW+iqkvxsQhntjzx1aoXIbHhu2/KZxEcM6oOnhgTYUOvfnJ8vggqsenMXO0eyHg+tfieIkbX6XkGjx+K8S96WDAjqBNbxAGRsxrKkHF13R/1oGZ43tsx+/MDBvI/i3xlUxjoTFBcWruLgbCrLY03LAkcOHGyxFyLgKfMxxbfYQZmal/blvqLgbfzWhwvNjbjzjkVo1cnSej8199enk2iifPJnyYkoUJRp/SebcFne63O6er5uK7QmUTRaoPPuSFLv09tOHPJ7YXX1nZQnjCODjCp8PI4x12bR8ccdvLiwfME6GdO348qYcJrNNd9mW8n6mL8qAV9PCh/UeKYTPKgozKx56MsHx8GNnF2VBKIOVhx17WvDU8o3e8n5aiQn2FwxrmLh+9qLzatKePBcWOYqNWOD4XYOa8SS/j4yzP1CF8mwGsFF7rdMgE2Tjp5D92W4GH1ZqNpzkIUuW3vneopxCHKLl7sESGGjffckHlLwFq+e8q3mHYMdIdtsejmQ/sTzGsVqfg2RZL+woMHe+WPZjv7eZA6Yi8r58bHUGXYh7FPfOxdk3yauUwAgxM0164wCW5PL5LSMRbiZWZZwZrtQnhuq8/JsxPi4eYoVQ0/Aq4fz/hqzqaC81upybWaj84vdrzGfXzMG/3wUbOjHbMTtLPHfHl4vOhWxQo2DwbkynIlUz7SzDafH7rPx5XYj1k7jkuwRHut4hUVIdZVbyi/vFyIjqNmhTO0hUi8LCM4dYrE39l+wVuraNBfrLH/u5sAhv3upw794CPgz6iclw8fZwxyfAVhVJiVhE5PquGhV7B8YZQCH3Z2RjSvk3zU/v3V/t+YGO+F1xdqGwuxhylwSlwjxIyLX21mbRAiQilyeq8jiZHa+1l+hQx4fE6jGbcJ4JzW1J13RID69txzOh18OG1lfbOo5WP8z9bsfAkV9i9b4ycFPDzIOlfcYc6H99JE4u1jMfS9auWGKELs9Iwejj0kRJZbgoP8biGKj7x4YraARMX4f+9mPSKxMHJ6r6OxEDMdTSdq2gSIuLbsmrmlAL9+HveapiTAki0a88YkfGgv6a2K/U2FbsK7ZqsRHvat69VIWiNG0eKC+GdZApAqgaeNZYRIXbXuye5jQqi+YcfHzyfhvykwOkqOjUc59379MxLivc6PxeGbhFjtT33197UAmW1qF0suCFC7iKmbby/A2URe6uu/fHyRf//DZzENbxtiCu9SRTgas5drc0iAuCilL501Alhcp/36rSlEesqLV5pLW0GhUCgUCg/LHO+fKfzejSXTJpOl27qxcM6m69yybly74lPma94N+xZ/2m2JBKt01hw5cliCxqv2dJnpEsxetaZziRIdXH7Lg8z5YiSZ7ss/cUcM5vCXnxVLJRhdHp/0sJCJX/9JBvTEfHiuOF8rfVUEh/j/OssDROh49iV/j40IHzdpNTwMEEEsv3KFs64It6af+m92ixDBcVuL11wRoqfyoMc7TwnoeguHh7K5cGG4bFt9XAj7o/x/fzuEMLN2aF0+yoT+0icv143z0bOVvfJGmghdz6+aWJ8Twb9m1WyeswidFU/q0vaLoK/UO2Nehgg8hY1zWg1FqL8+/VHfDyH8X7jKlPZL4MBPGrR5KILef+tzuC4d2Bv0oXl9nxDTSge3921iweD9T0MLGQG4818eqnggQmOY2/nQaBG87vzeOfuACIy009I1fiLcVr/+/b94EW41+pEGj0W4sHOTr4OHCLIVqR/9zndDQTl8zk8VMTp1+iefrhZDjW9o9mxmJwzPZd763FOOuCrZPV9Kaeg/V+5p8rQZoedS5u1/yMATfKpb4snA9i0XQiQuBAZPpb7Q1CVgaHZdJduQgNSNoqXyawiYGS7anb2tBYncbfM0npJYc00p17CLRDUkO2xVmNgzGBm4oYCOvy6KK299LYevsFjP2KcF/93LuTxVjQFXH8bmSi4dPw+m911lMJB+69Ksd/4EYioT9bjmBKLdCIvJWQQKfjz5+FrQgnPFGlQxmJA9/tv/TygTL5bWTVcoYGLJveyjoa9bkBheHjQQ0orfVTLFktZ8eIz5pZx/3oZnJ3ut61e142sJd/vMl1Rg98K7f1zpSHx3LnlvJoHxkvDerBImvEZz3Zf5doL7/NtITR8Pa3rvUHZN8rDNJOpCgywf13gbZ3iUE1iztj7PxIhAcH1U6uGAdsyL9z5h9rcdI1Peq08e4CH2RWHj4e88mI3YpS7fz8evn9G7DzXzEbn/nRVzvQCtVxuihIESVBixmNp2QjBaefFrXISIPtMfkbdfiE38g7fPnSVAW/vLdN93BhzZZxweDLXDvl3/iK4XHxoekZd/1LXDdsl/BvOO89CRk2Zr0smD3oy1+8J38lF+2tN+6A0fKx+/abXxlsDCy0EYt0YI19+uaZcNhJieeTBhxhYhuMdWlH25ROLch/eHno8SSJslsv52jYYKha7Ll3/z8XvxBdUoPz72mxuaWR+hIrUoOSs7j4d+pTVv+5bycebx7G2Lwvlo3OiWONAnRvemo3ESSyHi1pZU7dgkhD695mP9NiEaxE1GS/eSKD4xTWvdLRJK77xz0vYykMRdo1bjL8C4ikL+dgEfzcv+6Eu95kPviN4fWgsVDlxyxdGfPPC3vX/C38yHc4xOzLlQMU5OSA3/GhHg+t/yjng3IYSjtZtmWQuhdOSCkoYXiVnn2u7sCyDR5f30XGQNibkdlJV3+AJcWiR4r7xXADvVFiNSQwCD696zK0b4+EaUZI8b0SDc5JgWp8hHiq9EeGW5GEcbHB3EJQLE1ykYhy4TwozR5JccKUTKlIgeVXsS/Selb1XrkvDfFBgdJceG7t+/r03thHhh1GH2qUoAH705j9qvCmD65NJveQsBbs533SozX4CK0Gnv+A40rOs9te5rjgjuPXXmqw8IECqJWVtSJcD6n9v301YKMXOKntOfe61o86xWSlVrA4VCoVAoPBjtPOCztqYbhm53vacVdOMMc1Cv26Ab5U6HByXFElQsN3n20FOCDO696eNcMfTMLWzVLeiYePzF59qACHVNOpEzAsVYvftcy7RhMdyC1w5sHGSi0cbcsTKVhV//SQb0xHxQ92w4FREhgldwu4WypwgXM1S+FoaJ4JbW1J23SoQP13pI324hmnrLnfNjhOiJ1fi3XV+CE81f0izpXGzZ9OWllJ8Q25scfZlsIW7c/aXzUZeFaUHyqdsZLOgvffJy3TgfTmfS22YkiCBJ0c166CtC7vK0/pfHRXiSeaMl4IYIU2+9KlvlKILaUPTmwG9CUGadtv1dIUH29pkJbZkiLO4+XrY/rAO2DM2NCt1CBCvefBxxmgVSlCMjr8yGwfufhhYyAlhbH1Y0vikC/8gUQdspER7/PqTFCRFhxsuNBbEXRfjkzItJLBGB/bpoCrFXBP162Ye29t04HPo359wCMbRv+N1doyGGr2v20afanciIFROfoisQuUpPd8tPGoyu+hSeq6Zhm6991R5WMwJ++gWf8mfg6UaRqvkhArb+fx0ubyHgFR8glb6FwHEFq8POAQQuemx5wCtqgWrsqv4nLBLvN47GFM1jwqWnflnjTiacf5+IE3+l42Lw/aWrLlcg8+j1eUkHaPjUbnPL+XYLVBdGdy/op8PRfSKlR8DA6PXuotmhBJ7I+S+psyUwSB5JstpNQLCgTrrbtRWUQ7IvDwczcUuJvT00j4n1X3I6EnuY2PK7NT2tiA5SnzX4ZxcdcbX6Nxs1KsD6IPJuZrZABp/8z5rSYRq1UdlqAwP8QjWTwEYGEnVD0hT2EQhwjynaqEfgsWskNyqhFRGSlXQnZyaW3B4J8L3GRL51vUxYHRNzjfXaZ/S0IHWRFfvCm1Z4bFvYUdDfCoe1sS1P77zF/R8H0thz2rFaUui5KpGKY49rdMxV6Vge17vRcxOBwHQfL4UOJrxGc92X+XbiR8PppWQfD2YzVVd5TfLg6iXzmCPLB+3y9jnN0QQ86IzC3HEGpp35l0duZSDy9hzBAk470vN3KGwsaAe1d7G6zjEeEuTeGy7k8iDy3WWxz5YPw9NXbJaV8GHlPJvfbC1Bn1e6iq2hEL9+ZzZYmwmRnfm8sslaCKOfEy/nJpJoNLvh8HMaicP8D1ZLbQhYrFc83RpAg6Tvs4PuUT5W11nP1HGg4mBjkZtSNg8fNR2PuizkY45BQsCiK3x8qQhyP8wQ41HT+NdwZyHMK+21Oi2EGDnipuZtL0THK8GVoz4kGjYsCj3/hEQQsS0oq4eA3ZYfD7IXMTBGGQp8w+MjcbbnmZxsPia0X59+WkiFodFB5yUkD2H0da38HXyUaL8V5HmJYfBu17AlRYg9+XKdnINCZOfNNfZ2FGLpoqoSex8SatoLtsaeIrFUVhTNCydh8OH7xoJ9JPRXP7kTskeAio2K90+pCjC+Y9HTVyw+NiRv38JaSsOFxsbyOBU+hJtfbKj+JoJZHaUxIlcA7y+fCpRmC7GAFibSPi3EY+HO5rGbJKbtpofdDyXxr7DMaakziaj7fVbDa9lQj5+qxGoSIP92wEJ6ugCSF6Gqig4C/DTTuTauKkC9yvzIg9dpqLt2I8Xztgj3fzMzJ4IEKB1Ou5xMFyB4inNhtqEQUXfJQA/FNtSNTOEOebUh6TAMn5xpA4VCoVAoPMxD/DVRRjdWd9rSWcu6seFAv77uQwmafNalfFwlAf3aC6UpDWJ8dPI49egMHVHtnc3DH0TwqV/+a6GJGMIlNjtLy8VIeNFc9lmThe8XhSvsm1joFzb/bX7Gwq//JAN6Yj52S2+JVfcTwXJ7aduzKyIEt7m4Da8VIfiH5sAPQgjTna2ORreFOC/NO/FgQoywfae+K//jomb8RVbIASG+XDfwtqMKUdySRrE8xoLu05eH2xXYWC2XburQw4L+0icv143z0aVxX/jytAghDYatd0NFKGaqbH13W4S6PwPUN2YiXHdJNRqZIoKO3pX9Y1clsIlYcSY2VQSP9GcJHg870Hpbfn1kpxB3t204o/iKhd8Lbdm37dkYD9/wVvk/Ngze/zS0kBGgbTRAnXNRhKP3ZRQaY0RQl5SvarwsQniR2p0FOSJgXu0/j+MiDF8LcRld2A2pwrk3dRTEaG+KiOEtEkO5XNlWza4TNNEu7/7oCsQjOdDmJw3bn1T/iqmmYVL69/nyVBryrA/b/UtvhlPa3IyTZgRs29wyV88jsO9b066N8wj4nnr+5tdWAlMH3jyVG2mB5+qxFRUkiWFmYyltDhOJV++5dtsyMe3z/Evy3+gooMm8cL5cgXeR33bfOUCDn1iNSVtPw5qCA+rjzi0IDg6aM7OSgcHhHVJvdxJ4cbLB10+NQI/JF2bvKgKhz4wV5txqxebpXEHkKSZYhbkX0l8xcfzopQXZEib6q88mFRfRcXlKRI+qPR3Fq+6pjWlUYMYX/x1PHlJhlzHWH5zeAq+Ml+n+8xjgLI6Im/OQgeibRFeeDoHACuFUpjSBTd0/Ba8aWlF65c/MwN1MeBg3PotOZOJXf6bZrS9MENVkhl8kHf5T+L9bFtIxPyUiK/wCDSc60lOir5ej6Nn6+JctLTDe+y/D7hQd5Uxa0tA/Oo47/Se5nsiAQkvEFp1FBAZCynp0FrZhw2Pdj11bmGA4STn9PMfE0emMAel3TOiOO3KPPWhBHtdX3OHbithpRj3zn7TCi1YX0Uy0Ii9s4TqrA28xOSa6N65HxYetq1sbv9Lw9gZbGNXNwDpK3/TVzkx0nTdMV/XtRML2P5MUNg/cmCzZ+G88DJOJMdNHedjX/e1h6BES/+2Oox7kEagbet2QuJLAabn5x1ecY2D09eipQ4o0JDgqFfLVqYhI7Poof42Hv+eff1Gc4MH+Mr+RdpyPfNl9ztm3xLB313ay2S6Eb2/LIltdIZZcZ81pMRaCffXQprANJGrnZWglBZPwK6hWL/pIQKlJs3rnTAL962VtPCV0nIm+EONyjY/SwxPlwxeomMVSmDH9PQ8uZzjRDRp8nLJzvNOzQoyQgKUL4gcEuO3PmlxiL8T3o3z3x6ZCsDZebPXeQIJrRXW+tZ0EofHw1tAuEudMpm+Kf0TgNu2FMGcqiYLK+s1sKQHCc2c3NRXyoZH1mHOYT8WTWZsKc7t4eOJuOTD+VoTIp7GXL6QL8OjJ1sDZowJ4vaE81HQXosy2SvOnL4llxVbkTRsSc1T1X89fSaJ83vuX5EYS1oPis3MyWfCwns5ee14AtQ12x9R1BHiYPK1zk5CPvUUrOdb2NMTkrbO+5SHCxhDVuxu3CeB8hhPdkCWAXNTddwIZIdIjDp19s74NnR1J/B1RbXhh22gycqsNrMivgo11baBQKBQKhYfIQPMPWp0SHJ7nkJjqLYG9Q3fW4h4xdn6Q+eiaIIZ32OuftHg6nPx1V1lcFMFSd/feuVPE6Ny9p8QrRoyFcWqS8lUsXPHcaGvTwML5KrUV9VksZNy3zCpIZMFvVFNujMrHeOOH5P1nRfgE81rFRSIo/Brv9i4VIjND8rnGR4inCzo+sSvEiL9PF7G+cvGnSMH/q6UQozZ3Vld9FGJXbvfqbUdZKI6eSGySZ+NzT7ePrYQF2mdFycYWFl72iWt/9/Lxc8X3W62HRJC3ks1rjhbBdaKiRW21CD+evMyJaRFCLOrc1blTgvSfBrc3hYrwePzmT9uEDkx3Dvz9u0YIffUrmTNeshA7cm9K2k42bntcYc3VZOOJ0Wu3f7PYeBW10WXmLz5EI2WMyRMiBLrkpYqDRFAuGU67nCxClndnUJ+RCJai1T0xHAl2a2l85A+JoGGW8jyNIkZ9l0ldom4nWqd3/jz5tRLx43Vb7xTQ8ev5vdqFJXQ8qzmk0plKx4o+l0wcoCP74a7vkmOtUO2xuHI0joC29AfjkDgCUhzZzs+vCfC47RYu6m3Y2nlW+9UVJoL0i9sYV5iYJU441lvFRNhqvzD5Cga+qi3N3XmuCuEP/4X+vUKHUdnnxgpvOl4LCnctX0vHYJDzSTflNuzbKsOQGiOg2erq/L2BwOav9dL/GgicXbktmJHbhjapv/1v97EwsXfLDe4+Fu7Jzf5vMJUF5rhV4PHPDHzfee9A8jUGrJ4+XXFfqwq1EZpKXiQNFk8X3ap4QkOC6HpawaY2lA9Kti9yJvD39cjtch6B/P/CJs6+IzA13txMs60NlltfCdZsYyH05vfLLttYmGdVqeZ3gYXvcxd9PnmLgZFDJwrSvBmYK/9onW0jHTsCnJoON1Xi0paPESIlGtxnMuRmnWyDR3xG7+58Bh5ZvdoyakrAtnrqrfRGAlmeKiZKk22I2Wix9qc2Cx8SrlCX6LDgaOp2fL0vC5eqfi547cVA4jwrGboWA7eLz0ddTaWjf+7zaemjNExZ8/5cvF8lLm5ekhwe1gqO5vdLU9UZ8Mj+HPQil4GZGosbLDYTmBk751ViWDumUSxYS7RZMHK5Er5DmwWatJuy2xEWrGpeXZaxa8Mz5WcvBG/bIDrdvvsttQ1ZijsKP0i3Y/6zAO9L29sQ9235JPtIIU6kPb5yUI6OWjXu5oqpBGTLTxReDmDCa6KiRc23E/NuFFxXXc7H53xDzeLVfPCmqWVt1OXjdv7kpfZXJA5dEB+a0GSib5/3mY1nSfy22NsypZaAkeIe+VtrCNg+Iyt/j9NhG3jc3O4SFeVUt9mV7TysobhetdrKh1fARQ2jjWLI7FgVUbBUiJ0XFiZ+uyTEo1C1ntMnhHjsuWjBh1ck3v8xMP74i4RKrqGXnxwTi+6s/WDgTuK8t/av+nICLrROUbcyibOL3mdPUPk4JP+q7y+PigPDHuVf/vBQe+rO58kqEXRnd6pdqBXg1I427UItIfScrnu0JApB3T1HsI1Jws52/7q3v0jkxYX3xrJILHytkhQ1SOJEiHKDsguJKqXQvmOvWdAqTiRmWQtgN2H4++o4H3+uSzqO29LwS1DSorJbBEta8Lf80wLYtZc3vaUKMLPz4njDBiGs5UxzJcI2ML5tjF4f0o6F9svu6t5sx6vtR13tqtoxtizk+qvtVFAoFAqFwsOs1vjHJsESbPrro7xuVIzEmIzCiotiZCUXzV/yHwN2v5ff8X8sAsU6d8+BHBFeG137vGetGB7DnneOdbPAdTa+V1HDhkXyPte0bDYeb517o+A6GwV3dx23vsbG7EinhHnqAli/X3tCxVaE4xHL9Hd/F6Kk6HGY7k0hAkw7ynL7xPjz5kzgj4IObEqeuLrQXAhDjXjLr++FMGcGWf3pZuGj0S47+y9srD4TIL3iJRvpK1dh+w02si8eXMy8xkbrjIlE1ioBvt4O8w4sEEHex39V9l4RNI/nnJ0+LgRj4eUfV3+Kwd2oZn8/WISZO47rnxnpQNsbmZ/WxULI2s0trdBiI+bVt4oFshyM9iiE9fWz4Xfnyzs5KhuhQ1NvPGhjo/XRzkXfdQWwe6ZueeWxCE3qDwYUq0Xo0eoy8T8iQmuxpg47QoL7x2cF7vgmQgItf+T1kAi3BDuW6t/uhJLvu/0/1nzGrLu+hdbRDIRamJjUxzOgfWjqtWsHGciKG549uJYBkzkT93YEMPBmrEeSHd+GwKavDaZWJPBoy4mEQBLrx58H6MxrR11VW/+jqSzU3o4Mt7zMws+vn9WDLrNwVmerU1YGgUkj87qeiWrMHXzcPbaBgTMZIQHnTRgwjVybxJVloJkR/+igAwMyz5bU3vjXhup7Q4EKliTaSnxWLLEkkUW2JJ9yb8e7wY+GlOss5H85Vv90Lhsdi/MCWuey4Vd0lKL6kEBty2EzsTUBiw9e76au/AzVnDcrNLl08G74br/6go7rbXKHKT/omBIb92f3inZs+kNrOZRIYkqcxi57TxLewj0hpfntEJaEHtR/ygI/+b9hpiYbM7sDbk/VYqPq2r/B5acJrMiy2LBjF4HZOPvhvZCBtHyK2xl2NeRmqsqEq9LBmtbXEhJER8eaknWNbu2wX7TOy+kHgXQWCkbDSRiEOTqv7W3HPP9n52Nvs6C01XXmmiVsmN+8+8Z5CRs7tzC3H9xM4L2T6pXYBQQyv9nL/8phwMlzlruMMgPOJw9K3wmpBsWmLDuQSgPt1wefoLI20Pa80HX3IjB/wcfLlAECPUMsqxJvKlxny9RI32TBVVK055UKG/Gb/44xVNhYf8KqccpZAjem7nur5U7g6bPtv279YGDFHfP270cY8JHr/zpcQofRPH89vnQVCiV3Egsi2rEpYXbwUTkCgTbq9Qq3CLz42PL5rh0Vbex3Mp9nsOH36udUm+Ns3Jti+Pz0cTZOXd1zYOu6dhR80AhMzWmHvNoHiVRLO+ZLDQ6ZTqPCONHfTs61HQcDfaeqLKYiwUzxBPNOMZr07/EilxIoHDz1MiOOCd4lG9nlvp2wvlOibLKEDz3jVedDffgoUlx7f88xPuzZCtWOwUyMB1+dv+8ME0SKtF1LKROVJQ5lLxYw4b+X9/ZUKImKmKUiY1USuy7KuIRrk3gxFGu3v5eK5SNyVj/GeLjy7Aji8kQIX7qmToolwGJN44UOEIISsO6Y4mMhIlunXec+YCLjyzu53WeYKH7vE5l5lYmTBRtkvr1kQtOx5m+RAhMXwqfqRLwjIZrm8sCxmoVrjuM9nWN8rPCfEuDpQMP4zLybzptFOGl87DY9SoD5FqE9ar0C/Ik/YdxlK8QGL896j+52hBzS3NeiR8W5vRtU9a5SkQKt2/fKqTBkeXUzTWjo8h4ovqxMA4VCoVAoPPzLf3RFly/G9f+WxOgcFsP2+bQCNsHAvrjHdew5Iqzyf9eQkS1Cllx0b8NbEXb8ciw7eIgNb+cbg2V/2AjevtV3xiwO9jaujbr4j42K0fH5Vn/Z+Ccck+nx54D2Lb79iLIA19ofrEv+J0TE9wUatMdC9MZ1NhbtFCPC3PpHcEkHorvOzHRxE+LqlRKtlqdC+GVIH+weZSN4zDxt+3UO0np/yV3L4uBokHClVBoH9Pv5H5tucKDSJOxdz+PA13+QdueoAPyKMwLCXwRq5wCxcLYI1PJk3QfDYngpxQacuyJC4qEa/sbqTmy/sMhsY4YQoqaf20v+sCGjrXdW+QYHKxcLB7yfctD7uE2GTOPAse1+xsNkDm5PtDVO43MwcK/rY8UJAb7v03tPZ4jQFZKdveC8CAMnOAp1E2LQP5wrsmeJEPHWb+h6mwgmLeeX75HtwvRA2gkquwaeWptGRx0IJA0ezC1SIDCQYahvqETgTeS33Xd4DIxKfZl1fjWB1i85xvqyBFwqpHfWbm+Hxh89rcYxEh5vAjRs6trxMV/r3+RRFnoV7/oW1rPQI7L5476TDfO+mua1Z0kYRBb/9DT5Aq9LwVovpQjU/Wf9WkuOQOJsSmsCwcDCw+7COcsITP8+2rHbhsAe3ujKYmo7uk43R/ClmahJbn9vZUcFDXEDwv/YmHJvxOVuAhuU0y+6MYuDmAeb5h/WITH435XjJzVIOBStvp78sgaXBuL2r7rCAO/y5Y2PnRnoOfA+8GMDA83vnw5pUQjMtO4zuyBHha/lkOFxJgnP9bOT9+6nwmXeqycnxllIDFyisNKTjSs7mu838NhI1fKu6tIlMVjwWqVPk8TSBfEDY/cIxOe4v2xm1EDZft7RrF90WAj8rMzOMOC8/DH5MIcBG4+dMR7mVKQp2RdlaDKxP/BGXGU9FeYO3d/PL2XjTK7rPt2LbJyJlu0npnDgcXcyX3WMQMHKXTP++0FAeHk11/gIgcO9In3pCQYsTrTV/zxVg5kLXd8/pNGhf+3kg1nKDPzOeV0zM68dkTMVkzJukVCC6k77bTQ0Fs4kxxexMTIv3OxdGBt/enWoeyfZcOV1XZy3hcQtepx+ywYS000PNxh3ENi9wifP9iKBYbXnXnFtDERu+RghUvoMrxXSa6+U0hF/pa933WUq3vUa7im3JGFXVe1Zq0vDj2mnnytksnEjr6dEj8HGlZg7O5l7OdjxxaBw+TwSsFxlPMWTxIO1zadPKZJYteyyctQbArB8+zBqNoHNGV8aJt4w4KN3wvKHZxXmVS+yKKRSMVE61/6hJol9p/KdthyjIfbSye6zD9jgq6/up0RwUFqwJjpOwMFT3d38Db/bwWF1J7Vdo0J2qcNQ0RcqID/YkDJJhcMymcKivVRMeYd2GQMa3gUnLD5/job01Avzl4rLoLa1+KvUIya8p9K3rvDtRMb5mhvSC/iwOyjPeenJx5K93Jmq0XzEXjvvVqHNgilNZZy1mYWJCu1H/81gYeN/IUZbPVlwfNruolTJRNg0rXXKx5mIPcoRaNgxsUHYUXuFYOHhsuKzpc40XPqoG5f8QwhBpf3lmMMCfDi1hbq6UYCRBIejo7pC/Ny37/KGbCrePc3W199Aw8XiuHdl5jQc3PiIk/iWBhcv3cK6dXTEzdjY/mgWHdMzP33LNKWDQqFQKBQe5tJkV+5WEqNX6bWWfwyB0SuHidZ8ITZNL6UsHxCicO61LTNjRHiQJWVZ0sCGh6Peg5K7HCyBhfnMoxxkhX5ePHqFA7OjrnZVsRycyuxa82MaF5XVy7RaZLmo/L045LSCAOZvdao3XBJijBlVe1kkQuC6L+x73zpQ5XvsfL+mEO/dWu1jbgiR13nhqZYxB4cScia05LjQCC17fJ7kIPud6fkjoxxYP257uf4XB1OKpzGX2nGhtjys23OUi1h/F9pfTwEKNfbbVfYLsWXef2vSV4lxX2O6oq69CMfdluXK1HTim+bXxuJYIXyochd+tnKgfWkT/ecDLojjdqHmAVw4P290WH+Ni/H0/ZFjV7moyk3J6+ZzQXU1He8/3oHQn8ePP4gX4Fz9k87cfSIwll00rmsWI3HxFH1BqQh5vJDzvQUiWEomSwtauuBsRI2V/1qLyvNvAtuVScx5vKtuvhwJU79z4fQvBG5Lhbg4pBLYpMb+jypDoo5zLXb+DwIL6dEnbncR0N6R1PPPiIrfodOo1W+oaL0b+nnxKAtSo7061L1sjDffLQ/hsrFObsMMCzsm5u5dEruqvxbvc3/I2rcR6AheXKaQRGDrrEU34/cRUPtHqX7TQqAxIuBrmBQJ76hrYQYiAl2n9yqUJ1Lx6nzMFncKDZMX5rtn32Rj+L/x6d8G2dC8cvxkliMHqgp3zqqaMhGW/3yxeILEoeauzd96akFfNH1LozMBnfdSCyzVCehf13PYf4vAStFyaksTgfZXf9fN+kPAxFFWPamTCiNVSpzGLhpy3+QVfLzHxuv96V7/RtjoKJleX7CXA9+qmWUuwyQ+H1l8aPF7ElNMduwMDCHhd+6wjLl7LWZ9VRr/IcuAZYgBa6cageeOoxH2MQRcxSuOlH8ksOHbD7uANTQsGdp4evcZGkZ+7Upaep6NwScbjA7S2Vg55dmjhRs4YHUPHX79iYRJ4hT5M+EkTntr/6oHiVctX3alzSOQo/BHpn30C/RvCn9QLjGQ+yxuePYgA3/lvEQqmgS2vozOiL1FxZgn4Sz1jYaFcz5pfwxhY375Y3KinQ2rJfO2FuhxYPCVT6PoMXF8zJo4+5NEG3NtREMhCY9Y4t0FQxIzBxTs/rtKwFner2bV7C/QOZ5zdvonBshc64DNkwzgcOLZe6E0HNh2VcF2Lh2mK5fs36jJwcTPO/7L9nGw3lGOQf/IwebeZw4+s5nQjd67/N8KJo433NSa2kNi28Vt6rMzSHjOPpQc9ZfAN/vXmm+qCFxe828B1/8zGPzk9xGFDNiZtnbt5tMwmLf6tps9HU+t2BqF6zmQ6U/WNJvDBculiXXYhov08kUb3w6T4FRYji/8Q4Kb1ko1m8VETq15gFMTiZfXksTX15F4HB7v2DKVxEChTHp9IYEkucshhSVV8JHLDLruS8fFgBePjwzR0Zy1vTMrhQNNy7/8tae5SOp9K/7g1IE1o1dNX01SMat7+aZmFxp2ZO02nsimYeeJWor9KA2hiTs+KDnT8GlgaKdgEx3O9olNoRl0KEppeCktY2D3G07UrCeV6DijsXypbydcZl8w0prNh3Fa2G1VVz767k8rfRLGRy31ujz3CQ0X+g64Dv6ioXbH52GZHXQcFOQRE/50nLw+mOTQS4fkd13KxakMdL+sHcnawEDXvm/v3UkGKBQKhULhgbr1Nnu/LompITvs3m8QImyFWkuBlxAh9YYG6+8K8VNWvS/Gg4MHnksaJmW4YN1WTR9W5EKKSsmQdHPgdoYT3SDi4Jqa276nt7h4eP7dv08PubjTY7N6m3wHUuNIASklwDmLnxYXPETIKE7V0tXsxLnJlrVvRAIUl8jJ95gI8SqSkTPUyMFQiFWGbyAX5u9sj3iEcHF+QPv4LgcuKq7LuOjt5KLiiM2KcAYXtcq2anY3OlAQK7BqH+uA/IKti/OdBOjWLh5MThTh9VKTBwkUET5dr3wU9LMTKjuv8V4uF6KpYnHDY28u7kze1V7M42LA+P7IrG4uFIMvTUyp4GKfsptE/IkLj2+ZBestOkCJtp4sJTuQrrvQ+FlfJ74Yxb7ZfVEAifHUf/0tIrxRJWHmJULyelfTBxYilJStNFzQ2IUi+4fTA8/W4eCbBG3pRzzIlq2+VnSZh/zG6u9Hj/NgufvtfxtseDj0tPtEaxMPvZFFVQtpPCTd9woq+snDTYpln9xqPr7+bOiqdRZh8mtanL+RGKP2FwukLcUYzPflP3IRY5nhjsOm5/lYsqnpC3usDhPR8sTR/3h4mvzccNFsHoLWa4ubOF0IdU46SAvkocNtFbupnQe1s7rJ85k81KawUDDKA+H1JWn4rQjG4RE2h63E0O/yC6G4irFuq1NWxmExPh1VnnvJk4/Mj633t4zxcOHrQW7I9zpMSBlnj57ugs4cZdXkdV34nWu+RnYxD+H3HuxyvsDDfcFprZ0dPAgnLvLShTxczl/EKc8U4eEo5SKpK8Ztt76Xp8zESP1EIxXsxdhzt6eswoyPLf0PbSM6eBjYcLpILoKHhgVzlxv21MFgbaSlQXQnzGxDiL1Xu2B9ca9x4yoeEl59q1hwhYc3P6oyZvXyEHU10Lb5sghOf6PoCYvFsPc8PmX1f2JYlrusrTIS4+SFeTYli/nY3dxInP/Aw9SF97dNd+fB0P+qxc+xLpiv2RnsdaAO5Wm6JamXO/FoV5esn2MX/l5KdHKS42FL3nL2fX8eVJWXdnSHi9DsMyNjnCJG7Zeh2luzxKjQ5A7rLROjU8/jp/8LPjSNKvOiLfgwNzSzPtLDg8UMJaZeAg8Fc2/J5P/rgrEfMXErrg6/LIRuXXZdqPFx0PSW5WHdhRduk348sP6jxpUbiKBo+xO/t4vxfWZ86ObtYnSfOPWMcBRj7jo9S9c8Pgzn5/BKc/koryOyRx340LR39V06zAPj7eSmZcd5aIy9rRN5jAcFj7djd83rkO0nbxjC6sK9sz4LcnfxcHR3bs8UiRCqHr//zNsuxpSmlpcqUWKssNeK9IoUo2juTR2Fdj4KuP5Fee/4mBeUs9/oIx99OUyb9x58UD7r2CkyeNhne/pEPY2HtTSbwOgiHuJrLgY3SdXhDpvasHU9D3El7w26bwohE/nyd+g2MXoHo55fihSj7YD73tgKMe6Uh3DbZQQ4xlrbEUjw8cCxMXhDOR/WATMxpYaPpk2KJ9+a8pF2hlJRY8JHfX7MIKnGx8E5+S15U/hY/p6YocOtxSXTqI3KVkLY7Ch3WbtNDN3CW9IGkWIorwos2lQhRjv1rM+G62KMHO+78eOXGGJPT7eTHWKsJNp3rKsRw3abpvSCEjF0DTV+/Tgmxt5XE09TlMRIPKPp31AqwqKudykOviJQKBQKhcID45vyo7bBXryfssQu9kIfXNyG17Jk++FyM2KdnJsEe6vP7Ak8JkHTDrZCtaMEtxzNelw3SeBRbfHp2BIJ5He9nlN6SAIj7tDFPYck+PlpeYrhIQmmbXkn43tIgvGh6WG6of14H3Kbem1uH5z3J1z8cb0PXT4+SfQPfahqbrcf9ZOgcuzL2f3nJTC7uGZtvrcEUvHJsjusJWgYu8ndqy1BxLRVj9YckuDO3S6lf08lcAqbpdn1VIKj4w/uKT6T4FPdzEtFswewb8+ZV9U9/YiU+r5n/4I+BKS412h+7gPl1fUZM85L8JcZs/7kVQkuePR2VwZJYOZrX7VnnwRTWc43/UwkKBII3UxOS8AYqTK9/lSCQN5R1+weCdK8/zQxeyTYpH7gx7TsAbCjM7JClQYwL/FFk0htAHzuMsXTi/rw5r/vN39NNsDwe/iufWVMpK3fcnP0DRPDQf86bj1h4qK781JdHyZ+7jkz49gVJtzSnyV4PGTibHr3eG86E2tfX709v4CJry8Y/fHvaZDV5E0u3kCH53blXjaVg+eHi++qanPhb+N4aN5hNj6EVrw7Sm2CH/XV33XXmaifF7C9NZKJ3RNVUUe3MWF4PjcWJ5mgCFNXzG9n4rj0iv/0PzIRuqrsVvkzJkZc0y4bfKMj6eCn8NV+DHzO8z/3I4CLOe2K/22s5WLecwb95h42ZExzwv9uZCOhsU43s60JI42nP2y1YeLC+/oRthwTQ5dXTb1lxMTi5PXvZmQw0d8ZzJBrZsJ8+X7m6lwmkqjTDizZwsC9ozbGgQUMpL2U/7gvmAtOU96x+81clEq57VhkxcabO+u+X9Rkg/Ksu/HMAAuy8j2fXlObMPs/lloanUQl+6SsrTwTYZsGKh6fYOKZpvNvs0dMHL45pLS1kQnfE5preY8Y+Ho9oT9tHoGJrd+uSsVyYe2TbGLVyUVvQ/Rf5jQ2ug7syzIVs6AZMCfSIJsFzSmlaSEnWJDNfLGlwbUJA1V/rmvPIcH/cv5L/EwmXs6PPh1+nAnGtP4Xix8wMbvppMPbeQSYa1doKB8kYHDoif7qYC7CY3eI/Zu5CHRZISOrzYZXoa1h5nw2PufaqkezWRCPLePYZLMgPmkQHpnFRCC78udH2SYkUHTb7TNIXPIWJVXKMbGpruTHoa1M0CS0HhHBwLu7apcTFpPIpP+XlPeYi/7fzVt+/uWi9v6JtBGwMTP35se2c2x8OLvor8cWNv7kbahSUmSjZjw5f+FzFlqDpS/9XssCuSCTHmjWiOBPdu/KJ0nQ6miLFayY4GWO32r+TqDn42czgxgSg4dvWxde6AC3eZt7Qm4Hngg3UEbXsTHt3Z+llm5sSP0X7rzwJBvxBktOnwEbA3b2Kw7LsjFw7a7zlYcsrNjqfBGpLEx/+sutprEekUv35VXaMqEQvjVJNY/EPMVe39RlTEw+nx33k9WBDUeu1dgXdEL2WlaYlTUbkyf7NzO2srHyhZz/Eis2xr2yL5b5suG+fV1hxyY2fn6gFG4lWPBUeF5ewmFhs+L9Uw94LPDG98evdqgDa8a/xcseMEFb22kztoGFK94FP0s+daJMVU9v4a0uKP6V5QeF07HT5GDFFzoDMsWnNgpXERj32Oaz4BqB/Vr33p2RInF0XnGzZgkB2x/FJ2y4JGZ/M3A6kMnE+JzTvulpLLwwfxhWalIHCoVCoVB4MLpbZpYyjY8DSxeaGuzh44RQZ0hJioExv7t+L1wITNMpC5F6QsBm/iPTM9NJyLTl9+Q4kyiqGLmso8zEIr0HmR9CmdAfUFZ0mctC4e0FuQEGbOSvmneioomHN2f2/XO5w4L3VN2ebWMCJPkdt1i1Qojk5z+OtKZ2YOu0qaAbdeKAifqJxeiE63v38/oOnbhyjKb1x7QTJtGzS36d6MTX3YrOrvZdSN+oVGyd3wXalotX6A48WM+5drhDxIf5wj1jndNFSF+5Cttn8/DaJiu2aZ0Q0zdNGMf87kBOusK8+IxOkM3a29oedaJ2h++unredGP3bOZH7sBMflZZcf9neiZzLIfMOXumC+toYhqodD9mOoxH2n3lQvaXdorxVgNLcj5esNohwYm2Zg95UEY4tKvK0OMGDWc6uzQxRHUaXOZTZDpLYfWC2dL+AxPtHbTuvMkhsSliVtOImiZITNtzvgyQ+X14w/V0ZiV2aNvMNPpKQ3vb1jRdJIlVuzrS0SiryVzUXTw4xUWsi7FY9RQNzibbFFF8O5C/rSv29xsLWMdW9VkUNWDP1kv/yShJXt+Nd5TsSc3TSh9yCSUxNidpbQ5Lwp0vd8/9OwvxO7LZwLgnHj2pvZjSQoHm9vpv+hwYbq57Zr16zYBVSfnjNMzq2XomgK0/l4oPgffDFCBayzyUsFR5gQdVOkwh734CivxaaBhdIbBFn+DabkPh0/y99ySMSMVEPtQ98JFEdRH51HiSx5H3FYFAbCX0Ds3OrbOhQMr/iqFTIwt7wncuDf9ChGKM2P0KWi8Mwlkw5xYIzY9b1I7tZeMKv9fdcwMKdBEvv60UNaNmXc+OyNInEIXNO22kSkv88jP8kkrhYUqiY8JmEqZaLTsI3EkytGJvUPDpiOMdWlH1hITfrGu22AwOhi6W6tVS4SJb2yahYy0J45ibzlbNYaH7xQ20JjYkak3sGXreZSF4iK8w2aYC+3sJbdnIkbD/0gfcficgX4oTa6yRyb+2sPFxFYie/P1BRnYH346pN/Pcs3Nq+aubiBwy8NvlwfacsF1pPXx5uT2JhjjQt/XAIC5ET1Et2Jixk2TzbpCTPQrTUeeHmTCYiMlS+FoY1oDh/b5n3DBJ5c2eG0c6ROBIq7DzzmsT2U5y3V78wULzwruESczbeTUs1XxdIoGjYPjf4KBfvT65027qXhdHwnZcWJbOQY9le8WwPC+JLA3H7V7FQ0GwVcrCcic3S+3iz7jCRmEeJ32DSgOczKowu6JE4UbTJrPQSiaXu0w/JtDGwebHG/u+L2HjybPuvW0EkuqQC2nsbuNB6NL7cbScLsYEVZtkhLCR2bw85n8iC0pAgnfRg4bTcgh21K1nYq/b1/SM5Ftbv3jt3SgUT28MvBVYuqkfBjVhtn0ckyndavhkwJtGzoE6625WNtjHfJSPyTBhmftic196BG7b+918eYSFa5+1+Qy8WXhxuT7p4mIXopYGP1GJZ4FF+M+HKgqeNk7K7GQuTciVv0hVYWB06bc9mXRa2n3wZ8OhVLYxiua1O1kz8GbHMS1fh4GHqVZArWDijP5k9VNCJS8+NX4SdpUEQs/Pe8mY6go9kTQyoMmAYUDy/JY6B4/fc7TkTDPwOeLob2QQKte4cq3tFIOFX7L+S1UxIfyw/dNOMBc0/11v7FL7gRmPURc7vDlAoFAqFwoPpXevmk3J8xKT9JH/cYSPm1sqRi94cPG01X/XFl4NMq0t6nec4aJz4zX9yhIPaI9MCP/3lICTz1ueeVg4Ckj71Z83mgiW/amKkkwty0ZoNV853gfN7e+LHJwx4iywVG7IE0Mtwkl9B5eO2qCC/WZeOpf7qWt4xDGiutTD81M7AsQWaEzoGBPTbjV74RBN4vT82ctyTRLZWgpniCSY+CaXevpRmgX29ydRMi40pB6bbGjfzoLEyzcs8R4jKR2vMDiqwQGk2eG/1XYCvPsQp+f86kF4psZnd2oEPf6afd2V0IOuU2eWTkg4wV3/YsIHegXlHEzX/GnYi54RM0aKHnTA1v71r9HUXoguSaX4OPJzeys2YN8jHudmLHIjbAoT+i5PmTwhx60556fW5PHSYK6hW1n/BQskhN3t1EqGrNR5/m0eCeVTUmyRN4sBuY63VtQS6Nuw/91OdhPen8NV+fwksyv9bnEEQoDbc31M7TMA6Nsf9ZXM7Xh22WD2hy0RT5uNLdw4zkXrMYtb611SMri/ZyCtioiu1c7NBZh121afIrBoi8FrOyb5GRMBg+MW1/U8JqO+7OVwnS2KGnH+5ujaJOcsNe0R/CCQJPw/7DBC4d6MlYPY0GrbHHQjP/sbE/rJShsVyFpSfqidtFtLwNAkvDmUzkbpivmNdPBPvxZKPvnfrwKza99kwl8D+V6+l2gMI8A7PClKjEtD4IGO/4DeBxLErqZ8USLh2+WxoHiJQV3bJ3saFBoVoStmcX0xoFXsuyNNkYXXOa+uYtXQ491WrdN9lwjTiTpVtGBPXEx0fbAMTuiulLcIy6zCoVq591ZBA3se9IfQsAlcOVHu8ridgY360YIhP4JzTnyDJbBKGiqKVB4ppCFskvF4ynYXbjmY9rptYOEcdHHuRRMel/K8tT12ZCLCsDpEzYuKjU5jzlHES77tXHTWvJKFT+OVLunodXP+s0XfYTCDEdwpCUgjY6d7SXZdLoLfyx/RKEYHTqscdYnToeL35tlHXMBOtp37dCNNk4VDQFf01XDqUHQzuFBYyQcn3qLd5yMTJFx7vdA8zcePXuoA/+kwE5Ukpq9eQWLKB2fb4QB26D5wsHQ0kEJJRqrb5JoHHT+JWtLcTMJS7cDObRkeB69wslWgWyP+S8h5fYSFC75c/chngly3bmpHAhGbzjiPBn5lg3Wx4TrnKhHKr+MSHfUzMZ+rmz1Jj4uHsbYtK/5BInRsQH7WtDv9dfbU08hyBYcNVK6tqCGz30x08Wc5Accbs7cMHWIjwVd6rt4yNtk6G8ONNAr58jwuPfJioeKN2d/AaE7Wrs9oqnzBhrbf9+7JgJrJtzj5QNGUi+cDOWb3qTHQbfar/TWGCsna7T8qCOuw4Y9ypSyOQfy14S+8iAt+PfHiocYOFfL3y9wd3slE++PDGvjES9QmNIz/CmXB2Wz7/VhgTYSlbzTsjmXC34blGPGIiX+fI9QenmVgXUt1c7MHE+x/LjibsYiLsT374mAcTNw6kVmX//YKHTOlCIYMEvei/8yuc2LgTrXvdezYH1+Or322ay8KPjzl3kk5SsWvBqYUXP9OQ4z7r0G9lOrZ+8T8cG0GHpZtTvNkIHU+vCc/ufsJAad8f7WN3CaSwhVHdIwSUA27cetDIxIuba80zuNUwq2mNveXUgdjG44fV+jtAoVAoFAoP88LOnKuksaBw8fmtXRlsrC/88iX9ERtL102h5b5lY6foXNTph2xY7rw8O3EDB+ui/4Z1zuag3+12udR2DoYTt7FezOWiOyhVoay9E/OVU//LW8kAs7GUlszugtm5+ieduQJMkRud3KHAxp+Z/6nS6WyoWljvWsxhY35ZtJv+ABvmp//O+MNiI/Jd63iZKQe/HO7Ln2FzUKC52f7uTC4+uImPgsuFXqJAc35IF3hZl6XOP+HBSXVN1+8iBii0n2LjQgG6JTpTVLxpOBFw99yrd3SEri+7LDOFgdKFj+xCDzEQTcsfef2WgWne/9oskwg8UYvND4kk8fZPd1DGOBMtT99MrFdl4+TpiPOTzTw8/BfPkvsohGSipCG/Sohep56RafFMnHp7JzH9/jvYfDu4l3KyDWwPtrpmTRt2D7kEp7m3I7dHre7XWirM3Ed1afdpODDb7v6KbQz4hUuY8htJKLe/yT51mQX+Ag8PPyYbK8wzC+OOdOKCGQSR83mY/idnWvgaHr7vFGzKM27HMNtYey6Lim16Vy9Eh/GReXjqVE1vPm48D292sORDfWv0lkfv+OheJ9xxXkWArLx9tTULBcgVPy7+tliAp3+tClVjBdChT9dr75PgkbH0+KY3QrxtV8g1+iiErVLiwrSUdlyz183TuCaA7MDvvY1tVIwYmi5Un+ThW+gHobWYh0vzypzvOvJhMXWZ5+kiPvJKU9SaFwowVWWxWeoUAf6srdz8+ZgAEQZRgk3NEowHXIzsTBdCYfplvY77QnxUqllhvp4K4lnnxX1BAmw4Om+ntYwASc/tu1PoVHS7/UZtNA+hw3/fKA/yYCp0/zjowsfT+20XbIr4eLHRtWvZVAG+B5rIfHUQ4MKTKaxVbyQYfP3HTuaCEFMsp/ybHivEQvuiDM3ZNNTNvJ4+10UA9ZbqQ1P7+VC//zfkbCQfFxQsyKMiKuaKTJxUrvPAoma6Dw3zsPXaHFW7PXxsX3U7Uu0RH7r3wgbGHQVQUI+ZfztVgijKuf0FB4W4e+vSrHf+Qlj7Zq/su02Hj7+LX3GdANXaVw1f7hegZLzSdtkvPvZwxp8uSOTjhWBNlbkmDdnmkrUXb/IgNlu3zfwvD0mbFv5cuIOPsDd/PX/95mNLF92S/UICpZU1tK9HhFj+qSlm4KQQmsXGB8P3ENjErq9mbhDiQca/5LpGATL9Nz4zOSiAtk0QZcMfPrx9ruV5pPPRNcuK9dWfhrh347KsKh6WmYauy1PgQ7HA4+7VMj6S183arK0rwTX61cqPF4VYX98xrhIoxPQpB7/+82fir5Rx9qi+EMEhV+OH9IU4Y1l170iDAPKrJkb2ewtw/OT++zOmCDB6QpSgUM3H87y5xt7JNPyhJytxOngo/reO9+EaH+buYa2vSTH2bXOZu95MiFCzOePVj4Vwenvs4FAPG6kJlt7XDYUQCIZ1Py8SYsTHau+2xULc3B791vitAKOJor1iRwHiE4/t6J0nAKNgzlRTHh+am48k9efQsEK9yMPfho/x2hCa7CExDjU4Ooj/ChBXp2Acul+IE1c+7Wq34iLixOaPr7OEoNb0xIvihChdc+fhPX8hQj59n/nwuBCM6Y7vbGYKcWA6+XBXiwD5Ohc9Ht8VICHs+9VSRwGcaP8e9tvSsEOx9/KZi2KUbZBWknknQPC2mu2qC4SgUCgUCoWHyK5T9XU7etAf3fYgbVUPhjbqfhJJ9yBhNG3Zx+5u9LJfebyY7MZ1mnrwv/BuFExZfXHu0m5Y1yRsLn8ggdgvhEJ+kOA352iu8RoGlp5UWH/bUYJjp6a5zmdIEH/48meZCR7c9e7zf/eL8Cre9u2aVhFSv/+tE70VQdXn9crlt0R4mdSoKM4U4Wbx4e2n34vAPeaYFXNKhMoPVdQHWiLI1T45psQR4kXIgbTuV90gz5qHJpzvQMZVl8HOPSKYMnel1c3nI3fkGOvxbxGm2S98SbJEEBXUVdwoFSFM68LSlkciDO++LZ38XITrPXac+Gci/FSJ0jjCFaHT9+fuyFQRymLI9ectRDBb8fPxB+Me8Eqm1xfcFuNAsPIfcmMniqS6LyO7FOtfjDKp1CbUr3vMGDjEgKqrmY7uHgb8L3bGnLFi4GSsVMXNcQamjLwVTptJ4I/1jeq3XQw8PXHYsJPHwGbePgO/JQx8K5JZb3CzGQZTpqQNHifR/JaqM+05iRfL1jS/bm7G8t2dpvVT3iB0cITbUdIKYWW1qtSpNhToZPrs12/HwLYpKe5xVFhKed7cmkwHpWPRM+EhAjcfF1rVpTAxsuXc8YkyNp5Uzg2/fKQTWl4b/IoW8zDQ9uWJrR4PTV6/V49FEOhPef5M5m4b3jxrft5e344wOUlOJcnDaTvvkL5SHqZLjvQY/8fH7TPtk4cy+Vihd+Cf8yQfzP0hZzUm+bA2Wye+t1gAL5On4oynEmh5/FINdBGCnEJR/rlfCIW8uR3FQQQu/15Ub7ehHRTr5rk+XXxsOrhq453mdvjqPKD5evAwpTbL/EMdD6l7ZnnwjflYNu++G/UeH+EX3s1/M8nHlqiXb75MEcApR4N75YoE6vcD+29vFqJxe5hgsYUQzuNrU8IOEhh8FiZTNYUKUV641tJqPnouvjdN3seHg1dVXRm3HemFYslHXx5W73D4J2Dx8GFq1zjNhA/l48NF+ff5iM3rTSemCjCg+XzhmL8E7759G7dYLsSBtD8/69YIwbVYEN22gsQiVmJ8/nEablFLqUUbBUh+/EuyppmP/yLXb9Xw4UMlpGPXdFUqxorutJ8I4yFETdmg7QsPoX3XTPM0+RBqxyymJfLhr/u4eeKgBHsv7rkcLS/EdA3vcOn5Qly4rH6o1IBEQZeBRv8dBoxiby5JfS2AoUvLepkdAtD1ZyRMcvmYPe+h4qJQPvLcN7i9K6XiyhML5oM8HkL/ObhenM1Hv9mODU+C+ZB1MqLGykuALeGOV7cLcWkrjXpigxDL34QL/aaSuFTJ7x27SiLtsV/ESxUhkk89Slv2RoD/HLnfFlgKcMWFXeNez0eRy7pMj9d8JC4ghrgEFem2Jp1WX3hoNxtYcvI/PiZ80tUOpIlh7b3I/H6fALInWKcLdwoR8+d1/dspJGIEhj4GfBYWNU9Jcl0ohIG9yzvGQiGadG4otLwWYLneNdFcPQHc9ITF8xYK0K+fcii9lo9V0o/VP/2l4qOaxpO6ER48ppuvDNwsxp/6spvbsgX4SIR/cZspRFRk7cE9OgRUXuqvuruBiydsT1fVjULEmymeYKoIYdzuw2YvEuI642C+6j0BqIyiOLVoARzJI+sf6wmg1/5jEWWcD7dQJSfVNTRMadRrXMMQwdQgIMbMXoA/ZtoFe98KMBBpwOaKWkChUCgUCg87XDYfq5/ohq1s5mWVzm58rZ/s+VrWjRc9r4OkCrsR4GjyymJvN5o5F5/PIyXw2/Rk/vuzEuj7vT1ZLSOBqH5W7fe9dByjxLw3fyaGsfkj5Xv/STDl8nOHykIm5usFFY5J83FuwQX2i0QR/C9o//AOFGF4sGvnA2cRDjBu+46biDBNampsXKgIFxN/Ub+oiOCvWHNQQBMi7kaC1ebTQlzf8b699o8EmYucKl8u7sBWSbHolkAI4o9lzZVRJmLi+dIuanwc67NdyLolwh6H8bUpYSLwPr9OaPUSwSX76FNtKxEyMHBwub8IJjZad7RjRFBrGtW02iLC5l3lio86hFiz/0d08JVuiM50pH1QFSOm3W1PMLUD72/OvzdcW4Yfzj6Lv0fSkHDziulX6WZ0yH1jXvZhQFcvcX3jHgZ0N+X7D8gRSP1LtPXMI9AcdURxeDmBO9Rrc/csIGBoHj2L78jARY7Tei+FFmge/u/k0cckKheMy17gkvA5tKW05zQdZ4Lj/qttKsPDsj7Kttpm3F0nY7dGRIdoz9MnJwk6uj0bOm9cZcBIrdrw9C4CS6b5rF69noDavVxjm78MeB11Eum1MjCU3nHrcG4LZm6fufY/UyaeDnfUmoYwkVnyboOZdAvK5xx6P7utBS41n1RevnkDcvvmY0qebehZuka6bmE7SHfZgLC9VCz/M+40czMdZwunLLGLJVDRU5Hal8KEemxUkWo5G08q54ZfPtIJ06jia3WLeaAM29Z76PHwwOmny6xLBAwexf79PsLA24JCt89L26G8zurA3OJ2hOqc8Co7wMOGvY9b25p4qO6e2f1nCx+qFs+GjfP4ePqa0fd6jgBH3jfFfpknwNfaxTJJ4RJYt6dU3N4pxM+z94oWuwox8GiWfNtJAiHfH71508PAz9qwQSNRO9zjM6t/7+fjcPXV9Li6djRbCoOo/jx4kzvj/3bwUGjQfdNkJx94sCAp/w0f+Xx5f0JJgESL+9v/HZCAoz6HamEgxJXaxfV1pkJo/afnpaFLoink87TpLwkUkTJe+zfRsLDm3N9dVD7yn7xzsfTjY2qc3+rjM6iwKcgPTPDhIWy3aosRycMTM99XRzfyoRTtcTXpLh9Bx6WvlDtJEElf+8tURYiN5zcNVCwXot414OYPYxLFvKzLUudJNDeM/LfAhIHAezp+S20FkLtwtHq+gI/4wXIn8gAfotqVLxlPqUhg7DwlW85DeWaSWZQMH1pdUcue+/Mx/JF1yVYgxjnuR+NrxkIUdc36dEpbiNDXjs47bUmcqnzKitpHInWVx+vjr0nc+Ofz6UeFAFodDxb0uQrQGbH5wVo2H23Pjt9ZXsGHffsLlpMKDdvHWFlxHTxEfo8X5m7lY+O9lC73BDEmZn2Q65giRPGSEONfPkKM2rhoWm8mYf6RQwwsJREjMPQx4LPwbskBy5trhNj99+6ja1UC/Ey9KKNlJgCt+OqlEwoCzCiVnfOVx4dG8A+BkS4NeTFp8k+HeaCv0LtfpSTGN511k8ueCZBl/G2t4QwhONTjlQNHCFh/lA8a6ieg8lJ/1d0NXMgpqky9oy1E1f2TJzJ0hPA2dKJvyBFg+34vu7BzApw60NHxx1aAFP3A97GjfBw4ZSsK9aAhslfN/GKeCGtudwwssROgJytzyYbXAvgPlkbkHm5FiEv8moBpbaBQKBQKhYeDjhN7t9K6ITPPu2R+QTcSjEwkUc+60UtsqE/V7oZf+/Xuna0SmCf82JG9S4I5JZRHRV1ibJP78Ew9lo6s63tjuy6IcePR7iEeRQJf0u616yATZbkHlQSpLMzXCyock+aDZ20YuzxaBJ+yPr6Xrwh6hvFX59qKcD4h21pwQoSH849HLNMXoSpw+4RvmxCdQzOEFSeFUM9tmz+lVYJT5HW69tYOGNis2RncJYSO7dcVqnosPFaXis9msBATz5d2UeODvlSmevc1EVxTilVvnBJBXX5f3bY9IsysS10dfkCE/bQHO2LuiRC5NqS62ViEjDem2avYQtj7Hjm2x7Mbekz3dVuWiNFm2XTx9FAHPmqZv8uWlEP4Ien3kSIaHi2pCHsUR0NnzMLHMpub8Ue8iat2mIFEXRUlm4UEBn5uCd2hTmC00Xy0S4vANAdVtwd7CVQMHOk+cJmBj5Rz4TejWvBOQdGyhyDhWN7F+zubiQC/NfTbuXREyBSaXegvx8y/fcz3GjQMvDp/2ONvM3LknOxrRHRY7NGL+3aLgYyFa3ht7gS27tw2Z54hgfhFW6JSTQl8nW346a0UAa2SKeLM5a240/99+ssgJozuVT4ozWHCyS9RYBlCx7dn6aa5inTE5vJ0azXL8coxbJm2aQvSdRNpGoZ0rAv3l9/VR0ckfHzW5zGwMnhX/F5zAjPuu7+KXErgeJqz5KGQgd6/xcVGe1qx+KTC+tuOTBSoddXnJDDxaK/J/bYtLSjO61/6SLYVm+xcbm20aEXueuO1fufzwXzw2IEl1Q6ly4LL6cZUOFm/7N4zlQ75q6cqHZYT2Fj1fIZTARN2jYlTt5Wz8aRybvjlI51weooC1mIelJTbvwXo8RBgORitEU5gVnqU1vTfDLzekKO+bjMD6peVo940tYOx0GFJ07t27JnP2D/gx8OsjSfOkBwejqbKRC+05aPk/tYrHcV82AscHt1REyB/ZMI50lqC0OV7ZGeYCaFTd2AywVqIqLcz7QJNSShRHvxwKCRQ8rC29/xfBk6P6l7BQhpunhq//NuXjza5Cz/tf7ZjaR256vd+HqyPWMQ8aedhK5VPHt3KR/v8H4Maz/k48Xupq9EWCbb7VrszVgrxc2bL7r3rhBAti5aYWJJgPC8mdl0mcdxK2HKukYDinjjVNf/ooBzaGR3F48NCsCL9kjsf76891nieREWQLvPxp8c8OK+VPR41l4/vac3L/pzmw+Pg/s7IOjFMU6Zt5m0TIjzd7dNnAyGiDt36e86BRNSZ/oi8/SQuOn6snnGMxL8LzwIebCdB9B4Ys94jgPPtsGkNdD4S969cO+sxH7cS1p9Y/48KToJzW1wPD/u+Gu231ucjNshZ96arGAwF39hzPwW4vmjV+xt7hJDSWaGgEU3iYEZ2Se1hEj6UtNQdZiTSLd9qPZvORo1tRXBOkwCmY+yLa2wFCK+IeRG4UICDPZ2egn4+tJSm5n88ScNgStahMGk+gurtp+hPEUPXBK6/igT4avXvssJiIWRkZqadf0DgxNeX2yM0SPjsTl88+Y+Aykv9VXc3cFGwnpv7dJMQel2/i1Z+EMDjbk9ZRYQABtVLh6I3C/Dv5qW3M2cK0OJxaZ1DEg0eZ9Z5W4SLkO9eIbmxXYCgo5cWZD8TwENj86wAUStOfq8datreBhPP6bvC97WBQqFQKBQeBkbse35ldANbbsWdudENb2HJzM453bg4L5p25LYEoabjLnmbJQitt3p09pUYU008zVrf0IHEnX++WYmx9bhG0J0mMZLkByLFmiwM86d0n2xi4fuOgbJvz1iYrxdUOCbNh7eDq/qx0yL86dQ+MXWvCPG1ep9Wh4hw+NyrtBfLRdCm7x77JhTi4jr15jNHhHBpE2tOvyPB29j6R/8d6UCJgqJlDyGEw6XE36+OsdDk8tjdUJGNiuJE/cIeFmLi+dIuanzYa6z2untRhK9vx3P0DomgPm3p3aqjImhs5LkpJonQn3y5wnmXCPpfnVM5bUIs0WGoSq3uBlfptMrPuWL4vzb2/janE3v3f/R5HVUB5pKi9s4BGjIieveolNLgLFrMdUqiYf3pF9LLTzYj8sTmj681CAjXey97pUNgUNb3nsVGAqUdRWap+wnU5uWveVvLQObqYGX2rxZ0jeUsDpRjIuhV+PtocyaOn1zYb9VNh+nOUzkLYyswxUupQMeNhtKqHZuO/keDbYZcw9l1Lbiz6+46u6cMxLvsjN14lIDhVZ/Cc9sJmL8gjsZtJzBIjaRMsyAwWzBxkZfeilJmElv3KRPuV72CLbqY2Hsy9M/IGzq+vYpy9rSiY/tDGY2yFRU48CT5ueFdKmTM5KN3BLUg6QztXc4IHecuXJ5xs5iB+fmBa8scCLSuGU45s4JA0J4H/w1pEGhbXuR8ob4VZh4509RimeAohN/XL2diw0m2zPPzdLBeCQ/OnEvHWdPo2SVnaLjYduyOfFI5lGa8UKTltCBAb3Vx4h46XDexZ4WoMDAe8NngwWsGHvUsSjIxJdB/kiS85xAonP/wUbpyG0rOCN9cD2LCZfV7myc5TLz49VnaO6QFZbbBl3W3tsKwt2PyS3grPurIzuK+bYV22p4waa+3mCnr1v5sJRUpua2h6nwa9rsXTo/hMJCp9ec+zYqJ6qzT517y2HhSOTf88pFOHK37zOpbzMNq4z1Kl/R4SFvKf/XOisThK6GdmVUEFLQeE19kCBSbyZzzdGPgQWGzShGFhqkRCaGvue348mpCuMeZBxXuHbMvX3iYY7UxLWEjH6e1HT7desHH3afk69dzJZC6ZHKwS1OIYg3LfQf1hBiKd7WM2E2id+51w5RkEuHvitZ9IgjoNC5p361FIOws58pZFh26BlqxtQ58HK9/mvIrmAofDz2tE2k8WO07OKQ/jY/nphcWmZ3lw6u3dfjbfTFWmDQHle0Qwhsrfj42EsJP4Xl5iTsJb9W/5j/8SOz6Wxdnd4bExNy1WsJaAnsPLfcL/k3AYerS6NZ6PqxpFmsdUvm49NBc55OACsm/ue1R1TwsuNm978YGPoYX6JMVWmJ8ZItd5vwUIKg7K1ZrjxDc6oOalnEk9PR6iT4/Eptu0fL8zEmY9nXc1fUkQTd+VXTlBgt98XvVNcwE6HWLbMqaIYD6Cud83898vH9Xq/3QgYbczU5eVbJ8VN31v+v9UQQtx+WPyYcCdHvy279LCcFtpA3YSQhse3sqc7c/CTtlz+B2CxJ7f0b/+qRDYt2svH55Ny4evGVacz4I8DR6yi/3KAEEtnTWMlMBHCzmlt1VEMAyNDjuv1oaJpSfy790EWFx5LRZeXYCcJKqt3x5LcCAIlGVtL4NBscpazWi2jDOMo2n32rDutkH1inVtYFCoVAoFB6e/zq0fc6hbmSVDJskSiSIH5I8PX5UgsW7m9uivothsksXhjFirPCaTB3l0MGPzSwYoogxqe9guCNGjPPLY+uOHWCBP3pX55cUG1zP2OnBnSz82vTqstcXFubrBRWOSfPx/afL8aduItgPpex/c16EUMOhmsvqIvR/tG+e0yDE8PyvV9IDhZD9wmz4vFOCAyspWllXO+DVozHnSY0QDjdXmuo9ZuH58c2PP1mw8bp95fnNamyU59r9XSXLRkw8X9pFjY+O2pUvGYdF+Lo23MT2uAgGv1YmDiSIkB0SnRpiIEI2Tc3+L1uIgBp1H3+OBLH0HVVmFDHCZJ9NiNZ34oLkuPcH93JkZ2wtuselYY7lAtOlQhqqfLjXBCU0hNYpKi1NpCG0qHrot2szmPwl2/Z/YkCr0ik54BMD4+lZBiUiBqb73ldl72QgJfllzfQ7Ldg7OHNibjGJ4UedN1cNk1Cok814wKbD7dqv3o9GFXiAkO+Tb2hw9DTdWhNPw4zKk18m99Gw60NM2+KlLVDe3Hntz1oCS6/+fCmeZMDgxWHP4UkGSOrsX4FTCJQtT1gu/bYVvfOTP6kkMBFZe3CPTg0TR+/1XKd00RH2zSRiZTYdZ1aOVyjRyyFUfRR/aJKKO9E0xfutVKi5H5yx+lALLvSVTsyKZ2D8WOr39/MJ5IoXOAf0MOA6u6q/t5cBTu/14pzfrfBx2vYu5TQTf6cp7XyWy0Twu49Cqbd0JExTpGteoONBjpl9l5gGv/euc7NUysFacmq00owKn6NOIr3WFihHn3piJaHjnpRV+rdTDFhdvP81gUIgabq7YT6dgZT6Jayt/7Uh04rzb99+JgzHXt0OuM3EoXeLht6G0RE87aSAtKAjJueR57XXNHyWaum6rUSDgcp8jU8ny+DvPjZtYl8rnm4r25m1h44V1amwEdLRbtp3qzuQgY75gwsi/zDAOyhZZBTbhkofeXtzDyZcF3T0uN5k4nzCPdP8Ay3oeao1+nldK8ZoKtWVga1YeCmTos1uRe6n1ce09NrQGevVQDzKx9YLP08w4mhI/LaXfucZA8ofjmSHi0is9mytXmrPxgrzzMK4I50YWFpzU0qeh9fX97TfUufB5IjV3OtsAvK6a38X7yERofhSZvgJgcSxhwfKhxnYODMpRsONgVW/a67InafDin3bft1YOw71zDR/uJ+HgLP+MzVbeGhxizUwMONDR+WX74fNYrxPf51Ss0iIE3ouD21mCHGSttriLJvAzZPb/EJWkOhdbnFttRGJAUWT7qErBDLUNOX3fmMg2+WQU0oZgYEHLVH27nz0j3iv47lT8exY0NZnl3lgTdnR8G6Uh7vLZstG1Ikg9PLVT/oswN3jqw49XS3Es7plc6ZIk1Cu9N8fuIJE6sTVheYyJIY1Vn8yW0bi/sZ5vKfRBPIvaTndXsnCYzx77d3OxyHhu6UdMXxEXMhc7viFilKb03NWFPBw4rFVo9peEVz/7FNRPCHAPGkV61XtAqgusVr61YdAYmKuf5kGCfn6ppUpsiT8Q2P3v/1GQProNe62eSQ6J/b2Mks5+Jc0dVzeRoAa3dnH4ib4+Ph9t5NOAR9dhx5xY41oeKgaY+I3LMS9pola9akCeLI+jWw6LMAam2c7XlPa8HLtwyV/UtswfC1Of+xtGxKj2weVutqQo2QU2rGpHRQKhUKh8GDn7eTRGy7B+If8TdwpEnQ79C8buiIGJeH3soylYvxy9l9v94iON4wZKUEPRZA43lU9sUIMjV7ys8JMFl5ZF9Qd6mNhyWValGELC5EbE0tcC1hInGlcPuc1C0mT72iVwzx8oo/4lNqKEEexOff7uxBGykmKZKoQMmUBW8+pCaGQmP77fY8Yirm5r9bpdeDcCZNUpxQh/nqc28TYycLFUmfTNdpsSP6cHvk3iw3bl9rrVX+z4BPB1mcNsjC6R0NjiSIfywT3bBibRDApC9/i4SbCEL2CbvJPiLKwRzKdV4WQXWukN/2sBBceVf5nUy9CmvWVm1+pHYiiJ2R4DlRi7Sbeml05dKSKCvKb8+mwnBomlXqVjonDuVea3eiQfnNs791LdMTedvd9QWmFX1y8ukE4gU0e7M+RTwnIX7T5sVaWwIKjOXLuW9qQ60LPjiligvNSeVdeEROTzbxTRoUM+D9bMt2lrBLrry/2nPSk47bxoOCiDx02ewsNzE3pSA4ecS88SAfjldaC5v5WlO69Vit1gUDzWcr5uRcIYEjdaWkIgRvbqWsfXm7D7ejSX4LtLNQyKXdnmrMwda+s2rtiBlxOTj8Q6sNA4UqjwRPiSmyz0bqjPY2O377f+35wadh38pyqsxQdBkrvDJwXt0G/4ORKt0oCE1srsixSCPiV8sMdkgjY2Lsp9XPbUL0rXG2BGwsjTyo+w40F03X3ZxclMfDKXOzMPspAetd8pvU/Oqapsm7y71ZCVfgsT+REQ/YT1/jGaBrYKosEIU5tsGU0vLdeReBGGkfu+1sCj44r3nwcQWBht/MPd6V2jE5kGx3dyYKO01du8k4W1q6bXHbElYFyt8IXHZsZePJLsmZnOx0ePs/CvEzo6G4revDVohJ1h5JC5srS8Hn6i2X5uW24ExgyOZ7GAGvdFefH6gR6Za7RNr8kUNyWV3TEvx3CX8aaL61ZmGV7sYlmzUL9y0/uxCUGDNC87Z8PA9bCotjjf+moZXzw/hxIx1sndt7RPhqm+e49rjJcgYqPTxJebmtDLC/nwsVNDMzwjjyh8YWBOZvivpbuIsD2scgJ1qOi9fri4qfhLEiJ9+9vDWdhfntUdcG8NigX37v2/kYbdnHDK+jv2vDf+alXSvvaMNKz7iFh0Q4jJeXBtuY2LJlxL1nCeI+KMhvnddUM6FgdnVIySWJ8sXNw+FE2XnxZOXjlSCfCLa78t92Fh9ufegfr3HhQc1g8NOsxCVbfLXPlJySeySqFJfaTSNHd//WIOYlbytw6ygsCcdoyYf0WBGY06jWuYRDY2LU5eZsPFb4Bqg1tcTxUpC/f/fAPDx67O6JkCkRI2Nq+bDVbgKOXvwSKTYSws5hbdreZxBmTyk2zn5C4uubUG7cPJHb/t33jKyYJ99mVc3aYkDBfOVC9nk3g1CUvZ7oxC/cMz83WjebjpWtR/tZGKpKMRjNevuXhRJNxqvtWERYFzNtwIUaAyXzZOONuARaEfjOJWElic8/7j673SOwkj6x//JaEhpezrfJjEjERIUGCRhLH0h+vaPYj4b58wEOvnQO1+4H9t//ykb3tWcSj13ykWS6a6rSJhuEjb/JXVAvxRbfgnvxCAfy3TK26ESIAf+8nZalp7dDwjHsebN8O/d1pAcxn7cCmK5Lt3HZUXFlwuVuPCn/TfZY602igUCgUCoWH+3Z+sc19YlBpki87T4phsU52t123CL7Dp5iRFgw82npu8tw9EW7YnFp475kITq+Od/5dwsYZ+ZzZe9+xcWXMPe5xHRv7o6wfK39gg6od7ymdz8Zx6lDeYA8bkw+HDqyz4MPO09lAQ0oEbnzLib7nQpyutFaQMRDitE5AW2iPGHneuRNmEx2oc0gy+HpKiOqOfRWtS9noNbk9tKaAjb9rrP3O1rPBebcxpv8DG+b7z4Kdz4ZQinfjcy8by89u7v1nw8eh37NllgeI0Lc8YWvPHBEs11R1R94VIp1b+OrxDzGUmpp+tz8Twbfp4JYD2zpxviMnZ0tGNULr8gMmzjHw4jpn+acNDMzd/ObFZhMGKveeHaPMZ6D2sX5D8yYGmNJBch9VGejvaT92yrgNSwtaLlWuI/GdseXViRQC25cEVYb8bAOtcSD15xgTbY/urbxzkYWGq4e/Vt0gkPMtZX3DeDVil329n7eGAXF9pcZaPQYyu+PO/pRjQChVf+/wegZmrSvLZXozUOY7Ujy7oQ3MtX4kuZmExcurbwQbSbycoO8+G9IO8T6bKPITC8J1f+eFm7GhZsYy+GNJIKpxdaIUCHzSoDRNHKvGkwQK/b83dEw1ZOx7WUhHweTyNQoddNB+Hat6rcyAybolOy9ObcehqdeuHVQgQQ9/7RsgS2Jr5ry1l+PbEaxzctPBaywESqk1ysxlQ7mBIjhtR+CCi/2mi9sJvCzUnHn9AwO/Fhp0b7pZDfNJ74RXrnTMjySnpsXR4XjQ9/mqajq2P3orI2fajsTWPoUUTxIVxX3vQrVIbOOmanwfbofPsZexJ16z4G7mrqykz4b3o5vUEFUCbdpiragFBGYmyk4Z/MRAwreavSVODGiPT/826FSNgX+Xj02ZTcecMw+cA53o+FZy/5LdvXZ4/DTdNk4j8GvWv9J77iTmx89ruuhMxeYlrVs3F7CwYegSjWfIhsJS51k9lgS2zxmvfgwCz0NZbrO6GRjzYm5Ru8JAQd2yOVOkGdie8qx4ydRqrNu2wKVPTEPho3/6VlbtsKXc/ss4S6Bnyd6QkH8ErgQYmz9SpmEwefbpaxIWBn0rpxkcY4NxVTrVfTkBrvzoAuPjBLb8vO+Qs5RAmcB1uLicgVtPguQW7GBgiZyfPLuNjmma0fvGH1VCP1RYnmpPhZ/T/UuK+wjIB+7/+5pHIGWppvFCBxrUw9xHziazUWmXMTT+k43NrTHzX7LbYKfnG/7zdDuc1y+5F/+qHc4sWd15knYoUtvZMZZU1Of+ul4haUfbsX2domEqLhY92fhzRTHW7lmgf34REx937j2aEsWGpvVrratHOlGw6/pbL3sebqZn/f6dxYP/tCvxF92YkLNevea2HxOtG43bejYyMe1hjO/kVSaGq1w8XvwmYfhq7pfaFBLjM2Y3WweTOPrflc9+9iyskA8sedRKRa77Ml/nGB40QvcpuI0IUXWXuSz6iABTCvoKnJoFWGnkI+vzlgRzeHFO1yEmUgRrHdbrMqG6NG/1kt1M0OdLrzeJZUJ7suqOuzoTgx4VDzumMnFxbt0cFTEH+QluA/vP87HscjHnxiYaemYMn2OcFcL6o/rV3BE+Eqj6ahf3C3BnPF+KU9MOX6up+UxHKtZ48tb7eFMhz/qzV6+Vir+L9ApWraNB5lpmouU4DUVHdH75/EcHhUKhUCg8xNXv4q5bJoaxUkH17VQRbk8bONQxwEC5yqpNsqQQ5e+e3Rw9IcL97wL1LfvZ2Jc55/yWtRwsuqqhev0vG2mfn/w+v4QDnx0L08qWceBTMe9ehzMH8h3tPoZTuKhR1HoetZmPz60QpUQLMfY1d+1cBSEi9/1cMXOOGOT3V/7e/zpwpjx89MU+IRyFgaMyw2yMXX/gIPuZg4rwt7/2JnNg+UG32fwtB4uMnx27UsDBkoJHp5/3cdA4Ny205gwXMcPDDu4ZfFwJjj176YcQb1N+Vvw7L8Scw5b6+CCG2331a/PjRHgYdu2ComwXqp6+rArJrUGXipaEAQLrttaaLtIj4CH7Y4j5jYHqdzXn9v5iQLbcUrxyEYHWxc4uGbIEDjAUOMtGGKg+sVf65bp2/C27duqqOokOsczJH5x29BeYuM26yoLo6tf+AgU2Kl7SNVlHSETUDFem5NSg3e7YRadRBv7OS9Of+56BQ+VHPyaXMDCYeSJ8Gp8BWoVe6ZTFBB5FL+wp/suA+d89j+fHtGP1o/9mLKwgkSaJ41xZQ4Wwe5femuVszLMoz+BHsZGVa3TznSsJqd2Wm0MXkugw/nDOLbsG3z+Gv2u9wID74d2b7KMZoKx9KPc2m4HVGj2PnEUMLMyLzUyfTeC4obHOdaIdHfTqg5q1JFYkSGnsDqUifXFPwmYtNurP7f31I56N0YOvOnoVSfjIlES9biVwhSs+Pi2FAFdsav5mcQ2yu0SHHk5lYM6Cx6mP9Bh4nVzqwMhg4JYkl2rRzoCBvmvbIg0qtBf5bE58QmLS03h2xEMqmAeu+DyYzoZs2WizyxE2RgZoV33mkdiVLFj3H51A6hGFRZduEth7/XRl/0wCf6sfl6+3r8EIb5XfMjEd4T8a/NfuYaDC5bG74TUGNhWfcqm5TAWl78P+jdOZmHyLdhkDGvweljivMmIjY2JNGfc2G+1ae3wYm0n82lh2xHaCwPH5T2Y/qCAgU2Vj7riVQNSy68v6+xhY4rVncv/EZ/y9WnLPV0RHR0B+dqs2AycYz5ILN1Ph+nbvYtlsEoHfji9aM0KDL1m5/PwxNm4Z6CWub2SjKfeczj1VEvWW/01u1Cchff2o9OYRAtxtSv+sXhI4M7JmiacHgeoyR79EAQN66vO0ul9Xw5+90Zc7lYGTNvfX6O6nwTJPbZXrDRLjbnJPjQ3o2G7+xaBwOQf8r5utOe4cBFvkv+6XIlG4o/PMblkShYuO2J1fRiJuJ0eSyCEga7hYwf8GAZlI6epfqwmsztoxq+8vA1OryjpaFapQeYSI/ydLR8Up/86tbSRWa1771UDSofBaz0ArlgM/xz7Dd4e54KSXBqmJ2nEJjzUHjamILlombrlOhbsJ70hrJxVWZnM6+NtoSO9+MTNjgAo/C7frImU6TvGuXkivomN1Y/acc1fLkLpAavJ3Bhs5jUaHEo50Qmb9nTX/WfNQUBrvqnmfh5OZkTk65kyo/LiQ8OI7E17aEXvu/WYi/rC3x/VWJqrr9M4Gb2Qh7kax7D0aE1PUqUX7ipmIzMgKTZvCAjnoYOwwysG36DuDuptoePouWKteLMC8seNTYm7wEfdxf2rObz5Onem5EllBxcqkj/T0uTSc611yKvcgDTNiZZyfXKGh0f3w/jE1Otbu16pa/I0Oj716p3zUGfh2/KDlw2oGKBQKhULh4Uvh7qulw0KUOx2K+5dGIKLsfOtuXSEmB7e60P2FeJyyWVNmkA3JlTzlC2kcbPqcLwjM4MB+IqXnYDAHXN0725a+4GBxwL4ek04O8qiP7q28w8WF2VOy103rQN6CErvrunw83r/84Yo7Akz6zvK46S7Ctk+/py7W7oRO6mo14y4BCtojdxTFcpA4e0r2umlc1NtOC9aQ52IsRhy6QMTBlY/9tDZlLqqHc0IHd3IhMn144RaPC79PG+9OG+7A6+F9DqU3+Ii4XCW7R1qIH2dc/QbviWB3auPTg/+EMOl3u10u1YUuvwMK5yZrEJEz2eE4SIBGvbfhpoDAirHNSWw6AfvKw9vuJBPQj1znG1FIwOFc7febVALp/zk8zaonEPTtLqW3lwCz4vLdC/rt+LqoV3ZTUTu67hR4xS5ho8MyQ67hLBuf2jsHiIVMzKx5XvJmqBZtowHqnDICK9RmhqrmE7Dt3rC2M4CAwand7OEMAn7rH+ulSpH42b8niRggYCeyPbuPQ+DW3MFVXmFUKDH6dvuPUfHGatA06BMbb34TPe8XcHCHvs0tfwYTzl/Kjnr0kzhl7H/q3GAtlL78GuWeI7A1STUvYwMBc6kY38c+BCpSXZUlTQT8Fnzemj+FxISyuNVPQKBiFy2f2UzFw9AjGQZWNBy6EH8rspqNA/8FbvuylINw8lltwhgJvfrlxEYuicD7OysS7pMo1rDcd3CoFs39Htm5/xj4sahXdpMhges3p305/pBASP+wZQeDgE5B89OlU0msLc/bGbiBhpO72cOLc2hoV78/15/BRm1rTOnrdRx8Tlw0qyqfxIWLN5cGpJJ471QpU+VCIm3w3JdTKiQefpyIGT9ei4suQyn71zMwzdMrZe0mAlnuVXeZ9wm4fv96yYpO4EerQ8miHBqcjW/mHB2jocRv43XrajZKlZcaX1vKwY6Lood2bBJTv2vtHq8hcbBZcdw+nsSsbzKWO1xJ2LloregyJWC6uu1p8uJavO6aE1hcywDfIzWt2pSAn3iGODiEwFXXgmnBB2n4Opc++3UpHc4uMfOLfrHhIOVf8deeg7TK+fKnvpOQLs9KsNBhwlpamGL9g8Ti9TfvbakgMSdnj/ywI4mDnECkMwmUL1AiLm7/gkSGtPiJIYGaqJ+7VGIIZLr1trWk0PGUF7H92joGDK5EXfHq4GB4D+WmpSIX3ifLqEodJFTodZbv5ZlY/MJhWYc6E03GnpejfpC423Od4lVEojDj5D13exLPL4YqGlqR6BU+mD+74DOqDyQMXb1GYOBf7A3Pgww4HivQLCIZaM94o9hrzoX2lqNzT6/sgMA4cM7uYRJ3uWM5iwdJiJX/nZ09QsLV0DmPtZCJtT4OjcU9JD6FfPlgF0si+LjS29AUEtW7jugUPSShaP0uWWVpNfYvYXfMOEbgjGnwTM8ZJNYX5v1JMe5A6t4jE0sWd0LV65Z0Tkc7tC4YW94xp2HdcKHUuTs0SP/E8sppdJRRLS7bXKRDL9YiKus/OgwGtx294cPAnF1jgUMbCcw6oxIsY0lirkHPPOPFVXjwlrXi0pFOpJ86Ji7ZxsNMy41/1G7xsFV7zqTjNSpSdTLUQgdpGIj/V3rDjI4ZASdK5z6nI+LQvcjkETome+dW1X9mwO/Rd3dfXQKR5K1zLUwCp1Lc+mMWMEGhUCgUCg9rzp+7quFJYtqmU3nPAgX4wosedL8twCvlHzILL3OwtTJt6l9dLjLHbzV/N+LCMdrvT6wtF5cL2yN3GHKx/xmr61IAF9OjFyYoeXTg2s/kLfuaOvB++omqCLITPmOHz9is4uNFq5Tq+TghGHeatK9Ed+KqCeN+tJMAty/9lq/5xsEWrbvSL+5yke5zrz3zERfW/Scm9Aq4CF1Gxv58wMU+xS6hNsEFY4EgTvduBzZ3Tk3V+dSJfYOdC1xqu7C4jnW9M4aP1rrd3QvfCzGlwNL99AEhtORy5Zzsu2DkupK6TVKLLQvVJ6tP8yBaWXrgzD4ekvT3Va0CDxu2/lrB1OBh0wrHFRfCeOhepPJkSywPzaOx3zqe8tDuFuCry+IhpuJu5ZosHv5bLxt9RlcEL6eg08PzxHB/7/bw+nIx3lDiN5jY8PHQzDE191gdHGv27tf514WHvBvqN+q7MPi3TjSU1gV166ilgeo8vDo2r1IQy8N6S6GSdiIPxKGE6oZXPIwcX2/+tpUH2XEHoShZhBvbXvasXyVGfFbq/CYDMez03AThOnzklC6L29XEA+VX9wsbrzr8zUs5aqDeBduGhjW3BjrRoXeVpZXRhSUaC03c9HgI/y//nVMqD5UWn45l3uGhe5d3f/QnHnJzhwRD4SL0LD3XIp4jhuCS998INTG6D625Xj6bD/Pv3/VvvObhbHBL0RpnHnYtu/Vtxt46jNJtvReZd4Ix6/qR3T87ETTywSOqtAtm/kWOCmY8eL9J0JZ+xIP6h9T3t7J5eCBJn779mAjJEZYkd0KEeMH6aedmihGbPr55yjAPYrVKXnsKD3LPHvgEGPKwefzpR3FRFy7rhqQp7KuDutY8wT7bTsgLK9atW9AFz7Sx5uOsLgSdS/06ZRcPs1/63N2ezwP/mbEtY48I8ccWSi3oEyGyacr6/FERpjupd63azUd9Sspb+x88+DQtPbz9Jg9s5oS8+mYeLgQe3tVe3oX3/npbcvtrsV3j/uVpzp1Y3WlLZy3rQsj12FPKgi5cFDDmPHTmYYi93GvWKRGSHixfLj0sQvSUmfaPpoqhqB8tzHPnI9dJNn2VJx+vtpqdPTWVj9SpzbYvnvPgcTNvfp0dDz6Rq29513chNOO6cfiKOkzvzP2kvaALS0XD/bPZXfhxxv78dwcelg8qNeuuFiHEoMpt6wYxXH+7pl02EOMHmXPk8EU+jnOTZBce4mNHDqv27TE+UtTmNZnN5uPz0UefP7zn4ePZkEcXHHlYbl4QfXUnD1s3H1zp8asW0Wk1P/Sfd2HGX8tLDEMewhO+LZ9kC+H4sXpG30YxDLUyi7+fEYMbkzT/v/d8qLMc1rJj+WhK+NPxOpCPYf6OisRzfKR0RnZ7LOPj58NpP88V81C3/JtUVyEP/NGoA0OZPPD+Ky7+1FyLM3rOX54t5uHrcFb0mWtCXOtcuZhvJEbgrn+NkhAx9q4uCJwM5+O25GesdA4f1ncyN3DT+TCn7ZAdjuCj5E26wrx4PmJW11jGreSjwtM9zmI5Hzt6Mufmy/LxbvlG/+5+Hjao33//+X0tNC1oFBd9IVKmdW5asVaMkODCPs0AMaRTbLMPHhNDYfG5JVdoYnSNPdE+WSrGuHe71+3nYhxzoBRKpYgR49LQve+1GLWxX+bBU4yCiRm7kmeIobFn16eI9yL0VFUXBziKQKFQKBQKD+JXo49WBPZB/jLdg/O3D0prx5ZL9CX4bf3ixvsdEuz5VHMtaL0ElELR65OLJBjfOH349TQJnm3U+nNglgSGgUL/QmcJZk5JPjjFVYJZQexld/dJoDb63wOvXRKIFFnTF00bQP2Ds8sODfSCcl85V/ZBH2xnfuD+3i6BnuYRmy97JIi/G20VZSaBadpjv4j/JDCYX51boSRBp1L32yBVCQKbv6RZOktgcqQvufGOBEaE/i6fTAnsPGNatJIliP9HXVSUMYDP92Pj9vzph1boM0bIYC9upAvT3LzqcHasmD+jiARzc5hWdjYJg/DNQVaZJNLK/n7ovkYiPuFZye+nJFKenBJ9PE0ip8ylffEdEsEf7gdalZMIpyxJMSolMIUuumCZTUXl92Gqnz4NP0wcD9dqc1AumeZ5bTcL6aKC/GbdBuR29F7jxZFQUH1JXDpPwu/AsWWqASSa8zoNmxJJbPrhdeFUFomH65L/+XwiUbmOenIon4Sx4vvD/+6Q2KoVPc+aT8P2/9odzvjS4fbi6XBHLQeB2+Nb5piz4MXaH3JWg4WAjaXrpqxvwAbyje3QNhK3FgalJm8gca3EtWDFHhKOD8blfU+QWCIrzDZpI5Gw5tQbtw8kpOVcnh15SOLJxLwfLF06nNZ7KZS9pUMjy2LDjhYOFj5UMEvfwILCRmHPn/kseGzLqBlkMdFzhluspNeA1UkRn2wmCeyf9aiY1CTx1vKDCmUbCY5jVcOpLBKvHkh/PU2QCHpkeG52PgnLn67VO27S8dz1YZlgDgNpmzVlBjs5qBtPzl+4moX3Kb5u4TIsTHugahjcyMS4XqNN0Q0mXt/QOtCzuQGJh79WzZYikdVf7p+1ggSbU1yUcIWEdOPyr1olJHRHZNOyu0hkNzOK4tQY+HBocQrnJgNPCiw/qFC46E+S9skwY4HxbW3auv9Y2CpZe3HBdyZ89acadZYyseSORU5qGhNpcV3tzLZ6yGz9jzLHlcD5P6t+LHEl8YmVq/vwAwmXOM/vpWISLw6eOkgyGdDhde/SW0MgYWSvZog6FyF7I/fKy7NQcCQ7XLSdhePrdcoDZ7Dw/KjVZU0WE1aHlFBcxEQtQ2IZJSTx4O6XANfkelySm7ivNodEu+6q6G3xJEJ6qqcOl5CwUni587mIgaVRtyfvapPIlz5rNxDNReWf69rJOiwsqXey4bmysNKMjMg/zkK2zdkHiqYsZDtrfTFaxsLRnoiOZf1M6B8+cYL/kImArutJD7h1uG0UtUvUQCKPU0E7uIgJ1SSFiZMHSDjMcS08P0JCfYnlduuiDqzYnxJ83J4FZTOWwR9LFkzOjR4y28XC/PW3QgQBLLjObPmVb8+C+FrGWu1dLITfjR2vX8zCnWeP7Se9WODX+nvevFQLXsj53oI7TCwtHDHGPSZ+DtCu+sxj4fi/ziDLB514OlPVZwWY8HBxDstfzUJ40umXozosnJbOK5wNFmbeEGmtDGThRse0vpYQFihblbpdbFkIW79GXiuPBeUpBx8/2MhGwWXqdOOKGuDvvXXn5dmAYaDQv5ANpUXqC4rm8tCgLn7Q6kHD2smRtLPv6PjE2/XLTJqBqG4fheUnGDAZ/nJz0T8GMp0HOEvKCZRfmDVFsYZAVNO7pO+OTFCi5bzd7rEwnLT4ydtlHByyW3ouCF9AoVAoFAoPMl/bxs/J8fFE5frGD19p0Hz4N9fWjAHdQ6qfniUxEG47NWrhIANvN+l+fHSewBONLyeblUjc/t64tkuDibkn2ywuDDFRuNbeMEeFjbCenA0/6By0vk08v72Zh5GlZxfdL2SifWJ3nqodH5+MZO9cG+DiaPrCoNTkDiQ1Gz5k3+pA5I5Tr4ZedMAg2XxheGMHrr/Z49D8rQNLito7B/Z0YvaOriH7m124zJn5ZMyCh97f74MGlfhwKuoZ1BnkY8S5jvA3EKBH2MIIonfhdnyqioH5F/jVRBdOdhOokzb/d59NQNNq2o4tzQQux39O6igncGnPyRsDBIGEPVuWbOcQ2GH76fWZQgJzLKbbP+YSiF17ZtblLgbUswPP2L5vR0HFBabjGiZGQ6wyfAOp6N4i430sjIkMps/G5wvqcO/F20PEBwKTvadyzuQQ8Fij/1PpPoGK0Oczq0oJGGWtKfgrT8L40wi3jEWgznPNV9AJbDVRFNp+InC6IDUuv5+KHYJba/91MxH+VkPldBYNqRkJ1K/HmYjQ9Q7bvZOJkP8CCthKddB8HKrWc5pAnHDT0bgjBC5dVotXiSNgv7tu+yCDQEma9ZWbXwmk7GvUecEmIG+Ut6KgisBDgblfvwkNSp2GJ498YyL+w1tGyXcaNo3OMg/az4TWNfEAYwsTZ+7eq2+aysRXv0R8W1CHRXutk83WE9jJ9rvevI/A27Fox/hsAuc7NbObKgkUNHsdqxwlENZ645cnjcAsvWxMeUxD5JlFW2dPMtHR9fMybxcd3c5p74ftmWD0+e3ft54JjRH1zQ7DJFQTmnelfiTxOoYlqlSrQ/j6wlvSBgQe3E94opNAIOSo+k3xUwJz/4QdC+kgYLTy4JyRKSRMj1ga9WvTkfht09AmNRYG+0+4sWh0yEpkj4b4MTG6Q/e2vBMTPlt0o4aUmYhMmGZSLCGBH5avT5aSeBB2fc/b97WItsVxA3sCcy4ntZ4NJlArI/P6K5vAgfeFx+VlSUi5TOsWiuk4o2t78NZWFg4d3ay8/igDrz//t7jen4kf1N1mj7OYeJ5JRK49xoSY/sNKsomJyNbSKecWMiGM3TVXl01iResJy13T6sDZs/jHhosEdC+aM1x/EyAMBM4ztEiwztSGTdAYMO53u10uxcYtL/f7RpEE1ncf3dNqzkRioXbwspNMpOm8efk9mon5Rvb1ds5MKG2bOnh2AxMMxj4Zz6lMqM0T6n+fwcTtPvPl+5m1+KM2YJQ1TuDi4aWeCxxJhGtqa7uZEWAFWf3p1mcjOmiVLVNE4oat//2XR5jQWFRVYu/DhMluft/NY0wskYv4Z3SVCS9J4QXZ40z0H415H3WMiaNWY5VkDBMr6HWW7xOYSJu3wymx5Qu2X77X5PaNxPdD86o+aTFRqzAz9eFfNjR3PlGtorDwTiLTH+pO4sA606my5kxE+nro2tswcY5309TXnYlZx5rfhCUykVb0NXBlGhP93668OlTAxG0b7wMjnUzEX+nrXXeZhRvHTzTkLqlBj/bqM+8+snCvfm/vR3cuImYFfNiWxca6xSOODBcq4kJK22pe0iD45LZ4yz8aLq4uWtp8hI5pt72ST4/QscmjsWLFRwY4xxa31rwlcOH2W6richJTll1wcdVk4cyW0WVWrWzQunMTU2Z/xo+V+6UV+ztAoVAoFAoPWfzO/Z9aWcgQzb5ofYuN8iXrc2/cY+NN+rGPL/LY8OaZrNRvZ6N6cpdn9DAb6Xvu9pRVcJBoa/ph4CcHTfU3m9zruPCzdatdjk54UAdOG4Z0QT0hLoPqxUDQtohjU/t4kEzN3jGyhIbT8fPLrp6k46LdpibxJzrKDJakuKoyMLHctp6XzYBEgXdppjmB/DMDoQbHSVTusjfe282Ep2LLmjhFNj7XDi+waeDg5OmI85PNPPScn5RXaeJj3+3pRVI6TGzMXFnRevEtLL99K4tc3obnJXcmnUPbIHltVX98djvmTrdMCxW1w4d7TVBygwaD6KaSC4N06I6oxsmqkPhofHJh0xEWZi+z5ee+YsOu7tCrfae4mDAW/L5yuBNL/QL40+fw8PHVgoSJnjYUKqfkvrlFRV1W27KG03yoMq2yTuzjY/6izydvgY+CjrbuTe/4yHE4VFj2m4/Ns/Mun/3Dh/j5vroH43xwSier2w4L0Kc4i8OMFkDxRa2F5YgEeubO9pfyhWDpKKxM2dqOv3+NG7QuC9CkuYvtd52K0JfRxb4jPJwc+Bd7o4MHpTfy//Qc+ZBbcb+pKI0PC9XurvA/fJhw2neaC/gw/BFifcZWgK3b4g6E+wlwXNb892uWBEkhTfQVt4RY3ZvSP9jVDmunnrv7/QR4rbTUb9ckH66iZ0d1U6nwbzYRKFzgQTHZ2u3Ndx6kpnE1N+jzYUZZafL3Fh/dowpfWwV86Oj/VLqvK8DmmKnaz+wFOBRSPZD5SYLoj5YP7cKFcOxsXxZTQYXZx9v5yjsFSDvffElGyMc9m+lpYaF87LDJ3CvzhIoXEVfH86/xcPfnksaNVB6Y3w93jm/kI7Rn1/X2SD5Oe+mN5eoJ0EyPMHXaKMD4sIWW4kMJlsh9yf9zSAjGaHpkxFU6mk+cVTxSJ4D2+hyuy34BNCjCwfphPj53LrWtSuCjgPf0wPPVNICvz1xwk4ewjgv93DEeNIjDmfkWfMzZaS1z7TcfSybTk7Z6CHBURd/W7YUE7/cuWht7UgjzVRb6fRQCv7iLI+LmCOF632FXyHMB9py4crDfVIABu+pPQjoflwwvasum82HR22ffoUiDo3q+Wdo5HvRiHbefrebBvtoy8G0IHx5n0n4f7uDj3981XxrvSLCJJ77bZy9E/adOzXB7JrbMHt5kOE+Ij9Hmi3TnCfHO6cLap9kC7NIrunLPTADmklOjlRQBlBMP/awN5mPV87Pn1x2loZjZ7/brKQ8v6jN79Nz4eBvWe0JSzsfr1bfd7M0kOJ3y4POjKCF+FhVpXK1n4/ToIbOWBUJU28+ZIzUmQEe7j2HkmAChbx4khyYLMC3Dbcp9RwG2yNmNP6nhI63nBnvqUz5WFDrJlETRMGdgxWHxfD7KpCSvMsL48EpL2/+iUYyoRZlTXbcIkaTCtd6whIsDHNfb36KFKNCb7rfCV4is2+8vcW2E8NCaOdBpK0TnvFp3qZlC2L0d35UYLUD2ja06/QcFWIn5L6UXCXDOx+PALG0aDLXj7BZu5KMgaeVqlW4xJsekIjz+CPDN4OfZwemd+GvyyvVBlhCOafs06XFCbB6bgSR/IRpPlGY02AtxTD5t6Z9QIVjZ9bOjfghgcEXxO69NgLyNAv/xOAHWBcuM/zQRgHgiX6x/mwad0x9EK2ZIoHv9Rpv6QiEoFAqFQuEhVzirjOPcgxlXAtKeG/RAeXPLmxGlHhSN685r+tWNmtTThR8nu/FYP2D13e/dYMWM7Cm/1o3vp7suHJ/TjcsLG7fG/NcNg+CFfsb23RhYf8BcNoKBJ2H0da18CWZ8+K5M/cPDNtNHf9K+iZCctquhvl2EyLF/vKj3IsiffGtacUeEa9/Fr7Wei6B2ghA3xIjACF0nX1Asgpum1JXWIBECgtfst10tQo4o6lCElQg77zdavrXqwbaUxWsx3gGz8OUNj+I+gcgLZEnrNeFrD+9E2yoGMPvSFyUVBpINly7bJ8/A+/uFfyeHGchd2JhTU87A3j+F5TqVDIySqhRRAwPp1koTJ6QZOLLfTc5KiQGTIim7JQeaMeg/p7bbj8TOoYOz5JY3oymELpD+nIu5eWdvG2q04sKlwmDOqjYssqlaL+hpg9LnW9vMQ6mYd7bj3V3Q0bLH2Zm3g8Cxc2m1T8OYSLtCkepPZiPzptcH871cnPuwtzTucCf6vI7s613Ag4/d0nNBIBD/SvPddOs2BAs1tAKvtGPsgvYu2TYefpQ9V9Et5OFuVEEyTYsP15uOJ1+E8lGVWlA6nceHivraGEYXH3FTJUO7xvlIdvo1w3WRADtm+/6Z81aCfcE7Pm5yFeLF3U06eusJDDT03PgjakPwL2KtH8nHxP3wIsPEdnx9ID6T58xD6qGhrw51PDiGXG9cuZCPwuAhleiLfJRvObbCm8fHRXPvshABH4npgYxLFAFwSfL9X5oE9oWVMQdNhRD3uAiG1Ais3Xjz6tK37fByj7NYXsKH55ryfTcd+ViSqWLjmdEOLSf9G7t9eeg70hawuoiHnT76n2KW8uG0Nqfm4CU+KgIfPokT8qEq+SbR7uaDOn5UO/CcBGJps5uzNISYFaHzp38xiaJjoWLL/TTIEJ0hhzYK8PfpgkSyiY+0GEdJ6yE+VM+4fvVdRkWSc/d5mTAe5Ep9Oo5+4UExjivZocnH+fr2z/6JfMSGLbNVHeIj2Z/SHn5QgoyKvFUPlISwKb8r0yUiwLDFL1dzBn7H9TC3Jgjw01Xt63t1AW5udLfM+8CHluR6Ys05PhZuYLY9PkCF45yxWe4+PKjksjqf1vOwxkFXvlGHjw1TLyZfTOUjQNXFc2WwBPF7OYGYL8STjCvmU0UENofvXxl6ggQtb8Wc3lEBaBfdV3peFyDQ8+Yll1UCfJ/6TVWpng/aqTwpZW8+pswy1s/JpaLj/Y7bVck8lMvvW9n7i4cvpU9zMt35OKb7NmdyXAzZCl+TNB0hgj99n/lwgMAWW8aeJY0sjG9advzmiADzPwWlKI0KkH/31cDM6wLomU1LatQVwK5L1s+xmQ/mTReDzut82N6a5HVxqNDZvVX18RceOH5Svnu1+ahsP/BmVpYYW65/mnr7uwC+5jLPti8kcLfm48uHMlyEWZ7fuH++EP65/+1U/iOA6pbIjypjAtwXMvcxMwWoierYsN9IADvj/6b0j/Ex7bO7XlMtHxfkTs3dLk9D58RFXrqQh9M2J/1bTcWI/XNsMD1dgF0Z1SZzNQnQM5amyg11YHl2yy/FjUJMm3VpZp2KEInRen7T/wmgwXm6uEVOCPPzL0ecnglA1x2p4h0V4HqJ5nDlBgE8Hry/OLWbD6XjrzvTTtEwsG6qFttLjNdJI2buBQJsCaqQSX7VAgqFQqFQeAjYx9zPle/BqZmbwrQHujGrJbOD0tyNM4/ONioVduNyfpwXv74bP/bbR1RYdWNuz+89B3skYCpL5weGShB72om56r4EvdnfdDKy6QjeGTS4x1CCdwvrlrILmVi32uBVgBQfU9dHGTVeF6E/Xc8+5rQI5+QkOZWuIvRaFO6qsRRBo+ZMwCFrERa/JC6dPygCq8Ruj4qaCOOm80xOlQuxPu/Y1uc8IQxY9ZonbnbD//v+BM6tDpjNduTteVaKeYpPCqjmNKy/R9mifqQJ0p+1Tx1bzYBnf0N9ngoDYbt9nrtIEdiw9Pvt7FoG1uglyZ2cRmBwszXHnUIgPteUKdnMwN6qaHG5NQNNbHs7S7IZ3a3Gj70ekJByOKxwQI8O+W9VAVo5pWBF1X9vWt2Mwh4LseJzOhRmi0yc7tChExK+aCyKAf5WzqT+VALn3l1d8XgOgUDpo1IOYgbetSvkGn1kIFriVDynlgG9dNa7nSEtODxEeGptYsL+en/IXbdmyLgbnKhb2YLV+5Pv1qm/xukpa8sH5rTB1tiRatvShknu/aU+NlT88g8POtxLg9n6zQ1WRwkkl92fWxfGREmL3C6FFDZquH5Svnu5OPdhb2nc4U5MRvVfHFvAw1azRc8umxJoT3pl61vIwJL4mOVBzW0Q+D/gvznXjskHBVOm7+OhYfj7Ob8mHq7KJu3ZsoSPD8/G3vVf46M7KHH0wBAfT0OiU0OG+Xhfl/Ly0lwBOjI6FkldlSCkV7jvoL0QF65tdLdcS0CFW0fRfM7AStebp9PS2tG04VnX3z18aFEEC1bFtcNbtH6xvz8P6qPNoyjhwf7m5j1fdfgI926+ezCZj54nVefifvFhqTLnk/YfPo6rHHANDpBglvFB/1kbhfDwsWZAi8RwPue82mMCln7DxcP/0RBTd+fhWDsfS57Tx4aO8iEfK6xpkaWiy2FSRcOHB9nvx0PaCR42dRS4TNnIR47qS/1Vd/l43/77I2uSjzvPfBLgJEH9grj0fcuFuH1DYjyvn0CiyrI1K/8jMbBx6niUhI7VDy+tbFolQNXF4kufS/m4V/26PeoAH7aP6tRqrKhw2D71ZXUwD+62C+qyK3jw+xYw3KLBx8mHxeejrvLhffm3XL+NBC2/ld22zxRi6K7He7E2ieFXb4wXbybhLGD29aWQ+CKnunP9QwFO9O57rmkowK619n8kLD4k1f2UjiA+PtfPXLR8kIoEH9H+Kdk8nCj+3b9agY9zx7ef4pzi44nf62N/f4rx7sR0KZq5EAyFdP+Nq0hsurB4EVuWxBZbxp4ljSzcjL+wiiEnxJqjRRHNjwQYeTT8mg8Bqn3f6Lyq4uOPahN/QzYfFtPtH3vMoWFpYX7cxWoeGptPtxpo8bF1cJfWwXAx1v3U9g8eEKAh/dU0tiOBNRdsC7oIAndrPr58KMPF9/Mb+KS8EM/lE3eyZwpx2qL+wreXAujKx4590BHgRPXDvG1KAnisYjc5fuYjSir7yT0TGtq8v1ud4vHw/ainSF1ZjCBPzt5TKQK8Hzia8cKbwEKajG2ehAA9Y2mq3FAHErnxD69oCzHb0XSiZrYQwyY3t4gWCvFx6u5PeRkCjBUHBBpFCSBa+vyapZ4ABVMMVK4I+fCMCxYseUCDz67BR9qbxHj0wzfbMF+AV13Wed46rdh8937MtbZWUCgUCoXCw3WXuzud+d3QVv73WrOqGwd+TDVLe9aN8Y2RF9bd78YV5wMVY2bd6N9kcWVPhQTv/ZwFPo4SHKx5NGPxWQlynjQut2mjo+bl8sa7ChJoHAu4EDjIhPOKcJehFBbWrTZ4FSDFR+AhU6H5ZREmyUFEHROh+PmzII6LCI93LtUr2yIC9HfqUMNE0F3WOHh/iQhWD5OJkRIh4mb3crQ7hDgo2DLLwb8beYfb3rGLOrD5yaXf8jVlmHWq6brNeRoaGf+UYm1oiEq48Xjz3SaoPjh3ZpomAx02TfbvZhO4Pd91qwyDAacw/2E3eQKeH1nLn24joLRH/c2RQAYGjvmW4iIDMpTgOxftWhBybtn5LhoJmT27NWYfp+NfxI/ozvoyrC3QOnWnm4oNQfucu92b0V6d3PPiOR3XT4qrXG8wYGq8/UXyLAL3xo8qXFtIgBc9anNnNQGc/xHz4SsD864bpiT/ZeBE8QQldqAFX+8Wb8gOYOKbyzu98g10xKd+StJopmHm6iVntGTKEEOr+KvwoBklfQ3HZcdoCLm5zLJIQIeW9wXtH94MRKw4E0tbScBLZsGZOBkCtROKr581M3BrrsunSxwGtP8WEmpqrTi5jOl1aycTttrBvP9SmzFl9BOxd38LtOUrvthmtiBw0RfvJ25vsOVT3RLPj23YLBNqeFGbCrVP/01/84YGQurVyZ3TCcy+HXtl/T0mRErz76xLYaNvefCu+L1cnPuwtzTucCeUngVmySvzoGygTM/YTOBhZHbohQIGti2nBj37Q8ee+XrTZ4a346HL83vHTrWjP3+5bKwfD617vokWf+Th37D7tvo1fIwdGE2/lsFHYpbMa5spAohV9gx9nybAzcbyoyMuEuQaP39E3SrEq4EVVQkbSOwyLvQ9nkfgG7vT6+ZPBibjl4kYM2iQPrtU+7EvH1zZ38lvh9rx2mdl27X9PHRNTdUxaeeBdmJd5JStfBx9u+Vu6TM+TvskqAXNFODm+gtuH0wliMlePD64Vohc1/Gr+0cIPOfphwVuJLEt0uP03TACelXJg7de0PH58APofuJj//t589a486F21CLj5noqNj1IZIfs5WGYe+NndiMPa7M+DKvp8LFhz/Qwegof9WvD/NR1JUh9ddAtXUkIafvHHnP0SEz1/s3fYUZi3OSzxmNbEu+NVmQvXU1i8e9FCVc2CFBocX1/FJ2PN2FZ6fVOfMyMScv7R6di9e/9V6cU8GD54emR6n88OBwbGjjrw8ewadCnDRViJBwvCD+xUYjh7wcd5pwgYb6/91+BA4nd1TRCfw0J1zf2S659Z+HFB65TX44AlrKOq0U2AvgHvNyT18yHs+3jtWsK+Nj11Cuww54G38+VXc4MHhS50avlN/NBpB9PpPqJcXqKabXNPwF+19uSvlcJ7HzTdzlgFgmn+Yz9A70E7tZ8fPlQhou0e6OJooVCbP56/EBnkQDbLBUD/m0QYN0qflWTlABV8b31TJKP4a/cb6cDaHBeSd0mIXloGvaf0isWYVitbLckXgCumuyJjXcJQOb5UhNVEmbHk38V/yFAz1iaKjfUgWnCbJO2JUJ8Em1aTl8pBHULZ+3wEwGKlubFVAUJsDeyMKHFQoC9W2ylTNl8TEnb+FCtkQZz9r+ynzJiaL63eZLzQoDyebUm6q9asbQ7acbz1W3gOzf6uJq2gUKhUCgUHuaOD3BlX3ZDccSTf/lGN85sl6kIje7Gi5tOr+bJdUNtwP6We64Eq85lClYsk4BG653B3iYB9drT9uDfdESeGbZ2YonhfH+m/T9NFkJcl2hGNLJw7pM0ZfIpC+tWG7wKkOJjyM/zUuJZESLtbVwaD4pQEP8sS8pSBMrNpK0e/iI8iGR/XblehCHui4pXb4XQ2Un5wqcJof0i9/O4QTcONG+56UJ2YNPGvJmVwnJMIX4dq3pNQ+kmzcHxSzQE33M7Y+hAAyNUTWZPSxPq4r4NL1xK4PruUMlxPgM7PyTdqVAmoGp5asYdKwJLFh4fLspnQGHv9JTafAa4qwL1q8pbkHn40y5fGSZkPLC54ikdf+ep3U3tKceqhU475yylgR8WS7X9SkWMV++HKTea0e+70//9PQb0Qw7vHFlMIHVTwtufGgQezUnWTdIhYG5iUj9jHQGTC4wjKusIOPP/3K3zasWcK3vldB8x8dX9ofXJk3TE3cnOokvTMbPYJqFzZTkueLSZLfakglvpcECT1gzy2uXvSoN0qN2/377nBAPBB279t0mPwIr0PPcwBQLV5ft+jigS+DQ5dENmFgGl5e8CfVJbkSl/1kA1kgm2+5S9yzbREXBH7l10Gw1z/3t5QM+Ahpk2z7gB/mWIsHJmy89qwXtb9q9fu+iQch8iDZvp2Lu0f/2iZAb+ODJctq0msJWV/MD3LwNGKmtMQ/4y8OY/E695f1oxe2Ue71oAE9b141Yljc2QTbdA780WrHxhtY5Lb4H1wZnvd8m3YihckGeGfKyNH4y8rkzFYTWHW+ev06DB+P4uooyB4Eliab4uE3u//VW8/Y4NJb+Ld3L2cnHuw97SuMOd0Kr/Vb9EmQea6+jyVjMSuVU3nD8UE9hy6KOdYJKBofPLvp3bzYCBVfGZnUNUTL04feFcbjtcrW2Tvjvx8OZcd+/+Lzw8F0WbamzkQ6r+3uH1L/jYeGHa6rBZApR2bXi3bq4EdpVpU//qCvGlqq3/0VQS72xlue+2knAZWE/vuExg+rSbZ4JZDGQKxPuE0XQ4yKhInXbgg/vYaoZYmQrNBskBLRseumX6Q91Leei28x30XceHMqUl/+1NPpreD1y6JiNB4J41yacXCtExmq/mbEqCJSf9LN6WBCmh9Yj2kAj/Z3Q1LJvALNUHWUMsAp9er++Iq+fDd/BzupwFHz9uvwi4+pGKdX5LE8av8fDvgPNBuSl8bDQMKp3lw4eH0P7ggRQxzmzS3yWzUYiKy4/9DU6RWP5LcxHTmcRSt3lDe9aR0M1d2rnMikTqE+uXgedZGL7VUs+FACyZ5+cFZXyoXFaOepPIB9d85UD1ehqWBARyMkgeZvw4currCj50fa+snLJcjBML1BR1ugWgX5u33ridgMGZt13YQ0KLnVlUtp6EeWG10cuFJN7+ZxA4V5uL7IpdQ6kfBLi2fvWTO4YCcPNuvfSdLsBSowuMIyw+/OSoO3Y9p6HEZoG/FJ+H47eEOTvLRPDaMTVMKlWAasNHBSXtBFSXmflU7iGxPvo/EzNdEhu9HEvXqJCgZyxNlRvqwKc/B9VCNIV45Le5/cJzARq5P98/DBRA0ffd/h9rBPikoVzuIeJjZsov5Ye/aVh5yHfF6yoR2q57Bxy5JUCadCbLV6kNXYe+mP873IbH7VIP88LaQMis2vEtpw0UCoVCofBwKe+/e8sPdaNgsdng6r3dyDtTdFGFLUEdRX3uem8JxOMxEaJfYrQUzLrjPCaGt/8F48VLGDAwVZtNSRZj/fun6dEHWPA88f7EdCk2DtIe7IjpYMHv9ZfakzUsrFtt8CpAio8wn9NOJw6LEFEgbZloI8JMF9op42MiuPuSFVYLRHCtDr7Q9EmIzzPpJ9ZVCnHObEz2cK8EPqte6tn97sANg2nPTu0tR+kDm4EBOg1LbVVPHefQQDsqrtYtoCGpSdn6eAwNMxreN99b24zPAk2DfQ8YiLAy7X7FYMCWVHeNlSag8ic5Ws+PAeeAjosPshjQ1lx/RuZWCyafdjeeGSAxPHBG7hGVDv8NW2cqG1Yge3uEhn02De7eO84qRdEwv+bc3127aQi80nFH5Vcz/mzuixieQyBoTgdlpQqBiF7BpTJ1Av0+0/bVLyGgwbJ33riXgOvH2VSZN61YPXvVlZhPTLhVuUxOIekInvfy+6pHdMSeTp3e2laO4RUvy96OUPE6jrv9ay0V3B71yjCTFhzqr/8ud5wBh6ELFr/WEvi1Ku2soRwB/8fv7bdNJ/BkwRTb12YEQiPmH8sdbsW9neTFiSwmjjBXOU1/SUewVtj2/4LpiG3LLeN10BAh9X3P/gXlEKuF6czZRMX7d6FXO7NbMC3mYvr2ejq+XtXt10lkILl88ew9ywnk3NqVMWOQAZH83Ztn1xBgpXW92abVBta2wIbP15nYxYzzLjhNh5cW15FlSkdAm2Lz9ec0FGtutr87k4Ytvq+Onj1eBlefuDuTG1qRuL0BDzbRcd4mPD1+nA6VS77F+SkMaLsGbLJdTEBKs0FyQIuAztrsIaOYNkzuf7jodRIT84y3dBhvbIFiwyHFV9Kt+BLw1V7auhWx12ZMa3nXCv3J/1Znq7ahIDa4T/5hPtZ7lN70O0+Dnc6e06w7DGS53Co/yiQRvU/fsWA7GwfH3T7/COXi3Ie9pXGHO6FV/6t+iTIP4/OfqieVEPjMuzr9oyEJizJp5aEYAjYvJ06WMhgYLulNXb6ZgcaHkf1ux+mIexDxuL6gHaWh5azPGjw8shr68eEWDw+SThytmcHHfX6X0YFwPjrsRLsTCsVQ3+Tq9IEixBy7y3vwm8DKnofnzUAiNuPJg0EnEqlxd0dLsgiY3bm8RF2OwDos3fUin8CsTP3G50v58Bg6n5zrRIWC13/NPYd56NjjnfWb5IH1khqcbMuH1e7Jod3bxdiUl2empipE/sK301TXkFg6Z9LxmhWJuzuXzTyoTWI8uPj1/u0ksi4/o1W/IHD6XXyapwoL1xrzt5je4uOG86PaHd583Jv1Wiq4lgrFHP6t9ls8vPap0zopz0fkcJZFfosIyHO8S2kSoFrBSW16NAHjix9OENtIPCwmNV+uIiHtf8O7YSYJpQ/qbQeMSBwcmC6xe8vBiIpiQvcsASYeT8z7weLjYZPbia3pfEz8flf6YhMNB8tClsfW8fDUwbR8iqMIZqtuR6p5C1AmOirY8IXARpeW9TKXSbxI/trH9iOhsO3Zir/2JFYsdF793oZEN+X23gsLOtFy8/KLuAQBWlXP6ia7CBBsTUvgzxaAqj1vtlk9H/kpuQpb+DS8+rrE6s4ZEVQj9jqWxQpwkbkwNZHShumlc/2I1DbYLR27RL5tg2COuelQZxsmHr+sKd7UDgqFQqFQeDgjOdXdNrUb+t57+uiXJVD82ae6fYYEDI1PG79EisGy239q+UsxGD87bN+P0NE5EO+gt0IMk4It+W8sWIgZUdL6t5yNr/oGwnZpNpxFA4uHBlg4XHxX9VU3C+tWG7wKkOJjzYo7V2PWi3Cblem20FGEZRGXDS+MCtE0lZNmflkIkd2MwKw8IZQGXl1hn5GA+oDa19negaHOq99q9pZj5uTmMwpUGk69nP03kUnDqjrCbnc+Daw1M6iJkTQsfS+dpfeSBr/blxI29zeh6/n216I3DIyY7vuux2Kgwqt+MW8zA6M0JeWdIQzMlFJL2k5tgdg61fF+D4l5PX3pN1rpaIt6VVkwXoZZLiHPymJoOEmx096SQIPasrkXpXxoyA1Nfb4riQbnYwWaRWQzNoRauRS8ZiB676Ws6tcMjNIehre8ZGC91cC5zRIGhj4GMJoPtsL308a704KZkDo1d7s8nQ7f+FnR7eF07I8U3uqxKcfC2bGC/v9oqNexNciXoiEgWMl/QIeGsCeux3pXtOA5Xeud3C8G3qxA6+8aBlY+OPBiSiUDnvNePTkxzsD7ni1t+TJtuB33+7PhVSY8rF/oz3pOx+f930P0ouh480f17yNlOv6dXilufl+GXy+mjj45T0X5i5/0Q3lU+B1PfBeX3oKKXzHbx/YxYHHWbcNDCQN7dyVVOBUwkFjFLKn4zkB8xKbc5WhD08YVHfHhTGy2nrGtLJCO5uCKxg0udLye9WPX0z4aDl18c7/IhYZPpOC3WL4MnUe1ql/qUcGdMmuxo20r5iXrJuXW0XF9jswlJxcG7vXO/DfQwcC5Yr+cqQMMaB1LPa1zsw2v1xY0NYYyYTh/l/H9u3RkZu9X/3KJjvyd1j4GC+jQNrMxS0ygQaHMP2n9dBo2DCy1VowpxbR7S3e66rZhpvr+ZZwAOhIe6c+OHKMjI3TT9UXRDOhndAVlWRBYG/jX6OPmdmxfXCN15RUTentDtB98bcbAgx2b/vW0oHPfw3l317dik/x2lfoHrcDrxQderWnDmoqCEz9L2kCNe7N+3+x8nAsdXJtoxoBoeaz0uock+saVx6vqWMj590xmhYiDCWPB7yuHO5G37PflDdI8mFxsiUtuIfBnoS37diuBLxtrZysuJPHaa050TyCBAovr+6PoDPx98mLE5DQDvDKZXSa7CQx1MdcMdbZDdfiwX7wSDwvDG7HvMg/z201mD//hYTjts4vzRxH6Zg8J0kkB3hhcHVwyRoCxZtPxlFYCg4v6BFwRgVcqJUfXTidRMmlkXneUwNT9uzuiZAicezpcJu5gIkTWRtdPno8BdkfskAUVPaqP4g+Bh0hW3OIp73iQn7X8yIsdIoSaGPzpixTg4tEjJ6fuJpBJ6gyw6gkUfuk8qNFFIGJXU/idFgKCk9+f+IwS0G6RNVW9RcBQS3Vv01oOek9sm9kezceSYPZ3liEfZIMZIVVAxRVi1MV5Lw9r/Yu7RuuEiCPXn6+ZKwDnqkGoSjqByT9/fw3OJDGn99XxzctJ9AbejXszh4THYva2B3IkkvfM3hh7lASlbNGWR2c6EDLn1k6fST5eLFinG/COjwtlp4t/OfJxV3WTZuoeGnRl7x698VWIAvrbhIeqApTdXpnfqNaGf5mDZqbH2zAnZUL6UXUbloT+uDTzTxuS6y6pvrFvh7L+It39Y+2gUCgUCoUHcacezg+LkZis3zt2Tozcv67F4T9EeOD9KK5FRYxpcoOzd1TQkbk0WDMlQwRaW8+8W1tZeDNXvbiPYKFpCnX9hgEWMuPLbV9yWJhvMCl9h85C6R72pReybDwZPltv8IMHsyfqZ62miXB8/b9/itlC+Fka9WvrCWHOzTZ9aSZEkfbWxE8SMQYOxBLvLnQg6NxhGXP3SrheUyj69ICOWBfD42EedPRQZg6NHaTjzjLq9orNdHCft84/4kOHR1Pl7dr1dMzYz73+yasFrPSdL08dIvC0qJRQ/cLA8wuX5i3+y0Due5fwLcva0GWmohX/monOrJOzF79k4EG8XWLrp0rsE1n1BOymo+b60hNf99JxOldY/laXjgnZw70TbnQkDyuadJ+iw0zl2jKHiFYkzu6ZEn+SACVxBif1GIFJh0bl6w8IKN36qGj6uA0x83SVzgSyoOEmdHX0ZuCBWPe1+14GPpR/XC21phKhjh5L+wgahOPrn/h10HBhIunZutl07C8pcSjTpINzeItu1FArFopmfpy3lUCS19qUZSYE4jUGW1SCCLzdMNprV9CGed0ujZNbWVghkpvedJiB+JP7PAgPBnLb9NZ1t9Px7d3XbdhTCZWaUrr/RRo6L267WFhMA2v1hw0b6DTUSPnZnDNog12+n9H2ZAIhmX6d/3kSCPNes3hVCoFXZ87x3qu2Y2JFsvLMQyxcKHn4q9WIga4d3+3Y6xhY/GyuhhVBR6ZsW9i8ADrUbpVb/55XiYLxH+IV22m4ZTwouOhDw+bwK5uOpLdhNuPT8VwZAq9+XOjZdZ2A4zwHcugmgYvLR+MsLrWDy3hPY/qy4B8n/vr6IANZYUmPj7kxYD7GvrhGnoFEHuugySs6tlurbVj6Hx2H1DtOybVV4HVU3e8di2j4F3Pu1/DGdrSa2dzTy2HgpKecCl2PwLRLb/56/iLQcW6wXaxGhe791S8HbrMgtVW8avg/BqSriJfUYAa8s8NF21cx0H974xWnOjqq9jncz9tFx8+mqtY5/2hwyXZ823ytAvGq5778nt2OkNYlHl2XGfDMfRQcqUjgR4ulq3w7gV2/LyFhAw3CPc/C501jg8W7fqQlrBVWiiF7ulzbsKL2nK5GchsG/nsb4dHYhkudteILOu0YeHBv33RGO34+Gdg6/3k7zvrOWHvO6z1mfjs0r+oTiSlJKs4UEQuxpWs+RE1wEF7qv/7q4U6Y7fi46IwDD9U/J0socSSCjB54LrpJQm/V26n+h0gcvz5+J7KIhOfb5mv/rSDxQ3SuS6WCgOUaH9+yOwRaF33eeGmYCb9rN6/vtqFCenuts8IcHlqTuOLjMTxUlJjxno0LcWnLgtXTjgoQu5prLOkksFmU2uF9g8RE+7Oot54kTs9c5Xb/Igm76RcPluWTYBpqvcvdRQIXlTbv1iTxR9Nln6w5B1ql19xvTeXDZwb/9dYCKt6Lfi13X82DpuK/Zy8vCPHM/yDz7R8+DP7zLPq9iMSUbiW3U00kPtFXT1bdIaE36zmRV0xiTvLi4KFPJH7/8581T42JAxaut4r+kAgR5OaPXelAZcilNT3hfGyQLjpAU+GDMdFhPOFIw7k65Y7Ky0JsduM/mSEtwK7fs3dHCtqgtM178uKJdrD24JLcxXZ8oO59zuxvR55S8tRmWyqumzqt2TNEhdm2u5HyynRQKBQKhcLDWp8FrMbVYsSXXpT+eE+ETvPO/V7ZImhvbrA6up+BO22zH348KIIHMWnbqswG47DmwloJG4lOm0uVC9hYdK04TYrBRvesdXqWJBt90pkHE2ZwMK2zJn5dOAfLbr7f83grH/JRo/rPrgjRluf3OXuuEBH0M1GP1YTIen3vlewrMdyfuxblb+1EmZnPsvCAanzqilmXfoQBBZkVcay9DGxusTO5PZ+Be6HqVcpLGKB9envAaicD+/hZwmwVBlaYFF70UmCgSz6sYMHKNnx4qHBvswuBgsOtrI1XCdheGvjvg3Q7BrX8vmdtYYH7udNWN4JA8IG8pbf9q/F67pfauaoMsFnfL7Rz6NgU+3mqo4COkrus0VVKDLhfjradrc3Awb6KhL9zGPhv5FWtzvk29EZl69f/JCC09A/8rEli8tLxW/Z72vFe81cG6y0LBbXbPsoHEdA87ri/XZdAGyOI7uVXjaZLrPFrT+hYtyYvSvElHY1r5C/TuXTk9RqtP/SLDqbSWjNiFQPqKdX1u5vbkFa4LYk1RiAtbFoDfR2Jaxo9XQuz2uHvce1X70cWpMMLKds0CRxXr3kq08tA+u/v1z1yGCAiRh6851Th4lOzXC8tOn4O0K76pNKx0VWxS/iKDtede85e7qbj9cA8UfzidnT8FRw2JQkIK7IeG80kcWJkWdOOxnbkPFrbsvMmC1NlOnM/aRPwzBnYHfWVgcwIZVPyNQOHssNF21cx8GO/x+KNs6rBLNp7gG9Bx5SixWMmvnREfLKZDMmi4zL1IK/rYjveNRrGdxuROE1hppRvIXHUTiOpZT8VpnzvMnodCz4vXa/88CKQUlR+1VedAOVmlkGJiIHHZjePN9xkQOMee4ePGQNxiwK2/eqoQvLtllXRqnScCfus1+9LR9EHNadD/1Hxtdw/a4WIgOqqpuj3T0mMHt724acsDbcO+XqfnsrG70OulRs0CQxOyKa2bibwn4XlXdokA1P/DCXz3zLwPFfuzS8fBqbw9Jc1LGDg0mN+5IfMKvxV/e/gHy06fmw0DS9UpeJO/6EHmmUEGh0/Xyy+ROKj/sGXuSp0UEZ30H/4s5EU/fV6zxwCjJhFe+wXEGDsfawdok3gcYJybXwvA0vOuH71vc9A/57163d6MBCS6OR0dREDG8QFisGOlVg/qDXTOpaGH2vZgmOTBDTSjavGK0iIKJIx01I6/NqnLBSd4WCqYtyB/bVtiDj1gcpd1o7dTyNtjgW3Q0p8PU7lczuKnyh8ZGtS8Xq963Mem4oMxs/q+Q1UiP1N3p/bQscJ4rl0Td1HXJq3hlXyjwWD7+te3VLmYgK//BIOd+KMfcXjZBseahL+dLwOJHGGN1z63xImKhePXlFdwUS3M+vanHESiz68fPH7IBPPVmfP/SXDhMe2VbtbukikFm73Dddk4hstvP7eXg50ZCo+C95RsS9K6vue/V2Y90GF79UvwJPdSba8ZD6uXDvvVvGKxBrhGePNe5m4sCx0+novJrJc3eSXGzGxPvHAYa4/E9fbxQJHJhPmAweXr3nDRNiBCEP2KBOPqfFH+Jkd0Jiou480HrhLbasa7Wh4enSvz38/BViYUaBCzeBDY+FW6dUd7SDWzd29eR0VKxPPfN99iYqi1QfarZ5ScelfKeeBKQ1Ulf8qQr/SIObe1pFTZCDLkLbz6BsGKBQKhULhwTj39taMf0KwVpj8zpERQelzoUbWJANSwbezXjgLUft59t2L2Wy4SOIDv63i4L560mahDgeNbie2MqQ5sLwenHHdmIMKzwV5mmc50JtBTZzSw4G1yZuDV/5y0SE1kPnLkI9nj41mTrkvgDDP8KxHtgDW59bVbb8swpK8hjNvTTpBnbbIL7y8Gq5B7qvnKBN4fvvPRZcZBCbKbxF3/jKgGVeZsPcDA3P2eu/frkyANF148amQAefkUStxFwOnF8hu/Y9CIGHxbEOz/9rQ/aft3rIVJPYXNH+e5Lfh0pp2uapnLDT198xap0di5gFv5cK3Ncguqz92V8wAReJb1UkyoJ2bHHIvjYE51jHtReMMDP4aqnikRODN7sn1ylIEbGd+LLz7g4FwxR12IUHtkLFfW50iJnHhRKui3koq7Nkze3N3sPHqm134jBUkjvPqEgqmkfBhaLSEv6nBcILjtswHDKg6hY24eTCgzn6//3ANA9v3vXg63MGAVvhx8Q9lAr+we3bOKAOnsz12ZX5uR0lU7ZInAyRmzrN1/XuKiimKz7aV7WTj6o83fcJ5JLYKtf9c+0Xg0gdXobCIQHY55b3v2xo8z/8wdHwlA+0/FlHGbzEw38PFOSyfAYPd9/qXDjCwhmss2b+QQN45f+8ubSrUNbm3Ev+R6OgveyNgUHH0XXHpcy82Wp9p6C1qJHD79kOzmlcEGgIa/1t0ksAruejeBj0CN52LeFN0alB/4mlLrSYDCcvfqJnaMOBTdWyLynsG9im7V8T3MWDSY7ZjwxMqlAKDlfwHSBTTzvuuW0mD19/dxpd3stEzvq6+Zh2J+wFnzp9ZTGLU1k2jvpPAy+NOJ8tfEbhw3ezO2AYCc+TdL1wNqoGho1FJijYDvi0fuzIeM9CzZ/36nVwGmozXflFzpMF19stl3VFMBKQve2UnRccBed85XQVsvAkZvi83SkD3TLRq5wYS5z5VrhL8IWAiCdYl6wgYem94kOhGwDnOY/4iIwJytwVrHdbXYOHisa6K/Qxc8dvidfQNA+r3r/10dqbh5Ej3EpEnExuKzU/KKDGw0Fteff8yDs7ljr2d00/AYu50zbcqJCwkif9Y60iIzt05cmmSwMdVTTz3GgJ7xh+vGcsjMMP38m7yCIHZMjd3FIxUY2HEvHOPWAx4dXUJX3TSkSlU7TbJYkK4/Zzz+k8MrC+7NPRkAxd2xkHX7KeSmOD81VT5R8BaefKnwnQSRRtd1pCrSMQccrT68JvA3Ttlwn4RgbO9f1cdLiQQ8n5WToaIwMy1zQuaTlTBM3Nd1Jg0gZ1HJtsEISw8Wrn5ots3Auu7I1ZQ13Sgx27Mf0dVG5a/dvgRq0kFRaDiN+8iFa8yvm+T76PCcOO2g9t9aLBr+bmkbyEdByK4mZUL6LjCyslmvGVA+1nCMq6EwLTiOzLm58vh3yE0sNjIxQR++SUc7kT3x2v+Z8x5GAn68B9pzcS3zp4T8SosbF80sOmiGgvTHpUeVtJnQYO+8ht9GQvD0Rrhs8pZWBl+XPzjFgtzNbIec8QslG0rKf/QzIaRvMF8/3cdKJ31stLsEA2f5f87HDdfAFv27swTPB6GWvwujLxsx9a2n/eWKdCQoe26eaMnDR9n1Rp/qKZBS/r3v41KdLgXxqWcHqfjvaxDwrSlBH7FTP2Z9JlA3NOWC/VTmaBQKBQKhQdj5SRFcp4QoO6MklQQuFCZObtzvwCBLpdo0XM4kJ1c3nKklINg41V33D9zsLhy5PMPggObYa+db6s4OHAmdUnVOi4WC+bdznjEhdr+C+751R2QMVOr8mztRJBKcbGMDh+1JvcMvJh8+E38eCV/TohNPtVX0+M6MSuN8J+/rhoeVkG9W9kMvD45a+vlJgb+3My93VDGgEOZ7aBiPgNTcsyGjL4w4GOBhoIUBjbo5+Smf2JgZn48w0fMAGLO1mhw6cj9qr0qY1YbAr4Vfxq414Z5lo/qx46ykLX1QRtrkIDl96WLdi2vwev0Lu3GFwz8/RQeMyuDAUfRYq5TEgOUzEOHc3MYODehopBfzUDycH56bRcDvGfGtgyCAY++ysMPyhnI4B3cTN3TjtQXn6RV+e0Q/uI+l8iyEWUutaWAT8Bw4yU7r2oCWT78tvlqNfhx0Hda80EGLK9O95jjwsAcnfQht2AG2g60/pBKYkBeVdXm8TADt9m0LHEHA7OXuZe//sJAWlio6Uh2O1R3Gtn3rKXittbZKSOz2PCx9M25RiWwZJPw46YCAo6KilnXzhL4tqu0aFK9Bs0921xXLGOg3Hws9ccOBu4cuvDw6gEGlIbtHVrqGKg8++n8nQkGwqtPGt9hMtBqc8XBeC4VP1a+etGaTIV869ULPHU2ttk0LvSrJvBns+VqpacE9OecmuPnS2B2nlabyhoCi1Y0/NPRrsHg03X6F9UZKNlnHPtzGwNJf4Yft+YycGmXWMNMyIDjQYeC2hkEpq9bsjbzMRWl8542MZbQoBZyK8Pcgo2njctt2tgEdpsc/XvpC4GKRTuf0uIJHN+8Y7DelcBWaoyu9VoCR26U27QVfcb00R30H/50bD+e/Ks4lAGV66n7FfgMfA9wPPN5NgFn+Rs34w7RsOfopPSHQhrOXJ89OezLxsy/a5lrnxCIHS3dO5VPgIZxL+mnBB75vT729zSBe3srvw/vJhATX+MbGcAA+f1w5/jZz1B+nPpIbyMDrKuHNoW9Z6A6Sn7Jkn4GZDiq7Sv8aDD/b6MkvZaO5jvjX5La2Vg6uD0ptZbAN+ddUw1+E/iuSTDdZ5KILxGYTeESON61pOVzKYHtYZlHp8URkLUPPusEAraJD8dr3lXDPj4upotCYMlAjUjdhcAMt2Unt09nQGV+6OlrWQwIPx37g4VczE39L29lD4EWg/dW34UElgd+mCfqI3DtuqJt7kwSxkseb1D/TuDJgqkrnb4RCG/Vt99SQaB63ZPXN+aQyOgqcr1sWoWt272nrRESkK8qldI+QGD/u35dRw6BD9d08mPmdeBwfmphUDMD7qaiZcfKCRSd6+7d/4WA/+SH+b+YBFoW3mxNVCCBlHLv6gUkTMhfGrO+E6gp/CW8500i73NcZc8/Em+f/bfrh0cFglud5LcNk/j0njQLyWYChUOj8h87kT0b5Ppnbeg6le4ROJOKT0/30ye8qfD1Msj71UzFz9O7jp31oUHUTZ+btpaO5U9Xes1cR4fsTpUP3R0MTFvPt6RZkcg7MFop/4OJiiuG7eu6yuF9VyIXergT9JszdviY85Ch3KqT4N2Obwv32b+tpsL6zKeB+nk0+OzVHrA9T4PPTV7zHnk6dhzZvrgkh44Kk9LBg00MbDu7fdvWXAK7PsTNezFIIqFdLHBkskChUCgUCg/nRhVeyx8iIHvJ9ohpOg8yZ9rqVFrZuLssuHr8PAej+0Ku/Yjk4BXPqjgujYMT3o+ODnzkYD1/45GpXRxcnDp555cDF/G/PJ2KnnXATm7JwftFnchhzx9csJWHIJXiYhkdPqQrKaxfl/kYV9/sELy+E1Ufj3MtXWqh2FYxMGMrD0UdHrtKVvHg3j/6+vhMHmSHtFaYiLqw9OB9w+cOPOT3JJ46vZaHCv6P3oTdPMhMvz/ZFcvDh2ON+9gOPKwIe/TS0J+HChnZ+GRZEZTHnA0ie0UIXBT0MkaJD70nt++9ZdWCM6x3f8u9LujM9vd+dLQL59Y80Zhm0IX+1k11JT+6cOw2fZubLg8Lb2RWLdnMw2TADMVP7jyohuRde3KRh/i4mC7KHR5O2nC/Dx4TwUvvnozTPxGk7ba5fhnhYceX8m2UOzwkua57Z99Si5P3ZRQaP3fi00e06dzoxJn1bo1Xd3Xh4aGdPM2KLrjRiubFmfPAex3n22bDw9SP2j1FATz41U/dNP8KD1oWSTvT9onQtue87b0BEbLD502T5fAgGrnxfesFHkb8NYt3rOCBF9E5qlhbC5cY92MbBzrg2Pznk1FOJ2qogVoXlnZBrWdBnXR3F06dz+JccOZBN+qVj6InD5kpUe/9Q3jQr0VLqJUIiVP3vdXiiGD+WvTGuoyHKK2DmdFePKTe+3PkijQP0+obbb+5dWFxWtn9uXW1SHy28F6NTCe2XpznnXe+E/zDpq6d5l0IefxnV4kUD/PoDX/Hj/CwTCjlMs2Xh2ttP4MeGYrwYMMmk9F6EWw/vT5TqMHHaB/rutUXHo7PWxb/NICHGsWiswNKPMwsts43PteF8aZlmUnCWqR7b3n2S6kTYYsHfjZ0daK2aWWK7JMurJzy7NHCDTzMMH6xrO06D0fVb4qfWomwlW5/XIojgs+Tr6ffTfLQ/XbN8TQpPj5fX5JmncNDIg6+6dnNQ2jMn0jhcBdSKAv/y77chYnR3A+fL9ci1jDHaPmiTnw9LSgfFXVC/9/8qXWvuqCu3qp1QpeHuWbhR8MPijDnUXtDnFAEVe3Hz6VX8RGianpjuQIfazu23lylzMfBrQb8peU8nDsXpjZ5hIfBY4YrjNfz4HqFPWCb2IW3jClPAmm18NizVtWL34mTxlGHyewueGtaxlmt50H37bqQ8EUi9KToZj1cLYb5XaufjR58HOv4vFu8jo/xi6usNZbyIftZ+9Sx1XzEv1bjPWnlwW9P/BzDOzyYzXsj/0+PhwPCPSGlujw8/uuVfbGsFsOH5vYsvNCF5tyvD8tVeJjcGJq0tl2ITVOezNPRFqPK5OSuRyv5kPT5vpzjwkdwYv1Dh218OL/XLm5fx0depIG0mzEff7nWRyym8bF519LrPvd5qKcGal24x8NyI9V1lpd5GP+xPevys1owCt5FhnZ1QXVdX9euUCFcA4/dmKYhRspnz+3F2/goOLXnwX+n+LBQ+pDu58XHStPFr/vs+Nj99VJ/rjEfoVrZWj1H+FhtrxXpNYWPBVe0bFopfHQ+irk41MuD0rs7vZvLeOBErLx8elcdpH98HnqTJcRJY5O3CxzESOxu7fgLMWblyPrp5YixZu3db+xbYtwy0rlVEyPGrobKg0NBYsyXnuO2MkaMybG4Ee4DMY4J7nIaHcT4cy/rqw5FjI4FrLLEByJUS4Vqnm8RgUKhUCgUHh69upYoP9CHK3+M99yQkeDDh12b1JdK4N3S/ur+DAnc/fds3/9bjMfXueNeQjEGd9c9vPlLjM73vT8e/xMj9VnxkqmWEiwLW9vvYSfB+acinnC7BBtfqjy1Py3B8fG/flcTBkBuZrbWferFwsNXn5wuq8ERt6HzybkEKjLeVq1/SEC945RcWwqB6GX6u0/GEti13cozpoUATft+5tLrBK6pL1nvcIzA6dt97vNLCZzY/1/gti8M6O7blVRBY6D00LNlHzPbobbkU6G/LhW/zk+3pJoysaTAvUJyoxa3TNU1j14k8Psi/6RMEIG9FY+Knh0ikHokgrXvPYGI8wkGRDmByBRXzztJBDJzjW3+viWgdbx6u+wTAgNHug9cfkNg9sn69ao0KiKOmBbE+NBwj4z/4LKOidsBXt7Fs5gYnO6zZdH1Wqga9ohCNxGIOPdCW0WbwM7Tf1zyLxK4ran5uSGegJR/tmzQIwK+2eyrhz4SGPY9FBOYTaDwYNyjb4UETO+dn7tPg4YbxxsDm1/TYGeg+B9LjQnTBiHVdAoTiUTGa4MaEgEW0fU6ybWY97XIhfqLgRW12zwOOxIYaxO2H/IkUFVwRev5GQJxS0/WXiAJHJi02LOomMDqMb/Tt2sJvBjo7DkRTwMvdebyIUU6ft1IyfCYy0TPqqPmlUMkNuirqX8oJGFQ22jwN4zE+gNtJ6Zk1MJu6XWf+2sIdAf3r5AFgfItkQsq9hEQWSXU6n0isNxF+dS7HgL6p+M1qnoIMNXC+XuV6Pj5IO5XXyodd4q85bIOMBG/8KqxkQ0Tv2Zd1VCdxcRCT89OSQeJtqqHjpkFJIS8udNyCmrhaLRwU9MOAt2njmQqHidwa7grLJZKYP3j65ueTBJ40Vcok25N4oaew35lMR034g13ftjPQNOV/Ke+S5mYfYvVYLaHicoDnjObFzMxcfscLaafxMNU88QFDSQ4v82PeLaT8H910+O/fbXIejBI+9DAwNllhwaCaQRK1u6xk91EInHhI51b0SQCXCvcp0kYWGhStFdqMwH7nO+8gN8kcs6/+/dJjYk3PzZuuLaBCf1xhlP/dCbWS8efn99NomaHg3v8HxLTyzJWb3Qi0VF73NBYpxa7ONpmS9gEToS+Mw/0JtEycSxs+TsStEY9ywJnArK7oqY/uU9iWv2Jpy2WTMzZFeHovp0Jw8sPIh5bMzF7y5Y0cz8mnBadmatky0TotarKOm8m2vs/nNHyYSKyasb6Y/5MDB758FDjxhds2lgzqbqUicpJ2ZdVd5n4qj3rxZvdTLzxueOn0M9EBn3fztnbSdgvve5zfw0TgS55qWJdJrxbeh5MbGPir6vqvLnBTPjdH1Q5nsBEtEzjpdR6JjTzVh69OsxE02HhlpfFLOwtTO7z+P4ZWceKho88ZIFy5Gb6CXU2KG4LDa6mslHf56y6bTeJC/pXN7YZM/HAP/uw8lYmUkXCoHW7mdi+rrDjYzATsiviWHs5TBzdL765chELKpHTZuXZsZDNelOesJWN75ddqkb3ckC58si2I7oGXtPcnlT4dkA1bPK/v2s6USLr+tDbhIpFh5eq7k6m4dTLYr8GEQ1y85WP79tJx83Y8q7JDjosVw3sz/jIQONn5decOgLN+zvLE41JjCi3FubtYUEq/Evn9m0cfJvipx/L6ET4zprmdvvPoFAoFAqFh09Mla3vmFTIb4vQ3vIfHfOnZxnfCaFjz9fLFHk2HSmc94ZpPgyM/Dl6fq4xgfXlpY4t6iR23FabO5TJxK6la7LV/7DgK6N4+XIxBxlnFwT3P+/CLM06PZUWHr773Ci3aSMxv2jhccf9+YhQrO91etmKU++qXr3QbkO49NOv/p/bEFxMrNO4044bg46/5uygQW188q2hgA6qt2m/fi0B20dts77MZ0H44nek7i02BvPPiie3cpEVcXz41xkuHt480z55qBM/isrnJdxsw5D07PEZe6hYunSYzfXnI8jh+IFIRz4Op3SveWnIh/ye4Sd2OXzM+5kUuuI3H+0b5WfHEXw0vmDrlLL46ItN7d+5Q4AR4vHUPT4CTNN8q7I/SoBDa9+sOifVjc6fU/1avrfh6RSm3cyLAkxb2KRZvZOK1uXPz57/zkONz/JtTxg8+GquK7aw5sO/pu76kjQ+OBbmftcIPlS5u995l/Ox/aN80NAaAXbJLb4yZi2Au/t/D6YeFaDymjbJE0vwz4/Rx7nWjuG9sUu3egvguqi4f/0IH4k0jaKrjlR8sjhwXy2YB+8ZAQuqu3nweyY9cEePD+qqqBr5M3w0/rm2+2s5H4Z/L5ssniuAtd1NpxXrBXC59/TYml0CvJ/IaW6uk+CvijdraSAV3LINBmPmAmyJODa1j83HJofHC/YH8XFx06LnB7yp+DOa++HzZR6m3LS8ltHOw4kF1b4KSnx497YOf9vPx6XVX9TXzBOAm6l0uG2BAH1fV1xgGgjgFLZWDq8kcP4eNFJnTMe54HnJVhUCEBusvIz2CECdHnH61QAfx0XFzVnRfPS2rnhNkVDxKHp/Rlg6D4rT2MFfSR5kSMmU1uV8qM3vWCtP8vFfrdb7MhMB4kzOjR4yE6D0wWLthAIJ5qv+jAwYYuD+praE2XOE+DM2zY3yXIDxBpMTwaYCXMkOqTtO52NFmp5RchofRXcSSsYUaHhXzZH6E8JDxuoL0pwqHkoOn9JXDuGjQrn8YwOXj8Z6ebOL2wS43vPmpvQdCVbeeDlA/CGx/jjLo/qbAC+7tExLvwmQ99rbUTdWgG3XMnO0lgjQds518S4uH/4fJKRDMB/jemZlLDkant/4G/qfNQ8fOn2Yq+bx8flG/n72OT5at/0TJXXycfkZ5232CwmMGyIjsoPZKFsbAN8hAWQuL5qdyRBgueyJjYsIAQolL27MDRDAuv0QxVBHAN7sn5821fBhEK11MHM7HyY5r0pur6RhxZQBZ1YLD+0L8zKvG/DBaRhbvv0uHxvmMQunGkjwJYDs75fh4uIjmU65Q0JUjxbkYYcQH/cfrhnVEuL49xLdv/8JMcE6+0i1X4BrKtL7l0cL0HRzbKv0QgHuqA7dXdvKx9QXvIGv8jSEH4oUlE7jI745bcfYMT4SZ/pdOm0vQZz9QfIEvQNKUh73SqKFOOI248TgESH2VnyMybYRgmsQwJesFSKyffuh+fuEWDiHprrwhwC7r9VKjccKsFy53EPDVAD9imnn78kKcLD7+o6552h4JAx79yGejysXczxigiT4dl2tzX1mJ7J+Lv6RkiXEgE19ZW2cELw3ocwIfyEOr9TZUGYvBO/pgeerPYVIviV/1sBLCIYSxW3hsAAZ90bemuUIYO5itmBLiACxNbcWn74tQPfMq05dgzRkzTvt9SxVAgqFQqFQeOB1v3r77WAPkCgXX7ytB4D/U7kVPeiitMp0SfXg+63ZOU0KPQgd0I/M+d6Nr6Jtcu6UHiiPvHZRie6Gdu2yaN3MbpDLj7/srujG+5WDtBpxNz7voFNn8BiIqBjd+t2nBPY9qx9cu9YIh6FUI+ceOp6o6N24zabju1lbN7eJjiUv/GqiCxnQjRpSbi1kAP7bP1w7zUDY569zGl8xkFx25c/MQTrcaS3Skgk6/utRUiqXZ8Ak4m7md81mpE65aMA83YSLucXGctE5eHyvbqjzagsWv1qp9/59K8ImHZsS77XBhOnmcnctFdO+z85x0aXDpzLog/wvBih9xz4F72dCZ09Y3d3zbHDUzyRVreKiorJ7+00PLtSUBm79O9SJ/1p8ZmSMM6AoNvkW/a0Vb6iPQ+p3tMPhl73c4joeLAwbGdvzePg+Ru6UqPNRY3RBTyGUD8fiOZYLSvmIu+LlubuCj/SG0EVNXD4eLY7XpY/xYR+Wce2AigB3b9qzt5VJcH5JZJn7VwZKbdfcEKW2Qfnv6XzbVj4Mr/2dnmzTju0LZ+hV2vHQzZn/8lAFD59Uiq9dVebDNsP+Sao7HzrPMsY7KvhImPlLPFzFxx3PaRPjPD62li5wi5/kI216a9u6RxIUH7t3ekknAy1BKy2KvNsh661RN+0dHxPrPbjnrPnYnXDrduG+doQ37rE77MXD7hl/1ycW8iC+esMifYyH6nTu/S4vPlqNDBqnfeZDJy9K8WUtHyyFdP+NEj5WRTsqPIqRgDHlzNX5LAJa3i7uNxRpyOWEjVmsFeB5zjvF4s98WFz9dvStOx97fBibK7ntGP49Z8vx8zw8FFYeCX3CQ/g3hYpqWT6uJB8e6DnOR+r2t6cyqXwk1UqN1xB8dP/oyc87IcGra9SyVxwCr82/budsYOCmKFPUcFWA1AL66BZ1AQxSZzkZfuCjcZv57V3n+EgUnzOp8KKi6J0GrfwQD5fspz2Wq+fh+pB7yzodPu5kvXAWpvCBw6Nqi//wITeTrXPgtAQ5fsv/I1II3Hb4r5e6kEST3AyDM3QBPrPNrHqPCeD9Mewxf4oAwv20bQ+z+FB/oNvz4AAf3LtSIS4OVIRpMXutLXloUVOf35HHA4cakHFDiY/I1FLfuvN84M0vKbfDEvQMT522qZXA2hPzYuPyWAh94H/oLSFAknp5/HpSAC3KmFDzhADZTx1jumcLMCR+rdXdxMc6haNTz27lQ2/b1W9H31LRvzxha08yD7eMd8bfH+Fh299MF8KTj9sOWbLHZkpQ9jGgsH8qgTvpC2+c+cFBmsJ/F84PC5Bwmp2izxTAdJru5AaWAKXZVx4Mhwnwr1diPmwowFEz4XzpN3zoR7z1G7rOh8yu3/fV+6n4b0Po2c1veTB8kMgOWciHTf3baq1nYjyZ/d/O4rkERgdMhlzKO2Bw8uLmNfOFWMJYu1s0KsAra0GeeocAZo9cvPt6BbjKfyfd91SAccM35N+lApSHXFrTM8GHe//b8Zy3fGS91vc/vZuGne9PW2405MNq86+HxnViBMaWziSWE3iUf+u5xp8OuJ/Yyri8UYjt9B/9WCQE1eqpVfg/AcxjzvRHfBPg2dnvj68qCTGnuf90ZqIAjJkltVeOCxAc8e22nboA+YMW9yO3CZB27WBSaQ0No53lvbw+Me4esNtgEdwCCoVCoVB4uO95xum7Sg/uzyZOYqwbJtRN1HmcbmQ/nVex+nM3PI7t+jhU343p5Xf1PYq7sdm/dt0st25EfNk3tLRfAp3lz1Xos7qRp782fuOGbjx+96T3P2kGLjit3psV/Qkhynsu7pGm4QFRJJVd1Qif5q7N33ro+DAcnqrHoYPjrhzE+sxA5eUF09+VMTA/S0WqrZCBAE3lr9sEDFxe/pYes5IBR8NRvqc+A8vttnzfuJWB+ieSzEUPm0Frf3U//jsNZrp3xGevfIIotPtJTmwTlgyN5qUcpeOYyi1p0o2O1Ij1onBzBgJyph43/cOA+0rlJVKfGVCQllrvX8OAZYLJs85HDMx/GPWu6DUDooK6ihulDGzZHzhN3awFt+9L3zv6qglDXT0zhs81YyA1ZKiR9wryb5RqyYRW5HznBfyOasPaH97riyfbwRe9OarGoYFzd+/a4AUENNefkbm1nwmXzNh5pefZmH70ElO0iot+maFrHzy4UFMauPXvUCfGK70N34wxkD1rmHvjNAODM2rVPS60Yb770vVyhu1wWsgsVXbkYWnAvA0XPvPw42WRypPFfDg2u5qO+/Oxh1Xa3kHl4z2US4bpfFTeWEUdH+LjxKDRuvg5Augv//JX/6YElrMvDgT2MyD0nJ3i6MMANWXl2rXm7biwefvBzl18HPzudfiSRTuKPJzJ8EM8xN8dLckq5kH06XbXhBwfA3X/TncF8THiv+fhNIIPF36WMJvFx5StRWYbR/n4/MDK6GmoBMskN474SQh81l8x08aLwBevtvWmHCq0Wzau6Kjn48uroHDGAT7Mgr2VNGvbYfFTq+PKIR4ezW+gCN7x8NxcsvbiAj4+dpu6PznPx+c7V/l7eHyULuUeixDx8V175/FALwlGvZYN8fgEentcBENqJD6ve2MjzaTjy7i+rssqAQ5yTiTsLOVjm5pPh/wBPsod/RIFllS8E2sHaAbzUPHVLEGxgoeWG7eL9DX44G4Tm3bH83FJaoOc6iAfQ6fCOCttJBg5+vvuRw6B8fM2at97CeS/z8ozNyXx+ci0wE9hAnhboKFgrgAWVw2WnH7Px+bBE+LDQXzcdyE8m1KoWMU88+bKfh4utZZxFn/hIS5S/mDrGj70t7mvlUnh41zPUJWLhwTWO14cbJUl0Te+45NGL4G1J+bFxuWxoPS9fumPXgGymqed640QIEhm2/OJZQIcOeH6bWsVH3Y3O183u/Gx7g3rtbibio7Lf7M/X+Mh5n7pj/PDPKzzUih768aH8smUyE9iMZbJz5sfZULAlp5jdKmCwJ30hTfO/OBg6lbxquE+AYraN6f+7hfgknxoldxVAY6dujbDXkeAG/X7qj3r+YgTrJ92LpGP2ZY66y8soUH5Tm+n8mseNKQmtjnP56O8+6HJhUtizD7YmuNgR8BozdHWnW0ERgdMhlzKO/D08SZJlrwQK5W/bhN8F2Cm1I4YyqgA437ujeYZAiytXBXrZCjAAvZETcAYH+936t7Sfc1Hb8Poib8XadikIno914CPz/uEKkmPxGAQB/2PexAYfvD3mh+PwKP8W881/nSg5tH4JYqOEM4L3nBN5wghIzmseGtcAKXJm77iGUIY7jmoPvxUAJ2Tmw4q+gnQJDiuT10qgIzTZyMZCGB6yONZ1QAN5yZyD+p/FmNOK4Mz/1cLEuL/e7vtdisoFAqFQuHhwz7Dbb7fu+G6OCNrc1s34n49MHhe0A3qd0vC7H43HhbknL75thsLS+d7S+l1w2XhixVzqyWYEvzLpq5bgqjYC8X/KXRjcsL95rNVDARLXE7se1yKY2GX9rVspmF5V36n7Ewavn62PFr9uxGXXqzBlV46jJwvqRhSGVh86WLu7CYGTL+ODJSUMsB8+twgUYPA7Mb0V9PcGOB1cT52+DKQP6xeW3iGAUq9XJ/j/Ba0knfeb9KiY9Pn2r9rnpciuZS7eWk2FWZHQ2S/fGyCbrP5W20/OvrP3U7kODLQ9OSYUoY0gbgTv6Z/b2AgLDo1pECGQLu+2sX9BANPrI4kGIoYCPF8dXv2EAMh0WmOe9+3oJAcq+4ZosFl/jTVXVdpsD42NHBW9Alrrljc3/6vCYuHwzcmltDAedA+e3MWHe8bntwe28uAT/qUiwZMBv5I5z963M2AU5HpC7tCBjSbot+v/MzAL1591b92Bgw+71w93NOC+5uvT/8jasK3C0GnYvKb8Xq6Ios+2IyHx04ttgnOQ1bQ3g8yJ9rgs9jEhs5vh+KG/ePHHtNw9kQ97dw7Bh7M8AifvMTERcH1/V3n2dDOi18hs5oLpV3jLLYHF2pKA7f+HeoE8X7/4ZpRBi5I/ZB7FsTA/CwVqbZCOqY6VpY/1W4Hw3zlQPX6duC/HZ9vefNg+Gfhi4gPPKQv/tjIkuXjUJ3WSfkLfNw+nkHU8vkY5veqBIj5eLx1/OwfKQH6HV9Yax6U4MIJf6f3QwTmfZoYTfcn8NZj1Y/yNwxciBjbt6yYivdhxXkxXnwojJ7myee3Y66MQ+pPTx5Oaw0W2uXxYEEccLuozkfIeWqlcRwfEdqqVt8G+LjwJrBd+QcffYU+m3utJZi52mCb9jcCf177623RIZHmkPbf0VMEppUAi+/ScaDKyba4hA+puXXLCtz4uLZQIl2wjoqMrUX3uK487L9UUqjYyEPKDmFRrDYfD0bXbdmUwseOj5fkgkb52LeYYuyxXgKppSbbxDwC8utu1C76TmC6/H65s2MEVGZ+Px7STkB2NyVPaY4Abx4FRyq+4UPqxerzu5346J21f+hFCBUVfoOcK348uDYH7Ckq4mH/pWLusaV8yJvJZT+O5qPhU4HSDU0JfDXPWr50IEHpUno0uZ7E5PbJcxflSeykJdn8obKwfNl/ttIJAnwIuL+M8p8As4Mdlc2b+XhZWTBedZgPXYe+8COraFBPl5+a8ZCHllfN1o7SfIQELF0Qf5yPV0pvRNpUMWzxtnvWGQLHZW3lFf4QCAnYIEygEbiTvvDGmR8cvDr0gkoZFWD3uyXPxm4KEJOnfCFtgwBO2nfJ7g98/LQwmzL5gI8IXe+w3TtpyPRoYiY84yHvS5dJ3Uw+np5+vW/7LjFMbp642BlDwMNL5jFHlsTezz9C3YQERgdMhlzKO6CWvS9q4K8AnS97fQenCTHnmcj06hMBOCt873SsEiDGVKF6WEGAPVetIiVP+Ugki7UnX9JQwf2SfXkNH4/EPzY6B4rxR27qlrE0Aovpd71HFpLQ2BS76exPAo/ybz3X+NMB6xdLllgtFYJImjF0eYYQqzarsf+bK8TQh8cbLqYLkEa18kq/IMAJ1ZilcUoCvLjOWf5pgwA7tZxCt8+jwzn+06WxFDGmSjkdY5xuRX2nk1qkfBuS7JLDdNXbQKFQKBQKD1spV9sXf+jGlr9jjDv3u2E53qySEN2Nkt/3vV6HdcP2pFqgrGo3alcNrmi/LkFIlKltXIEE31I/F/eyJCjZNGfi3g4GAkNjYhQry+Dd9cJgRxANi7dzPkZspYF8poCSOTTYvSObOv5rgm7yo7LMTgaUPz3cfZbJgPZ/i1LUGxhI1zdbHPEfgTlJvEGXDAbkm0OvHc5ggMkvz9iSzUDvd+mY9CstYHRcbuo9SIeJ6+85/JoyRKtLK6d3UmEWa5TZmkdF2h6ZAN7XJlgFUTZs8mKghJf4kTKPQFiOco4zyYDhHO39LxQIkL+OVb1WJtC4YdqBcmUCd2xsAm6vJOD68kZ/hmYrCju62be16XBb/GPDv2oarCfUpLSlyiA8HC33YBkVF84ypu7Xa4b+7U0fb+fTkSXzxfTAYQY8qCtW3BUxUJpmfeXmVwb2hl4ZuDfCgO1H9au5IwyotPjeujydwMx+60pDj1bc77DqtfxNw6bFu81zk2jg5vz44f6DCvOkC84lVqWoKob/7ZPNUHFJKg+dTUf8ZxXzrZ/p2Hg9RCtsOwOJjoFRnjIE6CkVtxVEDFRS921cKmLgVveD7b9/MFDyk/paUtmKB0zlE/FLm/FDZYyt1NeM549vbj2s1YIT1J+mG7xbUH+b4Va17A087r1LEze1w/lO9LUNkTRUQmHhq+MMTAvKnRuhxMRwyVm6ZSYbe4ZTT2it5mJLmvTKCQ8u1JQGbv071IkEFgpGJwh4mTPF/BAC08SUTvlSBnLHD196PIuB51tu9qc/psI4IPqI/912lPafcXB24sHigfnpk094WGD3fsGjBXwoJesm5V7hw8L89q7R73xsqesl1Yf5WHRkWdqBZRLop8g+OfmHgMZis9RIIxKfSo4m5IQTOEJr1t7WxsApTuqLI2fo+H6oWXHcng9rp97gqcpUJByfo3HNmoedL+ebVnziweZ78rumtXxcejwarHyTj+WHrLbaj/Ph8TjIoV5aAuEXW2nNAQI85oMEzzEC3IKAlg/TSUQ2UU/a7yUQMHTsqF0MgTz5BRSlp3wULLE6n2rOR5PGqXwDDyrquHncaHMe5pxdsvvfRx622W3KlVrKx9eZ8aGbo/nQPZhaXDwmxoby9webnUmQZ5w/Ld9AgmRvSXs0iwRTxzrCT5NE1Jc4JseThRMTiytHlwlwUXHX5nNlfBwf13Kw2sJH8ffXf+xkaOCYFk/wH/NQX/kmTf87D0FRFG9/Fz5kJY3h7lfF0Jh23kxQQsCmea5PF0jY6E1s3r+IhIeiRqn1VBI5Yyku3fO5qLn8z/DNLQHot9a/XWcowHiBQ3lUMR+Ow9SZSY/4+L1zD007jgapqNlTsl/zoDSw4rB4Ph8mC1ZPe7FKDKVcmZpDnwhYnn3Yc8CMhDnnakXXIhL7i/K3Nk4lMTpgMuRS3oHm8rlHUmWEELWZ8+Y+F2BY8KqhZKUAJivkbioP81ETqGQq/YaP24zQrIsEDYeLOk5vXcRHzAm3jFlrxOCrZ8RcaiIws1//xQUHEtMclkX8/Y/EEm2LKd1zSTzKv/Vc408HGh/rDWrNFOLv4YgL7+YLIXeu54hHmgBuHyz0PhwUoGO6A+vZMgGs7F83zlghQClvh7TZJjqM564Uvd8pRtR4NS96sBVR7onuTOs2WNXXF17xasPFyX6PuuttoFAoFAqFh/y3tckbgroRQ5e22bS3G2WMqXrvbbshif7Rx6VKkJzDrEz2leD8oUPsKccl+B7cZ+2TLEHnkeqlc44w0BTCXlqxoQzzsifeB96k4ZIzdfNgJg26p24nvT9DQw+jYe6gBQ3BUgGpKxOaYGXn8PDgTQa8i+u7FK4wUF/9/ejxHgbmn5COaNvNwE6BY5jpNQY2L+9fuPMaA1o/vtSwvVpQe6I0o+E6HfJ5e2+E2ZZjxk79b9RjNFQe+4Pb22kIaVlTQ51HQ8SlvztHdzcj9umcrxOyBDyi/f7ENjKwsDf8FWsKgYhl+rtPTidQkHJxJ9eMwCuqcn+/GQGXqOfLOedaoWp+78aaNDr0039EOOyno63QL3xJXhkcdDyvbPxIhVzydYWgeCoi7j07lJ/RjF7nH+5KhxjQ8ZmxZQ+fgeur1j3Z3cfAhLvIqucrA0Zt68xdtAioz67n+2gROGX9qjiitBXabidEa33p2JJTRXfSpWP/7l/2chk0VOyoacn5WQqL/9aOpI21Y2jl4cX+ci249vXplJ0VdDDHS4XXtjCgU15oEjSVgIFjeNprJgOBNXXXlygR8J5+UEdbiYDnn//2vVJowwK31qGO9XRo5qwQTv1Lg8nuPfs/+tBw8WUUr7qeijMvo3jV1qXYkMZaKLzVggW5P3JerKfjwKcZ1/ry6Hh3cWp30ykGPszO1PshRSCNP9olPZdApHpK+IK5BF6lDN99fLQNTtb7ps640oy/uxa+JPe1wO7o5oPX77Wg4N9gYsGMVkBE7Lx5sxWZD31vJl16g6WMwSSN4zT03TpNTrNiwLA2oMCwhITq6rwFPtpsZMcF3jlpxcWWNOmVEx5cqCkN3Pp3qBNeYi7T7S2BNW+qPl1eS+J10paW8jACr0I3rE5oYsArQd12UJeBp3fWGXd60vHbXl2x+1071Jb0ZWpp8CDTv+T90ls8SK+/s+a/GXxMj5Eb/3yBD5pFsFSaiI+zm/xWfHkvhvIiNZN5TwlsLDQ+oPSNwFU/09jPU0mkuhh0XnciYOGrNvXXBwauz+2dM9WDgEdd4ev1S/nQvWjOcP3djofUkmWBi3no9ddK67vBQ0/5XZ/YaXyURE477nycj6Xt22xzc8RY4Vjzt0iBRH/yf8NMTRKOLdZ5r2aSePZL7Ji9goSjDPWMYjyB40RtVd04E7rUvZPUm3yIGt6ld87lI6J405yJe1TUh60pVj7Eg9+GuXbvCR5MF+l93WLMh+vpnnvyhmIoR39cnxtAwI5Y+POMNoniuUa51rNJaFYPiv1+E9CdHeVMWU5i/yefRvVMDjZGKt8NnyWAenah5szrfNCu5D/19eTjsrGRTZwKDVm/gj78d4uH4caP/lNFPIS1Rih7fBRB2a11qOMNgX25a98/O0mivHfnyfNOJDTuSr+4u4mEUWBBjrMBiTm7i4pqhR2Y+e960dIEAX6tXLfjlZwAT6f/Vy3D4oN5bva13jQ+LAndu0sqafj4nO56R5YPuT+zKv50iCBQM3j4Kp/AyuDUg2cCSdx583aJtTOJad7+Ln7GJJQMVfat2EDiUf6t5xp/OvCcPrci568AieP5u4zDBFjdPvyi31yAnRaFP5b18OF7XdR0cbYAmm4ny6hKdEhFN1b9Foiw+2GpU3NdK/IKmJfehLThq1LFzfybbbg+Q865qaoNT+8GctOWtoNCoVAoFB5sH2zaKpjajd2Vj+4Fjkqw5WzJ6PfzEpyjzTp3+qcYV/7mv2tdLIGJ31mlATUJxKB0h2xmoOL3J19/53I4OH1kj9fRUPQv6cCFVhqOLnF+u/UFDeoRlw0vnKPh0IyYGcsf0VDW8WbNxvImTIxWt19KYeD41D63ziEG3ryinNrmyUB+UFWeaQoDA2KFly+bGdh3yCxofWsLNj4+t8P8Cx0ZdzuN3UbL4Jo9LJ8XRkOJc32yThQNQaeuC4fcaPjVPFthSwwN5gF2RbK5zXBsqAuxT2ZgzvqO/57UMhBxOKXNqJGByP+cFQwoBD7cfyD7aDMB60XXPhZ7tWKd9sOfZQ10XNp+Tz82mA6tO/PnXNtRDg+fqVYcdRoUo+ruPByj4n1dchtHg4apPnu6k8eaUbPyJeMpwcDWv5kuRAcDr9fv/uvPZyD31zwj3wUERH5e+nRHAp0L7bdpSrfhvNRGUdU9OvgLwzUl5+j4/C43x0WRjsYE1/2n88uwZeKSZ2oQFdITgcZOT6mI7/uxLC6gBT07ph9J38wAY+eHkkv/GOB3XZxXRmegI8vM4eMMAgtVEm1XWRAoeGOtese0DT5St4aCfOn4uXbXhV5bOlj1Fyf38mlINdpi729HQ8x3pcAEmTIsL32meVeLigxTTvBzjVboCvJfW72kY2mvz3T9AAY0wv45uP5joC6zHX+nE5Cas3Tz0a0Edt19In6S1gbHVk6fYzIdFYcG2kPP0tEkQyr1zKTDeYwcRBQNBuf+mIr+UjF1mkPVu0ulKFAx/JG7rA3rTnWanbWnY1Fo8s0DcxlQk18jiHnCwEft9s+KRwikTeic0ggm4PFQPmedcTsObPubl1nRjJzJQ3r7Sluwa51r9G7pVmzbkzrZebYVGddvKEqU2+DifpbJedGGpg/a9QyFfKx3dL03pMeA7z7VAbNUEiYp4r0Rn1hoGnrOSWBycGO10H77RS7UlAZu/TvUCcNqW+bWPALfPev+s35NoCbM3LtshMC7rGu02w4E3l6d+VqvgIGRnpVWX3czUNkW8yTUnMAD125byzvtyFjQrztc0IVP75OTCu15+DRDar9jNQ+Ox0OCAw35mB3GVhDoi2E3NnhLsoDEzU0fyhT6CLzW9Nuwd5JA2GrjkmxVElEKH25ujybQ7t++7p86gS2/T77qaWOiwXMb734zD21xS9+fN6Wi5Pyd8FPLeNhvEDFrdgoPv28Or1wnzceqX4bLNlaKIDqmtp5zlkDbJ77ay68EenJSbg1PJVGtH/HWb4jAfJcnTIMlJLzefQr6XkxgzWezxIvLOVjozJ9y1ZqPS1vcKepKfBycfT6GX0BFjon575bNPLy0upA19JqHvJOmq80hQu/aI9YDbwnQI1Ybl2iREJxkvpkOEiUjNY3n15JY83h6nN9qEqWbPx9zSSTxaV68ztRjHcj8vWH70ho+FPmvtxZc5+P2sKJJtx4fyw6fIgJdaQjSuHUjns6DVs2MarG1CHUrnAyO/Sbw6ozSyk0BJEp9ajKio0ikDbwtHA0iMeXWUFD3MRLvPjb3/b5PomTB3ICbSzsxMXvp5qNbBTj29+amSXkBfin+1td7zcfnixT9WJIP8zTtJcVT6fBTUycXnhRBcfXtiRdqbXBecyhp0fE2HFALdLpY3YZTMj5NvaNtkOwZ8k+yb8fxysTwzWPtoFAoFAqFBxmf3Q3eoRI0bmLe+TkhRus915KWIDGC/x558CdcjB2sbSumPhej51Je9X0VBi4OMsfCN5ThZFbH+iU1NNz2l5GwI2mYWMD1d7tCw2uDx6Wq3jT8KH1wvSaehufqVCWKGw1vbiD0iWUTDDjrX+TcYGAy67eB3x86phz7EOSzlgGTTqmAdj8GhnxnPf91owXLne8Gr6yigzf3+bT00TKcGFt4sDmEBvLp1267izTEVr/bNNeVhlmqGw55RtAgsVb9oJpJQ9yMvb+T/JqxOXJBBesRA6Ol4xvEmQz8jCo7xWtnQGtkxomdCgTe/EfWD91uRfAMxU/uoXSQaR7zjgTSMf1S5OZxeimu+mrfsh6nYmTulKX1U2lInrdf7foGGl7Pbmr67UhDxbyTIws6mlHvvDnE5zQDl5LVv548wcCFPUIt/ycMvGtYu5LZycA3X+eIuaWtCEzd4EeE00FdFlPBC6ZjSoRn6o9eGoJ9e4OC15RBl5O0q/EJFT/S5o7O51GxLrwuP2CCiumn/ay5zi0YVb/9O7WagaMtHpsuXGfgeOqysyGfGXgocyxINMnA6OmXG4es21B4rHEf24GOVb9V8kWWdLi7mF7Z/Y0GUdFY19JYGsQ6SZcEz0pRO7fKK/ooFb3XKV5FSVQkrJi1YNbCVtRY9P/3bxMD91YEbn9YycB2i+165vUMhH+8ppM/jUC92n+dkdlteL9x9u7i83QM/PdB+swpOs5nTxduWEFH4/VptTs+0xA/anNn9TYaDm6ra5dTLcUkyTx1aTsVLqP/lW1d24ZL+efcozvo8Lw+cXrlYQb+vHmy5/VqAu2RlGk1GwiohyzXXG7bjptmt3acMKfjceWVBrtrdEzLXjetDHRcuN3zvJlPw759U8s0j9KQ2BT8PUGZhjvGOTMOfvgE2b+f+aP/2mBwYPta7kc6lmyp23FxKwP5Xie0ZKcRcIhUmpnyhcClI9G+FluoiKCKvoWgGdxobk5NcAs2+4cyX/W04OSL2K/n97fi7raVt2bObQOtzPLL9uI2JLywCniY1o6tyjEWenfeIO3hM4Mfm0iUepj/CYtnYe6vn8tKt3HA1lqXGtzDwcObZ9onD3WiUlj6r/cVgfK/pwx2VRII7o1l3b9G4OPutsD3AgKPzzw6vH8XgS0v7Ap39jMwZLhqZVUNA9/OuL26EMTE7a1uI58+t8PnwN0PGpu78Ov8wa8/ZvNwo4OQmhPLw+c3uyLypoiwp/688Qp5ArVHtR1vlhCI2YPfkisEZJLLmqnZBNrjFxBDXAJHP1WuEoQSWG2Xkb7HmcAclVWrKwfYMOj+KXh1lYf5WOuYu5aK8icjzhW+XeB3vGNKafKgKTXT8m2kEDOa/hbqWxKI8c9tfjZCYPu+ilMjnwmUp8JGKCaQOb+ge1UPgcToadGWtiTM53X+KFlJYnTBgv3W9Vw0PRK9nZjOh/DGxY29j3hI0F/DPdpExYjHDWmmAQ8P9jIFTolCmM981HvrKoGynN0U+c0kgu8cfuouS4JCF12w1CLBdtm3KGENCYbdF5tWTxIRHz/NOzlCopaWtrvkQge4/Octm6P4GGrbunv1Mj42T3fck6XNB+ft1S9/g2joePf99+V8IXYeHyZS/7ViOLLhtmVCG4puvlhIu9uG23OrzB7JtuOq81hG+tF2HA3/9HnZLCp6Dd39TRRooFAoFAqFh6DfXSnndMXYEtaoeeS5CFvnrK298U4EGZ18bhJXhPGyTSX+HDqumfkrjWpXovWXc/ZwAh1qS39SNl2kw160pHDEmI786z82h2ylo5caxX5+io4HG997CbbRIXIMM1VcSkdFzPmrbnotmHNtx9+YKwzMd5I1iihlwLLWSf/rKAOp4csih9e3QS14xpvNtxmwOvB+3rw1lajQvLn1lDkd376X/14/hQ6XjLQLb2ToYLzJPnV5Ex1ZnNRaKxc6VlMu27xUp6P/mNFER0cLyILMa4tWEaCF7m+c706AuUVNds4NApYX739NiGjD8NmApQviGTi4+EIVx5qBh4xzDWzNSvDSs89+q6PBfOvF8YBWGsRbOZP6U+kY32L9KmMZHZn//fwXs5GOM/ubvl+MbUVsz4ae/3QIXMkd0Vh1kEDSqY/e6rcIMJYv2ujS0gYnbb6PnRkDb9q+z5g7hYGOp4uGj1TTkR3xL319RgXSSmavLt9Fg7xU1rTPH2jY9PTr1WedNCw7tHjDhhEabvb4zXg8pw1tOkTFtRkE2oZuTcRvIUAr2mcce5pA4S+H5dG/2mBVKDu31pyBZ27KRYnTGehat+0Rv5aOnL0vrHZtoaNq/6E5+S0V+N6syTbzp0F++t+nk7dpsKDOKltVQMNmnYELT8604fnEfyIjfwKH9Rq/zg4icGAk8b+Zzwjk2uwRysW3I3df1MDfEAa4R/q+BW1iwNyi9XbNOB2S7xVt9Dw6jttNzzmxlw6XRcFGgfcqcFeSS7VwpGFDcvyPOyE0GJ7qOEJVb0fXj/2TwQsIzNlb3inNJFAUmtL8souAqU+w4np9Km4dmsF/vZWBhInit9YuDJSb//m4U4WBGsX5I68ZdByp1p7/OY6Oz79com9vouPkzcP0xccq8PGNg8FvDxqOzb16+4MCFfsuXA1a+YOBeEGfQ9FbAiumJetvPUNi4Mlhqu9yGgZaBnz0VRjQbFNZc3cpA5pZj6ZU6TMwSCzIpA/SEVyxp+rtUzrsHq+bfvsQHdGi5r3BO+hwb2ybUmJagdJrycen7KDiwqfkRO4aAs+XD/mf+Epgq/ntXaOvSYRuSbi76jEdY3dCul7uboXtwKZ7UVPaMMSybytzbcNJ+su6JS/aMLzzzPtEhXaUpMt7ba1oh6Oam3F1ERW5E0k1cwapiCkKPpss9R7eDy/vk3/Ggmbsr7Njnhy863m3nT6FC3WVSbkpPp24pXO7YyCTgNThJF0vSxKOD2+MO+0icYkVNHXHahJFJtuXrLlGQv/V1sta+iTMZ+e+osqTmBnwYVuWHYmga7/uu0lxIDJROLJrLRVvPGb9OxDSib83uXu1vbqgkrs60ndQgL3rVdwmmAQ++SrmnYwmMfPJnFCfqySMuUMX9xwiUTb7D3P2LRIL9lzcc1mKCY3i3gvruCS+Lfg1d8pSJp4tsPOK7eSCFncj2GUJD2lOtw8Z1lIxbCX5KBfcBdrowmOfRgXY9/rP9wNLSOy+csSpuZzE/Wq/ZVVfSDSN22gUPCDhout48QSNRMnk0A2ZWUysfSA1VZDLxMBfxdvvvjFhMbr6i9KNDkx0fV/meJuHjrEfLeuzeKid8URrhy8N+4KOrTkpJQRzzkPn5j9tiNcKesi3bQc7lm76J6MdJ9TLVb9XtkNjdMl/O9yoiJ8Xr6gmR4N/on3Twml0rJxnxla8xwCFQqFQKDx0WR7YYigrglqz9KamOSLYyj1kLVsmgjRlraT0FAPPpql7jV6sxIm4NY8CTRiozKsfLVjLwBzC1+GPGgOb7yVP/9BBh9YJXeZjEwY6Eka0js5nYNnzUpm073SoPGY5/5zFwMOld1Xtt7agfc3n0ZMuBGiDsRv8rxLIeju5yGRmG6Qz5gVvsiHA9TqqoHuqGpX0O6I/cgzMGTc02TJBB6q9f4x/pEPby9lWeTkDv7ZTj/vsYCDk6OfvNXMZMKtmXA2TZqDhyWbnTUfaMBKhvkykTOKXhdCty45E3fEph0/Yt+PnvRD+vM0E7l3/+b1sCYENjDWfR09WQ/6x+qe/n+nQ9bMNKo6kY8NgLPNzHx22z+Mci2Yx0JOim/VwNQO/f58QJSgwcONebPOM4jY8LygOGV5GIif0QsF3JxLOc8JzDe+1g3zI2fVtDYHYFFfPOwoEyn2jLL+1MnChXCZHEFSNotorx5ts6OiX6Q91L6Vj4UsDyhYhHRO+B182/aVDPdW1GDoMpDfWuo4ub4eKW+tQx3oSi1WT17EOk1jZNyitSqFi4R77mxpCBtosG7NaPzOgsPT3NY04BgY+5u5k2DDg51xGXBioQlfMGavVu+jItt5j9TCbjjXMdRO1n+mwbwlJOzZJx/Cdtnd/7rRD6rHvyU/LSMj6ar0qciIxlfqq1N+eCs1XbjptlgQ4Sx52XtInsOVvzBXabwYGFl+k1X9m4N0W/4lpuxgQyR08NV23GvbnOgW/EujQEV28b/OWjj6FndPW/qDDzvbHyxtWVNhvuxdq9J6EbIhp9OwSEh9THabG69AQkbRgGmsRgYGrT2Ne7SVgQBUmvFlCYMb4zZtP+xmQOfNI9kMeA7oZdRctEhlw+bBjpsfsavSdi7xz4TYde2trFmr00XE/lKPouo2GrS4niQe3SJwuaX2vu4+Jmzd9n1uspKNx9E+cxxADdzynTYyrEcjQTaRpGBLY+vn25gZpAgtPNb5/1sTAa8vHFItCBkKXxlvV3mFgy9f8TX+FVeiaH/Zk/yQd8xfvPRDzgAaNIPJGXhsJR9Iw0CyFibORS4cXPmIAxh8WF8wgsOn1Xps6OQLpfe7zS+cQkJF7a3dZnwBtY56frxSBnuOLp2f8YCDSeQfjRC8DMvqm679NITCrcLZAnF8JyhmPdRlRDLw5JZj+6ikTXcxtYlNfFkwO8ZZupxFI6M++Z7WzFSOXmIna8u24H/Ct/Kh3O5YIZrvMamlHsOGbdb+tqPC6mvfU9h8VK5dbi05Oo2NnlVTCSR86zK7+ldI8SMD/979gZlExKtufHWoL50Al3LKOqcqFusqk3BSfTsT+drgvf4ZE7KFIQak5E1/ux+S+tWUiceUeltV+Jor2lnnPsGHigmzp4PY+JgKyw9aPNzCRwV54caocCxND5785/WSh7qQdb9tvLoIsRg6+6qBCe27aElWiA2qZscPsRQIsVc7e7pdPQjGGFbc4komD5dzds68wsWxH3sVnd5nYHrpAxvcyE95j1sTZn0ysDbqu9SyDBdfH2iGv2Cy8Kr7WPVHNxpLmhKuDTzuQY0NXpYZ3Yeb7jVuMztFgNz766dNyAe4HVf3JrG3D31N2iz9rUWHd+voDJ5QK37eqHzx5VIhf7LrAXU+D/D7eLBUVOjw+O8QfXMLAxRl+efr5BHQDEZ38nQSFQqFQKDy8U6DVFCwVInhN7oWvq4SwPxu3tXgNAUlqh/eNbxU4uX/roUeTdFTGdhWLf9ChlBs+9z8RHYfoi4+dIOiYVzn7+O1+Oi4dF/9QHqLjn1XgcfMmOl6buPfq/KLj9LjrRukfNHAraRPTZrYgaOae4Sd2BPxYrJW7b7SCGKw3EMkSWPRdY3Y9vwo1Oa+f/ddChxJt578TFXQcHOt3efeWjjlv9btmttORqVFhoLSCgb1yIc5nv9NBerqqFvbTcffgEyKCTsfAmb3b02zbkOz9TmXhLBLMZ9883OXbUfdT53HZHwYixNlv/DgMBPn0fLnRVYWpoYYXta/QsfchgxF4no7V2w8fL75Dx0DcGRXfATqaDESyjtMYSEwePPdliI6dN03WJ3PoyD35+JPPgzZYptud1lxA4rDtsaca+9rx6nfyrDt9DLj1KCwzb2Igq9RI9s41Bj7t+px7nl8Fyb+57VHb6Wh3a1BwPE7Hp6vL676W09ExY/6scCYdH3VYu87PZeDjZsec81/pKLgn2uEk247Xxt7f5miS2KDR+U/+UzsS/vy6cJrDAPqDEpaVMhBSkXnm9CUGbDydDTS2MzCwvH34RX8VZD9NvurYQQcRwXu15zEdDrOKvS+W0hE8feoftVE6rrN1V2YvY6CMl5Nx4E47agQBCmdtSUyfSy6fa0mF5PzOPPYQA2n9KV5XOxmY1hAyo/MRA5l9gc70kwxERL5Od9rBwIYbX8xsQ6qQ5H3C7O9BOiYj9xT4xdMxb8cbvW+/6Fgp0ZM8XckAbbFT2IgbFZp/JYGrTpC4f0TXc5hHRb9ixla3EQb2zvyvSmcDgQdOR+t1/zBwNnwxfVUdAyHHdfapPWeAt3dimb0PA9eMttj7N1WBdmjt0pw7dKTb/Sv4uZCBCPNnt9J2MRBdeHxgpSsNmsXFn5o3M/F4Tsvi7Jl0BG7efrCzhQG7hzvJixMM7Axm5D6bR0AsLZhvJ2Tgy39LnsyqYeDBlwxT+2sM6N2ISLp6j4GQU5++z3xYBQXV768zVzHgKLKumhPBQEX6zf5lX2gIST56nn6GiTinq5vCrzAwKzVo89XvDKyQnXvs/iADx7fP35A6wgD9oP9xDxUCF7//29P2l4GB3v07pv5lYE9N3LLrywg457YZCNcSeOD7d/nDiEooBS93FWgTeH+3700ThwHu5g1ne+xZqPsxPoJyAj8s3gers+kYaJ/eodLEwGaFGS8UaQyMpLJpWWIGTuQWJZksIlC/iq96egUBh2uKyQd2Ehiqm7izOYZAaoVjxKWtJGbyV3D0PMoRm5VIOZlOYk5Xo5dTCxsNUwfs+pKYmHJaP3jp/Fa83750dMH3NuzV1V/jZNEO5lOXij/57dA788lQy5qKkSfXM37No6FrR8qz4iV0sDcE3Ym8ToeqkqOO6mcC3ifcdFvSmSiTP6plubwEHslrl2WpcqGuMik3xacTjzeOGU2eIPHsyX/h+VuY+NWpY/XMgomXhydPm+xl4ozEdfmjcCa0j3xVLXrMRIDlq5iciyxMd1X6W1zGwrrHN+ZZ5rJx9nLNza32XCxpTrg6+LQD93Ka3h9QoeHd52ELOW0edhWvzll/sQ0PSvKbXHntGFKef+zkeirm/43x+3GTinmbNtmOr6ZhVDbz9oE6GhbN0KucOY2BKx1PgjpvEyg7PJTkyyVxR7Qv+OZnFigUCoVC4WHOu4a5l6fxMehyIdqjjoEsh7DoSs8K6HgY/0mspCMsmunQ/o6OxpdnX8x/Roc6VYnidpuO8WUXs2YI6Vgleb6v7gEdO0LOJyqep+P7eq9jPlQ6mko/3f9Lp+EudeoruoCGNZWtKl8Zzeg0SOh9LNOKbRV9o7u/MNCVU6U75lCFsOwXJgtu0NHWtiPNLYqOVaOi/vvBdIzOeqR8sY6OzfejMj+30fHQKDLY7B4dIau+uqrX0tG3QhZnP9Dx0WiXnf0XOm6c2eN4ZX0b/Fz0xTO+tEGjetcRnSIGugoagpm3GdiofrmnclcVvpxdr7TQho4F91v8PUzokHtEzVqTTMetKUaRynfpUDz0TXPxWzrWuF3O122hI8v10e3L5XQEHu9+6tVMh/PJhw17Y9ow8idPhzavHdO+vKXJvGCguGit4G08A38S9zXJ2DEQJX+wNcehChnj8r4nptNR9MFwR9UhOrymidfdD6BjpZHqOsvLdDj/PhEn/krHjIn38wLb6eDOGVez7qJj7z2Bs+XXNjRSl8cVn21HT92CrYLbDKQWp9gnnWeAd710k8CEgbfaZ0ZTKAy86jqxgO1WhV6VAPGuLXREjj1WknGg447p50grfzqUWm7pJtLokGTcTLo/lYHvH2XNcqcy4MPLzna+2I6bFYtzKqZQwbq1//bXXgZiZLOs7zEY+CW58nPwNgOpMlFbuo8wcOZ+xaUjpgyYJBaHzz5bBb9yzrnjbnR0d1UPsCPoGH2y1N/rKx1zzB8uU17KwCathoe5RxlYcWNW1Mg+KtQXv9vtQVKh6zpao/mIgafOcTvGOxlgZtTspDxh4FPNub2/ghgoKbja8c2BAb35r4q93Rnw7o82sZlbBXddNbkakgatOR/vN3+jY2VmSf0dNwZuO/k9YTxj4ErBa85KPxpm7pOWIcpo8DLquf/lHAMr7w3N833OwKqg833XShlokorbbZDEwMPVlV0jJxi4ZpiSHBbLQJe8s/B9Ox31I2pluyWVqGvyt6gfp2PpSvWHfokM3N6bOsOQx4AOZUbPZTYNC4xoZs3GDEyTUTixrJkBq881lqJGBh5N1s1lURmQV1W1eTzMQEHOW4MFJAPLliwyXDfEwOfS/v2evxggFbU8VkgTOLCoe8TGpBKh57KlS/cR+Om+/Yk9l8DNoVCifzqBK4Yuz72eETBfyLpVUkaHRZYWpeANA3e6tBtfFDGw58i/31saGCi6xHlD/GPg397M2Q8XEaBkaC/m7SfwcstseZ2bBHrvO8ZWBpMweFBylzVahiVPovdn/CWhJDU4ZDqNCZ1pV45lnmdi+5XhgJstdBgqWRDXKhhIsLd6FF3LgC2h0qzCZkDK9VTYxUkGppVeeOkfQIDre2Sux1sCu6s/Cek/COxbnPreWIqJf7cCrVXAAj+ml/90UQXm7zm5W/MWB9pyU3qafblovtzO29jRgoydx/NW0NpgsjmK76XVjvLXWWelb7ZjySX5bxt0qGCtfvbNRomG28/Cuy/r0fHp4edn357TcWLWo5PnfhJYOX3Js55ZLAyH/9upNMRF6hK1N6+elID/+uTd5kOduFmgujfYoA1vbz/5mpLejmm/pBNeituhyjx7VduOCs0Fa44bdlCRqjOPXF1GQ8DO8x//5tMx9vTeDtEOAsf7y+2qqknMy34qvfItC513jrF//u4AhUKhUCg8nHe2vz/PhwFpya7FRtVf4DJl3LFUloefS9f7dnC7YLHp6H9mL7qQ7vzw6+PTXTiYysp0W8jDnG3948rjXaDsnnrQY6ALq6s+ZmRq8fB5yTrawPcutN4PKHaay4Ph6+3y+do8THm7MWCUJcTkg4Ip01k8hLSe5z62rEV6b61Gm24XeqZRevQmOmGgZpSTXteJ559bdu283AXOnSbtK1I88MzOtr6o6sJFFbWu49N54Anjx501ebAZ9tr5dhsP76ZtvD/LkwevKPraX6YiZLbH5u34xIPG96Ozth3ggRE9ZwgmtXBeWWIft78Tj8x+zu3T6sSBIgVFy55OvB51D3zt14X0fY06L9hd+GrlrqPzrQusE8oF/1R4sLj0dZqHAQ9516tdPu7iwen1npsR60TYLOXqfuUBD9Vqxp+it/OwOfDEaCq7CytyKwwnDWpx9J6O39KEDpyUNJao2nRCauzeGZ2mTqzRSb7aaNoFtQ+so3uleFge+exanzwP8ckXuixX8TDyaPf6p2Y83D7EVtulLgJpoCnbEMXDod3yfjWreLhAm71b+VEXRp4XFIcMd8Kz4mHH1I21oDvO2EvmdOC1oZff1bWdWGpKMJofdiJs94Xgvce6sGgJPfrEQh5WrFHoOLOQh6TNQh2aHg//PfBq8ZwnQtfWn00be3mgz4v2uJrEQ1qXduMLQx4uju/xOFjdhZSuCTlL5S5s+UPhhDvWQif3xbHx5g7wYp6sFZt1IvJ4s8Z8+S7EaYTPArULmo0V08jtPKxn//Hs3M7D1uU0W5vVIlw6Rw6cb+LBdk2Y21IaDxdz2kdSw3m4/O9C/PBqHqZbjy27mNWFGnlR/pfNXRhbscH2RngtLOcbfHRiduC9o7/Zf086McK5H/XnWBekb8zruzybh6918323+PNA7tjI3mIowg7Zo4u2f+Zh4P7Rc2p5PPyZpP4UF/MQKrOm4P5hHuh88ujW2Txs+D5UGfahCwO6M6eO7uhC73ixy5MNtUhfftvJWNiBc+WH1zx724k2RhDdy68LgzMn5hbP4iE550H2DmcRZq/Zs2z5Xx7em13ov0TnIT12u2vEZx5EAa9/xbXz8G5clhV1gQfBDvISacXD08wbLQGzeZjuVvzuy+4uVHrddb+YUQuHr8P72wo6MSoq/bDxeBeeTYjWn5vDg+VgtEb4LBGmlMUqhtXycHqWqOvVHx7snHNsc3t5OKMWzP7O4mHJDX7Gpm4e3l/zCJK840F4u+obPYwH7kzKo6cLeXiiFiAKXsTDqcSYWv2btVjTmGx+ZkUXaE0RMTx2F+QI+ZjwEiFaLIKl0kQ84BaVXrOADxXuHbMv0nzYvDjYKjvCQ/Vf7cikbh42ckoM4hfw0XSnhdfaycP6qg+Vo2E8HLxK1Ve7yMPX89nn9+zlwWXxkWy1LXWYeW3h/Nr5PFD1wzUu9grRsXHoKG8KH9ZXHIznGvCxuln52VwNPnbd4Rm4KPHRPPBSwprGx8I3N6/OVubjqn2p0rOtfDhrO2us7uLhx4tFNc/4PIh33jfe8IkHqZMCsqabh8ezH1RwZ9YjvtnwIVtZhAfc9AV/Z4mxdtXahL4LYkyZQi14dVyMlYdCpuR7iJFmtyxoxE6M2bxLM80PijGrQvP++tNiRKkdWm6TIsbEZM/MRzvEIM1Nupb3iSDN2FrWvliMGhfm+t87xaBQKBQKhYf/BwCbnx0LjHwDAA")
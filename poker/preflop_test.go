@@ -0,0 +1,186 @@
+package poker
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestPreflopClasses(t *testing.T) {
+	total := 0
+	for c := 0; c < NumPreflopClasses; c++ {
+		name := PreflopClassName(c)
+		got, err := ParsePreflopClass(name)
+		if err != nil || got != c {
+			t.Errorf("ParsePreflopClass(%q) = %d, %v, want %d", name, got, err, c)
+		}
+		combos := PreflopClassCombos(c)
+		total += len(combos)
+		for _, h := range combos {
+			if got := PreflopClass(h); got != c {
+				t.Errorf("PreflopClass(%v) = %d (%s), want %d (%s)", h, got, PreflopClassName(got), c, name)
+			}
+		}
+	}
+	if total != 52*51/2 {
+		t.Errorf("preflop classes contain %d hands, want %d", total, 52*51/2)
+	}
+	for _, tc := range []struct {
+		name  string
+		class int
+	}{
+		{"AA", 0}, {"AKs", 1}, {"AKo", 13}, {"22", 168}, {"72o", 12*13 + 7}, {"kqs", 15},
+	} {
+		if got, err := ParsePreflopClass(tc.name); err != nil || got != tc.class {
+			t.Errorf("ParsePreflopClass(%q) = %d, %v, want %d", tc.name, got, err, tc.class)
+		}
+	}
+	for _, bad := range []string{"AK", "AAs", "A", "XYs", "AKx"} {
+		if got, err := ParsePreflopClass(bad); err == nil {
+			t.Errorf("ParsePreflopClass(%q) = %d, want error", bad, got)
+		}
+	}
+}
+
+func TestPreflopMatchupKey(t *testing.T) {
+	tcs := []struct {
+		a, b string
+		same bool
+	}{
+		{"AcAd KcKd", "AhAs KhKs", true},
+		{"AcAd KcKd", "KhKs AhAs", true},
+		{"AcAd KcKd", "AcAd KcKh", false},
+		{"AcKc QdQh", "AsKs QcQd", true},
+		{"AcKc QcQh", "AsKs QcQd", false},
+	}
+	key := func(s string) [2]uint64 {
+		h := mustParseHand(t, s)
+		k, _ := preflopMatchupKey([2]Card{h[0], h[1]}, [2]Card{h[2], h[3]})
+		return k
+	}
+	for _, tc := range tcs {
+		if got := key(tc.a) == key(tc.b); got != tc.same {
+			t.Errorf("%s and %s have the same key: %v, want %v", tc.a, tc.b, got, tc.same)
+		}
+	}
+}
+
+// TestComputePreflopTable computes some entries of the table, and
+// compares them with the mean equity over all the pairs of hands.
+func TestComputePreflopTable(t *testing.T) {
+	pairs := [][2]string{{"AA", "KK"}, {"AKs", "QQ"}, {"AA", "AA"}}
+	var want [][2]int
+	for _, p := range pairs {
+		a, err := ParsePreflopClass(p[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ParsePreflopClass(p[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, [2]int{a, b})
+	}
+	pt, err := computePreflopTable(PreflopTableOptions{}, func(a, b int) bool {
+		for _, w := range want {
+			if w == [2]int{a, b} {
+				return true
+			}
+		}
+		return false
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, w := range want {
+		a, b := w[0], w[1]
+		if got := pt.Equity(a, b) + pt.Equity(b, a); math.Abs(got-1) > 1e-9 {
+			t.Errorf("%s vs %s equities sum to %f, want 1", pairs[i][0], pairs[i][1], got)
+		}
+	}
+
+	// AA vs KK can be checked against the matchups directly. Every
+	// pair of aces is the same up to suits, so it's enough to use one.
+	var sum float64
+	n := 0
+	h0 := PreflopClassCombos(0)[0]
+	for _, h1 := range PreflopClassCombos(14) {
+		eqs, err := HoldemEquities([][2]Card{h0, h1}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sum += eqs[0].Equity
+		n++
+	}
+	if got, want := pt.Equity(0, 14), sum/float64(n); math.Abs(got-want) > 1e-9 {
+		t.Errorf("AA vs KK equity = %f, want %f", got, want)
+	}
+	// Known values for the other matchups.
+	for i, eq := range []float64{0.8195, 0.4614, 0.5} {
+		if got := pt.Equity(want[i][0], want[i][1]); math.Abs(got-eq) > 0.001 {
+			t.Errorf("%s vs %s equity = %f, want %f", pairs[i][0], pairs[i][1], got, eq)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := pt.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	pt2, err := ReadPreflopTable(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *pt2 != *pt {
+		t.Errorf("ReadPreflopTable(Write()) differs from the table written")
+	}
+	// The gzip trailer (a checksum and the size) is checked.
+	if _, err := ReadPreflopTable(bytes.NewReader(data[:len(data)-8])); err == nil {
+		t.Errorf("ReadPreflopTable succeeded without the gzip trailer, want error")
+	}
+	bad := append([]byte(nil), data...)
+	bad[len(bad)-8]++
+	if _, err := ReadPreflopTable(bytes.NewReader(bad)); err == nil {
+		t.Errorf("ReadPreflopTable succeeded with a bad gzip checksum, want error")
+	}
+	if _, err := ReadPreflopTable(bytes.NewReader([]byte("not a table"))); err == nil {
+		t.Errorf("ReadPreflopTable(garbage) succeeded, want error")
+	}
+}
+
+func TestDefaultPreflopTable(t *testing.T) {
+	pt := DefaultPreflopTable()
+	for a := 0; a < NumPreflopClasses; a++ {
+		for b := 0; b < NumPreflopClasses; b++ {
+			if got := pt.Equity(a, b) + pt.Equity(b, a); math.Abs(got-1) > 1e-9 {
+				t.Fatalf("%s vs %s equities sum to %f, want 1", PreflopClassName(a), PreflopClassName(b), got)
+			}
+		}
+	}
+	// Known values.
+	for _, tc := range []struct {
+		a, b string
+		want float64
+	}{
+		{"AA", "KK", 0.8195},
+		{"AKs", "QQ", 0.4614},
+		{"AA", "AA", 0.5},
+		{"AKo", "72o", 0.6754},
+		{"22", "AKs", 0.5011},
+	} {
+		a, err := ParsePreflopClass(tc.a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ParsePreflopClass(tc.b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := pt.Equity(a, b); math.Abs(got-tc.want) > 0.001 {
+			t.Errorf("%s vs %s equity = %f, want %f", tc.a, tc.b, got, tc.want)
+		}
+	}
+	if DefaultPreflopTable() != pt {
+		t.Errorf("DefaultPreflopTable returned a different table the second time")
+	}
+}
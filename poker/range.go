@@ -0,0 +1,526 @@
+package poker
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// A RangeCombo is a single pair of hole cards in a Range,
+// with a weight from 0 to 1.
+type RangeCombo struct {
+	Hand   [2]Card
+	Weight float64
+}
+
+// A Range is a weighted set of holdem hole cards.
+type Range []RangeCombo
+
+// rawRanks maps the characters used for ranks to the RawRank
+// of the rank (2->0, 3->1, ..., K->11, A->12).
+const rawRanks = "23456789TJQKA"
+
+func rawRankCard(s Suit, rr int) Card {
+	return mustMakeCard(s, Rank((rr+1)%13+1))
+}
+
+func parseRawRank(b byte) (int, bool) {
+	i := strings.IndexByte(rawRanks, b)
+	if i < 0 {
+		i = strings.IndexByte(strings.ToLower(rawRanks), b)
+	}
+	return i, i >= 0
+}
+
+// ParseRange parses a holdem range in the standard notation.
+// The range is a comma-separated list of parts, each of which
+// is one of:
+//
+//	AA, AKs, AKo, AK: a pair, suited, offsuit, or any two cards of the ranks
+//	QQ+: the pair and all larger pairs
+//	ATs+, ATo+, AT+: the hand and all hands with a larger kicker
+//	QQ-88: the pairs between the two given (inclusive)
+//	A5s-A2s, K9o-K6o: hands with the same top card, and kickers
+//	  between the two given (inclusive)
+//	AcKh: a specific combination of cards, in any form accepted by ParseHand
+//
+// Any part may be followed by a weight from 0 to 1, for example "AA:0.5".
+// Parts without a weight have weight 1. If a combination of cards appears
+// in more than one part, the last weight given is used.
+func ParseRange(s string) (Range, error) {
+	var r Range
+	index := map[[2]Card]int{}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		w := 1.0
+		if i := strings.IndexByte(part, ':'); i >= 0 {
+			var err error
+			w, err = strconv.ParseFloat(part[i+1:], 64)
+			if err != nil || w < 0 || w > 1 {
+				return nil, fmt.Errorf("range part %q has bad weight %q: want a number from 0 to 1", part, part[i+1:])
+			}
+			part = part[:i]
+		}
+		hands, err := parseRangePart(part)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range hands {
+			if i, ok := index[h]; ok {
+				r[i].Weight = w
+				continue
+			}
+			index[h] = len(r)
+			r = append(r, RangeCombo{Hand: h, Weight: w})
+		}
+	}
+	if len(r) == 0 {
+		return nil, fmt.Errorf("range %q is empty", s)
+	}
+	return r, nil
+}
+
+// rangeClass is a hand class like AA, AKs, AKo or AK.
+// hi and lo are raw ranks, and suited is 's', 'o' or 0 (for either).
+type rangeClass struct {
+	hi, lo int
+	suited byte
+}
+
+func parseRangeClass(s string) (rangeClass, bool) {
+	if len(s) != 2 && len(s) != 3 {
+		return rangeClass{}, false
+	}
+	hi, ok0 := parseRawRank(s[0])
+	lo, ok1 := parseRawRank(s[1])
+	if !ok0 || !ok1 {
+		return rangeClass{}, false
+	}
+	if hi < lo {
+		hi, lo = lo, hi
+	}
+	rc := rangeClass{hi: hi, lo: lo}
+	if len(s) == 3 {
+		switch s[2] {
+		case 's', 'S':
+			rc.suited = 's'
+		case 'o', 'O':
+			rc.suited = 'o'
+		default:
+			return rangeClass{}, false
+		}
+		if hi == lo {
+			return rangeClass{}, false
+		}
+	}
+	return rc, true
+}
+
+// combos returns all the combinations of cards in the class.
+func (rc rangeClass) combos() [][2]Card {
+	var r [][2]Card
+	for s0 := Suit(0); s0 <= Spade; s0++ {
+		for s1 := Suit(0); s1 <= Spade; s1++ {
+			if rc.hi == rc.lo && s1 <= s0 {
+				continue
+			}
+			if (rc.suited == 's' && s0 != s1) || (rc.suited == 'o' && s0 == s1) {
+				continue
+			}
+			r = append(r, [2]Card{rawRankCard(s0, rc.hi), rawRankCard(s1, rc.lo)})
+		}
+	}
+	return r
+}
+
+func parseRangePart(part string) ([][2]Card, error) {
+	bad := func() ([][2]Card, error) {
+		return nil, fmt.Errorf("can't parse range part %q", part)
+	}
+	if _, ok := parseRangeClass(part); !ok && !strings.ContainsAny(part, "+-") {
+		// A specific combination of cards, like AcKh.
+		h, err := ParseHand(part)
+		if err != nil || len(h) != 2 {
+			return bad()
+		}
+		// Order the cards as rangeClass.combos does, so that the same
+		// combo always has the same key in ParseRange.
+		r0, r1 := h[0].RawRank(), h[1].RawRank()
+		if r0 < r1 || (r0 == r1 && h[0].Suit() > h[1].Suit()) {
+			h[0], h[1] = h[1], h[0]
+		}
+		return [][2]Card{{h[0], h[1]}}, nil
+	}
+	var classes []rangeClass
+	if i := strings.IndexByte(part, '-'); i >= 0 {
+		from, ok0 := parseRangeClass(part[:i])
+		to, ok1 := parseRangeClass(part[i+1:])
+		if !ok0 || !ok1 || from.suited != to.suited {
+			return bad()
+		}
+		if from.hi == from.lo && to.hi == to.lo {
+			if from.hi < to.hi {
+				from, to = to, from
+			}
+			for p := to.hi; p <= from.hi; p++ {
+				classes = append(classes, rangeClass{hi: p, lo: p})
+			}
+		} else if from.hi == to.hi && from.hi != from.lo && to.hi != to.lo {
+			if from.lo < to.lo {
+				from, to = to, from
+			}
+			for k := to.lo; k <= from.lo; k++ {
+				classes = append(classes, rangeClass{hi: from.hi, lo: k, suited: from.suited})
+			}
+		} else {
+			return bad()
+		}
+	} else if strings.HasSuffix(part, "+") {
+		rc, ok := parseRangeClass(part[:len(part)-1])
+		if !ok {
+			return bad()
+		}
+		if rc.hi == rc.lo {
+			for p := rc.hi; p < 13; p++ {
+				classes = append(classes, rangeClass{hi: p, lo: p})
+			}
+		} else {
+			for k := rc.lo; k < rc.hi; k++ {
+				classes = append(classes, rangeClass{hi: rc.hi, lo: k, suited: rc.suited})
+			}
+		}
+	} else {
+		rc, ok := parseRangeClass(part)
+		if !ok {
+			return bad()
+		}
+		classes = append(classes, rc)
+	}
+	var r [][2]Card
+	for _, rc := range classes {
+		r = append(r, rc.combos()...)
+	}
+	return r, nil
+}
+
+// Without returns the combos in the range which don't contain any
+// of the given cards, for example because they're on the board or known
+// to be in another hand.
+func (r Range) Without(cards []Card) Range {
+	var res Range
+	used := NewCardSet(cards...)
+	for _, rc := range r {
+		if !used.Contains(rc.Hand[0]) && !used.Contains(rc.Hand[1]) {
+			res = append(res, rc)
+		}
+	}
+	return res
+}
+
+// A ComboEquity is the equity of a single combo in a range,
+// against all the compatible combos from the other ranges.
+type ComboEquity struct {
+	Hand [2]Card
+
+	// Weight is the total weight of the matchups the combo appeared in,
+	// or when sampling, the number of samples in which it was chosen.
+	Weight float64
+
+	Equity Equity
+}
+
+// A RangeEquity is the equity of a range against other ranges.
+// The equity is averaged over each possible combination of combos
+// from the ranges, weighted by the product of their weights.
+type RangeEquity struct {
+	Equity
+
+	// Combos are the equities of the individual combos in the range,
+	// if they were requested.
+	Combos []ComboEquity
+}
+
+// RangeOptions configures how RangeEquities is computed.
+type RangeOptions struct {
+	// PerCombo requests the equity of each combo in the ranges.
+	PerCombo bool
+
+	// Sample, if not nil, causes equities to be estimated by sampling
+	// combos and runouts rather than enumerating them. Each sample is
+	// one runout for one choice of combos.
+	Sample *SampleOptions
+
+	// Dead are cards which can't be in any combo or appear in the
+	// runouts, as for EquityOptions.Dead.
+	Dead []Card
+}
+
+// RangeEquities returns the river equities of the given holdem ranges
+// given a board of up to 5 cards. Combos which contain board cards, or
+// cards in the combos chosen for the other ranges, are removed.
+// The board can't have more than 5 cards in it.
+//
+// Unless opts.Sample is set, every runout is enumerated separately for
+// each compatible combination of combos, so the cost is the product of
+// the sizes of the ranges times the cost of HoldemEquities. That's fine
+// on the turn or river, but preflop each combination takes a sizeable
+// fraction of a second, and two ranges of 100 combos take hours.
+// Sampling is the practical choice for wide ranges with few board cards.
+func RangeEquities(ranges []Range, board []Card, opts RangeOptions) ([]RangeEquity, error) {
+	if err := checkSampleDead(opts.Sample); err != nil {
+		return nil, err
+	}
+	if _, err := getRemainingDeckDead(nil, board, opts.Dead); err != nil {
+		return nil, err
+	}
+	known := append(board[:len(board):len(board)], opts.Dead...)
+	rs := make([]Range, len(ranges))
+	for i, r := range ranges {
+		for _, rc := range r {
+			if !rc.Hand[0].Valid() || !rc.Hand[1].Valid() || rc.Hand[0] == rc.Hand[1] {
+				return nil, fmt.Errorf("range %d contains invalid combo %v", i, rc.Hand)
+			}
+		}
+		rs[i] = r.Without(known)
+		if len(rs[i]) == 0 {
+			return nil, fmt.Errorf("range %d has no combos compatible with the board %s and dead cards", i, boardString(board))
+		}
+	}
+
+	req := make([]RangeEquity, len(rs))
+	if opts.PerCombo {
+		for i, r := range rs {
+			req[i].Combos = make([]ComboEquity, len(r))
+			for j, rc := range r {
+				req[i].Combos[j].Hand = rc.Hand
+			}
+		}
+	}
+	var err error
+	if opts.Sample != nil {
+		err = rangeEquitiesSampled(rs, board, opts.Dead, *opts.Sample, req)
+	} else {
+		err = rangeEquitiesExact(rs, board, opts.Dead, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// addWeighted adds w times the equity e to the totals in acc.
+func (acc *Equity) addWeighted(e Equity, w float64) {
+	acc.Equity += w * e.Equity
+	acc.Win += w * e.Win
+	acc.Tie += w * e.Tie
+	acc.Boards += e.Boards
+}
+
+// normalize divides the weighted totals by the total weight.
+func (acc *Equity) normalize(w float64) {
+	acc.Equity /= w
+	acc.Win /= w
+	acc.Tie /= w
+}
+
+func rangeEquitiesExact(rs []Range, board, dead []Card, req []RangeEquity) error {
+	hands := make([][2]Card, len(rs))
+	choice := make([]int, len(rs))
+	used := map[Card]bool{}
+	totalW := 0.0
+	var rec func(i int, w float64) error
+	rec = func(i int, w float64) error {
+		if w == 0 {
+			return nil
+		}
+		if i == len(rs) {
+			eqs, err := HoldemEquitiesWithOptions(hands, board, EquityOptions{Dead: dead})
+			if err != nil {
+				return err
+			}
+			totalW += w
+			for j := range rs {
+				req[j].addWeighted(eqs[j], w)
+				if req[j].Combos != nil {
+					ce := &req[j].Combos[choice[j]]
+					ce.Weight += w
+					ce.Equity.addWeighted(eqs[j], w)
+				}
+			}
+			return nil
+		}
+		for j, rc := range rs[i] {
+			if used[rc.Hand[0]] || used[rc.Hand[1]] {
+				continue
+			}
+			used[rc.Hand[0]], used[rc.Hand[1]] = true, true
+			hands[i], choice[i] = rc.Hand, j
+			err := rec(i+1, w*rc.Weight)
+			used[rc.Hand[0]], used[rc.Hand[1]] = false, false
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := rec(0, 1); err != nil {
+		return err
+	}
+	if totalW == 0 {
+		return fmt.Errorf("the ranges have no compatible combinations of combos")
+	}
+	for i := range req {
+		req[i].normalize(totalW)
+		for j := range req[i].Combos {
+			if ce := &req[i].Combos[j]; ce.Weight > 0 {
+				ce.Equity.normalize(ce.Weight)
+			}
+		}
+	}
+	return nil
+}
+
+// maxComboRejections is the number of consecutive failed attempts to
+// sample compatible combos before giving up.
+const maxComboRejections = 10000
+
+func rangeEquitiesSampled(rs []Range, board, dead []Card, opts SampleOptions, req []RangeEquity) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	float := rand.Float64
+	intn := rand.Intn
+	if opts.Rand != nil {
+		float = opts.Rand.Float64
+		intn = opts.Rand.Intn
+	}
+	maxSamples := opts.Samples
+	if maxSamples == 0 {
+		maxSamples = DefaultMaxSamples
+	}
+
+	// Cumulative weights, for choosing combos in proportion to their weight.
+	cum := make([][]float64, len(rs))
+	for i, r := range rs {
+		cum[i] = make([]float64, len(r))
+		t := 0.0
+		for j, rc := range r {
+			t += rc.Weight
+			cum[i][j] = t
+		}
+		if t == 0 {
+			return fmt.Errorf("range %d has zero total weight", i)
+		}
+	}
+	choose := func(i int) int {
+		x := float() * cum[i][len(cum[i])-1]
+		lo, hi := 0, len(cum[i])-1
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if cum[i][mid] > x {
+				hi = mid
+			} else {
+				lo = mid + 1
+			}
+		}
+		return lo
+	}
+
+	hands := make([][2]Card, len(rs))
+	choice := make([]int, len(rs))
+	hbs := make([][7]Card, len(rs))
+	evs := make([]int16, len(rs))
+	eqs := make([]Equity, len(rs))
+	sumSq := make([]float64, len(rs))
+	var used [52]bool
+	deck := make([]Card, 0, 52)
+	K := 5 - len(board)
+
+	T := 0
+	for T < maxSamples {
+		// Choose compatible combos by rejection sampling, so that
+		// each choice is made in proportion to the product of the weights.
+		for tries := 0; ; tries++ {
+			if tries == maxComboRejections {
+				return fmt.Errorf("the ranges have no compatible combinations of combos")
+			}
+			used = [52]bool{}
+			for _, c := range board {
+				used[c] = true
+			}
+			for _, c := range dead {
+				used[c] = true
+			}
+			ok := true
+			for i := range rs {
+				choice[i] = choose(i)
+				h := rs[i][choice[i]].Hand
+				if used[h[0]] || used[h[1]] {
+					ok = false
+					break
+				}
+				used[h[0]], used[h[1]] = true, true
+				hands[i] = h
+			}
+			if ok {
+				break
+			}
+		}
+		T++
+
+		deck = deck[:0]
+		for _, c := range Cards {
+			if !used[c] {
+				deck = append(deck, c)
+			}
+		}
+		for j := 0; j < K; j++ {
+			k := j + intn(len(deck)-j)
+			deck[j], deck[k] = deck[k], deck[j]
+		}
+		for i, h := range hands {
+			hbs[i] = [7]Card{h[0], h[1]}
+			copy(hbs[i][2:], board)
+			copy(hbs[i][2+len(board):], deck[:K])
+			eqs[i] = Equity{}
+		}
+		holdemRiverEquities(hbs, evs, eqs, 1)
+		for i := range rs {
+			eqs[i].Boards = 1
+			req[i].addWeighted(eqs[i], 1)
+			sumSq[i] += eqs[i].Equity * eqs[i].Equity
+			if req[i].Combos != nil {
+				ce := &req[i].Combos[choice[i]]
+				ce.Weight++
+				ce.Equity.addWeighted(eqs[i], 1)
+			}
+		}
+
+		if opts.TargetStdErr > 0 && T%sampleCheckInterval == 0 {
+			done := true
+			for i := range req {
+				if stdErr(req[i].Equity.Equity, sumSq[i], T) > opts.TargetStdErr {
+					done = false
+					break
+				}
+			}
+			if done {
+				break
+			}
+		}
+	}
+	for i := range req {
+		req[i].StdErr = stdErr(req[i].Equity.Equity, sumSq[i], T)
+		req[i].normalize(float64(T))
+		for j := range req[i].Combos {
+			if ce := &req[i].Combos[j]; ce.Weight > 0 {
+				ce.Equity.normalize(ce.Weight)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	tcs := []struct {
+		r          string
+		wantCombos int
+		wantWeight float64
+	}{
+		{"AA", 6, 6},
+		{"AKs", 4, 4},
+		{"AKo", 12, 12},
+		{"AK", 16, 16},
+		{"KA", 16, 16},
+		{"QQ+", 18, 18},
+		{"22+", 78, 78},
+		{"QQ-88", 30, 30},
+		{"88-QQ", 30, 30},
+		{"ATs+", 16, 16},
+		{"ATo+", 48, 48},
+		{"AT+", 64, 64},
+		{"A5s-A2s", 16, 16},
+		{"K9o-K6o", 48, 48},
+		{"AcKh", 1, 1},
+		{"KhAc", 1, 1},
+		{"AA:0.5", 6, 3},
+		{"QQ+, AKs, A5s-A2s, KQo", 18 + 4 + 16 + 12, 18 + 4 + 16 + 12},
+		{"QQ+,AA:0.5", 18, 12 + 3},
+		{"AKs,AsKs:0", 4, 3},
+		{"AA, AhAc:0.5, AcAh:0.25", 6, 5 + 0.25},
+		{"AdAs, AsAd", 1, 1},
+		{"qq+,aks", 22, 22},
+	}
+	for _, tc := range tcs {
+		r, err := ParseRange(tc.r)
+		if err != nil {
+			t.Errorf("ParseRange(%q) failed: %v", tc.r, err)
+			continue
+		}
+		if len(r) != tc.wantCombos {
+			t.Errorf("ParseRange(%q) has %d combos, want %d", tc.r, len(r), tc.wantCombos)
+		}
+		w := 0.0
+		seen := map[[2]Card]bool{}
+		for _, rc := range r {
+			w += rc.Weight
+			if rc.Hand[0] == rc.Hand[1] || !rc.Hand[0].Valid() || !rc.Hand[1].Valid() {
+				t.Errorf("ParseRange(%q) contains bad combo %v", tc.r, rc.Hand)
+			}
+			if seen[rc.Hand] {
+				t.Errorf("ParseRange(%q) contains duplicate combo %v", tc.r, rc.Hand)
+			}
+			seen[rc.Hand] = true
+		}
+		if math.Abs(w-tc.wantWeight) > 1e-9 {
+			t.Errorf("ParseRange(%q) has total weight %f, want %f", tc.r, w, tc.wantWeight)
+		}
+	}
+}
+
+func TestParseRangeErrors(t *testing.T) {
+	for _, r := range []string{"", "AAs", "AKx", "XY", "AA:2", "AA:x", "QQ-AKs", "A5s-K2s", "A5s-A2o", "AcAc", "AKs++", "AcKx"} {
+		if _, err := ParseRange(r); err == nil {
+			t.Errorf("ParseRange(%q) succeeded, want error", r)
+		}
+	}
+}
+
+func TestRangeWithout(t *testing.T) {
+	r, err := ParseRange("AA,AKs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := r.Without([]Card{NameToCard["SA"], NameToCard["D2"]})
+	if len(got) != 3+3 {
+		t.Errorf("AA,AKs without As has %d combos, want 6", len(got))
+	}
+}
+
+func mustParseRange(t *testing.T, s string) Range {
+	r, err := ParseRange(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestRangeEquitiesSingleCombos(t *testing.T) {
+	board := mustParseHand(t, "D2 H2 S2")
+	ranges := []Range{mustParseRange(t, "AcKh"), mustParseRange(t, "KdTh"), mustParseRange(t, "9h9d")}
+	got, err := RangeEquities(ranges, board, RangeOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := HoldemEquities([][2]Card{ranges[0][0].Hand, ranges[1][0].Hand, ranges[2][0].Hand}, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range want {
+		if got[i].Equity != want[i] {
+			t.Errorf("range %d: got %+v, want %+v", i, got[i].Equity, want[i])
+		}
+	}
+}
+
+func TestRangeEquitiesDead(t *testing.T) {
+	board := mustParseHand(t, "D2 H2 S2")
+	dead := mustParseHand(t, "CA S9")
+	ranges := []Range{mustParseRange(t, "AA"), mustParseRange(t, "99")}
+	for _, sample := range []*SampleOptions{nil, {Samples: 20000, Rand: rand.New(rand.NewSource(1))}} {
+		got, err := RangeEquities(ranges, board, RangeOptions{Dead: dead, PerCombo: true, Sample: sample})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, req := range got {
+			for _, ce := range req.Combos {
+				for _, d := range dead {
+					if ce.Hand[0] == d || ce.Hand[1] == d {
+						t.Errorf("range %d: combo %s contains a dead card", i, Hand(ce.Hand[:]).Notation())
+					}
+				}
+			}
+		}
+	}
+
+	// With single combos, the result is the same as for HoldemEquities.
+	ranges = []Range{mustParseRange(t, "AhAd"), mustParseRange(t, "9h9d")}
+	got, err := RangeEquities(ranges, board, RangeOptions{Dead: dead})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := HoldemEquitiesWithOptions([][2]Card{ranges[0][0].Hand, ranges[1][0].Hand}, board, EquityOptions{Dead: dead})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range want {
+		if got[i].Equity != want[i] {
+			t.Errorf("range %d: got %+v, want %+v", i, got[i].Equity, want[i])
+		}
+	}
+}
+
+func TestRangeEquitiesExact(t *testing.T) {
+	board := mustParseHand(t, "D2 H7 SK")
+	ranges := []Range{mustParseRange(t, "AA,KK:0.5"), mustParseRange(t, "AKs,7c7s")}
+	got, err := RangeEquities(ranges, board, RangeOptions{PerCombo: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(got[0].Equity.Equity+got[1].Equity.Equity-1) > 1e-9 {
+		t.Errorf("equities %f and %f don't sum to 1", got[0].Equity.Equity, got[1].Equity.Equity)
+	}
+	// KK combos containing Ks are removed by the board.
+	if len(got[0].Combos) != 6+3 {
+		t.Errorf("got %d combos for the first range, want 9", len(got[0].Combos))
+	}
+	// The combos' equities, weighted, average to the range's equity.
+	for i := range got {
+		var sum, w float64
+		for _, ce := range got[i].Combos {
+			sum += ce.Weight * ce.Equity.Equity
+			w += ce.Weight
+		}
+		if math.Abs(sum/w-got[i].Equity.Equity) > 1e-9 {
+			t.Errorf("range %d: combos average to %f, want %f", i, sum/w, got[i].Equity.Equity)
+		}
+	}
+	// Compute the expected equity of the first range by hand.
+	var want, totalW float64
+	for _, a := range ranges[0].Without(board) {
+		for _, b := range ranges[1].Without(append(board, a.Hand[:]...)) {
+			eqs, err := HoldemEquities([][2]Card{a.Hand, b.Hand}, board)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want += a.Weight * b.Weight * eqs[0].Equity
+			totalW += a.Weight * b.Weight
+		}
+	}
+	want /= totalW
+	if math.Abs(got[0].Equity.Equity-want) > 1e-9 {
+		t.Errorf("got equity %f, want %f", got[0].Equity.Equity, want)
+	}
+
+	sampled, err := RangeEquities(ranges, board, RangeOptions{Sample: &SampleOptions{Samples: 20000, Rand: rand.New(rand.NewSource(1))}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(sampled[0].Equity.Equity-want) > 5*sampled[0].StdErr {
+		t.Errorf("sampled equity %f±%f, want %f", sampled[0].Equity.Equity, sampled[0].StdErr, want)
+	}
+}
+
+func TestRangeEquitiesErrors(t *testing.T) {
+	board := mustParseHand(t, "SA HA D2")
+	if _, err := RangeEquities([]Range{mustParseRange(t, "AsAh"), mustParseRange(t, "KK")}, board, RangeOptions{}); err == nil {
+		t.Errorf("expected error for range blocked by board")
+	}
+	if _, err := RangeEquities([]Range{mustParseRange(t, "KsKh"), mustParseRange(t, "KhKs")}, nil, RangeOptions{}); err == nil {
+		t.Errorf("expected error for ranges with no compatible combos")
+	}
+	if _, err := RangeEquities([]Range{mustParseRange(t, "KsKh"), mustParseRange(t, "KhKs")}, nil, RangeOptions{Sample: &SampleOptions{Samples: 10}}); err == nil {
+		t.Errorf("expected error for sampled ranges with no compatible combos")
+	}
+	if _, err := RangeEquities([]Range{mustParseRange(t, "AA"), mustParseRange(t, "KK")}, nil, RangeOptions{Sample: &SampleOptions{Samples: 10, Dead: mustParseHand(t, "Qs")}}); err == nil {
+		t.Errorf("expected error for dead cards in the sample options")
+	}
+}
@@ -0,0 +1,189 @@
+package poker
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ScoreRazzMax is the largest possible score returned by the
+// ace-to-five lowball evaluators. It is the score of the wheel, 5-4-3-2-A.
+const ScoreRazzMax = 6174
+
+// razzRanks is the number of ranks of cards, which is all that
+// matters in ace-to-five lowball.
+const razzRanks = 13
+
+type razzInfos struct {
+	keyToScore map[int]int16
+	desc       [ScoreRazzMax + 1]string
+}
+
+var (
+	razzInfo     *razzInfos
+	razzInfoInit sync.Once
+)
+
+func getRazzInfo() *razzInfos {
+	razzInfoInit.Do(func() {
+		razzInfo = makeRazzInfo()
+	})
+	return razzInfo
+}
+
+// razzGroups returns the ranks (from 1 for ace to 13 for king) in the
+// counts, grouped and ordered as they are compared in ace-to-five lowball:
+// larger groups first, and then higher ranks first.
+func razzGroups(counts *[razzRanks]int) (ranks, sizes []int) {
+	for n := 4; n >= 1; n-- {
+		for r := razzRanks; r >= 1; r-- {
+			if counts[r-1] == n {
+				ranks = append(ranks, r)
+				sizes = append(sizes, n)
+			}
+		}
+	}
+	return ranks, sizes
+}
+
+// razzKey returns a number that orders 5-card hands (given by the count of
+// each rank) in ace-to-five lowball. Lower keys are better hands.
+func razzKey(counts *[razzRanks]int) int {
+	ranks, sizes := razzGroups(counts)
+	// The hand category is determined by the group sizes:
+	// no pair, one pair, two pair, trips, full house, quads.
+	var cat int
+	switch {
+	case sizes[0] == 4:
+		cat = 5
+	case sizes[0] == 3 && sizes[1] == 2:
+		cat = 4
+	case sizes[0] == 3:
+		cat = 3
+	case sizes[0] == 2 && sizes[1] == 2:
+		cat = 2
+	case sizes[0] == 2:
+		cat = 1
+	}
+	k := cat
+	for i := 0; i < 5; i++ {
+		k *= 16
+		if i < len(ranks) {
+			k += ranks[i]
+		}
+	}
+	return k
+}
+
+// razzDesc describes a 5-card hand (given by the count of each rank),
+// for example "7-5-4-2-A" or "44-9-8-7".
+func razzDesc(counts *[razzRanks]int) string {
+	ranks, sizes := razzGroups(counts)
+	var parts []string
+	for i, r := range ranks {
+		parts = append(parts, strings.Repeat(Rank(r).String(), sizes[i]))
+	}
+	return strings.Join(parts, "-")
+}
+
+func makeRazzInfo() *razzInfos {
+	// Enumerate every 5-card hand by its ranks.
+	keys := map[int]string{}
+	var counts [razzRanks]int
+	var add func(r, n int)
+	add = func(r, n int) {
+		if n == 5 {
+			keys[razzKey(&counts)] = razzDesc(&counts)
+			return
+		}
+		for ; r < razzRanks; r++ {
+			if counts[r] == 4 {
+				continue
+			}
+			counts[r]++
+			add(r, n+1)
+			counts[r]--
+		}
+	}
+	add(0, 0)
+
+	var sorted []int
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Ints(sorted)
+	if ScoreRazzMax != len(sorted)-1 {
+		log.Fatalf("Expected max razz score of %d, but found %d", ScoreRazzMax, len(sorted)-1)
+	}
+	ri := &razzInfos{keyToScore: map[int]int16{}}
+	for i, k := range sorted {
+		// The best hands have the lowest keys, but the highest scores.
+		score := int16(ScoreRazzMax - i)
+		ri.keyToScore[k] = score
+		ri.desc[score] = keys[k]
+	}
+	return ri
+}
+
+// EvalRazz evaluates a 7-card hand for ace-to-five lowball, as used in
+// Razz, returning the score of the best 5-card low hand from 0 to
+// ScoreRazzMax (inclusive). Higher scores are better lows.
+// Aces are low, and straights and flushes don't count against the hand.
+// Paired hands are worse than any unpaired hand, and are ranked
+// (inversely) as high hands are.
+// Suits don't matter, so the state machine used is indexed by rank.
+func EvalRazz(hand *[7]Card) int16 {
+	needTableRazz()
+	idx := rootNodeRazzTable[hand[0]>>2]
+	idx = rootNodeRazzTable[idx+uint32(hand[1]>>2)]
+	idx = rootNodeRazzTable[idx+uint32(hand[2]>>2)]
+	idx = rootNodeRazzTable[idx+uint32(hand[3]>>2)]
+	idx = rootNodeRazzTable[idx+uint32(hand[4]>>2)]
+	idx = rootNodeRazzTable[idx+uint32(hand[5]>>2)]
+	return int16(rootNodeRazzTable[idx+uint32(hand[6]>>2)])
+}
+
+// EvalSlowRazz takes a hand of 5, 6 or 7 cards and returns the score
+// of its best 5-card ace-to-five low, in the same range as EvalRazz.
+// This function should not generally be used, and EvalRazz used instead.
+// It uses a straightforward algorithm for hand-ranking.
+// It returns -1 if the hand doesn't have between 5 and 7 cards.
+func EvalSlowRazz(c []Card) int16 {
+	if len(c) < 5 || len(c) > 7 {
+		return -1
+	}
+	ri := getRazzInfo()
+	best := int16(-1)
+	idx := []int{0, 1, 2, 3, 4}
+	for {
+		var counts [razzRanks]int
+		for _, i := range idx {
+			counts[c[i]>>2]++
+		}
+		if s := ri.keyToScore[razzKey(&counts)]; s > best {
+			best = s
+		}
+		if !nextIdx(idx, len(c), 0) {
+			return best
+		}
+	}
+}
+
+// DescribeRazz describes a score returned by one of the ace-to-five
+// lowball evaluators, for example "7-5-4-2-A" or "44-9-8-7".
+func DescribeRazz(score int16) (string, error) {
+	if score < 0 || score > ScoreRazzMax {
+		return "", fmt.Errorf("invalid razz score %d", score)
+	}
+	return getRazzInfo().desc[score], nil
+}
+
+// InternalTablesRazz returns the table of data used in the
+// optimized ace-to-five lowball evaluator.
+// The contents of this table is subject to change.
+func InternalTablesRazz() []uint32 {
+	needTableRazz()
+	return rootNodeRazzTable[:]
+}
@@ -0,0 +1,104 @@
+package poker
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestEvalRazz(t *testing.T) {
+	testCases := []struct {
+		hand string
+		want string
+	}{
+		{"SA H2 D3 C4 S5 HK DK", "5-4-3-2-A"},
+		{"SA H2 D3 C4 S5 H6 D7", "5-4-3-2-A"},
+		{"S7 H5 D4 C2 SA HA D2", "7-5-4-2-A"},
+		{"S8 H8 D8 C8 SK HK DK", "888-KK"},
+		{"S9 H9 D8 C7 S4 H4 D4", "44-9-8-7"},
+		{"SA HA DA C2 S2 H2 D3", "22-AA-3"},
+		{"SK HQ DJ CT S9 H8 D7", "J-T-9-8-7"},
+		{"SA SK SQ SJ ST H2 H3", "J-T-3-2-A"},
+		{"S2 H2 D2 C2 S3 H3 D3", "222-33"},
+	}
+	for _, tc := range testCases {
+		var h [7]Card
+		copy(h[:], mustParseHand(t, tc.hand))
+		score := EvalRazz(&h)
+		if slow := EvalSlowRazz(h[:]); slow != score {
+			t.Errorf("EvalRazz(%s) = %d, but EvalSlowRazz = %d", tc.hand, score, slow)
+		}
+		got, err := DescribeRazz(score)
+		if err != nil {
+			t.Fatalf("DescribeRazz(EvalRazz(%s)) failed: %v", tc.hand, err)
+		}
+		if got != tc.want {
+			t.Errorf("DescribeRazz(EvalRazz(%s)) = %q, want %q", tc.hand, got, tc.want)
+		}
+	}
+}
+
+func TestEvalSlowRazzOrdering(t *testing.T) {
+	// Each hand is a better low than the one before it.
+	hands := []string{
+		"SK HK DK CK SQ",
+		"SK HK DK CQ SQ",
+		"SK HK DK CQ SJ",
+		"SK HK DQ CQ SJ",
+		"SK HK DQ CJ ST",
+		"S2 H2 D5 C4 S3",
+		"SK HQ DJ CT S9",
+		"S6 H5 D4 C3 S2", // straights don't count.
+		"S6 S5 S4 S3 SA", // neither do flushes.
+		"S5 H4 D3 C2 SA",
+	}
+	prev := int16(-1)
+	for _, hs := range hands {
+		got := EvalSlowRazz(mustParseHand(t, hs))
+		if got <= prev {
+			t.Errorf("%s (score %d) isn't better than the previous hand (score %d)", hs, got, prev)
+		}
+		prev = got
+	}
+	if prev != ScoreRazzMax {
+		t.Errorf("the wheel has score %d, want %d", prev, ScoreRazzMax)
+	}
+}
+
+func TestEvalRazzRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		var h [7]Card
+		for j, k := range rnd.Perm(52)[:7] {
+			h[j] = Cards[k]
+		}
+		if got, want := EvalRazz(&h), EvalSlowRazz(h[:]); got != want {
+			t.Fatalf("EvalRazz(%s) = %d, want %d", Hand(h[:]), got, want)
+		}
+	}
+}
+
+func TestDescribeRazzInvalid(t *testing.T) {
+	for _, score := range []int16{-1, ScoreRazzMax + 1} {
+		if d, err := DescribeRazz(score); err == nil {
+			t.Errorf("DescribeRazz(%d) = %q, want error", score, d)
+		}
+	}
+}
+
+func BenchmarkEvalRazz(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	hands := make([][7]Card, 1024)
+	for i := range hands {
+		for j, k := range rnd.Perm(52)[:7] {
+			hands[i][j] = Cards[k]
+		}
+	}
+	b.ResetTimer()
+	var T int64
+	for i := 0; i < b.N; i++ {
+		T += int64(EvalRazz(&hands[i%len(hands)]))
+	}
+	if T == 0 && b.N > 100 {
+		b.Fatal("all evaluations were 0")
+	}
+}
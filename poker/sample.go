@@ -0,0 +1,156 @@
+package poker
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// SampleOptions configures Monte Carlo estimation of equities.
+// At least one of Samples and TargetStdErr must be set.
+type SampleOptions struct {
+	// Samples is the maximum number of runouts to sample.
+	// If it's zero, DefaultMaxSamples is used.
+	Samples int
+
+	// TargetStdErr, if positive, stops sampling early once the standard
+	// error of every hand's equity is at most this value.
+	TargetStdErr float64
+
+	// Rand is the source of randomness. Runouts are reproducible when
+	// it's seeded with the same value. If it's nil, the top-level
+	// math/rand functions are used.
+	Rand *rand.Rand
+
+	// Dead are cards which can't appear in the runouts, as for
+	// EquityOptions.Dead. It's used by HoldemEquitiesSampled. Functions
+	// whose options have their own Dead field, such as RangeEquities,
+	// use that instead, and return an error if this is set.
+	Dead []Card
+}
+
+// checkSampleDead returns an error if opts, the sampling options of a
+// function whose options have their own Dead field, has dead cards,
+// since they'd be ignored.
+func checkSampleDead(opts *SampleOptions) error {
+	if opts != nil && len(opts.Dead) > 0 {
+		return fmt.Errorf("dead cards %s set in SampleOptions are ignored here: set Dead in the enclosing options instead", boardString(opts.Dead))
+	}
+	return nil
+}
+
+// DefaultMaxSamples is the number of runouts sampled when
+// SampleOptions.Samples is zero.
+const DefaultMaxSamples = 10_000_000
+
+// sampleCheckInterval is how often (in runouts) the standard errors
+// are checked against the target.
+const sampleCheckInterval = 1000
+
+// validate checks that the options are valid.
+func (opts SampleOptions) validate() error {
+	if opts.Samples < 0 || opts.TargetStdErr < 0 {
+		return fmt.Errorf("bad sample options: %d samples, target standard error %f", opts.Samples, opts.TargetStdErr)
+	}
+	if opts.Samples == 0 && opts.TargetStdErr == 0 {
+		return fmt.Errorf("sample options must set the number of samples or a target standard error")
+	}
+	return nil
+}
+
+// ConfidenceInterval returns the interval Equity ± z*StdErr,
+// clamped to [0, 1]. For example, z=1.96 gives a 95% confidence interval.
+func (eq Equity) ConfidenceInterval(z float64) (lo, hi float64) {
+	lo = math.Max(0, eq.Equity-z*eq.StdErr)
+	hi = math.Min(1, eq.Equity+z*eq.StdErr)
+	return lo, hi
+}
+
+// stdErr returns the standard error of the mean of T samples which
+// have the given sum and sum of squares.
+func stdErr(sum, sumSq float64, T int) float64 {
+	if T < 2 {
+		return math.Inf(1)
+	}
+	m := sum / float64(T)
+	v := (sumSq - float64(T)*m*m) / float64(T-1)
+	if v < 0 {
+		v = 0
+	}
+	return math.Sqrt(v / float64(T))
+}
+
+// HoldemEquitiesSampled estimates the river equities for the given holdem
+// hands given a board of up to 5 cards, by sampling random runouts
+// rather than enumerating all of them as HoldemEquities does.
+// Each result's StdErr is the standard error of its estimated equity.
+// The hands and board must be as for HoldemEquities.
+func HoldemEquitiesSampled(hands [][2]Card, board []Card, opts SampleOptions) ([]Equity, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	deck, err := getRemainingDeckDead(hands, board, opts.Dead)
+	if err != nil {
+		return nil, err
+	}
+	if len(board) == 5 {
+		// There's only one runout.
+		return HoldemEquitiesWithOptions(hands, board, EquityOptions{Dead: opts.Dead})
+	}
+	if len(deck) < 5-len(board) {
+		return nil, fmt.Errorf("only %d cards remain in the deck, but %d are needed to complete the board", len(deck), 5-len(board))
+	}
+	intn := rand.Intn
+	if opts.Rand != nil {
+		intn = opts.Rand.Intn
+	}
+	maxSamples := opts.Samples
+	if maxSamples == 0 {
+		maxSamples = DefaultMaxSamples
+	}
+
+	hbs := holdemHandsAndBoards(hands, board)
+	eqs := make([]Equity, len(hands))
+	evs := make([]int16, len(hands))
+	prev := make([]float64, len(hands))
+	sumSq := make([]float64, len(hands))
+	K := 5 - len(board)
+
+	T := 0
+	for T < maxSamples {
+		T++
+		// Choose the runout with a partial Fisher-Yates shuffle.
+		for j := 0; j < K; j++ {
+			k := j + intn(len(deck)-j)
+			deck[j], deck[k] = deck[k], deck[j]
+			for i := range hbs {
+				hbs[i][j] = deck[j]
+			}
+		}
+		for i := range eqs {
+			prev[i] = eqs[i].Equity
+		}
+		holdemRiverEquities(hbs, evs, eqs, 1)
+		for i := range eqs {
+			d := eqs[i].Equity - prev[i]
+			sumSq[i] += d * d
+		}
+		if opts.TargetStdErr > 0 && T%sampleCheckInterval == 0 {
+			done := true
+			for i := range eqs {
+				if stdErr(eqs[i].Equity, sumSq[i], T) > opts.TargetStdErr {
+					done = false
+					break
+				}
+			}
+			if done {
+				break
+			}
+		}
+	}
+	for i := range eqs {
+		eqs[i].StdErr = stdErr(eqs[i].Equity, sumSq[i], T)
+		eqs[i].scale(T)
+	}
+	return eqs, nil
+}
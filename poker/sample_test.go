@@ -0,0 +1,122 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestHoldemEquitiesSampled(t *testing.T) {
+	hands := [][2]Card{
+		{NameToCard["CA"], NameToCard["HK"]},
+		{NameToCard["DK"], NameToCard["HT"]},
+		{NameToCard["S7"], NameToCard["S6"]},
+	}
+	board := []Card{NameToCard["D2"], NameToCard["HJ"]}
+	exact, err := HoldemEquities(hands, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := HoldemEquitiesSampled(hands, board, SampleOptions{Samples: 20000, Rand: rand.New(rand.NewSource(1))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range hands {
+		if got[i].Boards != 20000 {
+			t.Errorf("hand %d: got %d boards, want 20000", i, got[i].Boards)
+		}
+		if got[i].StdErr <= 0 || got[i].StdErr > 0.005 {
+			t.Errorf("hand %d: standard error %f out of range", i, got[i].StdErr)
+		}
+		// This is a 5 standard error test, which should essentially never fail.
+		if math.Abs(got[i].Equity-exact[i].Equity) > 5*got[i].StdErr {
+			t.Errorf("hand %d: sampled equity %f±%f, exact %f", i, got[i].Equity, got[i].StdErr, exact[i].Equity)
+		}
+		lo, hi := got[i].ConfidenceInterval(1.96)
+		if lo >= got[i].Equity || hi <= got[i].Equity {
+			t.Errorf("hand %d: confidence interval [%f, %f] doesn't contain %f", i, lo, hi, got[i].Equity)
+		}
+	}
+}
+
+func TestHoldemEquitiesSampledReproducible(t *testing.T) {
+	hands := [][2]Card{
+		{NameToCard["CA"], NameToCard["HK"]},
+		{NameToCard["DK"], NameToCard["HT"]},
+	}
+	run := func(seed int64) []Equity {
+		eqs, err := HoldemEquitiesSampled(hands, nil, SampleOptions{Samples: 5000, Rand: rand.New(rand.NewSource(seed))})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return eqs
+	}
+	a, b, c := run(7), run(7), run(8)
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("same seed gave different results: %v and %v", a, b)
+	}
+	if reflect.DeepEqual(a, c) {
+		t.Errorf("different seeds gave identical results: %v", a)
+	}
+}
+
+func TestHoldemEquitiesSampledTarget(t *testing.T) {
+	hands := [][2]Card{
+		{NameToCard["CA"], NameToCard["HK"]},
+		{NameToCard["DK"], NameToCard["HT"]},
+	}
+	eqs, err := HoldemEquitiesSampled(hands, nil, SampleOptions{TargetStdErr: 0.01, Rand: rand.New(rand.NewSource(1))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, eq := range eqs {
+		if eq.StdErr > 0.01 {
+			t.Errorf("hand %d: standard error %f, want at most 0.01", i, eq.StdErr)
+		}
+		if eq.Boards%sampleCheckInterval != 0 || eq.Boards > 10*sampleCheckInterval {
+			t.Errorf("hand %d: sampled %d boards", i, eq.Boards)
+		}
+	}
+}
+
+func TestHoldemEquitiesSampledErrors(t *testing.T) {
+	hands := [][2]Card{
+		{NameToCard["CA"], NameToCard["HK"]},
+		{NameToCard["DK"], NameToCard["CA"]},
+	}
+	if _, err := HoldemEquitiesSampled(hands, nil, SampleOptions{Samples: 100}); err == nil {
+		t.Errorf("expected error with duplicate cards")
+	}
+	hands[1][1] = NameToCard["HT"]
+	if _, err := HoldemEquitiesSampled(hands, nil, SampleOptions{}); err == nil {
+		t.Errorf("expected error with no samples or target")
+	}
+}
+
+func TestHoldemEquitiesSampledDead(t *testing.T) {
+	hands := [][2]Card{
+		{NameToCard["CA"], NameToCard["HK"]},
+		{NameToCard["S7"], NameToCard["S6"]},
+	}
+	board := mustParseHand(t, "D2 HJ S5")
+	dead := mustParseHand(t, "S8 S4 S3 SK")
+	exact, err := HoldemEquitiesWithOptions(hands, board, EquityOptions{Dead: dead})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := HoldemEquitiesSampled(hands, board, SampleOptions{Samples: 20000, Rand: rand.New(rand.NewSource(1)), Dead: dead})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range hands {
+		if math.Abs(got[i].Equity-exact[i].Equity) > 5*got[i].StdErr {
+			t.Errorf("hand %d: sampled equity %f±%f with dead cards, exact %f", i, got[i].Equity, got[i].StdErr, exact[i].Equity)
+		}
+	}
+
+	dead = append(dead, NameToCard["CA"])
+	if _, err := HoldemEquitiesSampled(hands, board, SampleOptions{Samples: 100, Dead: dead}); err == nil {
+		t.Errorf("expected error with a dead card in a hand")
+	}
+}
@@ -0,0 +1,311 @@
+package poker
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+)
+
+// ShortDeckRules selects how hands are ranked in short-deck (6+) hold'em.
+// In all variants, the deck has 36 cards (sixes to aces), a flush beats
+// a full house, and A-6-7-8-9 is the lowest straight.
+type ShortDeckRules int
+
+const (
+	// ShortDeckFlushBeatsFullHouse ranks a flush above a full house,
+	// and otherwise ranks hands in the usual order.
+	ShortDeckFlushBeatsFullHouse ShortDeckRules = iota
+
+	// ShortDeckTripsBeatStraight ranks three of a kind above a
+	// straight, as well as a flush above a full house.
+	ShortDeckTripsBeatStraight
+
+	numShortDeckRules
+)
+
+// ShortDeckScoreMax is the largest possible score returned by the
+// short-deck evaluators.
+const ShortDeckScoreMax = 1403
+
+// ShortDeckCards is the 36-card short deck of all cards from six
+// to ace. Sorted by suit and then rank.
+var ShortDeckCards = makeShortDeckCards()
+
+func makeShortDeckCards() []Card {
+	var cards []Card
+	for _, c := range Cards {
+		if isShortDeckCard(c) {
+			cards = append(cards, c)
+		}
+	}
+	return cards
+}
+
+func isShortDeckCard(c Card) bool {
+	return c.Valid() && (c.Rank() == 1 || c.Rank() >= 6)
+}
+
+// shortDeckCategoryOrder gives, for each set of rules, the order
+// of the hand categories from worst to best.
+var shortDeckCategoryOrder = [numShortDeckRules][]HandCategory{
+	ShortDeckFlushBeatsFullHouse: {HighCard, OnePair, TwoPair, ThreeOfAKind, Straight, FullHouse, Flush, FourOfAKind, StraightFlush},
+	ShortDeckTripsBeatStraight:   {HighCard, OnePair, TwoPair, Straight, ThreeOfAKind, FullHouse, Flush, FourOfAKind, StraightFlush},
+}
+
+type shortDeckInfos struct {
+	// scores maps the score of a 5-card short-deck hand under the usual
+	// rules (as returned by Eval5) to its short-deck score, or -1 if
+	// the hand can't be made from the short deck.
+	scores [numShortDeckRules][ScoreMax + 1]int16
+
+	// descs describes each short-deck score.
+	descs [numShortDeckRules][ShortDeckScoreMax + 1]HandDescription
+}
+
+var (
+	shortDeckInfo     *shortDeckInfos
+	shortDeckInfoInit sync.Once
+)
+
+// getShortDeckInfo returns the tables that convert scores to short-deck
+// scores, computing them the first time it's called.
+func getShortDeckInfo() *shortDeckInfos {
+	shortDeckInfoInit.Do(func() {
+		shortDeckInfo = makeShortDeckInfo()
+	})
+	return shortDeckInfo
+}
+
+func makeShortDeckInfo() *shortDeckInfos {
+	// Find the descriptions of all 5-card short-deck hands, keyed by
+	// their normal score. The score determines the ranks, so the
+	// short-deck description can be derived from it.
+	descs := map[int16]HandDescription{}
+	var idx [5]int
+	for i := range idx {
+		idx[i] = i
+	}
+	for {
+		h := [5]Card{ShortDeckCards[idx[0]], ShortDeckCards[idx[1]], ShortDeckCards[idx[2]], ShortDeckCards[idx[3]], ShortDeckCards[idx[4]]}
+		score := EvalSlow(h[:])
+		if _, ok := descs[score]; !ok {
+			hd, err := DescribeScore(score)
+			if err != nil {
+				panic(err)
+			}
+			descs[score] = shortDeckDescription(hd)
+		}
+		if !nextIdx(idx[:], len(ShortDeckCards), 0) {
+			break
+		}
+	}
+
+	sdi := &shortDeckInfos{}
+	for rules := ShortDeckRules(0); rules < numShortDeckRules; rules++ {
+		var order [FiveOfAKind + 1]int
+		for i, cat := range shortDeckCategoryOrder[rules] {
+			order[cat] = i
+		}
+		// key orders the descriptions under these rules.
+		key := func(hd HandDescription) int {
+			ranks := append(hd.Ranks[:len(hd.Ranks):len(hd.Ranks)], hd.Kickers...)
+			k := order[hd.Category]
+			for i := 0; i < 5; i++ {
+				k *= 16
+				if i < len(ranks) {
+					k += (int(ranks[i])+11)%13 + 2 // aces high
+				}
+			}
+			return k
+		}
+		var scores []int16
+		for s := range descs {
+			scores = append(scores, s)
+		}
+		sort.Slice(scores, func(i, j int) bool {
+			return key(descs[scores[i]]) < key(descs[scores[j]])
+		})
+		if ShortDeckScoreMax != len(scores)-1 {
+			log.Fatalf("Expected max short-deck score of %d, but found %d", ShortDeckScoreMax, len(scores)-1)
+		}
+		for i := range sdi.scores[rules] {
+			sdi.scores[rules][i] = -1
+		}
+		for i, s := range scores {
+			sdi.scores[rules][s] = int16(i)
+			sdi.descs[rules][i] = descs[s]
+		}
+	}
+	return sdi
+}
+
+// shortDeckDescription converts the description of a 5-card hand
+// made from the short deck to its description in short-deck, where
+// A-6-7-8-9 is a straight (with the nine as its top card).
+func shortDeckDescription(hd HandDescription) HandDescription {
+	if hd.Category != HighCard && hd.Category != Flush {
+		return hd
+	}
+	if len(hd.Kickers) != 4 || hd.Ranks[0] != 1 || hd.Kickers[0] != 9 || hd.Kickers[3] != 6 {
+		return hd
+	}
+	cat := Straight
+	if hd.Category == Flush {
+		cat = StraightFlush
+	}
+	return HandDescription{Category: cat, Ranks: []Rank{9}}
+}
+
+// EvalShortDeck5 evaluates a 5-card short-deck poker hand under the
+// given rules, returning a score from 0 to ShortDeckScoreMax (inclusive)
+// where higher scores are better hands. The cards must be from the
+// short deck (see ShortDeckCards). Short-deck scores are not comparable
+// with the scores of the other evaluators.
+func EvalShortDeck5(hand *[5]Card, rules ShortDeckRules) int16 {
+	return getShortDeckInfo().scores[rules][Eval5(hand)]
+}
+
+// EvalShortDeck7 evaluates a 7-card short-deck poker hand under the
+// given rules, returning the score of the best 5-card hand made from
+// the 7 cards, as EvalShortDeck5 does. It uses precomputed tables
+// in the same way as Eval7.
+func EvalShortDeck7(hand *[7]Card, rules ShortDeckRules) int16 {
+	needTableShortDeck()
+	tbl := rootNodeShortDeckTable[rules][:]
+
+	v := tbl[hand[0]]
+	tx := suitTransformByte(v)
+	idx := int(v >> 8)
+
+	v = tbl[idx+int(tx.Apply(hand[1]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	v = tbl[idx+int(tx.Apply(hand[2]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	v = tbl[idx+int(tx.Apply(hand[3]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	v = tbl[idx+int(tx.Apply(hand[4]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	v = tbl[idx+int(tx.Apply(hand[5]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	return int16(tbl[idx+int(tx.Apply(hand[6]))])
+}
+
+// EvalSlowShortDeck takes a 5- or 7-card short-deck poker hand and
+// returns its short-deck score under the given rules, in the same range
+// as EvalShortDeck5 and EvalShortDeck7.
+// This function should not generally be used, and EvalShortDeck5 or
+// EvalShortDeck7 used instead. It uses a straightforward algorithm
+// for hand-ranking. It returns -1 if the hand isn't valid.
+func EvalSlowShortDeck(c []Card, rules ShortDeckRules) int16 {
+	if len(c) != 5 && len(c) != 7 || rules < 0 || rules >= numShortDeckRules {
+		return -1
+	}
+	for _, ci := range c {
+		if !isShortDeckCard(ci) {
+			return -1
+		}
+	}
+	sdi := getShortDeckInfo()
+	best := int16(-1)
+	idx := make([]int, 5)
+	for i := range idx {
+		idx[i] = i
+	}
+	for {
+		h := [5]Card{c[idx[0]], c[idx[1]], c[idx[2]], c[idx[3]], c[idx[4]]}
+		if s := sdi.scores[rules][EvalSlow(h[:])]; s > best {
+			best = s
+		}
+		if !nextIdx(idx, len(c), 0) {
+			return best
+		}
+	}
+}
+
+// DescribeShortDeckScore describes a score returned by one of the
+// short-deck evaluators under the given rules. A-6-7-8-9 is described
+// as a straight (or straight flush) with a top card of nine.
+func DescribeShortDeckScore(score int16, rules ShortDeckRules) (HandDescription, error) {
+	if rules < 0 || rules >= numShortDeckRules {
+		return HandDescription{}, fmt.Errorf("invalid short-deck rules %d", rules)
+	}
+	if score < 0 || score > ShortDeckScoreMax {
+		return HandDescription{}, fmt.Errorf("invalid short-deck score %d", score)
+	}
+	return getShortDeckInfo().descs[rules][score], nil
+}
+
+// ShortDeckEquities returns the river equities for the given short-deck
+// hold'em hands given a board of up to 5 cards, with hands ranked
+// according to rules. All the cards must be from the short deck
+// (see ShortDeckCards), and the hands and board must be distinct.
+func ShortDeckEquities(hands [][2]Card, board []Card, rules ShortDeckRules) ([]Equity, error) {
+	if rules < 0 || rules >= numShortDeckRules {
+		return nil, fmt.Errorf("invalid short-deck rules %d", rules)
+	}
+	full, err := getRemainingDeck(hands, board)
+	if err != nil {
+		return nil, err
+	}
+	for i, h := range hands {
+		for _, c := range h {
+			if !isShortDeckCard(c) {
+				return nil, fmt.Errorf("hand %d contains %s, which isn't in the short deck", i, c)
+			}
+		}
+	}
+	for _, c := range board {
+		if !isShortDeckCard(c) {
+			return nil, fmt.Errorf("board %s contains %s, which isn't in the short deck", boardString(board), c)
+		}
+	}
+	var deck []Card
+	for _, c := range full {
+		if isShortDeckCard(c) {
+			deck = append(deck, c)
+		}
+	}
+
+	K := 5 - len(board)
+	if len(deck) < K {
+		return nil, fmt.Errorf("only %d cards remain in the deck, but %d are needed to complete the board", len(deck), K)
+	}
+	hbs := holdemHandsAndBoards(hands, board)
+	eqs := make([]Equity, len(hands))
+	evs := make([]int16, len(hands))
+	idxs := make([]int, K)
+	for i := range idxs {
+		idxs[i] = i
+	}
+	T := 0 // total number of runouts we've considered.
+	for {
+		T++
+		for j, ix := range idxs {
+			for i := range hbs {
+				hbs[i][j] = deck[ix]
+			}
+		}
+		for i := range hbs {
+			evs[i] = EvalShortDeck7(&hbs[i], rules)
+		}
+		awardPot(evs, eqs)
+		if K == 0 || !incHEIndex(idxs, len(deck)) {
+			break
+		}
+	}
+	for i := range eqs {
+		eqs[i].scale(T)
+	}
+	return eqs, nil
+}
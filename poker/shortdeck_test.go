@@ -0,0 +1,201 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestShortDeckOrdering(t *testing.T) {
+	// Each hand is better than the one before it under the rules.
+	testCases := []struct {
+		rules ShortDeckRules
+		hands []string
+	}{
+		{ShortDeckFlushBeatsFullHouse, []string{
+			"SA HK D9 C7 S6",
+			"SA HA D9 C7 S6",
+			"SA HA D9 C9 S6",
+			"SA HA DA C9 S6",
+			"SA H6 D7 C8 S9", // A-6-7-8-9 is the lowest straight.
+			"S6 H7 D8 C9 ST",
+			"ST HJ DQ CK SA",
+			"SA HA DA C9 S9",
+			"S6 S7 S8 SQ SK", // Flush beats full house.
+			"SA HA DA CA S6",
+			"SA S6 S7 S8 S9",
+			"ST SJ SQ SK SA",
+		}},
+		{ShortDeckTripsBeatStraight, []string{
+			"SA HA D9 C9 S6",
+			"SA H6 D7 C8 S9",
+			"ST HJ DQ CK SA",
+			"S6 H6 D6 C8 S9", // Trips beat a straight.
+			"SA HA DA C9 S6",
+			"SA HA DA C9 S9",
+			"S6 S7 S8 SQ SK",
+			"SA HA DA CA S6",
+		}},
+	}
+	for _, tc := range testCases {
+		prev := int16(-1)
+		for _, hs := range tc.hands {
+			var h [5]Card
+			copy(h[:], mustParseHand(t, hs))
+			got := EvalShortDeck5(&h, tc.rules)
+			if slow := EvalSlowShortDeck(h[:], tc.rules); slow != got {
+				t.Errorf("rules %d: EvalShortDeck5(%s) = %d, but EvalSlowShortDeck = %d", tc.rules, hs, got, slow)
+			}
+			if got <= prev {
+				t.Errorf("rules %d: %s (score %d) isn't better than the previous hand (score %d)", tc.rules, hs, got, prev)
+			}
+			prev = got
+		}
+	}
+}
+
+func TestDescribeShortDeckScore(t *testing.T) {
+	testCases := []struct {
+		hand string
+		want string
+	}{
+		{"SA H6 D7 C8 S9", "9 straight"},
+		{"SA S6 S7 S8 S9", "9 straight flush"},
+		{"SA HK D9 C7 S6", "A-K-9-7-6"},
+		{"S6 S7 S8 SQ SK", "KQ876 flush"},
+		{"S6 H6 D6 C8 S9", "666-9-8"},
+	}
+	for _, tc := range testCases {
+		for rules := ShortDeckRules(0); rules < numShortDeckRules; rules++ {
+			score := EvalSlowShortDeck(mustParseHand(t, tc.hand), rules)
+			hd, err := DescribeShortDeckScore(score, rules)
+			if err != nil {
+				t.Fatalf("DescribeShortDeckScore(%d, %d) failed: %v", score, rules, err)
+			}
+			if got := hd.String(); got != tc.want {
+				t.Errorf("rules %d: description of %s = %q, want %q", rules, tc.hand, got, tc.want)
+			}
+		}
+	}
+}
+
+func TestEvalShortDeck7(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for rules := ShortDeckRules(0); rules < numShortDeckRules; rules++ {
+		for i := 0; i < 20000; i++ {
+			var h [7]Card
+			for j, k := range rnd.Perm(len(ShortDeckCards))[:7] {
+				h[j] = ShortDeckCards[k]
+			}
+			got := EvalShortDeck7(&h, rules)
+			want := EvalSlowShortDeck(h[:], rules)
+			if got != want {
+				t.Fatalf("rules %d: EvalShortDeck7(%s) = %d, want %d", rules, Hand(h[:]), got, want)
+			}
+		}
+	}
+}
+
+func TestEvalShortDeck7TripsOrStraight(t *testing.T) {
+	// Trips and a straight: which is best depends on the rules.
+	var h [7]Card
+	copy(h[:], mustParseHand(t, "S6 H6 D6 C7 S8 H9 DT"))
+	for _, tc := range []struct {
+		rules ShortDeckRules
+		want  HandCategory
+	}{
+		{ShortDeckFlushBeatsFullHouse, Straight},
+		{ShortDeckTripsBeatStraight, ThreeOfAKind},
+	} {
+		hd, err := DescribeShortDeckScore(EvalShortDeck7(&h, tc.rules), tc.rules)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hd.Category != tc.want {
+			t.Errorf("rules %d: %s is %s, want %s", tc.rules, Hand(h[:]), hd.Category, tc.want)
+		}
+	}
+}
+
+func TestShortDeckEquities(t *testing.T) {
+	hands := [][2]Card{
+		{mustParseHand(t, "SA")[0], mustParseHand(t, "SK")[0]},
+		{mustParseHand(t, "H6")[0], mustParseHand(t, "D6")[0]},
+	}
+	board := mustParseHand(t, "C7 D8 H9")
+	for rules := ShortDeckRules(0); rules < numShortDeckRules; rules++ {
+		eqs, err := ShortDeckEquities(hands, board, rules)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Check against a direct enumeration of the runouts.
+		var want [2]float64
+		var deck []Card
+		used := map[Card]bool{}
+		for _, c := range append(append(hands[0][:], hands[1][:]...), board...) {
+			used[c] = true
+		}
+		for _, c := range ShortDeckCards {
+			if !used[c] {
+				deck = append(deck, c)
+			}
+		}
+		n := 0
+		for i := range deck {
+			for j := i + 1; j < len(deck); j++ {
+				var evs [2]int16
+				for k, h := range hands {
+					evs[k] = EvalSlowShortDeck([]Card{h[0], h[1], board[0], board[1], board[2], deck[i], deck[j]}, rules)
+				}
+				switch {
+				case evs[0] > evs[1]:
+					want[0]++
+				case evs[0] < evs[1]:
+					want[1]++
+				default:
+					want[0] += 0.5
+					want[1] += 0.5
+				}
+				n++
+			}
+		}
+		for i := range eqs {
+			if eqs[i].Boards != n {
+				t.Errorf("rules %d: hand %d has %d boards, want %d", rules, i, eqs[i].Boards, n)
+			}
+			if w := want[i] / float64(n); math.Abs(eqs[i].Equity-w) > 1e-9 {
+				t.Errorf("rules %d: hand %d has equity %f, want %f", rules, i, eqs[i].Equity, w)
+			}
+		}
+	}
+}
+
+func TestShortDeckEquitiesErrors(t *testing.T) {
+	hands := [][2]Card{
+		{mustParseHand(t, "SA")[0], mustParseHand(t, "S2")[0]},
+		{mustParseHand(t, "H6")[0], mustParseHand(t, "D6")[0]},
+	}
+	if _, err := ShortDeckEquities(hands, nil, ShortDeckFlushBeatsFullHouse); err == nil {
+		t.Errorf("ShortDeckEquities with a deuce succeeded, want error")
+	}
+	hands[0][1] = mustParseHand(t, "SK")[0]
+	if _, err := ShortDeckEquities(hands, nil, numShortDeckRules); err == nil {
+		t.Errorf("ShortDeckEquities with invalid rules succeeded, want error")
+	}
+
+	// Sixteen hands leave only 4 of the 36 cards for the board.
+	var cards []Card
+	for c := Card(0); c < 52; c++ {
+		if isShortDeckCard(c) {
+			cards = append(cards, c)
+		}
+	}
+	hands = nil
+	for i := 0; i < 16; i++ {
+		hands = append(hands, [2]Card{cards[2*i], cards[2*i+1]})
+	}
+	if _, err := ShortDeckEquities(hands, nil, ShortDeckFlushBeatsFullHouse); err == nil || !strings.Contains(err.Error(), "cards remain") {
+		t.Errorf("ShortDeckEquities with too few cards left in the deck = %v, want error", err)
+	}
+}
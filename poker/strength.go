@@ -0,0 +1,464 @@
+package poker
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sync"
+)
+
+// The results of comparing a hand with the opponent's.
+const (
+	strengthAhead = iota
+	strengthTied
+	strengthBehind
+)
+
+// HandStrength holds hand strength and hand potential metrics for
+// holdem hole cards against an opponent, as described in Billings et al,
+// "Opponent Modeling in Poker" (1998). Ties are counted as half a win.
+type HandStrength struct {
+	// HS is the probability that the hand is ahead of the opponent's
+	// on the current board. Preflop, when there's no current hand to
+	// compare, it is the same as Equity.
+	HS float64
+
+	// PPot is the positive potential: the probability that a hand which
+	// is currently behind will be ahead on the river.
+	PPot float64
+
+	// NPot is the negative potential: the probability that a hand which
+	// is currently ahead will be behind on the river.
+	NPot float64
+
+	// EHS is the effective hand strength, HS*(1-NPot) + (1-HS)*PPot.
+	EHS float64
+
+	// EHS2 is the mean over the runouts of the square of the hand
+	// strength on the river.
+	EHS2 float64
+
+	// Equity is the probability of the hand winning at showdown.
+	Equity float64
+
+	// Histogram, if requested with HandStrengthOptions.Bins, is the
+	// distribution of the hand strength on the river over the runouts.
+	// Bin i counts the runouts with a river hand strength from i/Bins to
+	// (i+1)/Bins, with the last bin including 1, and the bins sum to 1.
+	Histogram []float64
+}
+
+// HandStrengthOptions configures how hand strength metrics are computed.
+type HandStrengthOptions struct {
+	// Opponent, if not nil, is the range of the opponent's hole cards.
+	// Combos that contain the hole cards, board or dead cards are
+	// removed. If it's nil, every two cards are equally likely.
+	Opponent Range
+
+	// Dead are cards which can't be in the opponent's hand or
+	// appear in the runouts.
+	Dead []Card
+
+	// Sample, if not nil, causes the metrics to be estimated by sampling
+	// runouts rather than enumerating them. Each sample is one runout,
+	// which is evaluated against every opponent combo, and sampling
+	// stops early once the standard error of the mean river hand
+	// strength is at most the target. Enumeration is slow preflop.
+	Sample *SampleOptions
+
+	// Workers is the maximum number of goroutines used to enumerate
+	// runouts. If it's zero, runtime.NumCPU() is used.
+	// The results don't depend on the number of workers.
+	Workers int
+
+	// Context, if not nil, can be used to cancel the computation,
+	// in which case the context's error is returned.
+	Context context.Context
+
+	// Bins, if positive, is the number of bins in the histogram of
+	// river hand strengths returned in HandStrength.Histogram.
+	Bins int
+}
+
+// HoldemHandStrength returns hand strength and potential metrics for
+// holdem hole cards given a board of 0, 3, 4 or 5 cards, against a
+// single opponent. The runouts are enumerated in parallel, unless
+// opts.Sample is set.
+func HoldemHandStrength(hole [2]Card, board []Card, opts HandStrengthOptions) (HandStrength, error) {
+	if len(board) != 0 && (len(board) < 3 || len(board) > 5) {
+		return HandStrength{}, fmt.Errorf("board %s has %d cards: want 0, 3, 4 or 5", boardString(board), len(board))
+	}
+	if err := checkSampleDead(opts.Sample); err != nil {
+		return HandStrength{}, err
+	}
+	deck, err := getRemainingDeckDead([][2]Card{hole}, board, opts.Dead)
+	if err != nil {
+		return HandStrength{}, err
+	}
+	if opts.Bins < 0 {
+		return HandStrength{}, fmt.Errorf("bad number of histogram bins %d", opts.Bins)
+	}
+	se := &strengthEvaluator{hole: hole, board: board, bins: opts.Bins}
+	if err := se.setOpponents(deck, opts.Opponent); err != nil {
+		return HandStrength{}, err
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var t strengthTotals
+	if opts.Sample != nil && len(board) < 5 {
+		t, err = se.sampled(deck, *opts.Sample)
+	} else {
+		workers := opts.Workers
+		if workers <= 0 {
+			workers = runtime.NumCPU()
+		}
+		t, err = se.exact(ctx, deck, workers)
+	}
+	if err != nil {
+		return HandStrength{}, err
+	}
+	return se.result(&t), nil
+}
+
+// A strengthOpp is one of the opponent's possible hands.
+type strengthOpp struct {
+	hand   [2]Card
+	cards  CardSet
+	weight float64
+	cur    int // the result of our hand against this one on the current board
+}
+
+// A strengthEvaluator computes hand strength metrics for hole cards
+// on a board.
+type strengthEvaluator struct {
+	hole  [2]Card
+	board []Card
+	opps  []strengthOpp
+	bins  int // the number of histogram bins, or 0 for no histogram
+}
+
+// strengthTotals are the sums used to compute the hand strength
+// metrics, summed over opponent hands and runouts.
+type strengthTotals struct {
+	hp      [3][3]float64 // the weight of each current and final result
+	ehs2    float64       // the sum over runouts of the squared river hand strength
+	runouts float64       // the number of runouts
+	hist    []float64     // the number of runouts in each bin of the histogram
+}
+
+// newTotals returns empty totals, with space for the histogram if
+// there is one.
+func (se *strengthEvaluator) newTotals() strengthTotals {
+	var t strengthTotals
+	if se.bins > 0 {
+		t.hist = make([]float64, se.bins)
+	}
+	return t
+}
+
+func (t *strengthTotals) add(u *strengthTotals) {
+	for i := range t.hp {
+		for j := range t.hp[i] {
+			t.hp[i][j] += u.hp[i][j]
+		}
+	}
+	t.ehs2 += u.ehs2
+	t.runouts += u.runouts
+	for i := range u.hist {
+		t.hist[i] += u.hist[i]
+	}
+}
+
+func compareScores(a, b int16) int {
+	switch {
+	case a > b:
+		return strengthAhead
+	case a == b:
+		return strengthTied
+	}
+	return strengthBehind
+}
+
+// evalHoldemCurrent evaluates the hole cards with a board of 3, 4 or 5
+// cards, returning the score of the best 5-card hand.
+func evalHoldemCurrent(hole [2]Card, board []Card) int16 {
+	c := [7]Card{hole[0], hole[1]}
+	switch 2 + copy(c[2:], board) {
+	case 5:
+		h := [5]Card{c[0], c[1], c[2], c[3], c[4]}
+		return Eval5(&h)
+	case 6:
+		best := int16(-1)
+		for skip := 0; skip < 6; skip++ {
+			var h [5]Card
+			n := 0
+			for i := 0; i < 6; i++ {
+				if i != skip {
+					h[n] = c[i]
+					n++
+				}
+			}
+			if s := Eval5(&h); s > best {
+				best = s
+			}
+		}
+		return best
+	}
+	return Eval7(&c)
+}
+
+// setOpponents finds the opponent's possible hands: either every two
+// cards from the deck, or the combos from the range which only contain
+// cards from the deck.
+func (se *strengthEvaluator) setOpponents(deck []Card, r Range) error {
+	if r == nil {
+		for i := range deck {
+			for j := i + 1; j < len(deck); j++ {
+				se.opps = append(se.opps, strengthOpp{hand: [2]Card{deck[i], deck[j]}, weight: 1})
+			}
+		}
+	} else {
+		avail := NewCardSet(deck...)
+		for _, rc := range r {
+			if rc.Weight > 0 && rc.Hand[0] != rc.Hand[1] && avail.Contains(rc.Hand[0]) && avail.Contains(rc.Hand[1]) {
+				se.opps = append(se.opps, strengthOpp{hand: rc.Hand, weight: rc.Weight})
+			}
+		}
+		if len(se.opps) == 0 {
+			return fmt.Errorf("opponent range has no combos compatible with the hole cards, board %s and dead cards", boardString(se.board))
+		}
+	}
+	var ours int16
+	if len(se.board) > 0 {
+		ours = evalHoldemCurrent(se.hole, se.board)
+	}
+	for i := range se.opps {
+		o := &se.opps[i]
+		o.cards = NewCardSet(o.hand[:]...)
+		o.cur = strengthTied
+		if len(se.board) > 0 {
+			o.cur = compareScores(ours, evalHoldemCurrent(o.hand, se.board))
+		}
+	}
+	return nil
+}
+
+// addRunout adds the results against every opponent hand on the
+// complete board b to the totals. runout is the set of cards which were
+// dealt to complete the board, and opponent hands which contain any of
+// them are skipped. It returns the hand strength on the river, and false
+// if there are no opponent hands.
+func (se *strengthEvaluator) addRunout(t *strengthTotals, b *[5]Card, runout CardSet) (float64, bool) {
+	h := [7]Card{se.hole[0], se.hole[1], b[0], b[1], b[2], b[3], b[4]}
+	ours := Eval7(&h)
+	var ahead, tied, total float64
+	for i := range se.opps {
+		o := &se.opps[i]
+		if o.cards&runout != 0 {
+			continue
+		}
+		h[0], h[1] = o.hand[0], o.hand[1]
+		final := compareScores(ours, Eval7(&h))
+		t.hp[o.cur][final] += o.weight
+		total += o.weight
+		switch final {
+		case strengthAhead:
+			ahead += o.weight
+		case strengthTied:
+			tied += o.weight
+		}
+	}
+	if total == 0 {
+		return 0, false
+	}
+	hs := (ahead + tied/2) / total
+	t.ehs2 += hs * hs
+	t.runouts++
+	if t.hist != nil {
+		bin := int(hs * float64(len(t.hist)))
+		if bin >= len(t.hist) {
+			bin = len(t.hist) - 1
+		}
+		t.hist[bin]++
+	}
+	return hs, true
+}
+
+// exact enumerates every runout from the deck. As in
+// HoldemEquitiesWithOptions, the runouts are split into chunks by their
+// first card, and the chunks are combined in order.
+func (se *strengthEvaluator) exact(ctx context.Context, deck []Card, workers int) (strengthTotals, error) {
+	t := se.newTotals()
+	K := 5 - len(se.board)
+	if K == 0 {
+		var b [5]Card
+		copy(b[:], se.board)
+		se.addRunout(&t, &b, 0)
+		return t, ctx.Err()
+	}
+	if len(deck) < K {
+		return t, fmt.Errorf("only %d cards remain in the deck, but %d are needed to complete the board", len(deck), K)
+	}
+	chunks := len(deck) - K + 1
+	if workers > chunks {
+		workers = chunks
+	}
+	partial := make([]strengthTotals, chunks)
+	work := make(chan int, chunks)
+	for c := 0; c < chunks; c++ {
+		work <- c
+	}
+	close(work)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range work {
+				if ctx.Err() != nil {
+					return
+				}
+				partial[c] = se.chunk(ctx, deck, c, K)
+			}
+		}()
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return t, err
+	}
+	for i := range partial {
+		t.add(&partial[i])
+	}
+	return t, nil
+}
+
+// chunk sums the results over the runouts of K cards whose first card
+// is deck[c].
+func (se *strengthEvaluator) chunk(ctx context.Context, deck []Card, c, K int) strengthTotals {
+	t := se.newTotals()
+	var b [5]Card
+	nb := copy(b[:], se.board)
+	b[nb] = deck[c]
+	rest := deck[c+1:]
+	idxs := make([]int, K-1)
+	for i := range idxs {
+		idxs[i] = i
+	}
+	for n := 1; ; n++ {
+		runout := NewCardSet(deck[c])
+		for j, ix := range idxs {
+			b[nb+1+j] = rest[ix]
+			runout = runout.Add(rest[ix])
+		}
+		se.addRunout(&t, &b, runout)
+		if !incHEIndex(idxs, len(rest)) {
+			break
+		}
+		if n%256 == 0 && ctx.Err() != nil {
+			break
+		}
+	}
+	return t
+}
+
+// sampled sums the results over random runouts from the deck.
+func (se *strengthEvaluator) sampled(deck []Card, opts SampleOptions) (strengthTotals, error) {
+	t := se.newTotals()
+	if err := opts.validate(); err != nil {
+		return t, err
+	}
+	K := 5 - len(se.board)
+	if len(deck) < K {
+		return t, fmt.Errorf("only %d cards remain in the deck, but %d are needed to complete the board", len(deck), K)
+	}
+	intn := rand.Intn
+	if opts.Rand != nil {
+		intn = opts.Rand.Intn
+	}
+	maxSamples := opts.Samples
+	if maxSamples == 0 {
+		maxSamples = DefaultMaxSamples
+	}
+	deck = append([]Card(nil), deck...)
+	var b [5]Card
+	nb := copy(b[:], se.board)
+	var sum, sumSq float64
+	n := 0 // the number of runouts with at least one opponent hand.
+	for T := 1; T <= maxSamples; T++ {
+		// Deal with a partial Fisher-Yates shuffle.
+		var runout CardSet
+		for d := 0; d < K; d++ {
+			k := d + intn(len(deck)-d)
+			deck[d], deck[k] = deck[k], deck[d]
+			b[nb+d] = deck[d]
+			runout = runout.Add(deck[d])
+		}
+		if hs, ok := se.addRunout(&t, &b, runout); ok {
+			sum += hs
+			sumSq += hs * hs
+			n++
+		}
+		if opts.TargetStdErr > 0 && T%sampleCheckInterval == 0 && stdErr(sum, sumSq, n) <= opts.TargetStdErr {
+			break
+		}
+	}
+	return t, nil
+}
+
+// result computes the metrics from the totals.
+func (se *strengthEvaluator) result(t *strengthTotals) HandStrength {
+	var res HandStrength
+	var hpTotal [3]float64
+	var total, wins float64
+	for cur := range t.hp {
+		for _, w := range t.hp[cur] {
+			hpTotal[cur] += w
+		}
+		total += hpTotal[cur]
+		wins += t.hp[cur][strengthAhead] + t.hp[cur][strengthTied]/2
+	}
+	if total > 0 {
+		res.Equity = wins / total
+	}
+	if t.runouts > 0 {
+		res.EHS2 = t.ehs2 / t.runouts
+		if t.hist != nil {
+			res.Histogram = make([]float64, len(t.hist))
+			for i, n := range t.hist {
+				res.Histogram[i] = n / t.runouts
+			}
+		}
+	}
+	if len(se.board) == 0 {
+		res.HS = res.Equity
+		res.EHS = res.Equity
+		return res
+	}
+
+	var ahead, tied, opps float64
+	for _, o := range se.opps {
+		opps += o.weight
+		switch o.cur {
+		case strengthAhead:
+			ahead += o.weight
+		case strengthTied:
+			tied += o.weight
+		}
+	}
+	res.HS = (ahead + tied/2) / opps
+
+	const A, T, B = strengthAhead, strengthTied, strengthBehind
+	if d := hpTotal[B] + hpTotal[T]/2; d > 0 {
+		res.PPot = (t.hp[B][A] + t.hp[B][T]/2 + t.hp[T][A]/2) / d
+	}
+	if d := hpTotal[A] + hpTotal[T]/2; d > 0 {
+		res.NPot = (t.hp[A][B] + t.hp[T][B]/2 + t.hp[A][T]/2) / d
+	}
+	res.EHS = res.HS*(1-res.NPot) + (1-res.HS)*res.PPot
+	return res
+}
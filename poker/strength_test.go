@@ -0,0 +1,255 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// handStrengthSlow computes the hand strength metrics against a uniform
+// random opponent on a flop or turn with the algorithm from Billings
+// et al: for each opponent hand, each runout is considered in turn.
+func handStrengthSlow(t *testing.T, hole [2]Card, board []Card) HandStrength {
+	deck, err := getRemainingDeck([][2]Card{hole}, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hp [3][3]float64
+	var hpTotal [3]float64
+	var hs [3]float64
+	ours := evalHoldemCurrent(hole, board)
+	for i := range deck {
+		for j := i + 1; j < len(deck); j++ {
+			opp := [2]Card{deck[i], deck[j]}
+			cur := compareScores(ours, evalHoldemCurrent(opp, board))
+			hs[cur]++
+			var rest []Card
+			for _, c := range deck {
+				if c != opp[0] && c != opp[1] {
+					rest = append(rest, c)
+				}
+			}
+			idx := make([]int, 5-len(board))
+			for k := range idx {
+				idx[k] = k
+			}
+			for {
+				h := [7]Card{hole[0], hole[1]}
+				o := [7]Card{opp[0], opp[1]}
+				copy(h[2:], board)
+				copy(o[2:], board)
+				for k, ix := range idx {
+					h[2+len(board)+k] = rest[ix]
+					o[2+len(board)+k] = rest[ix]
+				}
+				hp[cur][compareScores(Eval7(&h), Eval7(&o))]++
+				hpTotal[cur]++
+				if !incHEIndex(idx, len(rest)) {
+					break
+				}
+			}
+		}
+	}
+	var res HandStrength
+	res.HS = (hs[0] + hs[1]/2) / (hs[0] + hs[1] + hs[2])
+	res.PPot = (hp[2][0] + hp[2][1]/2 + hp[1][0]/2) / (hpTotal[2] + hpTotal[1]/2)
+	res.NPot = (hp[0][2] + hp[1][2]/2 + hp[0][1]/2) / (hpTotal[0] + hpTotal[1]/2)
+	res.EHS = res.HS*(1-res.NPot) + (1-res.HS)*res.PPot
+	return res
+}
+
+func TestHoldemHandStrengthSlow(t *testing.T) {
+	tcs := []struct {
+		hole, board string
+	}{
+		{"AcKc", "2c7cTd8s"},
+		{"6h5h", "AsKd7h2h"},
+		{"QdQs", "Ac9h4d"},
+	}
+	for _, tc := range tcs {
+		h := mustParseHand(t, tc.hole)
+		hole := [2]Card{h[0], h[1]}
+		board := mustParseHand(t, tc.board)
+		got, err := HoldemHandStrength(hole, board, HandStrengthOptions{})
+		if err != nil {
+			t.Fatalf("HoldemHandStrength(%s, %s) failed: %v", tc.hole, tc.board, err)
+		}
+		want := handStrengthSlow(t, hole, board)
+		for _, f := range []struct {
+			name      string
+			got, want float64
+		}{
+			{"HS", got.HS, want.HS},
+			{"PPot", got.PPot, want.PPot},
+			{"NPot", got.NPot, want.NPot},
+			{"EHS", got.EHS, want.EHS},
+		} {
+			if math.Abs(f.got-f.want) > 1e-9 {
+				t.Errorf("HoldemHandStrength(%s, %s).%s = %f, want %f", tc.hole, tc.board, f.name, f.got, f.want)
+			}
+		}
+	}
+}
+
+func TestHoldemHandStrengthRiver(t *testing.T) {
+	hole := [2]Card{mustParseHand(t, "Ac")[0], mustParseHand(t, "Ah")[0]}
+	board := mustParseHand(t, "AdKsQc7h2d")
+	got, err := HoldemHandStrength(hole, board, HandStrengthOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deck, err := getRemainingDeck([][2]Card{hole}, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wins, n float64
+	for i := range deck {
+		for j := i + 1; j < len(deck); j++ {
+			s := EvalSlow(append(Hand{deck[i], deck[j]}, board...))
+			ours := EvalSlow(append(Hand{hole[0], hole[1]}, board...))
+			switch {
+			case ours > s:
+				wins++
+			case ours == s:
+				wins += 0.5
+			}
+			n++
+		}
+	}
+	hs := wins / n
+	if math.Abs(got.HS-hs) > 1e-9 || got.PPot != 0 || got.NPot != 0 || math.Abs(got.EHS-hs) > 1e-9 || math.Abs(got.EHS2-hs*hs) > 1e-9 || math.Abs(got.Equity-hs) > 1e-9 {
+		t.Errorf("HoldemHandStrength(AcAh, %s) = %+v, want HS = EHS = Equity = %f, EHS2 = %f", Hand(board), got, hs, hs*hs)
+	}
+}
+
+func TestHoldemHandStrengthNuts(t *testing.T) {
+	hole := [2]Card{mustParseHand(t, "Ac")[0], mustParseHand(t, "Kc")[0]}
+	board := mustParseHand(t, "QcJcTc")
+	got, err := HoldemHandStrength(hole, board, HandStrengthOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := HandStrength{HS: 1, PPot: 0, NPot: 0, EHS: 1, EHS2: 1, Equity: 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("HoldemHandStrength(AcKc, QcJcTc) = %+v, want %+v", got, want)
+	}
+}
+
+func TestHoldemHandStrengthWorkers(t *testing.T) {
+	hole := [2]Card{mustParseHand(t, "9s")[0], mustParseHand(t, "8s")[0]}
+	board := mustParseHand(t, "Ts7d2s")
+	var results []HandStrength
+	for _, w := range []int{1, 3, 8} {
+		got, err := HoldemHandStrength(hole, board, HandStrengthOptions{Workers: w, Bins: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, got)
+	}
+	for i := range results {
+		if !reflect.DeepEqual(results[i], results[0]) {
+			t.Errorf("results depend on workers: %+v != %+v", results[i], results[0])
+		}
+	}
+}
+
+func TestHoldemHandStrengthHistogram(t *testing.T) {
+	hole := [2]Card{mustParseHand(t, "Jd")[0], mustParseHand(t, "Td")[0]}
+	board := mustParseHand(t, "9d8c2h")
+	got, err := HoldemHandStrength(hole, board, HandStrengthOptions{Bins: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Histogram) != 20 {
+		t.Fatalf("got %d bins, want 20", len(got.Histogram))
+	}
+	// The mean of the histogram is close to the mean river hand
+	// strength, which is close to the equity.
+	var sum, mean float64
+	for i, p := range got.Histogram {
+		sum += p
+		mean += p * (float64(i) + 0.5) / 20
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("histogram sums to %f, want 1", sum)
+	}
+	if math.Abs(mean-got.Equity) > 1.0/40 {
+		t.Errorf("histogram has mean %f, want close to equity %f", mean, got.Equity)
+	}
+
+	// On the river, all the weight is in the bin of the hand strength.
+	river := append(board, mustParseHand(t, "Qs7s")...)
+	got, err = HoldemHandStrength(hole, river, HandStrengthOptions{Bins: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := make([]float64, 20)
+	want[19] = 1
+	if !reflect.DeepEqual(got.Histogram, want) {
+		t.Errorf("river histogram is %v, want %v", got.Histogram, want)
+	}
+}
+
+// TestHoldemHandStrengthRange checks the equity against a range matches
+// RangeEquities.
+func TestHoldemHandStrengthRange(t *testing.T) {
+	hole := [2]Card{mustParseHand(t, "Ah")[0], mustParseHand(t, "Qh")[0]}
+	board := mustParseHand(t, "Qs8h3c")
+	dead := mustParseHand(t, "2d")
+	opp := mustParseRange(t, "TT+, AK, KQs, 87s:0.5")
+	got, err := HoldemHandStrength(hole, board, HandStrengthOptions{Opponent: opp, Dead: dead})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ours := Range{{Hand: hole, Weight: 1}}
+	req, err := RangeEquities([]Range{ours, opp}, board, RangeOptions{Dead: dead})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(got.Equity-req[0].Equity.Equity) > 1e-9 {
+		t.Errorf("HoldemHandStrength(AhQh, %s, %s).Equity = %f, want %f", Hand(board), "TT+, AK, KQs, 87s:0.5", got.Equity, req[0].Equity.Equity)
+	}
+	if got.HS <= 0 || got.HS >= 1 || got.PPot <= 0 || got.NPot <= 0 {
+		t.Errorf("HoldemHandStrength(AhQh, %s, %s) = %+v, want metrics strictly between 0 and 1", Hand(board), "TT+, AK, KQs, 87s:0.5", got)
+	}
+}
+
+func TestHoldemHandStrengthPreflopSampled(t *testing.T) {
+	hole := [2]Card{mustParseHand(t, "Ac")[0], mustParseHand(t, "Ad")[0]}
+	opts := HandStrengthOptions{Sample: &SampleOptions{Samples: 4000, Rand: rand.New(rand.NewSource(1))}}
+	got, err := HoldemHandStrength(hole, nil, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// AA has 85.2% equity against a random hand.
+	if math.Abs(got.Equity-0.852) > 0.01 || got.HS != got.Equity || got.EHS != got.Equity {
+		t.Errorf("HoldemHandStrength(AcAd) = %+v, want HS = EHS = Equity = 0.852", got)
+	}
+	if got.EHS2 <= 0.852*0.852 || got.EHS2 >= 0.852 {
+		t.Errorf("HoldemHandStrength(AcAd).EHS2 = %f, want between %f and %f", got.EHS2, 0.852*0.852, 0.852)
+	}
+}
+
+func TestHoldemHandStrengthErrors(t *testing.T) {
+	hole := [2]Card{mustParseHand(t, "Ac")[0], mustParseHand(t, "Ad")[0]}
+	tcs := []struct {
+		board string
+		opts  HandStrengthOptions
+	}{
+		{"Kc", HandStrengthOptions{}},
+		{"KcQc", HandStrengthOptions{}},
+		{"KcQcJcTc9c8c", HandStrengthOptions{}},
+		{"KcQcAc", HandStrengthOptions{}},
+		{"KcQcJc", HandStrengthOptions{Dead: mustParseHand(t, "Kc")}},
+		{"KcQcJc", HandStrengthOptions{Opponent: Range{{Hand: hole, Weight: 1}}}},
+		{"KcQcJc", HandStrengthOptions{Sample: &SampleOptions{}}},
+		{"KcQcJc", HandStrengthOptions{Sample: &SampleOptions{Samples: 10, Dead: mustParseHand(t, "2d")}}},
+	}
+	for _, tc := range tcs {
+		board := mustParseHand(t, tc.board)
+		if got, err := HoldemHandStrength(hole, board, tc.opts); err == nil {
+			t.Errorf("HoldemHandStrength(AcAd, %s, %+v) = %+v, want error", tc.board, tc.opts, got)
+		}
+	}
+}
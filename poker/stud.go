@@ -0,0 +1,225 @@
+package poker
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// StudOptions configures how stud equities are computed.
+type StudOptions struct {
+	// Dead are cards which can't be dealt to any hand, for example
+	// the exposed cards of hands which have folded.
+	Dead []Card
+
+	// Sample, if not nil, causes equities to be estimated by sampling
+	// runouts rather than enumerating them. Early in a hand there are
+	// far too many runouts to enumerate, since each hand receives
+	// its own cards.
+	Sample *SampleOptions
+}
+
+// maxStudRunouts is the largest number of runouts that StudEquities
+// will enumerate.
+const maxStudRunouts = 1e8
+
+// StudEquities returns the equities of seven-card stud hands, where
+// each hand is the cards known to be in it (up to 7 of them), and the
+// rest of its 7 cards are dealt from the remaining deck. Hands are
+// evaluated as high hands, as Eval7 does.
+// The hands and dead cards must be distinct. Without sampling, it's an
+// error if there are more than 1e8 runouts to enumerate.
+func StudEquities(hands [][]Card, opts StudOptions) ([]Equity, error) {
+	return studEquities(hands, opts, false)
+}
+
+// StudHiLoEquities returns the equities of seven-card stud hi/lo
+// (eight-or-better) hands. The pot is split between the best high hand
+// and the best qualifying low, and the High, Low and Scoop fields of
+// the results are filled in. The hands and options are as for
+// StudEquities.
+func StudHiLoEquities(hands [][]Card, opts StudOptions) ([]Equity, error) {
+	return studEquities(hands, opts, true)
+}
+
+func studEquities(hands [][]Card, opts StudOptions, hilo bool) ([]Equity, error) {
+	K := 0 // the number of cards to be dealt.
+	for i, h := range hands {
+		if len(h) > 7 {
+			return nil, fmt.Errorf("hand %d has %d cards, more than 7", i, len(h))
+		}
+		K += 7 - len(h)
+	}
+	if err := checkSampleDead(opts.Sample); err != nil {
+		return nil, err
+	}
+	deck, err := getRemainingDeckN(hands, nil, opts.Dead)
+	if err != nil {
+		return nil, err
+	}
+	if len(deck) < K {
+		return nil, fmt.Errorf("only %d cards remain in the deck, but %d are needed to complete the hands", len(deck), K)
+	}
+
+	se := newStudEvaluator(hands, hilo)
+	if opts.Sample != nil {
+		return se.sampled(deck, *opts.Sample)
+	}
+	runouts := 1.0
+	n := len(deck)
+	for _, h := range hands {
+		for k := 0; k < 7-len(h); k++ {
+			runouts *= float64(n-k) / float64(k+1)
+		}
+		n -= 7 - len(h)
+	}
+	if runouts > maxStudRunouts {
+		return nil, fmt.Errorf("there are %.3g runouts, which is too many to enumerate: use sampling instead", runouts)
+	}
+	return se.exact(deck), nil
+}
+
+// A studEvaluator deals cards to stud hands, and evaluates them.
+type studEvaluator struct {
+	hands [][7]Card // the hands, with the known cards at the end
+	need  []int     // the number of cards each hand needs
+	hilo  bool
+
+	his, los []int16 // scratch space for evaluations
+	eqs      []Equity
+}
+
+func newStudEvaluator(hands [][]Card, hilo bool) *studEvaluator {
+	se := &studEvaluator{
+		hands: make([][7]Card, len(hands)),
+		need:  make([]int, len(hands)),
+		hilo:  hilo,
+		his:   make([]int16, len(hands)),
+		los:   make([]int16, len(hands)),
+		eqs:   make([]Equity, len(hands)),
+	}
+	for i, h := range hands {
+		se.need[i] = 7 - len(h)
+		copy(se.hands[i][se.need[i]:], h)
+	}
+	return se
+}
+
+// award evaluates the hands, once they've been dealt, and awards
+// the pot.
+func (se *studEvaluator) award() {
+	for i := range se.hands {
+		se.his[i] = Eval7(&se.hands[i])
+	}
+	if !se.hilo {
+		awardPot(se.his, se.eqs)
+		return
+	}
+	for i := range se.hands {
+		se.los[i] = EvalLow8(se.hands[i][:])
+	}
+	awardPotHiLo(se.his, se.los, se.eqs)
+}
+
+// exact enumerates every way of dealing the deck to the hands.
+func (se *studEvaluator) exact(deck []Card) []Equity {
+	T := 0
+	used := make([]bool, len(deck))
+	var deal func(i int)
+	deal = func(i int) {
+		if i == len(se.hands) {
+			T++
+			se.award()
+			return
+		}
+		K := se.need[i]
+		if K == 0 {
+			deal(i + 1)
+			return
+		}
+		var avail []int
+		for j := range deck {
+			if !used[j] {
+				avail = append(avail, j)
+			}
+		}
+		idxs := make([]int, K)
+		for j := range idxs {
+			idxs[j] = j
+		}
+		for {
+			for j, ix := range idxs {
+				se.hands[i][j] = deck[avail[ix]]
+				used[avail[ix]] = true
+			}
+			deal(i + 1)
+			for _, ix := range idxs {
+				used[avail[ix]] = false
+			}
+			if !incHEIndex(idxs, len(avail)) {
+				break
+			}
+		}
+	}
+	deal(0)
+	for i := range se.eqs {
+		se.eqs[i].scale(T)
+	}
+	return se.eqs
+}
+
+// sampled estimates the equities by dealing random cards from the deck.
+func (se *studEvaluator) sampled(deck []Card, opts SampleOptions) ([]Equity, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	intn := rand.Intn
+	if opts.Rand != nil {
+		intn = opts.Rand.Intn
+	}
+	maxSamples := opts.Samples
+	if maxSamples == 0 {
+		maxSamples = DefaultMaxSamples
+	}
+	prev := make([]float64, len(se.hands))
+	sumSq := make([]float64, len(se.hands))
+
+	T := 0
+	for T < maxSamples {
+		T++
+		// Deal with a partial Fisher-Yates shuffle.
+		d := 0
+		for i := range se.hands {
+			for j := 0; j < se.need[i]; j++ {
+				k := d + intn(len(deck)-d)
+				deck[d], deck[k] = deck[k], deck[d]
+				se.hands[i][j] = deck[d]
+				d++
+			}
+		}
+		for i := range se.eqs {
+			prev[i] = se.eqs[i].Equity
+		}
+		se.award()
+		for i := range se.eqs {
+			d := se.eqs[i].Equity - prev[i]
+			sumSq[i] += d * d
+		}
+		if opts.TargetStdErr > 0 && T%sampleCheckInterval == 0 {
+			done := true
+			for i := range se.eqs {
+				if stdErr(se.eqs[i].Equity, sumSq[i], T) > opts.TargetStdErr {
+					done = false
+					break
+				}
+			}
+			if done {
+				break
+			}
+		}
+	}
+	for i := range se.eqs {
+		se.eqs[i].StdErr = stdErr(se.eqs[i].Equity, sumSq[i], T)
+		se.eqs[i].scale(T)
+	}
+	return se.eqs, nil
+}
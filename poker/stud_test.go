@@ -0,0 +1,152 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestStudEquitiesSixthStreet(t *testing.T) {
+	hands := [][]Card{
+		mustParseHand(t, "SA HA D7 C7 S2 H9"),
+		mustParseHand(t, "SK SQ SJ S4 HK D3"),
+	}
+	dead := mustParseHand(t, "S8 S9 CQ")
+	eqs, err := StudEquities(hands, StudOptions{Dead: dead})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Check against a direct enumeration of the river cards.
+	used := map[Card]bool{}
+	for _, c := range append(append(append([]Card{}, hands[0]...), hands[1]...), dead...) {
+		used[c] = true
+	}
+	var deck []Card
+	for _, c := range Cards {
+		if !used[c] {
+			deck = append(deck, c)
+		}
+	}
+	var want [2]float64
+	n := 0
+	for _, a := range deck {
+		for _, b := range deck {
+			if a == b {
+				continue
+			}
+			h0 := append(append([]Card{}, hands[0]...), a)
+			h1 := append(append([]Card{}, hands[1]...), b)
+			e0, e1 := EvalSlow(h0), EvalSlow(h1)
+			switch {
+			case e0 > e1:
+				want[0]++
+			case e0 < e1:
+				want[1]++
+			default:
+				want[0] += 0.5
+				want[1] += 0.5
+			}
+			n++
+		}
+	}
+	for i := range eqs {
+		if eqs[i].Boards != n {
+			t.Errorf("hand %d has %d runouts, want %d", i, eqs[i].Boards, n)
+		}
+		if w := want[i] / float64(n); math.Abs(eqs[i].Equity-w) > 1e-9 {
+			t.Errorf("hand %d has equity %f, want %f", i, eqs[i].Equity, w)
+		}
+	}
+}
+
+func TestStudEquitiesDeadCards(t *testing.T) {
+	// A flush draw is worse when its suit is dead.
+	hands := [][]Card{
+		mustParseHand(t, "SA S7 S2 S9 HK"),
+		mustParseHand(t, "DQ CQ H3 D8 C5"),
+	}
+	live, err := StudEquities(hands, StudOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dead, err := StudEquities(hands, StudOptions{Dead: mustParseHand(t, "S3 S4 S5 S6 SJ")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dead[0].Equity >= live[0].Equity {
+		t.Errorf("flush draw has equity %f with its suit dead, and %f without, want less", dead[0].Equity, live[0].Equity)
+	}
+}
+
+func TestStudHiLoEquities(t *testing.T) {
+	hands := [][]Card{
+		mustParseHand(t, "SA H2 D4 C6 SK H7"),
+		mustParseHand(t, "HK DK CQ SQ H9 S9"),
+		mustParseHand(t, "C3 C5 C7 C8 DJ C9"),
+	}
+	eqs, err := StudHiLoEquities(hands, StudOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	total := 0.0
+	for i, eq := range eqs {
+		total += eq.Equity
+		if math.Abs(eq.High+eq.Low-eq.Equity) > 1e-9 {
+			t.Errorf("hand %d: high %f + low %f != equity %f", i, eq.High, eq.Low, eq.Equity)
+		}
+	}
+	if math.Abs(total-1) > 1e-9 {
+		t.Errorf("equities sum to %f, want 1", total)
+	}
+	if eqs[1].Low != 0 {
+		t.Errorf("hand 1 can't make a low, but has low equity %f", eqs[1].Low)
+	}
+}
+
+func TestStudEquitiesSampled(t *testing.T) {
+	hands := [][]Card{
+		mustParseHand(t, "SA HA D7 C7 S2"),
+		mustParseHand(t, "SK SQ SJ S4 HK"),
+	}
+	exact, err := StudEquities(hands, StudOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := StudOptions{Sample: &SampleOptions{Samples: 100000, Rand: rand.New(rand.NewSource(1))}}
+	sampled, err := StudEquities(hands, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range exact {
+		if d := math.Abs(sampled[i].Equity - exact[i].Equity); d > 4*sampled[i].StdErr {
+			t.Errorf("hand %d: sampled equity %f±%f, exact %f", i, sampled[i].Equity, sampled[i].StdErr, exact[i].Equity)
+		}
+	}
+}
+
+func TestStudEquitiesErrors(t *testing.T) {
+	testCases := []struct {
+		hands []string
+		dead  string
+	}{
+		{[]string{"SA HA D7 C7 S2 H3 D3 C3", "SK"}, ""},
+		{[]string{"SA HA D7", "SK SQ SJ"}, "SA"},
+		{[]string{"SA HA D7", "SK SQ SJ"}, ""}, // too many runouts
+	}
+	for _, tc := range testCases {
+		var hands [][]Card
+		for _, h := range tc.hands {
+			hands = append(hands, mustParseHand(t, h))
+		}
+		if _, err := StudEquities(hands, StudOptions{Dead: mustParseHand(t, tc.dead)}); err == nil {
+			t.Errorf("StudEquities(%v, dead %q) succeeded, want error", tc.hands, tc.dead)
+		}
+	}
+
+	hands := [][]Card{mustParseHand(t, "SA HA D7"), mustParseHand(t, "SK SQ SJ")}
+	opts := StudOptions{Sample: &SampleOptions{Samples: 10, Dead: mustParseHand(t, "C2")}}
+	if _, err := StudEquities(hands, opts); err == nil {
+		t.Errorf("StudEquities with dead cards in the sample options succeeded, want error")
+	}
+}
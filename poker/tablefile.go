@@ -0,0 +1,659 @@
+package poker
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"unsafe"
+)
+
+// TablesFile is the name of the file of evaluator tables that
+// FindTablesFile looks for.
+const TablesFile = "poker.dat"
+
+// MappedTablesFile is the name of the uncompressed file of evaluator
+// tables that's mapped into memory with -tags mmapdata.
+const MappedTablesFile = "poker.mmap"
+
+// TablesEnv is the environment variable that can be set to the path
+// of a table file, for FindTablesFile. With -tags mmapdata, it's the
+// path of the mapped table file.
+const TablesEnv = "POKER_TABLES"
+
+// tablesFileVersion is the version of the table file format. It must
+// change whenever the layout of the file changes.
+const tablesFileVersion = 3
+
+// tableCRCs are the CRC-32s (IEEE) of the tables in the order returned
+// by packageTables, as the generator makes them. Table files record the
+// CRC of each of their tables, and a file whose CRCs differ from these
+// was made by a different version of the generator, so it's rejected as
+// stale even if its dimensions are right. Whenever the generator changes
+// its output, these must be updated (TestTableCRCs reports the new
+// values) and the table files regenerated.
+var tableCRCs = []uint32{
+	0x97e03a73, // 7 cards
+	0x5504f356, // 5 cards
+	0x2198ce35, // 3 cards
+	0x4234237e, // 2-7 lowball
+	0x0a3a3738, // short deck, flush beats full house
+	0x4079b529, // short deck, trips beat straight
+	0xc70511f5, // razz
+	0x79173476, // badugi
+}
+
+var tablesFileMagic = [4]byte{'P', 'K', 'T', 'B'}
+
+// The number of entries in each of the tables.
+const (
+	table7Size         = 18370*52 + 125672*26
+	table5Size         = 3459 * 52
+	table3Size         = 16 * 16 * 16
+	table27Size        = 3459 * 52
+	tableShortDeckSize = 20455 * 52
+	tableRazzSize      = 26950 * razzRanks
+	tableBadugiSize    = 1938 * 52
+)
+
+// The table file has a header, followed by each of the tables gzipped
+// separately, so that a table can be read without reading the others.
+// The header has:
+//  - the magic bytes "PKTB"
+//  - the format version (uint32)
+//  - the number of tables (uint32)
+//  - for each table, the size in bytes of its entries, the number of
+//    entries, the CRC-32 (IEEE) of its contents, and the offset in the
+//    file and size of its gzipped section (uint32 each)
+//  - the CRC-32 (IEEE) of the header before it (uint32)
+// All numbers are little-endian.
+
+// The indexes of the tables in packageTables.
+const (
+	table7Index = iota
+	table5Index
+	table3Index
+	table27Index
+	tableShortDeckIndex // the first of numShortDeckRules tables
+	tableRazzIndex      = tableShortDeckIndex + int(numShortDeckRules)
+	tableBadugiIndex    = tableRazzIndex + 1
+)
+
+// packageTables returns the tables that are stored in the table file,
+// in the order they're stored.
+func packageTables() []interface{} {
+	tbls := []interface{}{
+		rootNode7table[:],
+		rootNode5table[:],
+		rootNode3table[:],
+		rootNode27table[:],
+	}
+	for rules := range rootNodeShortDeckTable {
+		tbls = append(tbls, rootNodeShortDeckTable[rules][:])
+	}
+	return append(tbls, rootNodeRazzTable[:], rootNodeBadugiTable[:])
+}
+
+// tableDims returns the size of an entry and the number of entries
+// of each of the tables returned by packageTables.
+func tableDims() [][2]uint32 {
+	dims := [][2]uint32{
+		{4, table7Size},
+		{4, table5Size},
+		{2, table3Size},
+		{4, table27Size},
+	}
+	for rules := ShortDeckRules(0); rules < numShortDeckRules; rules++ {
+		dims = append(dims, [2]uint32{4, tableShortDeckSize})
+	}
+	return append(dims, [2]uint32{4, tableRazzSize}, [2]uint32{4, tableBadugiSize})
+}
+
+// tableCRC returns the CRC-32 (IEEE) of the contents of a table.
+func tableCRC(tbl interface{}) uint32 {
+	crc := crc32.NewIEEE()
+	binary.Write(crc, binary.LittleEndian, tbl)
+	return crc.Sum32()
+}
+
+// checkTableCRCs checks that the CRCs of the tables in a table file
+// are those of the tables made by this version of the generator.
+func checkTableCRCs(crcs []uint32) error {
+	for i, want := range tableCRCs {
+		if crcs[i] != want {
+			return fmt.Errorf("table %d has checksum %08x, want %08x: the file is stale, and should be regenerated", i, crcs[i], want)
+		}
+	}
+	return nil
+}
+
+// newTable returns an empty table with the given dimensions.
+func newTable(dims [2]uint32) interface{} {
+	if dims[0] == 2 {
+		return make([]int16, dims[1])
+	}
+	return make([]uint32, dims[1])
+}
+
+// WriteTables writes the evaluator tables to w, in the format that
+// LoadTables reads.
+func WriteTables(w io.Writer) error {
+	if err := Warmup(); err != nil {
+		return err
+	}
+	return writeTableData(w, packageTables())
+}
+
+// tableFileHeaderSize returns the size of the header of a table file
+// with n tables.
+func tableFileHeaderSize(n int) int {
+	return 16 + 20*n
+}
+
+// writeTableData writes tables, each a []uint32 or []int16, to w in
+// the table file format.
+func writeTableData(w io.Writer, tbls []interface{}) error {
+	var header, sections bytes.Buffer
+	for _, x := range []interface{}{tablesFileMagic, uint32(tablesFileVersion), uint32(len(tbls))} {
+		binary.Write(&header, binary.LittleEndian, x)
+	}
+	offset := tableFileHeaderSize(len(tbls))
+	for _, tbl := range tbls {
+		var size uint32
+		switch t := tbl.(type) {
+		case []uint32:
+			size = 4
+		case []int16:
+			size = 2
+		default:
+			return fmt.Errorf("can't write table of type %T", t)
+		}
+		start := sections.Len()
+		zf := gzip.NewWriter(&sections)
+		if err := binary.Write(zf, binary.LittleEndian, tbl); err != nil {
+			return err
+		}
+		if err := zf.Close(); err != nil {
+			return err
+		}
+		entry := [5]uint32{
+			size,
+			uint32(binary.Size(tbl)) / size,
+			tableCRC(tbl),
+			uint32(offset + start),
+			uint32(sections.Len() - start),
+		}
+		binary.Write(&header, binary.LittleEndian, entry)
+	}
+	binary.Write(&header, binary.LittleEndian, crc32.ChecksumIEEE(header.Bytes()))
+	if _, err := w.Write(header.Bytes()); err != nil {
+		return err
+	}
+	_, err := w.Write(sections.Bytes())
+	return err
+}
+
+// WriteTablesFile writes the evaluator tables to the named file, as
+// WriteTables does.
+func WriteTablesFile(name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := WriteTables(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadTables reads evaluator tables written by WriteTables, and
+// replaces the tables used by the evaluators with them. The tables
+// are only replaced if the whole file is read successfully, has the
+// right version, dimensions and checksums, and was written by a
+// program with the same tables as this one.
+//
+// LoadTables must be called before any evaluator is used (for example,
+// at the start of main), because the evaluators read the tables without
+// locking. If any table has already been used, it returns an error
+// and leaves the tables alone.
+func LoadTables(r io.Reader) error {
+	tbls, err := readTableData(r, checkPackageTables)
+	if err != nil {
+		return err
+	}
+	return replaceTables(tbls)
+}
+
+// checkPackageTables checks that the tables in a table file have the
+// dimensions and CRCs of the tables returned by packageTables.
+func checkPackageTables(dims [][2]uint32, crcs []uint32) error {
+	wantDims := tableDims()
+	if len(dims) != len(wantDims) {
+		return fmt.Errorf("table file has %d tables, want %d", len(dims), len(wantDims))
+	}
+	for i, want := range wantDims {
+		if dims[i] != want {
+			return fmt.Errorf("table file: table %d has %d entries of %d bytes, want %d entries of %d bytes", i, dims[i][1], dims[i][0], want[1], want[0])
+		}
+	}
+	if err := checkTableCRCs(crcs); err != nil {
+		return fmt.Errorf("table file: %v", err)
+	}
+	return nil
+}
+
+// maxTableFileTables is the most tables a table file can have.
+const maxTableFileTables = 64
+
+// A tableFileHeader is the header of a table file.
+type tableFileHeader struct {
+	dims     [][2]uint32 // the entry size and number of entries
+	crcs     []uint32    // the CRC-32 of each table
+	sections [][2]uint32 // the offset and size of each gzipped table
+}
+
+// readTableFileHeader reads the header of a table file, and checks
+// its checksum.
+func readTableFileHeader(r io.ReaderAt) (*tableFileHeader, error) {
+	le := binary.LittleEndian
+	var start [12]byte
+	if _, err := r.ReadAt(start[:], 0); err != nil {
+		return nil, fmt.Errorf("reading table file: %v", err)
+	}
+	if !bytes.Equal(start[:4], tablesFileMagic[:]) {
+		return nil, errors.New("not a poker table file")
+	}
+	if version := le.Uint32(start[4:]); version != tablesFileVersion {
+		return nil, fmt.Errorf("table file has format version %d, want %d", version, tablesFileVersion)
+	}
+	n := int(le.Uint32(start[8:]))
+	if n > maxTableFileTables {
+		return nil, fmt.Errorf("table file has %d tables, more than the maximum %d", n, maxTableFileTables)
+	}
+	buf := make([]byte, tableFileHeaderSize(n))
+	if _, err := r.ReadAt(buf, 0); err != nil {
+		return nil, fmt.Errorf("reading table file: %v", err)
+	}
+	end := len(buf) - 4
+	if sum, want := crc32.ChecksumIEEE(buf[:end]), le.Uint32(buf[end:]); sum != want {
+		return nil, fmt.Errorf("table file header checksum is %08x, want %08x", sum, want)
+	}
+	h := &tableFileHeader{
+		dims:     make([][2]uint32, n),
+		crcs:     make([]uint32, n),
+		sections: make([][2]uint32, n),
+	}
+	for i := 0; i < n; i++ {
+		e := buf[12+20*i:]
+		h.dims[i] = [2]uint32{le.Uint32(e), le.Uint32(e[4:])}
+		h.crcs[i] = le.Uint32(e[8:])
+		h.sections[i] = [2]uint32{le.Uint32(e[12:]), le.Uint32(e[16:])}
+	}
+	return h, nil
+}
+
+// readTable reads the i'th table of a table file, and checks its CRC.
+// The table's dimensions should already have been checked, since
+// the table is allocated before it's read.
+func (h *tableFileHeader) readTable(r io.ReaderAt, i int) (interface{}, error) {
+	d, sec := h.dims[i], h.sections[i]
+	if d[0] != 2 && d[0] != 4 {
+		return nil, fmt.Errorf("table %d has entries of %d bytes, want 2 or 4", i, d[0])
+	}
+	zf, err := gzip.NewReader(io.NewSectionReader(r, int64(sec[0]), int64(sec[1])))
+	if err != nil {
+		return nil, fmt.Errorf("table %d: %v", i, err)
+	}
+	tbl := newTable(d)
+	if err := binary.Read(zf, binary.LittleEndian, tbl); err != nil {
+		return nil, fmt.Errorf("table %d: %v", i, err)
+	}
+	// Read to the end of the section, which checks the gzip trailer.
+	if n, err := io.Copy(ioutil.Discard, zf); err != nil || n > 0 {
+		if err == nil {
+			err = errors.New("section is too long")
+		}
+		return nil, fmt.Errorf("table %d: %v", i, err)
+	}
+	if crc := tableCRC(tbl); crc != h.crcs[i] {
+		return nil, fmt.Errorf("table %d has checksum %08x, want %08x", i, crc, h.crcs[i])
+	}
+	return tbl, nil
+}
+
+// readTableData reads all of the tables in the table file format from
+// r. The dimensions and CRCs of the tables are passed to check before
+// they're read, which returns an error if they're not as expected.
+func readTableData(r io.Reader, check func(dims [][2]uint32, crcs []uint32) error) ([]interface{}, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading table file: %v", err)
+	}
+	return readTableFile(bytes.NewReader(data), check)
+}
+
+// readTableFile is like readTableData, but reads from an io.ReaderAt.
+func readTableFile(r io.ReaderAt, check func(dims [][2]uint32, crcs []uint32) error) ([]interface{}, error) {
+	h, err := readTableFileHeader(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := check(h.dims, h.crcs); err != nil {
+		return nil, err
+	}
+	// Read into new tables, so that the current tables are
+	// left alone if there's an error.
+	tbls := make([]interface{}, len(h.dims))
+	for i := range tbls {
+		if tbls[i], err = h.readTable(r, i); err != nil {
+			return nil, fmt.Errorf("table file: %v", err)
+		}
+	}
+	return tbls, nil
+}
+
+// Write writes the table to w, in the same format as WriteTables, so
+// that it can be read by ReadTable. The first table in the file holds
+// the hand size, and the second is the state machine.
+func (t *Table) Write(w io.Writer) error {
+	return writeTableData(w, []interface{}{[]uint32{uint32(t.handSize)}, t.tbl})
+}
+
+// WriteFile writes the table to the named file, as Write does.
+func (t *Table) WriteFile(name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := t.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadTable reads a table written by Table.Write.
+func ReadTable(r io.Reader) (*Table, error) {
+	tbls, err := readTableData(r, func(dims [][2]uint32, crcs []uint32) error {
+		if len(dims) != 2 || dims[0] != [2]uint32{4, 1} || dims[1][0] != 4 || dims[1][1]%52 != 0 || dims[1][1] > maxTableNodes*52 {
+			return errors.New("table file doesn't hold a generated table")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	handSize := int(tbls[0].([]uint32)[0])
+	if handSize < 1 || handSize > 7 {
+		return nil, fmt.Errorf("table file has hand size %d, want 1 to 7", handSize)
+	}
+	return &Table{handSize: handSize, tbl: tbls[1].([]uint32)}, nil
+}
+
+// ReadTableFile reads a table from the named file, as ReadTable does.
+func ReadTableFile(name string) (*Table, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadTable(f)
+}
+
+// LoadTablesFile loads evaluator tables from the named file, as
+// LoadTables does. Like LoadTables, it must be called before any
+// evaluator is used.
+func LoadTablesFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	tbls, err := readTableFile(f, checkPackageTables)
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	return replaceTables(tbls)
+}
+
+// TablesSearchPath returns the places FindTablesFile looks for a
+// table file, in order: TablesFile in the directory of the executable,
+// and TablesFile in the current directory. If the POKER_TABLES
+// environment variable is set, the path is just the file it names.
+func TablesSearchPath() []string {
+	return tablesSearchPath(TablesFile)
+}
+
+func tablesSearchPath(file string) []string {
+	if p := os.Getenv(TablesEnv); p != "" {
+		return []string{p}
+	}
+	var paths []string
+	if exe, err := os.Executable(); err == nil {
+		paths = append(paths, filepath.Join(filepath.Dir(exe), file))
+	}
+	return append(paths, file)
+}
+
+// FindTablesFile returns the first file in TablesSearchPath that exists.
+// If POKER_TABLES names a file that doesn't exist, that's an error,
+// rather than a reason to look elsewhere.
+func FindTablesFile() (string, error) {
+	return findTablesFile(TablesFile)
+}
+
+func findTablesFile(file string) (string, error) {
+	if p := os.Getenv(TablesEnv); p != "" {
+		if _, err := os.Stat(p); err != nil {
+			return "", fmt.Errorf("poker table file named by %s: %v", TablesEnv, err)
+		}
+		return p, nil
+	}
+	paths := tablesSearchPath(file)
+	for _, p := range paths {
+		if _, err := os.Stat(p); err == nil {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("no poker table file found (tried %s)", strings.Join(paths, ", "))
+}
+
+var mappedTablesMagic = [4]byte{'P', 'K', 'T', 'M'}
+
+// The mapped table file is uncompressed, so that it can be mapped into
+// memory and the tables used directly. It has:
+//  - the magic bytes "PKTM"
+//  - the format version (uint32)
+//  - the number of tables (uint32)
+//  - for each table, the size in bytes of its entries and the number
+//    of entries (uint32 each)
+//  - the CRC-32 (IEEE) of the contents of each table (uint32 each)
+//  - the CRC-32 (IEEE) of the header before it (uint32)
+//  - the contents of each table, each starting at a multiple of
+//    64 bytes and padded with zeros before
+// All numbers are little-endian.
+
+// mappedHeaderSize returns the size of the header of a mapped table
+// file with n tables.
+func mappedHeaderSize(n int) int {
+	return 16 + 12*n
+}
+
+// mappedTablesLayout returns the offset of each table in a mapped table
+// file, and the size of the file.
+func mappedTablesLayout(dims [][2]uint32) ([]int, int) {
+	align := func(n int) int { return (n + 63) &^ 63 }
+	offsets := make([]int, len(dims))
+	size := mappedHeaderSize(len(dims))
+	for i, d := range dims {
+		offsets[i] = align(size)
+		size = offsets[i] + int(d[0]*d[1])
+	}
+	return offsets, size
+}
+
+// WriteMappedTables writes the evaluator tables to w in the format used
+// with -tags mmapdata.
+func WriteMappedTables(w io.Writer) error {
+	if err := Warmup(); err != nil {
+		return err
+	}
+	tbls := packageTables()
+	dims := tableDims()
+	crcs := make([]uint32, len(tbls))
+	for i, tbl := range tbls {
+		crcs[i] = tableCRC(tbl)
+	}
+	var header bytes.Buffer
+	for _, x := range []interface{}{mappedTablesMagic, uint32(tablesFileVersion), uint32(len(dims)), dims, crcs} {
+		binary.Write(&header, binary.LittleEndian, x)
+	}
+	binary.Write(&header, binary.LittleEndian, crc32.ChecksumIEEE(header.Bytes()))
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(header.Bytes()); err != nil {
+		return err
+	}
+	offsets, _ := mappedTablesLayout(dims)
+	at := header.Len()
+	for i, tbl := range tbls {
+		if _, err := bw.Write(make([]byte, offsets[i]-at)); err != nil {
+			return err
+		}
+		if err := binary.Write(bw, binary.LittleEndian, tbl); err != nil {
+			return err
+		}
+		at = offsets[i] + int(dims[i][0]*dims[i][1])
+	}
+	return bw.Flush()
+}
+
+// WriteMappedTablesFile writes the evaluator tables to the named file,
+// as WriteMappedTables does.
+func WriteMappedTablesFile(name string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if err := WriteMappedTables(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// checkMappedHeader checks the header of the contents of a mapped table
+// file, and that the file is the right size.
+func checkMappedHeader(data []byte) error {
+	le := binary.LittleEndian
+	wantDims := tableDims()
+	n := len(wantDims)
+	hsize := mappedHeaderSize(n)
+	_, size := mappedTablesLayout(wantDims)
+	if len(data) < hsize {
+		return fmt.Errorf("mapped table file has %d bytes, want %d", len(data), size)
+	}
+	if !bytes.Equal(data[:4], mappedTablesMagic[:]) {
+		return errors.New("not a poker mapped table file")
+	}
+	if version := le.Uint32(data[4:]); version != tablesFileVersion {
+		return fmt.Errorf("mapped table file has format version %d, want %d", version, tablesFileVersion)
+	}
+	if got := le.Uint32(data[8:]); int(got) != n {
+		return fmt.Errorf("mapped table file has %d tables, want %d", got, n)
+	}
+	if sum, want := crc32.ChecksumIEEE(data[:hsize-4]), le.Uint32(data[hsize-4:]); sum != want {
+		return fmt.Errorf("mapped table file header checksum is %08x, want %08x", sum, want)
+	}
+	for i, want := range wantDims {
+		dims := [2]uint32{le.Uint32(data[12+8*i:]), le.Uint32(data[16+8*i:])}
+		if dims != want {
+			return fmt.Errorf("mapped table file: table %d has %d entries of %d bytes, want %d entries of %d bytes", i, dims[1], dims[0], want[1], want[0])
+		}
+	}
+	crcs := make([]uint32, n)
+	for i := range crcs {
+		crcs[i] = le.Uint32(data[12+8*n+4*i:])
+	}
+	if err := checkTableCRCs(crcs); err != nil {
+		return fmt.Errorf("mapped table file: %v", err)
+	}
+	if len(data) != size {
+		return fmt.Errorf("mapped table file has %d bytes, want %d", len(data), size)
+	}
+	return nil
+}
+
+// mappedTable returns the i'th table, in the order returned by
+// packageTables, in the contents of a mapped table file whose header
+// has been checked, after checking the table's checksum. Where
+// possible, the table shares memory with data.
+func mappedTable(data []byte, i int) (interface{}, error) {
+	dims := tableDims()
+	offsets, _ := mappedTablesLayout(dims)
+	b := data[offsets[i] : offsets[i]+int(dims[i][0]*dims[i][1])]
+	want := binary.LittleEndian.Uint32(data[12+8*len(dims)+4*i:])
+	if crc := crc32.ChecksumIEEE(b); crc != want {
+		return nil, fmt.Errorf("table %d has checksum %08x, want %08x", i, crc, want)
+	}
+	return tableFromBytes(b, dims[i]), nil
+}
+
+// mappedTables returns all the tables in the contents of a mapped table
+// file, in the order returned by packageTables, after checking the
+// header and the checksum of each table.
+func mappedTables(data []byte) ([]interface{}, error) {
+	if err := checkMappedHeader(data); err != nil {
+		return nil, err
+	}
+	tbls := make([]interface{}, len(tableDims()))
+	for i := range tbls {
+		var err error
+		if tbls[i], err = mappedTable(data, i); err != nil {
+			return nil, fmt.Errorf("mapped table file: %v", err)
+		}
+	}
+	return tbls, nil
+}
+
+// nativeLittleEndian is whether this machine is little-endian.
+var nativeLittleEndian = func() bool {
+	x := uint16(1)
+	return *(*byte)(unsafe.Pointer(&x)) == 1
+}()
+
+// tableFromBytes returns the table with the given dimensions stored in
+// b, little-endian. If the machine is little-endian and b is suitably
+// aligned, the table uses the same memory as b.
+func tableFromBytes(b []byte, dims [2]uint32) interface{} {
+	n := int(dims[1])
+	p := unsafe.Pointer(&b[0])
+	direct := nativeLittleEndian && uintptr(p)%uintptr(dims[0]) == 0
+	if dims[0] == 2 {
+		if direct {
+			return (*[1 << 28]int16)(p)[:n:n]
+		}
+		t := make([]int16, n)
+		for i := range t {
+			t[i] = int16(binary.LittleEndian.Uint16(b[2*i:]))
+		}
+		return t
+	}
+	if direct {
+		return (*[1 << 28]uint32)(p)[:n:n]
+	}
+	t := make([]uint32, n)
+	for i := range t {
+		t[i] = binary.LittleEndian.Uint32(b[4*i:])
+	}
+	return t
+}
@@ -0,0 +1,222 @@
+package poker
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fixTableFileHeader recomputes the checksum of the header of a table
+// file, after it's been changed.
+func fixTableFileHeader(data []byte) {
+	n := int(binary.LittleEndian.Uint32(data[8:]))
+	end := tableFileHeaderSize(n) - 4
+	binary.LittleEndian.PutUint32(data[end:], crc32.ChecksumIEEE(data[:end]))
+}
+
+func TestTablesFileRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteTables(&buf); err != nil {
+		t.Fatalf("WriteTables failed: %v", err)
+	}
+	data := buf.Bytes()
+	tbls, err := readTableData(bytes.NewReader(data), checkPackageTables)
+	if err != nil {
+		t.Fatalf("reading the tables failed: %v", err)
+	}
+	for i, tbl := range packageTables() {
+		if !reflect.DeepEqual(tbls[i], tbl) {
+			t.Errorf("table %d differs after writing and reading", i)
+		}
+	}
+
+	n := len(tableDims())
+	corrupt := func(f func(d []byte)) []byte {
+		d := append([]byte(nil), data...)
+		f(d)
+		return d
+	}
+	// corruptHeader changes the header, and fixes its checksum.
+	corruptHeader := func(f func(d []byte)) []byte {
+		return corrupt(func(d []byte) {
+			f(d)
+			fixTableFileHeader(d)
+		})
+	}
+	// The 7-card table's section starts straight after the header.
+	section := tableFileHeaderSize(n)
+	for _, tc := range []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"bad magic", corrupt(func(d []byte) { d[0] = 'X' }), "not a poker table file"},
+		{"bad version", corrupt(func(d []byte) { d[4]++ }), "format version"},
+		{"too many tables", corrupt(func(d []byte) { d[10]++ }), "more than the maximum"},
+		{"bad header checksum", corrupt(func(d []byte) { d[16]++ }), "header checksum"},
+		{"bad table count", corruptHeader(func(d []byte) { d[8]-- }), "tables, want"},
+		{"bad dimensions", corruptHeader(func(d []byte) { d[16]++ }), "entries of"},
+		{"stale", corruptHeader(func(d []byte) { d[20]++ }), "stale"},
+		{"bad section", corrupt(func(d []byte) { d[section] = 0 }), "table file: table 0"},
+		{"bad checksum", corrupt(func(d []byte) { d[len(d)/2]++ }), "table file: table"},
+		{"truncated", data[:len(data)-1], "table file: table 7"},
+		{"empty", nil, "reading table file"},
+	} {
+		err := LoadTables(bytes.NewReader(tc.data))
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: LoadTables() = %v, want error containing %q", tc.name, err, tc.want)
+		}
+	}
+
+	// The failed loads must have left the tables alone.
+	h := [7]Card{}
+	copy(h[:], mustParseHand(t, "AsKsQsJsTs2d3c"))
+	if got, want := Eval7(&h), EvalSlow(h[:]); got != want {
+		t.Fatalf("after failed loads, Eval7(%v) = %d, want %d", h, got, want)
+	}
+
+	// The tables have been used, so they can't be replaced.
+	if err := LoadTables(bytes.NewReader(data)); err == nil || !strings.Contains(err.Error(), "after they've been used") {
+		t.Errorf("LoadTables() after using the tables = %v, want error", err)
+	}
+}
+
+// TestLoadTablesFile checks that a table file written by WriteTablesFile
+// can be loaded. The tables can only be loaded before they're used,
+// so the file is loaded in a new process.
+func TestLoadTablesFile(t *testing.T) {
+	if name := os.Getenv("POKER_TEST_TABLES_FILE"); name != "" {
+		if err := LoadTablesFile(name); err != nil {
+			t.Fatalf("LoadTablesFile(%q) failed: %v", name, err)
+		}
+		h := [7]Card{}
+		copy(h[:], mustParseHand(t, "AsKsQsJsTs2d3c"))
+		if got, want := Eval7(&h), EvalSlow(h[:]); got != want {
+			t.Errorf("after loading, Eval7(%v) = %d, want %d", h, got, want)
+		}
+		return
+	}
+	dir, err := ioutil.TempDir("", "pokertables")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, TablesFile)
+	if err := WriteTablesFile(name); err != nil {
+		t.Fatal(err)
+	}
+	inNewProcess(t, "TestLoadTablesFile", "POKER_TEST_TABLES_FILE="+name)
+}
+
+func TestFindTablesFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pokertables")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "tables.dat")
+	if err := ioutil.WriteFile(name, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Setenv(TablesEnv, os.Getenv(TablesEnv))
+	os.Setenv(TablesEnv, name)
+	if got, err := FindTablesFile(); err != nil || got != name {
+		t.Errorf("with %s=%s, FindTablesFile() = %q, %v, want %q", TablesEnv, name, got, err, name)
+	}
+	if paths := TablesSearchPath(); len(paths) != 1 || paths[0] != name {
+		t.Errorf("with %s=%s, TablesSearchPath() = %q, want just that file", TablesEnv, name, paths)
+	}
+
+	// A missing file named by POKER_TABLES is an error, even if there's
+	// a table file elsewhere in the search path.
+	missing := filepath.Join(dir, "missing.dat")
+	os.Setenv(TablesEnv, missing)
+	if got, err := FindTablesFile(); err == nil || !strings.Contains(err.Error(), TablesEnv) {
+		t.Errorf("with %s=%s, FindTablesFile() = %q, %v, want error", TablesEnv, missing, got, err)
+	}
+
+	os.Unsetenv(TablesEnv)
+	if paths := TablesSearchPath(); paths[len(paths)-1] != TablesFile {
+		t.Errorf("TablesSearchPath() = %q, want it to end with %q", paths, TablesFile)
+	}
+}
+
+func TestMappedTables(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteMappedTables(&buf); err != nil {
+		t.Fatalf("WriteMappedTables failed: %v", err)
+	}
+	data := buf.Bytes()
+	tbls, err := mappedTables(data)
+	if err != nil {
+		t.Fatalf("mappedTables failed: %v", err)
+	}
+	for i, tbl := range packageTables() {
+		if !reflect.DeepEqual(tbls[i], tbl) {
+			t.Errorf("table %d differs after writing and mapping", i)
+		}
+	}
+
+	n := len(tableDims())
+	hsize := mappedHeaderSize(n)
+	corrupt := func(f func(d []byte)) []byte {
+		d := append([]byte(nil), data...)
+		f(d)
+		return d
+	}
+	// corruptHeader changes the header, and fixes its checksum.
+	corruptHeader := func(f func(d []byte)) []byte {
+		return corrupt(func(d []byte) {
+			f(d)
+			binary.LittleEndian.PutUint32(d[hsize-4:], crc32.ChecksumIEEE(d[:hsize-4]))
+		})
+	}
+	for _, tc := range []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"bad magic", corrupt(func(d []byte) { d[0] = 'X' }), "not a poker mapped table file"},
+		{"bad version", corrupt(func(d []byte) { d[4]++ }), "format version"},
+		{"bad table count", corrupt(func(d []byte) { d[8]++ }), "tables, want"},
+		{"bad header checksum", corrupt(func(d []byte) { d[16]++ }), "header checksum"},
+		{"bad dimensions", corruptHeader(func(d []byte) { d[16]++ }), "entries of"},
+		{"stale", corruptHeader(func(d []byte) { d[12+8*n]++ }), "stale"},
+		{"bad checksum", corrupt(func(d []byte) { d[len(d)-1]++ }), "table 7 has checksum"},
+		{"truncated", data[:len(data)-1], "bytes, want"},
+		{"empty", nil, "bytes, want"},
+	} {
+		if _, err := mappedTables(tc.data); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: mappedTables() = %v, want error containing %q", tc.name, err, tc.want)
+		}
+	}
+}
+
+// TestTableCRCs checks that tableCRCs are the CRCs of the tables, so
+// that they're kept up to date when the generator changes. It's most
+// useful with -tags gendata, where the tables come from the generator.
+func TestTableCRCs(t *testing.T) {
+	if err := Warmup(); err != nil {
+		t.Fatal(err)
+	}
+	tbls := packageTables()
+	var got []string
+	for _, tbl := range tbls {
+		got = append(got, fmt.Sprintf("0x%08x", tableCRC(tbl)))
+	}
+	if len(tableCRCs) != len(tbls) {
+		t.Fatalf("there are %d table CRCs, want %d: %s", len(tableCRCs), len(tbls), strings.Join(got, ", "))
+	}
+	for i, tbl := range tbls {
+		if crc := tableCRC(tbl); crc != tableCRCs[i] {
+			t.Errorf("table %d has CRC %08x, want %08x: if the generator changed, update tableCRCs to %s", i, crc, tableCRCs[i], strings.Join(got, ", "))
+		}
+	}
+}
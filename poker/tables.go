@@ -0,0 +1,186 @@
+package poker
+
+import (
+	"errors"
+	"sync"
+)
+
+// Each evaluator table is initialized the first time it's used, by
+// the initTable function of the build mode (in tables_static2.go,
+// tables_gen.go, tables_file.go or tables_mmap.go). If that fails, the
+// error is kept with the table, and every use of the table panics with
+// it.
+var (
+	table7Once         sync.Once
+	table5Once         sync.Once
+	table3Once         sync.Once
+	table27Once        sync.Once
+	tableShortDeckOnce sync.Once
+	tableRazzOnce      sync.Once
+	tableBadugiOnce    sync.Once
+)
+
+var (
+	table7Err         error
+	table5Err         error
+	table3Err         error
+	table27Err        error
+	tableShortDeckErr error
+	tableRazzErr      error
+	tableBadugiErr    error
+)
+
+var tableOnces = []*sync.Once{
+	&table7Once,
+	&table5Once,
+	&table3Once,
+	&table27Once,
+	&tableShortDeckOnce,
+	&tableRazzOnce,
+	&tableBadugiOnce,
+}
+
+func loadTable7() error {
+	table7Once.Do(func() { table7Err = initLazily(initTable7) })
+	return table7Err
+}
+
+func loadTable5() error {
+	table5Once.Do(func() { table5Err = initLazily(initTable5) })
+	return table5Err
+}
+
+func loadTable3() error {
+	table3Once.Do(func() { table3Err = initLazily(initTable3) })
+	return table3Err
+}
+
+func loadTable27() error {
+	table27Once.Do(func() { table27Err = initLazily(initTable27) })
+	return table27Err
+}
+
+func loadTableShortDeck() error {
+	tableShortDeckOnce.Do(func() { tableShortDeckErr = initLazily(initTableShortDeck) })
+	return tableShortDeckErr
+}
+
+func loadTableRazz() error {
+	tableRazzOnce.Do(func() { tableRazzErr = initLazily(initTableRazz) })
+	return tableRazzErr
+}
+
+func loadTableBadugi() error {
+	tableBadugiOnce.Do(func() { tableBadugiErr = initLazily(initTableBadugi) })
+	return tableBadugiErr
+}
+
+func needTable7()         { mustLoad(loadTable7()) }
+func needTable5()         { mustLoad(loadTable5()) }
+func needTable3()         { mustLoad(loadTable3()) }
+func needTable27()        { mustLoad(loadTable27()) }
+func needTableShortDeck() { mustLoad(loadTableShortDeck()) }
+func needTableRazz()      { mustLoad(loadTableRazz()) }
+func needTableBadugi()    { mustLoad(loadTableBadugi()) }
+
+// mustLoad panics with err, the error from initializing a table, if it's
+// not nil. An evaluator can't return a meaningful result without its
+// table, and has no way to return an error.
+func mustLoad(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+var (
+	// tablesMu guards tablesUsed and tablesLoaded.
+	tablesMu sync.Mutex
+
+	// tablesUsed is set when any table is first used.
+	tablesUsed bool
+
+	// tablesLoaded is set when the tables are loaded explicitly,
+	// by replaceTables.
+	tablesLoaded bool
+)
+
+// initLazily is called with a table's init function the first time the
+// table is used, and returns the error from init, which is also
+// recorded for TablesError. It doesn't initialize the table if the
+// tables were loaded explicitly.
+func initLazily(init func() error) error {
+	tablesMu.Lock()
+	tablesUsed = true
+	loaded := tablesLoaded
+	tablesMu.Unlock()
+	if loaded {
+		return nil
+	}
+	err := init()
+	if err != nil {
+		setTablesError(err)
+	}
+	return err
+}
+
+// replaceTables replaces the evaluator tables with tbls, which are in
+// the order returned by packageTables. Evaluators read the tables
+// without locking, so it fails if any table has been used already.
+func replaceTables(tbls []interface{}) error {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+	if tablesUsed {
+		return errors.New("can't replace the evaluator tables after they've been used: load them before using any evaluator")
+	}
+	tablesLoaded = true
+	setTables(tbls)
+	return nil
+}
+
+// Warmup initializes all of the evaluator tables. Otherwise, each table
+// is initialized the first time it's used, which takes some time (how
+// long depends on the build mode). Programs that want to pay that cost
+// up front, such as servers, can call Warmup when they start.
+// It returns TablesError, so that a missing or bad table file is
+// reported at startup rather than by the first evaluation panicking.
+// Programs built with -tags filedata or mmapdata should call it.
+func Warmup() error {
+	loadTable7()
+	loadTable5()
+	loadTable3()
+	loadTable27()
+	loadTableShortDeck()
+	loadTableRazz()
+	loadTableBadugi()
+	return TablesError()
+}
+
+var (
+	tablesErrMu sync.Mutex
+	tablesErr   error
+)
+
+// TablesError returns the error, if any, from initializing the evaluator
+// tables so far. With -tags filedata or mmapdata, the tables are read
+// from a file when they're first used, and if the file is missing,
+// stale or corrupt, every evaluator that uses a table that couldn't be
+// read panics with the error. Programs built with those tags should
+// call Warmup at startup, or load the tables explicitly with
+// LoadTablesFile (or MapTablesFile), which return the error directly.
+// In the other build modes the tables can't fail to initialize, and
+// TablesError is nil.
+func TablesError() error {
+	tablesErrMu.Lock()
+	defer tablesErrMu.Unlock()
+	return tablesErr
+}
+
+// setTablesError records an error from initializing the tables, for
+// TablesError. Only the first error is kept.
+func setTablesError(err error) {
+	tablesErrMu.Lock()
+	defer tablesErrMu.Unlock()
+	if tablesErr == nil {
+		tablesErr = err
+	}
+}
@@ -0,0 +1,22 @@
+// +build !mmapdata
+
+package poker
+
+// setTable copies tbl into the i'th evaluator table, in the order
+// returned by packageTables.
+func setTable(i int, tbl interface{}) {
+	switch t := packageTables()[i].(type) {
+	case []uint32:
+		copy(t, tbl.([]uint32))
+	case []int16:
+		copy(t, tbl.([]int16))
+	}
+}
+
+// setTables copies tbls, which are in the order returned by
+// packageTables, into the evaluator tables.
+func setTables(tbls []interface{}) {
+	for i, tbl := range tbls {
+		setTable(i, tbl)
+	}
+}
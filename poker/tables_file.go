@@ -3,39 +3,95 @@
 package poker
 
 import (
-	"compress/gzip"
-	"encoding/binary"
+	"fmt"
 	"os"
+	"sync"
 )
 
 var (
-	rootNode7table [163060 * 52]uint32
-	rootNode5table [3459 * 52]uint32
-	rootNode3table [16 * 16 * 16]int16
+	rootNode7table [table7Size]uint32
+	rootNode5table [table5Size]uint32
+	rootNode3table [table3Size]int16
+
+	rootNode27table [table27Size]uint32
+
+	rootNodeShortDeckTable [numShortDeckRules][tableShortDeckSize]uint32
+
+	rootNodeRazzTable [tableRazzSize]uint32
+
+	rootNodeBadugiTable [tableBadugiSize]uint32
 )
 
-func init() {
-	rf, err := os.Open("poker.dat")
+// In filedata mode, the tables are read from the first table file in
+// TablesSearchPath. The file's header is read the first time any table
+// is used, and each table is read from its own section of the file the
+// first time that table is used, so the file is kept open. If a table
+// can't be read, the table is left empty (all zeros), and its
+// evaluators panic with the error (see needTable7).
+var tablesFile struct {
+	once sync.Once
+	f    *os.File
+	h    *tableFileHeader
+	err  error
+}
+
+// openTablesFile opens the table file and reads its header, the first
+// time it's called.
+func openTablesFile() (*os.File, *tableFileHeader, error) {
+	tf := &tablesFile
+	tf.once.Do(func() {
+		name, err := FindTablesFile()
+		if err != nil {
+			tf.err = err
+			return
+		}
+		f, err := os.Open(name)
+		if err != nil {
+			tf.err = err
+			return
+		}
+		h, err := readTableFileHeader(f)
+		if err == nil {
+			err = checkPackageTables(h.dims, h.crcs)
+		}
+		if err != nil {
+			f.Close()
+			tf.err = fmt.Errorf("%s: %v", name, err)
+			return
+		}
+		tf.f, tf.h = f, h
+	})
+	return tf.f, tf.h, tf.err
+}
+
+// loadFileTable reads the i'th table, in the order returned by
+// packageTables, from the table file.
+func loadFileTable(i int) error {
+	f, h, err := openTablesFile()
 	if err != nil {
-		panic(err)
+		return err
 	}
-	zf, err := gzip.NewReader(rf)
+	tbl, err := h.readTable(f, i)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("%s: table file: %v", f.Name(), err)
 	}
-	if err := binary.Read(zf, binary.LittleEndian, rootNode7table[:]); err != nil {
-		panic(err)
-	}
-	if err := binary.Read(zf, binary.LittleEndian, rootNode5table[:]); err != nil {
-		panic(err)
-	}
-	if err := binary.Read(zf, binary.LittleEndian, rootNode3table[:]); err != nil {
-		panic(err)
-	}
-	if err := zf.Close(); err != nil {
-		panic(err)
-	}
-	if err := rf.Close(); err != nil {
-		panic(err)
+	setTable(i, tbl)
+	return nil
+}
+
+func initTable7() error  { return loadFileTable(table7Index) }
+func initTable5() error  { return loadFileTable(table5Index) }
+func initTable3() error  { return loadFileTable(table3Index) }
+func initTable27() error { return loadFileTable(table27Index) }
+
+func initTableShortDeck() error {
+	for rules := 0; rules < int(numShortDeckRules); rules++ {
+		if err := loadFileTable(tableShortDeckIndex + rules); err != nil {
+			return err
+		}
 	}
+	return nil
 }
+
+func initTableRazz() error   { return loadFileTable(tableRazzIndex) }
+func initTableBadugi() error { return loadFileTable(tableBadugiIndex) }
@@ -0,0 +1,86 @@
+// +build filedata
+
+package poker
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestTablesErrorMissingFile checks that in filedata mode, a missing
+// table file is reported by Warmup and TablesError, and that every
+// evaluation panics with the error rather than returning a meaningless
+// result.
+func TestTablesErrorMissingFile(t *testing.T) {
+	missing := filepath.Join(os.TempDir(), "poker-test-no-such-dir", TablesFile)
+	if !inNewProcess(t, "TestTablesErrorMissingFile", TablesEnv+"="+missing) {
+		return
+	}
+	if err := Warmup(); err == nil {
+		t.Errorf("Warmup() = nil with a missing table file, want error")
+	}
+	if err := TablesError(); err == nil {
+		t.Errorf("TablesError() = nil with %s=%s, want error", TablesEnv, missing)
+	}
+	h := [7]Card{}
+	copy(h[:], mustParseHand(t, "AsKsQsJsTs2d3c"))
+	for i := 0; i < 2; i++ {
+		v := panicValue(func() { Eval7(&h) })
+		if err, ok := v.(error); !ok || err != TablesError() {
+			t.Errorf("Eval7 with a missing table file panicked with %v, want %v", v, TablesError())
+		}
+	}
+}
+
+// TestFileTablesPerTable checks that in filedata mode, each table is
+// read from its own section of the table file when it's first used: a
+// corrupt 7-card table doesn't stop Eval3 from working, and is only
+// reported once Eval7 is used, which panics.
+func TestFileTablesPerTable(t *testing.T) {
+	name := os.Getenv("POKER_TEST_TABLES_FILE")
+	if name == "" {
+		dir, err := ioutil.TempDir("", "pokertables")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		var buf bytes.Buffer
+		if err := WriteTables(&buf); err != nil {
+			t.Fatal(err)
+		}
+		data := buf.Bytes()
+		// Change a byte in the middle of the 7-card table's section.
+		sec := binary.LittleEndian.Uint32(data[12+20*table7Index+12:])
+		size := binary.LittleEndian.Uint32(data[12+20*table7Index+16:])
+		data[sec+size/2]++
+		name = filepath.Join(dir, TablesFile)
+		if err := ioutil.WriteFile(name, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		inNewProcess(t, "TestFileTablesPerTable", TablesEnv+"="+name, "POKER_TEST_TABLES_FILE="+name)
+		return
+	}
+
+	h3 := [3]Card{}
+	copy(h3[:], mustParseHand(t, "KhQhJh"))
+	if got, want := Eval3(&h3), EvalSlow(h3[:]); got != want {
+		t.Errorf("Eval3(%v) = %d, want %d", h3, got, want)
+	}
+	if err := TablesError(); err != nil {
+		t.Errorf("after Eval3, TablesError() = %v, want nil", err)
+	}
+	h7 := [7]Card{}
+	copy(h7[:], mustParseHand(t, "AsKsQsJsTs2d3c"))
+	v := panicValue(func() { Eval7(&h7) })
+	if err, ok := v.(error); !ok || !strings.Contains(err.Error(), "table 0") {
+		t.Errorf("Eval7 panicked with %v, want error about table 0", v)
+	}
+	if err := TablesError(); err == nil || !strings.Contains(err.Error(), "table 0") {
+		t.Errorf("after Eval7, TablesError() = %v, want error about table 0", err)
+	}
+}
@@ -3,31 +3,132 @@
 package poker
 
 var (
-	rootNode7table [163060 * 52]uint32
-	rootNode5table [3459 * 52]uint32
-	rootNode3table [16 * 16 * 16]int16
+	rootNode7table [table7Size]uint32
+	rootNode5table [table5Size]uint32
+	rootNode3table [table3Size]int16
+
+	rootNode27table [table27Size]uint32
+
+	rootNodeShortDeckTable [numShortDeckRules][tableShortDeckSize]uint32
+
+	rootNodeRazzTable [tableRazzSize]uint32
+
+	rootNodeBadugiTable [tableBadugiSize]uint32
 )
 
-func genTables(ncards int, indextable []uint32, node *tblNode, done []bool) int {
-	table := indextable[node.Index*52 : (node.Index+1)*52]
-	if node.N == ncards-1 {
-		for i, t := range node.T {
-			table[i] = uint32(t.rank)
+// genTables7 builds the 7-card table. Once a hand has 5 cards, at most
+// one suit can still make a flush, and the suit transforms map that suit
+// to suit 0 and all the other suits to suit 3. So the nodes for 5- and
+// 6-card hands are narrow: they have 26 entries rather than 52, with
+// card c stored at entry c>>1. Nodes with identical entries are merged.
+// The wide nodes come first in the table, then the narrow nodes for
+// 5-card hands, then the (terminal) narrow nodes for 6-card hands.
+// It returns the number of wide nodes and the number of non-terminal
+// narrow nodes.
+func genTables7(indextable []uint32, root *tblNode) (int, int) {
+	var nodes []*tblNode
+	done := map[*tblNode]bool{root: true}
+	nodes = append(nodes, root)
+	for i := 0; i < len(nodes); i++ {
+		for _, t := range nodes[i].T {
+			if t.N != nil && !done[t.N] {
+				done[t.N] = true
+				nodes = append(nodes, t.N)
+			}
+		}
+	}
+
+	var wideCards, narrowCards []Card
+	for c := Card(0); c < 52; c++ {
+		wideCards = append(wideCards, c)
+		if c&3 == 0 || c&3 == 3 {
+			narrowCards = append(narrowCards, c)
+		}
+	}
+	// cards returns the cards that have entries in the node.
+	cards := func(node *tblNode) []Card {
+		if node.N >= 5 {
+			return narrowCards
+		}
+		return wideCards
+	}
+
+	// Merge nodes from the leaves upwards. rep maps each node to the
+	// node it's merged with.
+	rep := map[*tblNode]*tblNode{}
+	for n := 6; n >= 0; n-- {
+		reps := map[string]*tblNode{}
+		for _, node := range nodes {
+			if node.N != n {
+				continue
+			}
+			var key []byte
+			for _, c := range cards(node) {
+				t := node.T[c]
+				var v uint32
+				if n == 6 {
+					v = uint32(t.rank)
+				} else if t.N != nil {
+					v = uint32(rep[t.N].Index)<<8 | uint32(t.SX.Byte())
+				}
+				key = append(key, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+			}
+			r, ok := reps[string(key)]
+			if !ok {
+				r = node
+				reps[string(key)] = node
+			}
+			rep[node] = r
+		}
+	}
+
+	// Number the merged nodes in breadth-first order, which puts
+	// nodes with fewer cards first.
+	ord := map[*tblNode]int{root: 0}
+	merged := []*tblNode{root}
+	for i := 0; i < len(merged); i++ {
+		for _, t := range merged[i].T {
+			if t.N == nil {
+				continue
+			}
+			if _, ok := ord[rep[t.N]]; !ok {
+				ord[rep[t.N]] = len(merged)
+				merged = append(merged, rep[t.N])
+			}
 		}
-		return 0
 	}
-	S := 0
-	for i, t := range node.T {
-		if t.N == nil {
-			continue
+	wide, narrow := 0, 0
+	for _, node := range merged {
+		if node.N < 5 {
+			wide++
+		} else if node.N == 5 {
+			narrow++
 		}
-		table[i] = (uint32(t.N.Index*52) << 8) | uint32(t.SX.Byte())
-		if !done[t.N.Index] {
-			done[t.N.Index] = true
-			S += genTables(ncards, indextable, t.N, done)
+	}
+	offset := func(node *tblNode) int {
+		i := ord[node]
+		if i < wide {
+			return i * 52
 		}
+		return wide*52 + (i-wide)*26
 	}
-	return 1 + S
+
+	for _, node := range merged {
+		table := indextable[offset(node):]
+		for _, c := range cards(node) {
+			i := int(c)
+			if node.N >= 5 {
+				i = int(c >> 1)
+			}
+			t := node.T[c]
+			if node.N == 6 {
+				table[i] = uint32(t.rank)
+			} else if t.N != nil {
+				table[i] = uint32(offset(rep[t.N]))<<8 | uint32(t.SX.Byte())
+			}
+		}
+	}
+	return wide, narrow
 }
 
 // The 3-card tables are simpler: we build a table with the rank for
@@ -47,11 +148,82 @@ func genTables3(indextable []int16) {
 	}
 }
 
-func init() {
-	p := func(a, b int) {
-		// fmt.Println(a, b)
+// The razz table is a state machine over the ranks of the cards
+// seen so far, since suits don't matter. Each node has an entry for
+// each of the 13 ranks, holding the index of the next node, or for the
+// nodes after 6 cards, the score of the 7-card hand.
+// It returns the number of nodes.
+func genTablesRazz(indextable []uint32) int {
+	type razzNode struct {
+		counts [razzRanks]int
+		n      int
+	}
+	nodes := []razzNode{{}}
+	index := map[[razzRanks]int]int{{}: 0}
+	for i := 0; i < len(nodes); i++ {
+		node := nodes[i]
+		for r := 0; r < razzRanks; r++ {
+			if node.counts[r] == 4 {
+				continue
+			}
+			counts := node.counts
+			counts[r]++
+			if node.n == 6 {
+				var cards []Card
+				for cr, n := range counts {
+					for j := 0; j < n; j++ {
+						cards = append(cards, Card(cr*4))
+					}
+				}
+				indextable[i*razzRanks+r] = uint32(EvalSlowRazz(cards))
+				continue
+			}
+			ni, ok := index[counts]
+			if !ok {
+				ni = len(nodes)
+				index[counts] = ni
+				nodes = append(nodes, razzNode{counts: counts, n: node.n + 1})
+			}
+			indextable[i*razzRanks+r] = uint32(ni * razzRanks)
+		}
 	}
-	p(5, genTables(5, rootNode5table[:], rootNode5(), make([]bool, len(rootNode5table))))
-	p(7, genTables(7, rootNode7table[:], rootNode7(), make([]bool, len(rootNode7table))))
+	return len(nodes)
+}
+
+func initTable7() error {
+	genTables7(rootNode7table[:], rootNode7())
+	return nil
+}
+
+func initTable5() error {
+	genTables(5, rootNode5table[:], rootNode5(), make([]bool, len(rootNode5table)))
+	return nil
+}
+
+func initTable3() error {
 	genTables3(rootNode3table[:])
+	return nil
+}
+
+func initTable27() error {
+	genTables(5, rootNode27table[:], rootNode27(), make([]bool, len(rootNode27table)))
+	return nil
+}
+
+func initTableShortDeck() error {
+	for rules := range rootNodeShortDeckTable {
+		tbl := rootNodeShortDeckTable[rules][:]
+		genTables(7, tbl, rootNodeShortDeck(ShortDeckRules(rules)), make([]bool, len(tbl)))
+	}
+	return nil
+}
+
+func initTableRazz() error {
+	genTablesRazz(rootNodeRazzTable[:])
+	return nil
+}
+
+func initTableBadugi() error {
+	genTables(4, rootNodeBadugiTable[:], rootNodeBadugi(), make([]bool, len(rootNodeBadugiTable)))
+	return nil
 }
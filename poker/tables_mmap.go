@@ -0,0 +1,149 @@
+// +build mmapdata
+
+package poker
+
+import (
+	"fmt"
+	"sync"
+)
+
+// With -tags mmapdata, the tables are mapped read-only into memory from
+// an uncompressed table file, which avoids decompressing them at
+// startup, and lets processes share them via the page cache.
+var (
+	rootNode7table []uint32
+	rootNode5table []uint32
+	rootNode3table []int16
+
+	rootNode27table []uint32
+
+	rootNodeShortDeckTable [numShortDeckRules][]uint32
+
+	rootNodeRazzTable []uint32
+
+	rootNodeBadugiTable []uint32
+)
+
+// setTable points the i'th evaluator table, in the order returned by
+// packageTables, at tbl.
+func setTable(i int, tbl interface{}) {
+	switch {
+	case i == table7Index:
+		rootNode7table = tbl.([]uint32)
+	case i == table5Index:
+		rootNode5table = tbl.([]uint32)
+	case i == table3Index:
+		rootNode3table = tbl.([]int16)
+	case i == table27Index:
+		rootNode27table = tbl.([]uint32)
+	case i < tableRazzIndex:
+		rootNodeShortDeckTable[i-tableShortDeckIndex] = tbl.([]uint32)
+	case i == tableRazzIndex:
+		rootNodeRazzTable = tbl.([]uint32)
+	case i == tableBadugiIndex:
+		rootNodeBadugiTable = tbl.([]uint32)
+	}
+}
+
+// setTables points the evaluator tables at tbls, which are in the
+// order returned by packageTables.
+func setTables(tbls []interface{}) {
+	for i, tbl := range tbls {
+		setTable(i, tbl)
+	}
+}
+
+// MapTablesFile maps the named table file, as written by
+// WriteMappedTables, read-only into memory, and uses it for the
+// evaluator tables. The checksums of all the tables are checked when
+// they're mapped.
+//
+// Like LoadTables, MapTablesFile must be called before any evaluator
+// is used, and returns an error if any table has already been used.
+func MapTablesFile(name string) error {
+	data, err := mapFile(name)
+	if err != nil {
+		return err
+	}
+	tbls, err := mappedTables(data)
+	if err == nil {
+		err = replaceTables(tbls)
+	}
+	if err != nil {
+		unmapFile(data)
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	return nil
+}
+
+// In mmapdata mode, the first mapped table file in the search path (see
+// TablesSearchPath) is mapped, and its header checked, the first time
+// any table is used. Each table's checksum is checked the first time
+// that table is used, so that only the tables that are used are read.
+// If a table can't be used, the table is left empty (all zeros), and
+// its evaluators panic with the error (see needTable7).
+var mappedTablesFile struct {
+	once sync.Once
+	name string
+	data []byte
+	err  error
+}
+
+// mapTablesFile maps the mapped table file and checks its header, the
+// first time it's called.
+func mapTablesFile() (string, []byte, error) {
+	mf := &mappedTablesFile
+	mf.once.Do(func() {
+		name, err := findTablesFile(MappedTablesFile)
+		if err != nil {
+			mf.err = err
+			return
+		}
+		data, err := mapFile(name)
+		if err != nil {
+			mf.err = err
+			return
+		}
+		if err := checkMappedHeader(data); err != nil {
+			unmapFile(data)
+			mf.err = fmt.Errorf("%s: %v", name, err)
+			return
+		}
+		mf.name, mf.data = name, data
+	})
+	return mf.name, mf.data, mf.err
+}
+
+// mapFileTable sets the i'th table, in the order returned by
+// packageTables, from the mapped table file.
+func mapFileTable(i int) error {
+	name, data, err := mapTablesFile()
+	var tbl interface{}
+	if err == nil {
+		if tbl, err = mappedTable(data, i); err != nil {
+			err = fmt.Errorf("%s: mapped table file: %v", name, err)
+		}
+	}
+	if err != nil {
+		tbl = newTable(tableDims()[i])
+	}
+	setTable(i, tbl)
+	return err
+}
+
+func initTable7() error  { return mapFileTable(table7Index) }
+func initTable5() error  { return mapFileTable(table5Index) }
+func initTable3() error  { return mapFileTable(table3Index) }
+func initTable27() error { return mapFileTable(table27Index) }
+
+func initTableShortDeck() error {
+	for rules := 0; rules < int(numShortDeckRules); rules++ {
+		if err := mapFileTable(tableShortDeckIndex + rules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func initTableRazz() error   { return mapFileTable(tableRazzIndex) }
+func initTableBadugi() error { return mapFileTable(tableBadugiIndex) }
@@ -0,0 +1,15 @@
+// +build mmapdata,!linux
+
+package poker
+
+import "io/ioutil"
+
+// mapFile reads the named file into memory. Only on Linux is the file
+// actually mapped.
+func mapFile(name string) ([]byte, error) {
+	return ioutil.ReadFile(name)
+}
+
+func unmapFile(data []byte) error {
+	return nil
+}
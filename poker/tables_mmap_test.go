@@ -0,0 +1,114 @@
+// +build mmapdata
+
+package poker
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestTablesErrorMissingFile checks that in mmapdata mode, a missing
+// table file is reported by Warmup and TablesError, and that every
+// evaluation panics with the error rather than returning a meaningless
+// result.
+func TestTablesErrorMissingFile(t *testing.T) {
+	missing := filepath.Join(os.TempDir(), "poker-test-no-such-dir", MappedTablesFile)
+	if !inNewProcess(t, "TestTablesErrorMissingFile", TablesEnv+"="+missing) {
+		return
+	}
+	if err := Warmup(); err == nil {
+		t.Errorf("Warmup() = nil with a missing table file, want error")
+	}
+	if err := TablesError(); err == nil {
+		t.Errorf("TablesError() = nil with %s=%s, want error", TablesEnv, missing)
+	}
+	h := [7]Card{}
+	copy(h[:], mustParseHand(t, "AsKsQsJsTs2d3c"))
+	for i := 0; i < 2; i++ {
+		v := panicValue(func() { Eval7(&h) })
+		if err, ok := v.(error); !ok || err != TablesError() {
+			t.Errorf("Eval7 with a missing table file panicked with %v, want %v", v, TablesError())
+		}
+	}
+}
+
+// TestMapTablesFile checks that a mapped table file can be mapped
+// before the tables are used, but not after.
+func TestMapTablesFile(t *testing.T) {
+	h := [7]Card{}
+	copy(h[:], mustParseHand(t, "AsKsQsJsTs2d3c"))
+	if name := os.Getenv("POKER_TEST_TABLES_FILE"); name != "" {
+		if err := MapTablesFile(name); err != nil {
+			t.Fatalf("MapTablesFile(%q) failed: %v", name, err)
+		}
+		if got, want := Eval7(&h), EvalSlow(h[:]); got != want {
+			t.Errorf("after mapping, Eval7(%v) = %d, want %d", h, got, want)
+		}
+		return
+	}
+	dir, err := ioutil.TempDir("", "pokertables")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, MappedTablesFile)
+	if err := WriteMappedTablesFile(name); err != nil {
+		t.Fatal(err)
+	}
+	inNewProcess(t, "TestMapTablesFile", "POKER_TEST_TABLES_FILE="+name)
+
+	// WriteMappedTablesFile used the tables, so they can't be replaced.
+	if err := MapTablesFile(name); err == nil {
+		t.Errorf("MapTablesFile(%q) after using the tables succeeded, want error", name)
+	}
+}
+
+// TestMappedTablesPerTable checks that in mmapdata mode, each table's
+// checksum is only checked when the table is first used: a corrupt
+// 7-card table doesn't stop Eval3 from working, and is only reported
+// once Eval7 is used, which panics.
+func TestMappedTablesPerTable(t *testing.T) {
+	name := os.Getenv("POKER_TEST_TABLES_FILE")
+	if name == "" {
+		dir, err := ioutil.TempDir("", "pokertables")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		var buf bytes.Buffer
+		if err := WriteMappedTables(&buf); err != nil {
+			t.Fatal(err)
+		}
+		data := buf.Bytes()
+		offsets, _ := mappedTablesLayout(tableDims())
+		data[offsets[table7Index]+1000]++
+		name = filepath.Join(dir, MappedTablesFile)
+		if err := ioutil.WriteFile(name, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		inNewProcess(t, "TestMappedTablesPerTable", TablesEnv+"="+name, "POKER_TEST_TABLES_FILE="+name)
+		return
+	}
+
+	h3 := [3]Card{}
+	copy(h3[:], mustParseHand(t, "KhQhJh"))
+	if got, want := Eval3(&h3), EvalSlow(h3[:]); got != want {
+		t.Errorf("Eval3(%v) = %d, want %d", h3, got, want)
+	}
+	if err := TablesError(); err != nil {
+		t.Errorf("after Eval3, TablesError() = %v, want nil", err)
+	}
+	h7 := [7]Card{}
+	copy(h7[:], mustParseHand(t, "AsKsQsJsTs2d3c"))
+	v := panicValue(func() { Eval7(&h7) })
+	if err, ok := v.(error); !ok || !strings.Contains(err.Error(), "table 0") {
+		t.Errorf("Eval7 panicked with %v, want error about table 0", v)
+	}
+	if err := TablesError(); err == nil || !strings.Contains(err.Error(), "table 0") {
+		t.Errorf("after Eval7, TablesError() = %v, want error about table 0", err)
+	}
+}
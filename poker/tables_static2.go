@@ -1,4 +1,4 @@
-// +build !gendata,!filedata
+// +build !gendata,!filedata,!mmapdata
 
 package poker
 
@@ -10,9 +10,17 @@ import (
 )
 
 var (
-	rootNode7table [163060 * 52]uint32
-	rootNode5table [3459 * 52]uint32
-	rootNode3table [16 * 16 * 16]int16
+	rootNode7table [table7Size]uint32
+	rootNode5table [table5Size]uint32
+	rootNode3table [table3Size]int16
+
+	rootNode27table [table27Size]uint32
+
+	rootNodeShortDeckTable [numShortDeckRules][tableShortDeckSize]uint32
+
+	rootNodeRazzTable [tableRazzSize]uint32
+
+	rootNodeBadugiTable [tableBadugiSize]uint32
 )
 
 // denorm undoes some crunching performed by gen_tables_static.go.
@@ -28,26 +36,85 @@ func denorm(tbl []uint32, n int) {
 	}
 }
 
-func init() {
-	rf := bytes.NewReader(pokerTableData)
+// denorm7 is like denorm, but for the 7-card table, which has n wide
+// nodes of 52 entries followed by nodes of 26 entries, of which the
+// first m are non-terminal.
+func denorm7(tbl []uint32, n, m int) {
+	offset := func(i uint32) uint32 {
+		if i < uint32(n) {
+			return i * 52
+		}
+		return uint32(n)*52 + (i-uint32(n))*26
+	}
+	for i := 0; i < n*52+m*26; i++ {
+		if tbl[i] == 0 {
+			continue
+		}
+		ni := uint32(i / 52)
+		if i >= n*52 {
+			ni = uint32(n + (i-n*52)/26)
+		}
+		ix := tbl[i] >> 8
+		tbl[i] = (tbl[i] & 0xff) | offset(ix+ni)<<8
+	}
+}
+
+// readStaticTable decompresses the data for a table, as written by
+// gen_tables_static.go, into tbl.
+func readStaticTable(data []uint8, tbl interface{}) {
+	rf := bytes.NewReader(data)
 	d64f := base64.NewDecoder(base64.RawStdEncoding, rf)
 	f, err := gzip.NewReader(d64f)
 	if err != nil {
 		panic(err)
 	}
-	if err := binary.Read(f, binary.LittleEndian, rootNode7table[:]); err != nil {
-		panic(err)
-	}
-	if err := binary.Read(f, binary.LittleEndian, rootNode5table[:]); err != nil {
-		panic(err)
-	}
-	if err := binary.Read(f, binary.LittleEndian, rootNode3table[:]); err != nil {
+	if err := binary.Read(f, binary.LittleEndian, tbl); err != nil {
 		panic(err)
 	}
 	if err := f.Close(); err != nil {
 		panic(err)
 	}
+}
+
+func initTable7() error {
+	readStaticTable(pokerTable7Data, rootNode7table[:])
+	denorm7(rootNode7table[:], 18370, 42783)
+	return nil
+}
 
+func initTable5() error {
+	readStaticTable(pokerTable5Data, rootNode5table[:])
 	denorm(rootNode5table[:], 924)
-	denorm(rootNode7table[:], 61153)
+	return nil
+}
+
+func initTable3() error {
+	readStaticTable(pokerTable3Data, rootNode3table[:])
+	return nil
+}
+
+func initTable27() error {
+	readStaticTable(pokerTable27Data, rootNode27table[:])
+	denorm(rootNode27table[:], 924)
+	return nil
+}
+
+func initTableShortDeck() error {
+	data := [numShortDeckRules][]uint8{pokerTableShortDeck0Data, pokerTableShortDeck1Data}
+	for rules := range rootNodeShortDeckTable {
+		readStaticTable(data[rules], rootNodeShortDeckTable[rules][:])
+		denorm(rootNodeShortDeckTable[rules][:], 10645)
+	}
+	return nil
+}
+
+func initTableRazz() error {
+	readStaticTable(pokerTableRazzData, rootNodeRazzTable[:])
+	return nil
+}
+
+func initTableBadugi() error {
+	readStaticTable(pokerTableBadugiData, rootNodeBadugiTable[:])
+	denorm(rootNodeBadugiTable[:], 183)
+	return nil
 }
@@ -0,0 +1,103 @@
+package poker
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+)
+
+// inNewProcess reports whether the named test is running in a new
+// process started by an earlier call of inNewProcess. If it isn't, it
+// runs the test again in a new process, with the given extra environment
+// variables, and fails if it fails. It's used by tests that need tables
+// which haven't been initialized yet.
+func inNewProcess(t *testing.T, name string, env ...string) bool {
+	if os.Getenv("POKER_TEST_NEW_PROCESS") == name {
+		return true
+	}
+	cmd := exec.Command(os.Args[0], "-test.run=^"+name+"$", "-test.v")
+	cmd.Env = append(append(os.Environ(), "POKER_TEST_NEW_PROCESS="+name), env...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("running %s in a new process failed: %v\n%s", name, err, out)
+	}
+	return false
+}
+
+// panicValue calls f, and returns the value it panics with, or nil if
+// it doesn't panic.
+func panicValue(f func()) (v interface{}) {
+	defer func() { v = recover() }()
+	f()
+	return nil
+}
+
+// tableEmpty reports whether a table is empty or all zeros, as it is
+// before it's initialized.
+func tableEmpty(tbl interface{}) bool {
+	switch t := tbl.(type) {
+	case []uint32:
+		for _, x := range t {
+			if x != 0 {
+				return false
+			}
+		}
+	case []int16:
+		for _, x := range t {
+			if x != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// TestEval3Lazy checks that Eval3 works without initializing any of the
+// other tables. It checks the contents of the tables rather than just
+// their sync.Onces, so that with -tags filedata or mmapdata it checks
+// that the other tables weren't read from the table file.
+func TestEval3Lazy(t *testing.T) {
+	if !inNewProcess(t, "TestEval3Lazy") {
+		return
+	}
+
+	for _, s := range []string{"AcAdAh", "2c7d9s", "KhQhJh"} {
+		var h [3]Card
+		copy(h[:], mustParseHand(t, s))
+		if got, want := Eval3(&h), EvalSlow(h[:]); got != want {
+			t.Errorf("Eval3(%s) = %d, want %d", s, got, want)
+		}
+	}
+	for i, once := range tableOnces {
+		if once == &table3Once {
+			continue
+		}
+		initialized := true
+		once.Do(func() { initialized = false })
+		if initialized {
+			t.Errorf("table %d was initialized by Eval3", i)
+		}
+	}
+	for i, tbl := range packageTables() {
+		if empty := tableEmpty(tbl); empty != (i != table3Index) {
+			t.Errorf("after Eval3, table %d is empty: %v, want %v", i, empty, i != table3Index)
+		}
+	}
+}
+
+func TestWarmup(t *testing.T) {
+	if err := Warmup(); err != nil {
+		t.Fatalf("Warmup failed: %v", err)
+	}
+	for i, once := range tableOnces {
+		initialized := true
+		once.Do(func() { initialized = false })
+		if !initialized {
+			t.Errorf("table %d wasn't initialized by Warmup", i)
+		}
+	}
+	h := [7]Card{}
+	copy(h[:], mustParseHand(t, "AsKsQsJsTs2d3c"))
+	if got, want := Eval7(&h), EvalSlow(h[:]); got != want {
+		t.Errorf("after Warmup, Eval7(%v) = %d, want %d", h, got, want)
+	}
+}